@@ -41,6 +41,7 @@ import (
 	"github.com/PokeForum/PokeForum/internal/pkg/asynq"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
 	"github.com/PokeForum/PokeForum/internal/pkg/logging"
+	"github.com/PokeForum/PokeForum/internal/pkg/storage"
 	"github.com/PokeForum/PokeForum/internal/service"
 	"github.com/PokeForum/PokeForum/internal/utils"
 )
@@ -111,6 +112,29 @@ func RunServer() {
 	signinAsyncTask := service.NewSigninAsyncTask(configs.DB, taskManager, configs.Log)
 	signinAsyncTask.RegisterHandler()
 
+	// 初始化附件存储
+	store, err := storage.New(configs.Config.Storage)
+	if err != nil {
+		configs.Log.Error("Storage initializer failed", zap.Error(err))
+		return
+	}
+
+	// 注册图片处理任务处理器
+	imageTask := service.NewImageProcessTask(configs.DB, store, taskManager, configs.Log)
+	imageTask.RegisterHandler()
+
+	// 注册站内通知任务处理器
+	notificationTask := service.NewNotificationTask(configs.DB, cacheService, taskManager, configs.Log)
+	notificationTask.RegisterHandler()
+
+	// 注册提及提醒任务处理器
+	mentionTask := service.NewMentionTask(configs.DB, cacheService, taskManager, notificationTask, configs.Log)
+	mentionTask.RegisterHandler()
+
+	// 注册徽章评估任务处理器
+	badgeTask := service.NewBadgeTask(configs.DB, taskManager, configs.Log)
+	badgeTask.RegisterHandler()
+
 	// 注册统计数据同步任务处理器和定时任务(每5分钟同步一次)
 	syncTask := service.NewStatsSyncTask(configs.DB, cacheService, taskManager, configs.Log)
 	syncTask.RegisterHandler()
@@ -118,6 +142,20 @@ func RunServer() {
 		configs.Log.Error("注册统计同步定时任务失败", zap.Error(err))
 	}
 
+	// 注册帖子热度榜刷新任务处理器和定时任务(每10分钟刷新一次)
+	hotTask := service.NewPostHotTask(configs.DB, cacheService, taskManager, configs.Log)
+	hotTask.RegisterHandler()
+	if err := hotTask.RegisterSchedule(10 * time.Minute); err != nil {
+		configs.Log.Error("注册热度榜刷新定时任务失败", zap.Error(err))
+	}
+
+	// 注册用户组成员过期清理任务处理器和定时任务(每10分钟清理一次)
+	userGroupExpireTask := service.NewUserGroupExpireTask(configs.DB, cacheService, taskManager, configs.Log)
+	userGroupExpireTask.RegisterHandler()
+	if err := userGroupExpireTask.RegisterSchedule(10 * time.Minute); err != nil {
+		configs.Log.Error("注册用户组成员过期清理定时任务失败", zap.Error(err))
+	}
+
 	// 启动asynq任务服务器
 	if err := taskManager.Start(); err != nil {
 		configs.Log.Error("启动asynq任务服务器失败", zap.Error(err))
@@ -126,10 +164,17 @@ func RunServer() {
 
 	// 启动时立即执行一次统计同步
 	syncTask.SyncNow(context.Background())
+	hotTask.RefreshNow(context.Background())
+	userGroupExpireTask.CleanNow(context.Background())
 
 	// 将SigninAsyncTask注入到injector供SigninService使用
 	do.ProvideValue(injector, signinAsyncTask)
 	do.ProvideValue(injector, taskManager)
+	do.ProvideValue(injector, imageTask)
+	do.ProvideValue(injector, mentionTask)
+	do.ProvideValue(injector, notificationTask)
+	do.ProvideValue(injector, badgeTask)
+	do.ProvideValue(injector, store)
 
 	// 注册路由
 	router := initializer.Routers(injector)
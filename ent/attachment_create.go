@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/attachment"
+)
+
+// AttachmentCreate is the builder for creating a Attachment entity.
+type AttachmentCreate struct {
+	config
+	mutation *AttachmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AttachmentCreate) SetCreatedAt(v time.Time) *AttachmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AttachmentCreate) SetNillableCreatedAt(v *time.Time) *AttachmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AttachmentCreate) SetUpdatedAt(v time.Time) *AttachmentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AttachmentCreate) SetNillableUpdatedAt(v *time.Time) *AttachmentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AttachmentCreate) SetUserID(v int) *AttachmentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *AttachmentCreate) SetPostID(v int) *AttachmentCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_c *AttachmentCreate) SetNillablePostID(v *int) *AttachmentCreate {
+	if v != nil {
+		_c.SetPostID(*v)
+	}
+	return _c
+}
+
+// SetCommentID sets the "comment_id" field.
+func (_c *AttachmentCreate) SetCommentID(v int) *AttachmentCreate {
+	_c.mutation.SetCommentID(v)
+	return _c
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (_c *AttachmentCreate) SetNillableCommentID(v *int) *AttachmentCreate {
+	if v != nil {
+		_c.SetCommentID(*v)
+	}
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *AttachmentCreate) SetFilename(v string) *AttachmentCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *AttachmentCreate) SetHash(v string) *AttachmentCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *AttachmentCreate) SetStorageKey(v string) *AttachmentCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_c *AttachmentCreate) SetMimeType(v string) *AttachmentCreate {
+	_c.mutation.SetMimeType(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *AttachmentCreate) SetSize(v int64) *AttachmentCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (_c *AttachmentCreate) SetThumbnailKey(v string) *AttachmentCreate {
+	_c.mutation.SetThumbnailKey(v)
+	return _c
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (_c *AttachmentCreate) SetNillableThumbnailKey(v *string) *AttachmentCreate {
+	if v != nil {
+		_c.SetThumbnailKey(*v)
+	}
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *AttachmentCreate) SetWidth(v int) *AttachmentCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_c *AttachmentCreate) SetNillableWidth(v *int) *AttachmentCreate {
+	if v != nil {
+		_c.SetWidth(*v)
+	}
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *AttachmentCreate) SetHeight(v int) *AttachmentCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_c *AttachmentCreate) SetNillableHeight(v *int) *AttachmentCreate {
+	if v != nil {
+		_c.SetHeight(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AttachmentCreate) SetID(v int) *AttachmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (_c *AttachmentCreate) Mutation() *AttachmentMutation {
+	return _c.mutation
+}
+
+// Save creates the Attachment in the database.
+func (_c *AttachmentCreate) Save(ctx context.Context) (*Attachment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AttachmentCreate) SaveX(ctx context.Context) *Attachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttachmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttachmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AttachmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := attachment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := attachment.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		v := attachment.DefaultPostID
+		_c.mutation.SetPostID(v)
+	}
+	if _, ok := _c.mutation.CommentID(); !ok {
+		v := attachment.DefaultCommentID
+		_c.mutation.SetCommentID(v)
+	}
+	if _, ok := _c.mutation.ThumbnailKey(); !ok {
+		v := attachment.DefaultThumbnailKey
+		_c.mutation.SetThumbnailKey(v)
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		v := attachment.DefaultWidth
+		_c.mutation.SetWidth(v)
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		v := attachment.DefaultHeight
+		_c.mutation.SetHeight(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AttachmentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Attachment.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Attachment.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Attachment.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := attachment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "Attachment.post_id"`)}
+	}
+	if v, ok := _c.mutation.PostID(); ok {
+		if err := attachment.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.post_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CommentID(); !ok {
+		return &ValidationError{Name: "comment_id", err: errors.New(`ent: missing required field "Attachment.comment_id"`)}
+	}
+	if v, ok := _c.mutation.CommentID(); ok {
+		if err := attachment.CommentIDValidator(v); err != nil {
+			return &ValidationError{Name: "comment_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.comment_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "Attachment.filename"`)}
+	}
+	if v, ok := _c.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "Attachment.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := attachment.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "Attachment.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "Attachment.storage_key"`)}
+	}
+	if v, ok := _c.mutation.StorageKey(); ok {
+		if err := attachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.storage_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MimeType(); !ok {
+		return &ValidationError{Name: "mime_type", err: errors.New(`ent: missing required field "Attachment.mime_type"`)}
+	}
+	if v, ok := _c.mutation.MimeType(); ok {
+		if err := attachment.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.mime_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Attachment.size"`)}
+	}
+	if v, ok := _c.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ThumbnailKey(); !ok {
+		return &ValidationError{Name: "thumbnail_key", err: errors.New(`ent: missing required field "Attachment.thumbnail_key"`)}
+	}
+	if v, ok := _c.mutation.ThumbnailKey(); ok {
+		if err := attachment.ThumbnailKeyValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.thumbnail_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Attachment.width"`)}
+	}
+	if v, ok := _c.mutation.Width(); ok {
+		if err := attachment.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Attachment.width": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Attachment.height"`)}
+	}
+	if v, ok := _c.mutation.Height(); ok {
+		if err := attachment.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Attachment.height": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := attachment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Attachment.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AttachmentCreate) sqlSave(ctx context.Context) (*Attachment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AttachmentCreate) createSpec() (*Attachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Attachment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(attachment.Table, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(attachment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(attachment.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.PostID(); ok {
+		_spec.SetField(attachment.FieldPostID, field.TypeInt, value)
+		_node.PostID = value
+	}
+	if value, ok := _c.mutation.CommentID(); ok {
+		_spec.SetField(attachment.FieldCommentID, field.TypeInt, value)
+		_node.CommentID = value
+	}
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(attachment.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := _c.mutation.MimeType(); ok {
+		_spec.SetField(attachment.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.ThumbnailKey(); ok {
+		_spec.SetField(attachment.FieldThumbnailKey, field.TypeString, value)
+		_node.ThumbnailKey = value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(attachment.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(attachment.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	return _node, _spec
+}
+
+// AttachmentCreateBulk is the builder for creating many Attachment entities in bulk.
+type AttachmentCreateBulk struct {
+	config
+	err      error
+	builders []*AttachmentCreate
+}
+
+// Save creates the Attachment entities in the database.
+func (_c *AttachmentCreateBulk) Save(ctx context.Context) ([]*Attachment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Attachment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AttachmentCreateBulk) SaveX(ctx context.Context) []*Attachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
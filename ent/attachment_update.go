@@ -0,0 +1,832 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/attachment"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (_u *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AttachmentUpdate) SetUpdatedAt(v time.Time) *AttachmentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AttachmentUpdate) SetUserID(v int) *AttachmentUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableUserID(v *int) *AttachmentUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *AttachmentUpdate) AddUserID(v int) *AttachmentUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *AttachmentUpdate) SetPostID(v int) *AttachmentUpdate {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillablePostID(v *int) *AttachmentUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *AttachmentUpdate) AddPostID(v int) *AttachmentUpdate {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (_u *AttachmentUpdate) SetCommentID(v int) *AttachmentUpdate {
+	_u.mutation.ResetCommentID()
+	_u.mutation.SetCommentID(v)
+	return _u
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableCommentID(v *int) *AttachmentUpdate {
+	if v != nil {
+		_u.SetCommentID(*v)
+	}
+	return _u
+}
+
+// AddCommentID adds value to the "comment_id" field.
+func (_u *AttachmentUpdate) AddCommentID(v int) *AttachmentUpdate {
+	_u.mutation.AddCommentID(v)
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *AttachmentUpdate) SetFilename(v string) *AttachmentUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableFilename(v *string) *AttachmentUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AttachmentUpdate) SetHash(v string) *AttachmentUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableHash(v *string) *AttachmentUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *AttachmentUpdate) SetStorageKey(v string) *AttachmentUpdate {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableStorageKey(v *string) *AttachmentUpdate {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *AttachmentUpdate) SetMimeType(v string) *AttachmentUpdate {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableMimeType(v *string) *AttachmentUpdate {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AttachmentUpdate) SetSize(v int64) *AttachmentUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableSize(v *int64) *AttachmentUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AttachmentUpdate) AddSize(v int64) *AttachmentUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (_u *AttachmentUpdate) SetThumbnailKey(v string) *AttachmentUpdate {
+	_u.mutation.SetThumbnailKey(v)
+	return _u
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableThumbnailKey(v *string) *AttachmentUpdate {
+	if v != nil {
+		_u.SetThumbnailKey(*v)
+	}
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *AttachmentUpdate) SetWidth(v int) *AttachmentUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableWidth(v *int) *AttachmentUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *AttachmentUpdate) AddWidth(v int) *AttachmentUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *AttachmentUpdate) SetHeight(v int) *AttachmentUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableHeight(v *int) *AttachmentUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *AttachmentUpdate) AddHeight(v int) *AttachmentUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (_u *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AttachmentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttachmentUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := attachment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := attachment.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommentID(); ok {
+		if err := attachment.CommentIDValidator(v); err != nil {
+			return &ValidationError{Name: "comment_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.comment_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := attachment.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "Attachment.hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := attachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MimeType(); ok {
+		if err := attachment.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.mime_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThumbnailKey(); ok {
+		if err := attachment.ThumbnailKeyValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.thumbnail_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Width(); ok {
+		if err := attachment.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Attachment.width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Height(); ok {
+		if err := attachment.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Attachment.height": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AttachmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(attachment.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(attachment.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(attachment.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(attachment.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CommentID(); ok {
+		_spec.SetField(attachment.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommentID(); ok {
+		_spec.AddField(attachment.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(attachment.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(attachment.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ThumbnailKey(); ok {
+		_spec.SetField(attachment.FieldThumbnailKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AttachmentUpdateOne) SetUpdatedAt(v time.Time) *AttachmentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AttachmentUpdateOne) SetUserID(v int) *AttachmentUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableUserID(v *int) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *AttachmentUpdateOne) AddUserID(v int) *AttachmentUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *AttachmentUpdateOne) SetPostID(v int) *AttachmentUpdateOne {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillablePostID(v *int) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *AttachmentUpdateOne) AddPostID(v int) *AttachmentUpdateOne {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (_u *AttachmentUpdateOne) SetCommentID(v int) *AttachmentUpdateOne {
+	_u.mutation.ResetCommentID()
+	_u.mutation.SetCommentID(v)
+	return _u
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableCommentID(v *int) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetCommentID(*v)
+	}
+	return _u
+}
+
+// AddCommentID adds value to the "comment_id" field.
+func (_u *AttachmentUpdateOne) AddCommentID(v int) *AttachmentUpdateOne {
+	_u.mutation.AddCommentID(v)
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *AttachmentUpdateOne) SetFilename(v string) *AttachmentUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableFilename(v *string) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *AttachmentUpdateOne) SetHash(v string) *AttachmentUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableHash(v *string) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *AttachmentUpdateOne) SetStorageKey(v string) *AttachmentUpdateOne {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableStorageKey(v *string) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *AttachmentUpdateOne) SetMimeType(v string) *AttachmentUpdateOne {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableMimeType(v *string) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AttachmentUpdateOne) SetSize(v int64) *AttachmentUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableSize(v *int64) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AttachmentUpdateOne) AddSize(v int64) *AttachmentUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (_u *AttachmentUpdateOne) SetThumbnailKey(v string) *AttachmentUpdateOne {
+	_u.mutation.SetThumbnailKey(v)
+	return _u
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableThumbnailKey(v *string) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetThumbnailKey(*v)
+	}
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *AttachmentUpdateOne) SetWidth(v int) *AttachmentUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableWidth(v *int) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *AttachmentUpdateOne) AddWidth(v int) *AttachmentUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *AttachmentUpdateOne) SetHeight(v int) *AttachmentUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableHeight(v *int) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *AttachmentUpdateOne) AddHeight(v int) *AttachmentUpdateOne {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (_u *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (_u *AttachmentUpdateOne) Where(ps ...predicate.Attachment) *AttachmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AttachmentUpdateOne) Select(field string, fields ...string) *AttachmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Attachment entity.
+func (_u *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AttachmentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttachmentUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := attachment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := attachment.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommentID(); ok {
+		if err := attachment.CommentIDValidator(v); err != nil {
+			return &ValidationError{Name: "comment_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.comment_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := attachment.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "Attachment.hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := attachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MimeType(); ok {
+		if err := attachment.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.mime_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThumbnailKey(); ok {
+		if err := attachment.ThumbnailKeyValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.thumbnail_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Width(); ok {
+		if err := attachment.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Attachment.width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Height(); ok {
+		if err := attachment.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Attachment.height": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachment.FieldID)
+		for _, f := range fields {
+			if !attachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(attachment.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(attachment.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(attachment.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(attachment.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CommentID(); ok {
+		_spec.SetField(attachment.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommentID(); ok {
+		_spec.AddField(attachment.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(attachment.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(attachment.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ThumbnailKey(); ok {
+		_spec.SetField(attachment.FieldThumbnailKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	_node = &Attachment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/badge"
+)
+
+// BadgeCreate is the builder for creating a Badge entity.
+type BadgeCreate struct {
+	config
+	mutation *BadgeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BadgeCreate) SetCreatedAt(v time.Time) *BadgeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableCreatedAt(v *time.Time) *BadgeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BadgeCreate) SetUpdatedAt(v time.Time) *BadgeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableUpdatedAt(v *time.Time) *BadgeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BadgeCreate) SetName(v string) *BadgeCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BadgeCreate) SetDescription(v string) *BadgeCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableDescription(v *string) *BadgeCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetIcon sets the "icon" field.
+func (_c *BadgeCreate) SetIcon(v string) *BadgeCreate {
+	_c.mutation.SetIcon(v)
+	return _c
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableIcon(v *string) *BadgeCreate {
+	if v != nil {
+		_c.SetIcon(*v)
+	}
+	return _c
+}
+
+// SetCriteria sets the "criteria" field.
+func (_c *BadgeCreate) SetCriteria(v badge.Criteria) *BadgeCreate {
+	_c.mutation.SetCriteria(v)
+	return _c
+}
+
+// SetNillableCriteria sets the "criteria" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableCriteria(v *badge.Criteria) *BadgeCreate {
+	if v != nil {
+		_c.SetCriteria(*v)
+	}
+	return _c
+}
+
+// SetThreshold sets the "threshold" field.
+func (_c *BadgeCreate) SetThreshold(v int) *BadgeCreate {
+	_c.mutation.SetThreshold(v)
+	return _c
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableThreshold(v *int) *BadgeCreate {
+	if v != nil {
+		_c.SetThreshold(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *BadgeCreate) SetEnabled(v bool) *BadgeCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *BadgeCreate) SetNillableEnabled(v *bool) *BadgeCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BadgeCreate) SetID(v int) *BadgeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BadgeMutation object of the builder.
+func (_c *BadgeCreate) Mutation() *BadgeMutation {
+	return _c.mutation
+}
+
+// Save creates the Badge in the database.
+func (_c *BadgeCreate) Save(ctx context.Context) (*Badge, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BadgeCreate) SaveX(ctx context.Context) *Badge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BadgeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BadgeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BadgeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := badge.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := badge.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Criteria(); !ok {
+		v := badge.DefaultCriteria
+		_c.mutation.SetCriteria(v)
+	}
+	if _, ok := _c.mutation.Threshold(); !ok {
+		v := badge.DefaultThreshold
+		_c.mutation.SetThreshold(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := badge.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BadgeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Badge.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Badge.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Badge.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := badge.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Badge.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Criteria(); !ok {
+		return &ValidationError{Name: "criteria", err: errors.New(`ent: missing required field "Badge.criteria"`)}
+	}
+	if v, ok := _c.mutation.Criteria(); ok {
+		if err := badge.CriteriaValidator(v); err != nil {
+			return &ValidationError{Name: "criteria", err: fmt.Errorf(`ent: validator failed for field "Badge.criteria": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "Badge.threshold"`)}
+	}
+	if v, ok := _c.mutation.Threshold(); ok {
+		if err := badge.ThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "threshold", err: fmt.Errorf(`ent: validator failed for field "Badge.threshold": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Badge.enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := badge.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Badge.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BadgeCreate) sqlSave(ctx context.Context) (*Badge, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BadgeCreate) createSpec() (*Badge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Badge{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(badge.Table, sqlgraph.NewFieldSpec(badge.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(badge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(badge.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(badge.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(badge.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Icon(); ok {
+		_spec.SetField(badge.FieldIcon, field.TypeString, value)
+		_node.Icon = value
+	}
+	if value, ok := _c.mutation.Criteria(); ok {
+		_spec.SetField(badge.FieldCriteria, field.TypeEnum, value)
+		_node.Criteria = value
+	}
+	if value, ok := _c.mutation.Threshold(); ok {
+		_spec.SetField(badge.FieldThreshold, field.TypeInt, value)
+		_node.Threshold = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(badge.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// BadgeCreateBulk is the builder for creating many Badge entities in bulk.
+type BadgeCreateBulk struct {
+	config
+	err      error
+	builders []*BadgeCreate
+}
+
+// Save creates the Badge entities in the database.
+func (_c *BadgeCreateBulk) Save(ctx context.Context) ([]*Badge, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Badge, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BadgeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BadgeCreateBulk) SaveX(ctx context.Context) []*Badge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BadgeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BadgeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -34,6 +34,27 @@ func (_u *CategoryUpdate) SetUpdatedAt(v time.Time) *CategoryUpdate {
 	return _u
 }
 
+// SetParentID sets the "parent_id" field.
+func (_u *CategoryUpdate) SetParentID(v int) *CategoryUpdate {
+	_u.mutation.ResetParentID()
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableParentID(v *int) *CategoryUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// AddParentID adds value to the "parent_id" field.
+func (_u *CategoryUpdate) AddParentID(v int) *CategoryUpdate {
+	_u.mutation.AddParentID(v)
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *CategoryUpdate) SetName(v string) *CategoryUpdate {
 	_u.mutation.SetName(v)
@@ -200,6 +221,11 @@ func (_u *CategoryUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *CategoryUpdate) check() error {
+	if v, ok := _u.mutation.ParentID(); ok {
+		if err := category.ParentIDValidator(v); err != nil {
+			return &ValidationError{Name: "parent_id", err: fmt.Errorf(`ent: validator failed for field "Category.parent_id": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Name(); ok {
 		if err := category.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Category.name": %w`, err)}
@@ -233,6 +259,12 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.ParentID(); ok {
+		_spec.SetField(category.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParentID(); ok {
+		_spec.AddField(category.FieldParentID, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 	}
@@ -292,6 +324,27 @@ func (_u *CategoryUpdateOne) SetUpdatedAt(v time.Time) *CategoryUpdateOne {
 	return _u
 }
 
+// SetParentID sets the "parent_id" field.
+func (_u *CategoryUpdateOne) SetParentID(v int) *CategoryUpdateOne {
+	_u.mutation.ResetParentID()
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableParentID(v *int) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// AddParentID adds value to the "parent_id" field.
+func (_u *CategoryUpdateOne) AddParentID(v int) *CategoryUpdateOne {
+	_u.mutation.AddParentID(v)
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *CategoryUpdateOne) SetName(v string) *CategoryUpdateOne {
 	_u.mutation.SetName(v)
@@ -471,6 +524,11 @@ func (_u *CategoryUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *CategoryUpdateOne) check() error {
+	if v, ok := _u.mutation.ParentID(); ok {
+		if err := category.ParentIDValidator(v); err != nil {
+			return &ValidationError{Name: "parent_id", err: fmt.Errorf(`ent: validator failed for field "Category.parent_id": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Name(); ok {
 		if err := category.NameValidator(v); err != nil {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Category.name": %w`, err)}
@@ -521,6 +579,12 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.ParentID(); ok {
+		_spec.SetField(category.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParentID(); ok {
+		_spec.AddField(category.FieldParentID, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(category.FieldName, field.TypeString, value)
 	}
@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
+)
+
+// CategoryPermission is the model entity for the CategoryPermission schema.
+type CategoryPermission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 版块ID
+	CategoryID int `json:"category_id,omitempty"`
+	// 规则作用的操作
+	Action categorypermission.Action `json:"action,omitempty"`
+	// 最低身份要求
+	MinRole categorypermission.MinRole `json:"min_role,omitempty"`
+	// 最低经验值要求
+	MinExperience int `json:"min_experience,omitempty"`
+	// 允许的用户组ID列表
+	AllowedGroupIds []int `json:"allowed_group_ids,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryPermission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categorypermission.FieldAllowedGroupIds:
+			values[i] = new([]byte)
+		case categorypermission.FieldID, categorypermission.FieldCategoryID, categorypermission.FieldMinExperience:
+			values[i] = new(sql.NullInt64)
+		case categorypermission.FieldAction, categorypermission.FieldMinRole:
+			values[i] = new(sql.NullString)
+		case categorypermission.FieldCreatedAt, categorypermission.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryPermission fields.
+func (_m *CategoryPermission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categorypermission.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case categorypermission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case categorypermission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case categorypermission.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = int(value.Int64)
+			}
+		case categorypermission.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = categorypermission.Action(value.String)
+			}
+		case categorypermission.FieldMinRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field min_role", values[i])
+			} else if value.Valid {
+				_m.MinRole = categorypermission.MinRole(value.String)
+			}
+		case categorypermission.FieldMinExperience:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_experience", values[i])
+			} else if value.Valid {
+				_m.MinExperience = int(value.Int64)
+			}
+		case categorypermission.FieldAllowedGroupIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_group_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedGroupIds); err != nil {
+					return fmt.Errorf("unmarshal field allowed_group_ids: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryPermission.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategoryPermission) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CategoryPermission.
+// Note that you need to call CategoryPermission.Unwrap() before calling this method if this CategoryPermission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategoryPermission) Update() *CategoryPermissionUpdateOne {
+	return NewCategoryPermissionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategoryPermission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategoryPermission) Unwrap() *CategoryPermission {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategoryPermission is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategoryPermission) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryPermission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("category_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoryID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("min_role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinRole))
+	builder.WriteString(", ")
+	builder.WriteString("min_experience=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinExperience))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_group_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedGroupIds))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryPermissions is a parsable slice of CategoryPermission.
+type CategoryPermissions []*CategoryPermission
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorypermission
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the categorypermission type in the database.
+	Label = "category_permission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldMinRole holds the string denoting the min_role field in the database.
+	FieldMinRole = "min_role"
+	// FieldMinExperience holds the string denoting the min_experience field in the database.
+	FieldMinExperience = "min_experience"
+	// FieldAllowedGroupIds holds the string denoting the allowed_group_ids field in the database.
+	FieldAllowedGroupIds = "allowed_group_ids"
+	// Table holds the table name of the categorypermission in the database.
+	Table = "category_permissions"
+)
+
+// Columns holds all SQL columns for categorypermission fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCategoryID,
+	FieldAction,
+	FieldMinRole,
+	FieldMinExperience,
+	FieldAllowedGroupIds,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// CategoryIDValidator is a validator for the "category_id" field. It is called by the builders before save.
+	CategoryIDValidator func(int) error
+	// DefaultMinExperience holds the default value on creation for the "min_experience" field.
+	DefaultMinExperience int
+	// MinExperienceValidator is a validator for the "min_experience" field. It is called by the builders before save.
+	MinExperienceValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionView  Action = "View"
+	ActionPost  Action = "Post"
+	ActionReply Action = "Reply"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionView, ActionPost, ActionReply:
+		return nil
+	default:
+		return fmt.Errorf("categorypermission: invalid enum value for action field: %q", a)
+	}
+}
+
+// MinRole defines the type for the "min_role" enum field.
+type MinRole string
+
+// MinRoleUser is the default value of the MinRole enum.
+const DefaultMinRole = MinRoleUser
+
+// MinRole values.
+const (
+	MinRoleUser       MinRole = "User"
+	MinRoleModerator  MinRole = "Moderator"
+	MinRoleAdmin      MinRole = "Admin"
+	MinRoleSuperAdmin MinRole = "SuperAdmin"
+)
+
+func (mr MinRole) String() string {
+	return string(mr)
+}
+
+// MinRoleValidator is a validator for the "min_role" field enum values. It is called by the builders before save.
+func MinRoleValidator(mr MinRole) error {
+	switch mr {
+	case MinRoleUser, MinRoleModerator, MinRoleAdmin, MinRoleSuperAdmin:
+		return nil
+	default:
+		return fmt.Errorf("categorypermission: invalid enum value for min_role field: %q", mr)
+	}
+}
+
+// OrderOption defines the ordering options for the CategoryPermission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByMinRole orders the results by the min_role field.
+func ByMinRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinRole, opts...).ToFunc()
+}
+
+// ByMinExperience orders the results by the min_experience field.
+func ByMinExperience(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinExperience, opts...).ToFunc()
+}
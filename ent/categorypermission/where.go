@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorypermission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// MinExperience applies equality check predicate on the "min_experience" field. It's identical to MinExperienceEQ.
+func MinExperience(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldMinExperience, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDGT applies the GT predicate on the "category_id" field.
+func CategoryIDGT(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldCategoryID, v))
+}
+
+// CategoryIDGTE applies the GTE predicate on the "category_id" field.
+func CategoryIDGTE(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldCategoryID, v))
+}
+
+// CategoryIDLT applies the LT predicate on the "category_id" field.
+func CategoryIDLT(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldCategoryID, v))
+}
+
+// CategoryIDLTE applies the LTE predicate on the "category_id" field.
+func CategoryIDLTE(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldCategoryID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// MinRoleEQ applies the EQ predicate on the "min_role" field.
+func MinRoleEQ(v MinRole) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldMinRole, v))
+}
+
+// MinRoleNEQ applies the NEQ predicate on the "min_role" field.
+func MinRoleNEQ(v MinRole) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldMinRole, v))
+}
+
+// MinRoleIn applies the In predicate on the "min_role" field.
+func MinRoleIn(vs ...MinRole) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldMinRole, vs...))
+}
+
+// MinRoleNotIn applies the NotIn predicate on the "min_role" field.
+func MinRoleNotIn(vs ...MinRole) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldMinRole, vs...))
+}
+
+// MinExperienceEQ applies the EQ predicate on the "min_experience" field.
+func MinExperienceEQ(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldEQ(FieldMinExperience, v))
+}
+
+// MinExperienceNEQ applies the NEQ predicate on the "min_experience" field.
+func MinExperienceNEQ(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNEQ(FieldMinExperience, v))
+}
+
+// MinExperienceIn applies the In predicate on the "min_experience" field.
+func MinExperienceIn(vs ...int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIn(FieldMinExperience, vs...))
+}
+
+// MinExperienceNotIn applies the NotIn predicate on the "min_experience" field.
+func MinExperienceNotIn(vs ...int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotIn(FieldMinExperience, vs...))
+}
+
+// MinExperienceGT applies the GT predicate on the "min_experience" field.
+func MinExperienceGT(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGT(FieldMinExperience, v))
+}
+
+// MinExperienceGTE applies the GTE predicate on the "min_experience" field.
+func MinExperienceGTE(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldGTE(FieldMinExperience, v))
+}
+
+// MinExperienceLT applies the LT predicate on the "min_experience" field.
+func MinExperienceLT(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLT(FieldMinExperience, v))
+}
+
+// MinExperienceLTE applies the LTE predicate on the "min_experience" field.
+func MinExperienceLTE(v int) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldLTE(FieldMinExperience, v))
+}
+
+// AllowedGroupIdsIsNil applies the IsNil predicate on the "allowed_group_ids" field.
+func AllowedGroupIdsIsNil() predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldIsNull(FieldAllowedGroupIds))
+}
+
+// AllowedGroupIdsNotNil applies the NotNil predicate on the "allowed_group_ids" field.
+func AllowedGroupIdsNotNil() predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.FieldNotNull(FieldAllowedGroupIds))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategoryPermission) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategoryPermission) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategoryPermission) predicate.CategoryPermission {
+	return predicate.CategoryPermission(sql.NotPredicates(p))
+}
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
+)
+
+// CategoryPermissionCreate is the builder for creating a CategoryPermission entity.
+type CategoryPermissionCreate struct {
+	config
+	mutation *CategoryPermissionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CategoryPermissionCreate) SetCreatedAt(v time.Time) *CategoryPermissionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableCreatedAt(v *time.Time) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CategoryPermissionCreate) SetUpdatedAt(v time.Time) *CategoryPermissionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableUpdatedAt(v *time.Time) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *CategoryPermissionCreate) SetCategoryID(v int) *CategoryPermissionCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *CategoryPermissionCreate) SetAction(v categorypermission.Action) *CategoryPermissionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetMinRole sets the "min_role" field.
+func (_c *CategoryPermissionCreate) SetMinRole(v categorypermission.MinRole) *CategoryPermissionCreate {
+	_c.mutation.SetMinRole(v)
+	return _c
+}
+
+// SetNillableMinRole sets the "min_role" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableMinRole(v *categorypermission.MinRole) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetMinRole(*v)
+	}
+	return _c
+}
+
+// SetMinExperience sets the "min_experience" field.
+func (_c *CategoryPermissionCreate) SetMinExperience(v int) *CategoryPermissionCreate {
+	_c.mutation.SetMinExperience(v)
+	return _c
+}
+
+// SetNillableMinExperience sets the "min_experience" field if the given value is not nil.
+func (_c *CategoryPermissionCreate) SetNillableMinExperience(v *int) *CategoryPermissionCreate {
+	if v != nil {
+		_c.SetMinExperience(*v)
+	}
+	return _c
+}
+
+// SetAllowedGroupIds sets the "allowed_group_ids" field.
+func (_c *CategoryPermissionCreate) SetAllowedGroupIds(v []int) *CategoryPermissionCreate {
+	_c.mutation.SetAllowedGroupIds(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CategoryPermissionCreate) SetID(v int) *CategoryPermissionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CategoryPermissionMutation object of the builder.
+func (_c *CategoryPermissionCreate) Mutation() *CategoryPermissionMutation {
+	return _c.mutation
+}
+
+// Save creates the CategoryPermission in the database.
+func (_c *CategoryPermissionCreate) Save(ctx context.Context) (*CategoryPermission, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategoryPermissionCreate) SaveX(ctx context.Context) *CategoryPermission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryPermissionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryPermissionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CategoryPermissionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := categorypermission.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := categorypermission.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.MinRole(); !ok {
+		v := categorypermission.DefaultMinRole
+		_c.mutation.SetMinRole(v)
+	}
+	if _, ok := _c.mutation.MinExperience(); !ok {
+		v := categorypermission.DefaultMinExperience
+		_c.mutation.SetMinExperience(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategoryPermissionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CategoryPermission.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CategoryPermission.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CategoryID(); !ok {
+		return &ValidationError{Name: "category_id", err: errors.New(`ent: missing required field "CategoryPermission.category_id"`)}
+	}
+	if v, ok := _c.mutation.CategoryID(); ok {
+		if err := categorypermission.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.category_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "CategoryPermission.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := categorypermission.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MinRole(); !ok {
+		return &ValidationError{Name: "min_role", err: errors.New(`ent: missing required field "CategoryPermission.min_role"`)}
+	}
+	if v, ok := _c.mutation.MinRole(); ok {
+		if err := categorypermission.MinRoleValidator(v); err != nil {
+			return &ValidationError{Name: "min_role", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.min_role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MinExperience(); !ok {
+		return &ValidationError{Name: "min_experience", err: errors.New(`ent: missing required field "CategoryPermission.min_experience"`)}
+	}
+	if v, ok := _c.mutation.MinExperience(); ok {
+		if err := categorypermission.MinExperienceValidator(v); err != nil {
+			return &ValidationError{Name: "min_experience", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.min_experience": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := categorypermission.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CategoryPermissionCreate) sqlSave(ctx context.Context) (*CategoryPermission, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategoryPermissionCreate) createSpec() (*CategoryPermission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryPermission{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categorypermission.Table, sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(categorypermission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorypermission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CategoryID(); ok {
+		_spec.SetField(categorypermission.FieldCategoryID, field.TypeInt, value)
+		_node.CategoryID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(categorypermission.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.MinRole(); ok {
+		_spec.SetField(categorypermission.FieldMinRole, field.TypeEnum, value)
+		_node.MinRole = value
+	}
+	if value, ok := _c.mutation.MinExperience(); ok {
+		_spec.SetField(categorypermission.FieldMinExperience, field.TypeInt, value)
+		_node.MinExperience = value
+	}
+	if value, ok := _c.mutation.AllowedGroupIds(); ok {
+		_spec.SetField(categorypermission.FieldAllowedGroupIds, field.TypeJSON, value)
+		_node.AllowedGroupIds = value
+	}
+	return _node, _spec
+}
+
+// CategoryPermissionCreateBulk is the builder for creating many CategoryPermission entities in bulk.
+type CategoryPermissionCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryPermissionCreate
+}
+
+// Save creates the CategoryPermission entities in the database.
+func (_c *CategoryPermissionCreateBulk) Save(ctx context.Context) ([]*CategoryPermission, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategoryPermission, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryPermissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategoryPermissionCreateBulk) SaveX(ctx context.Context) []*CategoryPermission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryPermissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryPermissionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// CategoryPermissionDelete is the builder for deleting a CategoryPermission entity.
+type CategoryPermissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CategoryPermissionMutation
+}
+
+// Where appends a list predicates to the CategoryPermissionDelete builder.
+func (_d *CategoryPermissionDelete) Where(ps ...predicate.CategoryPermission) *CategoryPermissionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CategoryPermissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CategoryPermissionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CategoryPermissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(categorypermission.Table, sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CategoryPermissionDeleteOne is the builder for deleting a single CategoryPermission entity.
+type CategoryPermissionDeleteOne struct {
+	_d *CategoryPermissionDelete
+}
+
+// Where appends a list predicates to the CategoryPermissionDelete builder.
+func (_d *CategoryPermissionDeleteOne) Where(ps ...predicate.CategoryPermission) *CategoryPermissionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CategoryPermissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{categorypermission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CategoryPermissionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
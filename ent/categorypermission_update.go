@@ -0,0 +1,503 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// CategoryPermissionUpdate is the builder for updating CategoryPermission entities.
+type CategoryPermissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CategoryPermissionMutation
+}
+
+// Where appends a list predicates to the CategoryPermissionUpdate builder.
+func (_u *CategoryPermissionUpdate) Where(ps ...predicate.CategoryPermission) *CategoryPermissionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategoryPermissionUpdate) SetUpdatedAt(v time.Time) *CategoryPermissionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryPermissionUpdate) SetCategoryID(v int) *CategoryPermissionUpdate {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableCategoryID(v *int) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *CategoryPermissionUpdate) AddCategoryID(v int) *CategoryPermissionUpdate {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *CategoryPermissionUpdate) SetAction(v categorypermission.Action) *CategoryPermissionUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableAction(v *categorypermission.Action) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetMinRole sets the "min_role" field.
+func (_u *CategoryPermissionUpdate) SetMinRole(v categorypermission.MinRole) *CategoryPermissionUpdate {
+	_u.mutation.SetMinRole(v)
+	return _u
+}
+
+// SetNillableMinRole sets the "min_role" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableMinRole(v *categorypermission.MinRole) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetMinRole(*v)
+	}
+	return _u
+}
+
+// SetMinExperience sets the "min_experience" field.
+func (_u *CategoryPermissionUpdate) SetMinExperience(v int) *CategoryPermissionUpdate {
+	_u.mutation.ResetMinExperience()
+	_u.mutation.SetMinExperience(v)
+	return _u
+}
+
+// SetNillableMinExperience sets the "min_experience" field if the given value is not nil.
+func (_u *CategoryPermissionUpdate) SetNillableMinExperience(v *int) *CategoryPermissionUpdate {
+	if v != nil {
+		_u.SetMinExperience(*v)
+	}
+	return _u
+}
+
+// AddMinExperience adds value to the "min_experience" field.
+func (_u *CategoryPermissionUpdate) AddMinExperience(v int) *CategoryPermissionUpdate {
+	_u.mutation.AddMinExperience(v)
+	return _u
+}
+
+// SetAllowedGroupIds sets the "allowed_group_ids" field.
+func (_u *CategoryPermissionUpdate) SetAllowedGroupIds(v []int) *CategoryPermissionUpdate {
+	_u.mutation.SetAllowedGroupIds(v)
+	return _u
+}
+
+// AppendAllowedGroupIds appends value to the "allowed_group_ids" field.
+func (_u *CategoryPermissionUpdate) AppendAllowedGroupIds(v []int) *CategoryPermissionUpdate {
+	_u.mutation.AppendAllowedGroupIds(v)
+	return _u
+}
+
+// ClearAllowedGroupIds clears the value of the "allowed_group_ids" field.
+func (_u *CategoryPermissionUpdate) ClearAllowedGroupIds() *CategoryPermissionUpdate {
+	_u.mutation.ClearAllowedGroupIds()
+	return _u
+}
+
+// Mutation returns the CategoryPermissionMutation object of the builder.
+func (_u *CategoryPermissionUpdate) Mutation() *CategoryPermissionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategoryPermissionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryPermissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategoryPermissionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryPermissionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategoryPermissionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categorypermission.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryPermissionUpdate) check() error {
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := categorypermission.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := categorypermission.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinRole(); ok {
+		if err := categorypermission.MinRoleValidator(v); err != nil {
+			return &ValidationError{Name: "min_role", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.min_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinExperience(); ok {
+		if err := categorypermission.MinExperienceValidator(v); err != nil {
+			return &ValidationError{Name: "min_experience", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.min_experience": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CategoryPermissionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorypermission.Table, categorypermission.Columns, sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorypermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(categorypermission.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(categorypermission.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(categorypermission.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MinRole(); ok {
+		_spec.SetField(categorypermission.FieldMinRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MinExperience(); ok {
+		_spec.SetField(categorypermission.FieldMinExperience, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinExperience(); ok {
+		_spec.AddField(categorypermission.FieldMinExperience, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AllowedGroupIds(); ok {
+		_spec.SetField(categorypermission.FieldAllowedGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, categorypermission.FieldAllowedGroupIds, value)
+		})
+	}
+	if _u.mutation.AllowedGroupIdsCleared() {
+		_spec.ClearField(categorypermission.FieldAllowedGroupIds, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorypermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategoryPermissionUpdateOne is the builder for updating a single CategoryPermission entity.
+type CategoryPermissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CategoryPermissionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategoryPermissionUpdateOne) SetUpdatedAt(v time.Time) *CategoryPermissionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CategoryPermissionUpdateOne) SetCategoryID(v int) *CategoryPermissionUpdateOne {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableCategoryID(v *int) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *CategoryPermissionUpdateOne) AddCategoryID(v int) *CategoryPermissionUpdateOne {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *CategoryPermissionUpdateOne) SetAction(v categorypermission.Action) *CategoryPermissionUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableAction(v *categorypermission.Action) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetMinRole sets the "min_role" field.
+func (_u *CategoryPermissionUpdateOne) SetMinRole(v categorypermission.MinRole) *CategoryPermissionUpdateOne {
+	_u.mutation.SetMinRole(v)
+	return _u
+}
+
+// SetNillableMinRole sets the "min_role" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableMinRole(v *categorypermission.MinRole) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetMinRole(*v)
+	}
+	return _u
+}
+
+// SetMinExperience sets the "min_experience" field.
+func (_u *CategoryPermissionUpdateOne) SetMinExperience(v int) *CategoryPermissionUpdateOne {
+	_u.mutation.ResetMinExperience()
+	_u.mutation.SetMinExperience(v)
+	return _u
+}
+
+// SetNillableMinExperience sets the "min_experience" field if the given value is not nil.
+func (_u *CategoryPermissionUpdateOne) SetNillableMinExperience(v *int) *CategoryPermissionUpdateOne {
+	if v != nil {
+		_u.SetMinExperience(*v)
+	}
+	return _u
+}
+
+// AddMinExperience adds value to the "min_experience" field.
+func (_u *CategoryPermissionUpdateOne) AddMinExperience(v int) *CategoryPermissionUpdateOne {
+	_u.mutation.AddMinExperience(v)
+	return _u
+}
+
+// SetAllowedGroupIds sets the "allowed_group_ids" field.
+func (_u *CategoryPermissionUpdateOne) SetAllowedGroupIds(v []int) *CategoryPermissionUpdateOne {
+	_u.mutation.SetAllowedGroupIds(v)
+	return _u
+}
+
+// AppendAllowedGroupIds appends value to the "allowed_group_ids" field.
+func (_u *CategoryPermissionUpdateOne) AppendAllowedGroupIds(v []int) *CategoryPermissionUpdateOne {
+	_u.mutation.AppendAllowedGroupIds(v)
+	return _u
+}
+
+// ClearAllowedGroupIds clears the value of the "allowed_group_ids" field.
+func (_u *CategoryPermissionUpdateOne) ClearAllowedGroupIds() *CategoryPermissionUpdateOne {
+	_u.mutation.ClearAllowedGroupIds()
+	return _u
+}
+
+// Mutation returns the CategoryPermissionMutation object of the builder.
+func (_u *CategoryPermissionUpdateOne) Mutation() *CategoryPermissionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CategoryPermissionUpdate builder.
+func (_u *CategoryPermissionUpdateOne) Where(ps ...predicate.CategoryPermission) *CategoryPermissionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategoryPermissionUpdateOne) Select(field string, fields ...string) *CategoryPermissionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategoryPermission entity.
+func (_u *CategoryPermissionUpdateOne) Save(ctx context.Context) (*CategoryPermission, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryPermissionUpdateOne) SaveX(ctx context.Context) *CategoryPermission {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategoryPermissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryPermissionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategoryPermissionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categorypermission.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryPermissionUpdateOne) check() error {
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := categorypermission.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := categorypermission.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinRole(); ok {
+		if err := categorypermission.MinRoleValidator(v); err != nil {
+			return &ValidationError{Name: "min_role", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.min_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinExperience(); ok {
+		if err := categorypermission.MinExperienceValidator(v); err != nil {
+			return &ValidationError{Name: "min_experience", err: fmt.Errorf(`ent: validator failed for field "CategoryPermission.min_experience": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CategoryPermissionUpdateOne) sqlSave(ctx context.Context) (_node *CategoryPermission, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorypermission.Table, categorypermission.Columns, sqlgraph.NewFieldSpec(categorypermission.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategoryPermission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categorypermission.FieldID)
+		for _, f := range fields {
+			if !categorypermission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categorypermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categorypermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(categorypermission.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(categorypermission.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(categorypermission.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MinRole(); ok {
+		_spec.SetField(categorypermission.FieldMinRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MinExperience(); ok {
+		_spec.SetField(categorypermission.FieldMinExperience, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinExperience(); ok {
+		_spec.AddField(categorypermission.FieldMinExperience, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AllowedGroupIds(); ok {
+		_spec.SetField(categorypermission.FieldAllowedGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, categorypermission.FieldAllowedGroupIds, value)
+		})
+	}
+	if _u.mutation.AllowedGroupIdsCleared() {
+		_spec.ClearField(categorypermission.FieldAllowedGroupIds, field.TypeJSON)
+	}
+	_node = &CategoryPermission{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorypermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,21 +14,43 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/attachment"
+	"github.com/PokeForum/PokeForum/ent/badge"
 	"github.com/PokeForum/PokeForum/ent/blacklist"
 	"github.com/PokeForum/PokeForum/ent/category"
 	"github.com/PokeForum/PokeForum/ent/categorymoderator"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
 	"github.com/PokeForum/PokeForum/ent/comment"
 	"github.com/PokeForum/PokeForum/ent/commentaction"
+	"github.com/PokeForum/PokeForum/ent/conversation"
+	"github.com/PokeForum/PokeForum/ent/follow"
+	"github.com/PokeForum/PokeForum/ent/invitecode"
+	"github.com/PokeForum/PokeForum/ent/mention"
+	"github.com/PokeForum/PokeForum/ent/notification"
+	"github.com/PokeForum/PokeForum/ent/notificationpreference"
 	"github.com/PokeForum/PokeForum/ent/oauthprovider"
 	"github.com/PokeForum/PokeForum/ent/post"
 	"github.com/PokeForum/PokeForum/ent/postaction"
+	"github.com/PokeForum/PokeForum/ent/postpurchase"
+	"github.com/PokeForum/PokeForum/ent/postrevision"
+	"github.com/PokeForum/PokeForum/ent/posttag"
+	"github.com/PokeForum/PokeForum/ent/privatemessage"
+	"github.com/PokeForum/PokeForum/ent/report"
 	"github.com/PokeForum/PokeForum/ent/settings"
+	"github.com/PokeForum/PokeForum/ent/tag"
 	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/userbadge"
 	"github.com/PokeForum/PokeForum/ent/userbalancelog"
+	"github.com/PokeForum/PokeForum/ent/usergroup"
+	"github.com/PokeForum/PokeForum/ent/usergroupmember"
+	"github.com/PokeForum/PokeForum/ent/userinvitation"
+	"github.com/PokeForum/PokeForum/ent/userlevellog"
 	"github.com/PokeForum/PokeForum/ent/userloginlog"
 	"github.com/PokeForum/PokeForum/ent/useroauth"
 	"github.com/PokeForum/PokeForum/ent/usersigninlogs"
 	"github.com/PokeForum/PokeForum/ent/usersigninstatus"
+	"github.com/PokeForum/PokeForum/ent/usertwofactor"
+	"github.com/PokeForum/PokeForum/ent/webauthncredential"
 )
 
 // Client is the client that holds all ent builders.
@@ -36,28 +58,68 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// Badge is the client for interacting with the Badge builders.
+	Badge *BadgeClient
 	// Blacklist is the client for interacting with the Blacklist builders.
 	Blacklist *BlacklistClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// CategoryModerator is the client for interacting with the CategoryModerator builders.
 	CategoryModerator *CategoryModeratorClient
+	// CategoryPermission is the client for interacting with the CategoryPermission builders.
+	CategoryPermission *CategoryPermissionClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
 	// CommentAction is the client for interacting with the CommentAction builders.
 	CommentAction *CommentActionClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
+	// Follow is the client for interacting with the Follow builders.
+	Follow *FollowClient
+	// InviteCode is the client for interacting with the InviteCode builders.
+	InviteCode *InviteCodeClient
+	// Mention is the client for interacting with the Mention builders.
+	Mention *MentionClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
 	// OAuthProvider is the client for interacting with the OAuthProvider builders.
 	OAuthProvider *OAuthProviderClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostAction is the client for interacting with the PostAction builders.
 	PostAction *PostActionClient
+	// PostPurchase is the client for interacting with the PostPurchase builders.
+	PostPurchase *PostPurchaseClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// PostTag is the client for interacting with the PostTag builders.
+	PostTag *PostTagClient
+	// PrivateMessage is the client for interacting with the PrivateMessage builders.
+	PrivateMessage *PrivateMessageClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserBadge is the client for interacting with the UserBadge builders.
+	UserBadge *UserBadgeClient
 	// UserBalanceLog is the client for interacting with the UserBalanceLog builders.
 	UserBalanceLog *UserBalanceLogClient
+	// UserGroup is the client for interacting with the UserGroup builders.
+	UserGroup *UserGroupClient
+	// UserGroupMember is the client for interacting with the UserGroupMember builders.
+	UserGroupMember *UserGroupMemberClient
+	// UserInvitation is the client for interacting with the UserInvitation builders.
+	UserInvitation *UserInvitationClient
+	// UserLevelLog is the client for interacting with the UserLevelLog builders.
+	UserLevelLog *UserLevelLogClient
 	// UserLoginLog is the client for interacting with the UserLoginLog builders.
 	UserLoginLog *UserLoginLogClient
 	// UserOAuth is the client for interacting with the UserOAuth builders.
@@ -66,6 +128,10 @@ type Client struct {
 	UserSigninLogs *UserSigninLogsClient
 	// UserSigninStatus is the client for interacting with the UserSigninStatus builders.
 	UserSigninStatus *UserSigninStatusClient
+	// UserTwoFactor is the client for interacting with the UserTwoFactor builders.
+	UserTwoFactor *UserTwoFactorClient
+	// WebAuthnCredential is the client for interacting with the WebAuthnCredential builders.
+	WebAuthnCredential *WebAuthnCredentialClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -77,21 +143,43 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Attachment = NewAttachmentClient(c.config)
+	c.Badge = NewBadgeClient(c.config)
 	c.Blacklist = NewBlacklistClient(c.config)
 	c.Category = NewCategoryClient(c.config)
 	c.CategoryModerator = NewCategoryModeratorClient(c.config)
+	c.CategoryPermission = NewCategoryPermissionClient(c.config)
 	c.Comment = NewCommentClient(c.config)
 	c.CommentAction = NewCommentActionClient(c.config)
+	c.Conversation = NewConversationClient(c.config)
+	c.Follow = NewFollowClient(c.config)
+	c.InviteCode = NewInviteCodeClient(c.config)
+	c.Mention = NewMentionClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
 	c.OAuthProvider = NewOAuthProviderClient(c.config)
 	c.Post = NewPostClient(c.config)
 	c.PostAction = NewPostActionClient(c.config)
+	c.PostPurchase = NewPostPurchaseClient(c.config)
+	c.PostRevision = NewPostRevisionClient(c.config)
+	c.PostTag = NewPostTagClient(c.config)
+	c.PrivateMessage = NewPrivateMessageClient(c.config)
+	c.Report = NewReportClient(c.config)
 	c.Settings = NewSettingsClient(c.config)
+	c.Tag = NewTagClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserBadge = NewUserBadgeClient(c.config)
 	c.UserBalanceLog = NewUserBalanceLogClient(c.config)
+	c.UserGroup = NewUserGroupClient(c.config)
+	c.UserGroupMember = NewUserGroupMemberClient(c.config)
+	c.UserInvitation = NewUserInvitationClient(c.config)
+	c.UserLevelLog = NewUserLevelLogClient(c.config)
 	c.UserLoginLog = NewUserLoginLogClient(c.config)
 	c.UserOAuth = NewUserOAuthClient(c.config)
 	c.UserSigninLogs = NewUserSigninLogsClient(c.config)
 	c.UserSigninStatus = NewUserSigninStatusClient(c.config)
+	c.UserTwoFactor = NewUserTwoFactorClient(c.config)
+	c.WebAuthnCredential = NewWebAuthnCredentialClient(c.config)
 }
 
 type (
@@ -182,23 +270,45 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		Blacklist:         NewBlacklistClient(cfg),
-		Category:          NewCategoryClient(cfg),
-		CategoryModerator: NewCategoryModeratorClient(cfg),
-		Comment:           NewCommentClient(cfg),
-		CommentAction:     NewCommentActionClient(cfg),
-		OAuthProvider:     NewOAuthProviderClient(cfg),
-		Post:              NewPostClient(cfg),
-		PostAction:        NewPostActionClient(cfg),
-		Settings:          NewSettingsClient(cfg),
-		User:              NewUserClient(cfg),
-		UserBalanceLog:    NewUserBalanceLogClient(cfg),
-		UserLoginLog:      NewUserLoginLogClient(cfg),
-		UserOAuth:         NewUserOAuthClient(cfg),
-		UserSigninLogs:    NewUserSigninLogsClient(cfg),
-		UserSigninStatus:  NewUserSigninStatusClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Attachment:             NewAttachmentClient(cfg),
+		Badge:                  NewBadgeClient(cfg),
+		Blacklist:              NewBlacklistClient(cfg),
+		Category:               NewCategoryClient(cfg),
+		CategoryModerator:      NewCategoryModeratorClient(cfg),
+		CategoryPermission:     NewCategoryPermissionClient(cfg),
+		Comment:                NewCommentClient(cfg),
+		CommentAction:          NewCommentActionClient(cfg),
+		Conversation:           NewConversationClient(cfg),
+		Follow:                 NewFollowClient(cfg),
+		InviteCode:             NewInviteCodeClient(cfg),
+		Mention:                NewMentionClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		OAuthProvider:          NewOAuthProviderClient(cfg),
+		Post:                   NewPostClient(cfg),
+		PostAction:             NewPostActionClient(cfg),
+		PostPurchase:           NewPostPurchaseClient(cfg),
+		PostRevision:           NewPostRevisionClient(cfg),
+		PostTag:                NewPostTagClient(cfg),
+		PrivateMessage:         NewPrivateMessageClient(cfg),
+		Report:                 NewReportClient(cfg),
+		Settings:               NewSettingsClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		User:                   NewUserClient(cfg),
+		UserBadge:              NewUserBadgeClient(cfg),
+		UserBalanceLog:         NewUserBalanceLogClient(cfg),
+		UserGroup:              NewUserGroupClient(cfg),
+		UserGroupMember:        NewUserGroupMemberClient(cfg),
+		UserInvitation:         NewUserInvitationClient(cfg),
+		UserLevelLog:           NewUserLevelLogClient(cfg),
+		UserLoginLog:           NewUserLoginLogClient(cfg),
+		UserOAuth:              NewUserOAuthClient(cfg),
+		UserSigninLogs:         NewUserSigninLogsClient(cfg),
+		UserSigninStatus:       NewUserSigninStatusClient(cfg),
+		UserTwoFactor:          NewUserTwoFactorClient(cfg),
+		WebAuthnCredential:     NewWebAuthnCredentialClient(cfg),
 	}, nil
 }
 
@@ -216,30 +326,52 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		Blacklist:         NewBlacklistClient(cfg),
-		Category:          NewCategoryClient(cfg),
-		CategoryModerator: NewCategoryModeratorClient(cfg),
-		Comment:           NewCommentClient(cfg),
-		CommentAction:     NewCommentActionClient(cfg),
-		OAuthProvider:     NewOAuthProviderClient(cfg),
-		Post:              NewPostClient(cfg),
-		PostAction:        NewPostActionClient(cfg),
-		Settings:          NewSettingsClient(cfg),
-		User:              NewUserClient(cfg),
-		UserBalanceLog:    NewUserBalanceLogClient(cfg),
-		UserLoginLog:      NewUserLoginLogClient(cfg),
-		UserOAuth:         NewUserOAuthClient(cfg),
-		UserSigninLogs:    NewUserSigninLogsClient(cfg),
-		UserSigninStatus:  NewUserSigninStatusClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Attachment:             NewAttachmentClient(cfg),
+		Badge:                  NewBadgeClient(cfg),
+		Blacklist:              NewBlacklistClient(cfg),
+		Category:               NewCategoryClient(cfg),
+		CategoryModerator:      NewCategoryModeratorClient(cfg),
+		CategoryPermission:     NewCategoryPermissionClient(cfg),
+		Comment:                NewCommentClient(cfg),
+		CommentAction:          NewCommentActionClient(cfg),
+		Conversation:           NewConversationClient(cfg),
+		Follow:                 NewFollowClient(cfg),
+		InviteCode:             NewInviteCodeClient(cfg),
+		Mention:                NewMentionClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		OAuthProvider:          NewOAuthProviderClient(cfg),
+		Post:                   NewPostClient(cfg),
+		PostAction:             NewPostActionClient(cfg),
+		PostPurchase:           NewPostPurchaseClient(cfg),
+		PostRevision:           NewPostRevisionClient(cfg),
+		PostTag:                NewPostTagClient(cfg),
+		PrivateMessage:         NewPrivateMessageClient(cfg),
+		Report:                 NewReportClient(cfg),
+		Settings:               NewSettingsClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		User:                   NewUserClient(cfg),
+		UserBadge:              NewUserBadgeClient(cfg),
+		UserBalanceLog:         NewUserBalanceLogClient(cfg),
+		UserGroup:              NewUserGroupClient(cfg),
+		UserGroupMember:        NewUserGroupMemberClient(cfg),
+		UserInvitation:         NewUserInvitationClient(cfg),
+		UserLevelLog:           NewUserLevelLogClient(cfg),
+		UserLoginLog:           NewUserLoginLogClient(cfg),
+		UserOAuth:              NewUserOAuthClient(cfg),
+		UserSigninLogs:         NewUserSigninLogsClient(cfg),
+		UserSigninStatus:       NewUserSigninStatusClient(cfg),
+		UserTwoFactor:          NewUserTwoFactorClient(cfg),
+		WebAuthnCredential:     NewWebAuthnCredentialClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Blacklist.
+//		Attachment.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -262,9 +394,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Blacklist, c.Category, c.CategoryModerator, c.Comment, c.CommentAction,
-		c.OAuthProvider, c.Post, c.PostAction, c.Settings, c.User, c.UserBalanceLog,
-		c.UserLoginLog, c.UserOAuth, c.UserSigninLogs, c.UserSigninStatus,
+		c.Attachment, c.Badge, c.Blacklist, c.Category, c.CategoryModerator,
+		c.CategoryPermission, c.Comment, c.CommentAction, c.Conversation, c.Follow,
+		c.InviteCode, c.Mention, c.Notification, c.NotificationPreference,
+		c.OAuthProvider, c.Post, c.PostAction, c.PostPurchase, c.PostRevision,
+		c.PostTag, c.PrivateMessage, c.Report, c.Settings, c.Tag, c.User, c.UserBadge,
+		c.UserBalanceLog, c.UserGroup, c.UserGroupMember, c.UserInvitation,
+		c.UserLevelLog, c.UserLoginLog, c.UserOAuth, c.UserSigninLogs,
+		c.UserSigninStatus, c.UserTwoFactor, c.WebAuthnCredential,
 	} {
 		n.Use(hooks...)
 	}
@@ -274,9 +411,14 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Blacklist, c.Category, c.CategoryModerator, c.Comment, c.CommentAction,
-		c.OAuthProvider, c.Post, c.PostAction, c.Settings, c.User, c.UserBalanceLog,
-		c.UserLoginLog, c.UserOAuth, c.UserSigninLogs, c.UserSigninStatus,
+		c.Attachment, c.Badge, c.Blacklist, c.Category, c.CategoryModerator,
+		c.CategoryPermission, c.Comment, c.CommentAction, c.Conversation, c.Follow,
+		c.InviteCode, c.Mention, c.Notification, c.NotificationPreference,
+		c.OAuthProvider, c.Post, c.PostAction, c.PostPurchase, c.PostRevision,
+		c.PostTag, c.PrivateMessage, c.Report, c.Settings, c.Tag, c.User, c.UserBadge,
+		c.UserBalanceLog, c.UserGroup, c.UserGroupMember, c.UserInvitation,
+		c.UserLevelLog, c.UserLoginLog, c.UserOAuth, c.UserSigninLogs,
+		c.UserSigninStatus, c.UserTwoFactor, c.WebAuthnCredential,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -285,28 +427,68 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AttachmentMutation:
+		return c.Attachment.mutate(ctx, m)
+	case *BadgeMutation:
+		return c.Badge.mutate(ctx, m)
 	case *BlacklistMutation:
 		return c.Blacklist.mutate(ctx, m)
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
 	case *CategoryModeratorMutation:
 		return c.CategoryModerator.mutate(ctx, m)
+	case *CategoryPermissionMutation:
+		return c.CategoryPermission.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
 	case *CommentActionMutation:
 		return c.CommentAction.mutate(ctx, m)
+	case *ConversationMutation:
+		return c.Conversation.mutate(ctx, m)
+	case *FollowMutation:
+		return c.Follow.mutate(ctx, m)
+	case *InviteCodeMutation:
+		return c.InviteCode.mutate(ctx, m)
+	case *MentionMutation:
+		return c.Mention.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
 	case *OAuthProviderMutation:
 		return c.OAuthProvider.mutate(ctx, m)
 	case *PostMutation:
 		return c.Post.mutate(ctx, m)
 	case *PostActionMutation:
 		return c.PostAction.mutate(ctx, m)
+	case *PostPurchaseMutation:
+		return c.PostPurchase.mutate(ctx, m)
+	case *PostRevisionMutation:
+		return c.PostRevision.mutate(ctx, m)
+	case *PostTagMutation:
+		return c.PostTag.mutate(ctx, m)
+	case *PrivateMessageMutation:
+		return c.PrivateMessage.mutate(ctx, m)
+	case *ReportMutation:
+		return c.Report.mutate(ctx, m)
 	case *SettingsMutation:
 		return c.Settings.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserBadgeMutation:
+		return c.UserBadge.mutate(ctx, m)
 	case *UserBalanceLogMutation:
 		return c.UserBalanceLog.mutate(ctx, m)
+	case *UserGroupMutation:
+		return c.UserGroup.mutate(ctx, m)
+	case *UserGroupMemberMutation:
+		return c.UserGroupMember.mutate(ctx, m)
+	case *UserInvitationMutation:
+		return c.UserInvitation.mutate(ctx, m)
+	case *UserLevelLogMutation:
+		return c.UserLevelLog.mutate(ctx, m)
 	case *UserLoginLogMutation:
 		return c.UserLoginLog.mutate(ctx, m)
 	case *UserOAuthMutation:
@@ -315,11 +497,281 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.UserSigninLogs.mutate(ctx, m)
 	case *UserSigninStatusMutation:
 		return c.UserSigninStatus.mutate(ctx, m)
+	case *UserTwoFactorMutation:
+		return c.UserTwoFactor.mutate(ctx, m)
+	case *WebAuthnCredentialMutation:
+		return c.WebAuthnCredential.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AttachmentClient is a client for the Attachment schema.
+type AttachmentClient struct {
+	config
+}
+
+// NewAttachmentClient returns a client for the Attachment from the given config.
+func NewAttachmentClient(c config) *AttachmentClient {
+	return &AttachmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attachment.Hooks(f(g(h())))`.
+func (c *AttachmentClient) Use(hooks ...Hook) {
+	c.hooks.Attachment = append(c.hooks.Attachment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `attachment.Intercept(f(g(h())))`.
+func (c *AttachmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Attachment = append(c.inters.Attachment, interceptors...)
+}
+
+// Create returns a builder for creating a Attachment entity.
+func (c *AttachmentClient) Create() *AttachmentCreate {
+	mutation := newAttachmentMutation(c.config, OpCreate)
+	return &AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Attachment entities.
+func (c *AttachmentClient) CreateBulk(builders ...*AttachmentCreate) *AttachmentCreateBulk {
+	return &AttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AttachmentClient) MapCreateBulk(slice any, setFunc func(*AttachmentCreate, int)) *AttachmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AttachmentCreateBulk{err: fmt.Errorf("calling to AttachmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AttachmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Attachment.
+func (c *AttachmentClient) Update() *AttachmentUpdate {
+	mutation := newAttachmentMutation(c.config, OpUpdate)
+	return &AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttachmentClient) UpdateOne(_m *Attachment) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachment(_m))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttachmentClient) UpdateOneID(id int) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachmentID(id))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Attachment.
+func (c *AttachmentClient) Delete() *AttachmentDelete {
+	mutation := newAttachmentMutation(c.config, OpDelete)
+	return &AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttachmentClient) DeleteOne(_m *Attachment) *AttachmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AttachmentClient) DeleteOneID(id int) *AttachmentDeleteOne {
+	builder := c.Delete().Where(attachment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttachmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Attachment.
+func (c *AttachmentClient) Query() *AttachmentQuery {
+	return &AttachmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAttachment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Attachment entity by its id.
+func (c *AttachmentClient) Get(ctx context.Context, id int) (*Attachment, error) {
+	return c.Query().Where(attachment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttachmentClient) GetX(ctx context.Context, id int) *Attachment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AttachmentClient) Hooks() []Hook {
+	return c.hooks.Attachment
+}
+
+// Interceptors returns the client interceptors.
+func (c *AttachmentClient) Interceptors() []Interceptor {
+	return c.inters.Attachment
+}
+
+func (c *AttachmentClient) mutate(ctx context.Context, m *AttachmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Attachment mutation op: %q", m.Op())
+	}
+}
+
+// BadgeClient is a client for the Badge schema.
+type BadgeClient struct {
+	config
+}
+
+// NewBadgeClient returns a client for the Badge from the given config.
+func NewBadgeClient(c config) *BadgeClient {
+	return &BadgeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `badge.Hooks(f(g(h())))`.
+func (c *BadgeClient) Use(hooks ...Hook) {
+	c.hooks.Badge = append(c.hooks.Badge, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `badge.Intercept(f(g(h())))`.
+func (c *BadgeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Badge = append(c.inters.Badge, interceptors...)
+}
+
+// Create returns a builder for creating a Badge entity.
+func (c *BadgeClient) Create() *BadgeCreate {
+	mutation := newBadgeMutation(c.config, OpCreate)
+	return &BadgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Badge entities.
+func (c *BadgeClient) CreateBulk(builders ...*BadgeCreate) *BadgeCreateBulk {
+	return &BadgeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BadgeClient) MapCreateBulk(slice any, setFunc func(*BadgeCreate, int)) *BadgeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BadgeCreateBulk{err: fmt.Errorf("calling to BadgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BadgeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BadgeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Badge.
+func (c *BadgeClient) Update() *BadgeUpdate {
+	mutation := newBadgeMutation(c.config, OpUpdate)
+	return &BadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BadgeClient) UpdateOne(_m *Badge) *BadgeUpdateOne {
+	mutation := newBadgeMutation(c.config, OpUpdateOne, withBadge(_m))
+	return &BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BadgeClient) UpdateOneID(id int) *BadgeUpdateOne {
+	mutation := newBadgeMutation(c.config, OpUpdateOne, withBadgeID(id))
+	return &BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Badge.
+func (c *BadgeClient) Delete() *BadgeDelete {
+	mutation := newBadgeMutation(c.config, OpDelete)
+	return &BadgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BadgeClient) DeleteOne(_m *Badge) *BadgeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BadgeClient) DeleteOneID(id int) *BadgeDeleteOne {
+	builder := c.Delete().Where(badge.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BadgeDeleteOne{builder}
+}
+
+// Query returns a query builder for Badge.
+func (c *BadgeClient) Query() *BadgeQuery {
+	return &BadgeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBadge},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Badge entity by its id.
+func (c *BadgeClient) Get(ctx context.Context, id int) (*Badge, error) {
+	return c.Query().Where(badge.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BadgeClient) GetX(ctx context.Context, id int) *Badge {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BadgeClient) Hooks() []Hook {
+	return c.hooks.Badge
+}
+
+// Interceptors returns the client interceptors.
+func (c *BadgeClient) Interceptors() []Interceptor {
+	return c.inters.Badge
+}
+
+func (c *BadgeClient) mutate(ctx context.Context, m *BadgeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BadgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BadgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Badge mutation op: %q", m.Op())
+	}
+}
+
 // BlacklistClient is a client for the Blacklist schema.
 type BlacklistClient struct {
 	config
@@ -719,6 +1171,139 @@ func (c *CategoryModeratorClient) mutate(ctx context.Context, m *CategoryModerat
 	}
 }
 
+// CategoryPermissionClient is a client for the CategoryPermission schema.
+type CategoryPermissionClient struct {
+	config
+}
+
+// NewCategoryPermissionClient returns a client for the CategoryPermission from the given config.
+func NewCategoryPermissionClient(c config) *CategoryPermissionClient {
+	return &CategoryPermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categorypermission.Hooks(f(g(h())))`.
+func (c *CategoryPermissionClient) Use(hooks ...Hook) {
+	c.hooks.CategoryPermission = append(c.hooks.CategoryPermission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categorypermission.Intercept(f(g(h())))`.
+func (c *CategoryPermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryPermission = append(c.inters.CategoryPermission, interceptors...)
+}
+
+// Create returns a builder for creating a CategoryPermission entity.
+func (c *CategoryPermissionClient) Create() *CategoryPermissionCreate {
+	mutation := newCategoryPermissionMutation(c.config, OpCreate)
+	return &CategoryPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategoryPermission entities.
+func (c *CategoryPermissionClient) CreateBulk(builders ...*CategoryPermissionCreate) *CategoryPermissionCreateBulk {
+	return &CategoryPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryPermissionClient) MapCreateBulk(slice any, setFunc func(*CategoryPermissionCreate, int)) *CategoryPermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryPermissionCreateBulk{err: fmt.Errorf("calling to CategoryPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryPermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategoryPermission.
+func (c *CategoryPermissionClient) Update() *CategoryPermissionUpdate {
+	mutation := newCategoryPermissionMutation(c.config, OpUpdate)
+	return &CategoryPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryPermissionClient) UpdateOne(_m *CategoryPermission) *CategoryPermissionUpdateOne {
+	mutation := newCategoryPermissionMutation(c.config, OpUpdateOne, withCategoryPermission(_m))
+	return &CategoryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryPermissionClient) UpdateOneID(id int) *CategoryPermissionUpdateOne {
+	mutation := newCategoryPermissionMutation(c.config, OpUpdateOne, withCategoryPermissionID(id))
+	return &CategoryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategoryPermission.
+func (c *CategoryPermissionClient) Delete() *CategoryPermissionDelete {
+	mutation := newCategoryPermissionMutation(c.config, OpDelete)
+	return &CategoryPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryPermissionClient) DeleteOne(_m *CategoryPermission) *CategoryPermissionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryPermissionClient) DeleteOneID(id int) *CategoryPermissionDeleteOne {
+	builder := c.Delete().Where(categorypermission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryPermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for CategoryPermission.
+func (c *CategoryPermissionClient) Query() *CategoryPermissionQuery {
+	return &CategoryPermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategoryPermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategoryPermission entity by its id.
+func (c *CategoryPermissionClient) Get(ctx context.Context, id int) (*CategoryPermission, error) {
+	return c.Query().Where(categorypermission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryPermissionClient) GetX(ctx context.Context, id int) *CategoryPermission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryPermissionClient) Hooks() []Hook {
+	return c.hooks.CategoryPermission
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryPermissionClient) Interceptors() []Interceptor {
+	return c.inters.CategoryPermission
+}
+
+func (c *CategoryPermissionClient) mutate(ctx context.Context, m *CategoryPermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CategoryPermission mutation op: %q", m.Op())
+	}
+}
+
 // CommentClient is a client for the Comment schema.
 type CommentClient struct {
 	config
@@ -985,107 +1570,107 @@ func (c *CommentActionClient) mutate(ctx context.Context, m *CommentActionMutati
 	}
 }
 
-// OAuthProviderClient is a client for the OAuthProvider schema.
-type OAuthProviderClient struct {
+// ConversationClient is a client for the Conversation schema.
+type ConversationClient struct {
 	config
 }
 
-// NewOAuthProviderClient returns a client for the OAuthProvider from the given config.
-func NewOAuthProviderClient(c config) *OAuthProviderClient {
-	return &OAuthProviderClient{config: c}
+// NewConversationClient returns a client for the Conversation from the given config.
+func NewConversationClient(c config) *ConversationClient {
+	return &ConversationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `oauthprovider.Hooks(f(g(h())))`.
-func (c *OAuthProviderClient) Use(hooks ...Hook) {
-	c.hooks.OAuthProvider = append(c.hooks.OAuthProvider, hooks...)
+// A call to `Use(f, g, h)` equals to `conversation.Hooks(f(g(h())))`.
+func (c *ConversationClient) Use(hooks ...Hook) {
+	c.hooks.Conversation = append(c.hooks.Conversation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `oauthprovider.Intercept(f(g(h())))`.
-func (c *OAuthProviderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OAuthProvider = append(c.inters.OAuthProvider, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `conversation.Intercept(f(g(h())))`.
+func (c *ConversationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Conversation = append(c.inters.Conversation, interceptors...)
 }
 
-// Create returns a builder for creating a OAuthProvider entity.
-func (c *OAuthProviderClient) Create() *OAuthProviderCreate {
-	mutation := newOAuthProviderMutation(c.config, OpCreate)
-	return &OAuthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Conversation entity.
+func (c *ConversationClient) Create() *ConversationCreate {
+	mutation := newConversationMutation(c.config, OpCreate)
+	return &ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OAuthProvider entities.
-func (c *OAuthProviderClient) CreateBulk(builders ...*OAuthProviderCreate) *OAuthProviderCreateBulk {
-	return &OAuthProviderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Conversation entities.
+func (c *ConversationClient) CreateBulk(builders ...*ConversationCreate) *ConversationCreateBulk {
+	return &ConversationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OAuthProviderClient) MapCreateBulk(slice any, setFunc func(*OAuthProviderCreate, int)) *OAuthProviderCreateBulk {
+func (c *ConversationClient) MapCreateBulk(slice any, setFunc func(*ConversationCreate, int)) *ConversationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OAuthProviderCreateBulk{err: fmt.Errorf("calling to OAuthProviderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConversationCreateBulk{err: fmt.Errorf("calling to ConversationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OAuthProviderCreate, rv.Len())
+	builders := make([]*ConversationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OAuthProviderCreateBulk{config: c.config, builders: builders}
+	return &ConversationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OAuthProvider.
-func (c *OAuthProviderClient) Update() *OAuthProviderUpdate {
-	mutation := newOAuthProviderMutation(c.config, OpUpdate)
-	return &OAuthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Conversation.
+func (c *ConversationClient) Update() *ConversationUpdate {
+	mutation := newConversationMutation(c.config, OpUpdate)
+	return &ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OAuthProviderClient) UpdateOne(_m *OAuthProvider) *OAuthProviderUpdateOne {
-	mutation := newOAuthProviderMutation(c.config, OpUpdateOne, withOAuthProvider(_m))
-	return &OAuthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConversationClient) UpdateOne(_m *Conversation) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversation(_m))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OAuthProviderClient) UpdateOneID(id int) *OAuthProviderUpdateOne {
-	mutation := newOAuthProviderMutation(c.config, OpUpdateOne, withOAuthProviderID(id))
-	return &OAuthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConversationClient) UpdateOneID(id int) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversationID(id))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OAuthProvider.
-func (c *OAuthProviderClient) Delete() *OAuthProviderDelete {
-	mutation := newOAuthProviderMutation(c.config, OpDelete)
-	return &OAuthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Conversation.
+func (c *ConversationClient) Delete() *ConversationDelete {
+	mutation := newConversationMutation(c.config, OpDelete)
+	return &ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OAuthProviderClient) DeleteOne(_m *OAuthProvider) *OAuthProviderDeleteOne {
+func (c *ConversationClient) DeleteOne(_m *Conversation) *ConversationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OAuthProviderClient) DeleteOneID(id int) *OAuthProviderDeleteOne {
-	builder := c.Delete().Where(oauthprovider.ID(id))
+func (c *ConversationClient) DeleteOneID(id int) *ConversationDeleteOne {
+	builder := c.Delete().Where(conversation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OAuthProviderDeleteOne{builder}
+	return &ConversationDeleteOne{builder}
 }
 
-// Query returns a query builder for OAuthProvider.
-func (c *OAuthProviderClient) Query() *OAuthProviderQuery {
-	return &OAuthProviderQuery{
+// Query returns a query builder for Conversation.
+func (c *ConversationClient) Query() *ConversationQuery {
+	return &ConversationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOAuthProvider},
+		ctx:    &QueryContext{Type: TypeConversation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OAuthProvider entity by its id.
-func (c *OAuthProviderClient) Get(ctx context.Context, id int) (*OAuthProvider, error) {
-	return c.Query().Where(oauthprovider.ID(id)).Only(ctx)
+// Get returns a Conversation entity by its id.
+func (c *ConversationClient) Get(ctx context.Context, id int) (*Conversation, error) {
+	return c.Query().Where(conversation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OAuthProviderClient) GetX(ctx context.Context, id int) *OAuthProvider {
+func (c *ConversationClient) GetX(ctx context.Context, id int) *Conversation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1094,131 +1679,131 @@ func (c *OAuthProviderClient) GetX(ctx context.Context, id int) *OAuthProvider {
 }
 
 // Hooks returns the client hooks.
-func (c *OAuthProviderClient) Hooks() []Hook {
-	return c.hooks.OAuthProvider
+func (c *ConversationClient) Hooks() []Hook {
+	return c.hooks.Conversation
 }
 
 // Interceptors returns the client interceptors.
-func (c *OAuthProviderClient) Interceptors() []Interceptor {
-	return c.inters.OAuthProvider
+func (c *ConversationClient) Interceptors() []Interceptor {
+	return c.inters.Conversation
 }
 
-func (c *OAuthProviderClient) mutate(ctx context.Context, m *OAuthProviderMutation) (Value, error) {
+func (c *ConversationClient) mutate(ctx context.Context, m *ConversationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OAuthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OAuthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OAuthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OAuthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OAuthProvider mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Conversation mutation op: %q", m.Op())
 	}
 }
 
-// PostClient is a client for the Post schema.
-type PostClient struct {
+// FollowClient is a client for the Follow schema.
+type FollowClient struct {
 	config
 }
 
-// NewPostClient returns a client for the Post from the given config.
-func NewPostClient(c config) *PostClient {
-	return &PostClient{config: c}
+// NewFollowClient returns a client for the Follow from the given config.
+func NewFollowClient(c config) *FollowClient {
+	return &FollowClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
-func (c *PostClient) Use(hooks ...Hook) {
-	c.hooks.Post = append(c.hooks.Post, hooks...)
+// A call to `Use(f, g, h)` equals to `follow.Hooks(f(g(h())))`.
+func (c *FollowClient) Use(hooks ...Hook) {
+	c.hooks.Follow = append(c.hooks.Follow, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
-func (c *PostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Post = append(c.inters.Post, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `follow.Intercept(f(g(h())))`.
+func (c *FollowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Follow = append(c.inters.Follow, interceptors...)
 }
 
-// Create returns a builder for creating a Post entity.
-func (c *PostClient) Create() *PostCreate {
-	mutation := newPostMutation(c.config, OpCreate)
-	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Follow entity.
+func (c *FollowClient) Create() *FollowCreate {
+	mutation := newFollowMutation(c.config, OpCreate)
+	return &FollowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Post entities.
-func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
-	return &PostCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Follow entities.
+func (c *FollowClient) CreateBulk(builders ...*FollowCreate) *FollowCreateBulk {
+	return &FollowCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+func (c *FollowClient) MapCreateBulk(slice any, setFunc func(*FollowCreate, int)) *FollowCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FollowCreateBulk{err: fmt.Errorf("calling to FollowClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostCreate, rv.Len())
+	builders := make([]*FollowCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostCreateBulk{config: c.config, builders: builders}
+	return &FollowCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Post.
-func (c *PostClient) Update() *PostUpdate {
-	mutation := newPostMutation(c.config, OpUpdate)
-	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Follow.
+func (c *FollowClient) Update() *FollowUpdate {
+	mutation := newFollowMutation(c.config, OpUpdate)
+	return &FollowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostClient) UpdateOne(_m *Post) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPost(_m))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FollowClient) UpdateOne(_m *Follow) *FollowUpdateOne {
+	mutation := newFollowMutation(c.config, OpUpdateOne, withFollow(_m))
+	return &FollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FollowClient) UpdateOneID(id int) *FollowUpdateOne {
+	mutation := newFollowMutation(c.config, OpUpdateOne, withFollowID(id))
+	return &FollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Post.
-func (c *PostClient) Delete() *PostDelete {
-	mutation := newPostMutation(c.config, OpDelete)
-	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Follow.
+func (c *FollowClient) Delete() *FollowDelete {
+	mutation := newFollowMutation(c.config, OpDelete)
+	return &FollowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostClient) DeleteOne(_m *Post) *PostDeleteOne {
+func (c *FollowClient) DeleteOne(_m *Follow) *FollowDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
-	builder := c.Delete().Where(post.ID(id))
+func (c *FollowClient) DeleteOneID(id int) *FollowDeleteOne {
+	builder := c.Delete().Where(follow.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostDeleteOne{builder}
+	return &FollowDeleteOne{builder}
 }
 
-// Query returns a query builder for Post.
-func (c *PostClient) Query() *PostQuery {
-	return &PostQuery{
+// Query returns a query builder for Follow.
+func (c *FollowClient) Query() *FollowQuery {
+	return &FollowQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePost},
+		ctx:    &QueryContext{Type: TypeFollow},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Post entity by its id.
-func (c *PostClient) Get(ctx context.Context, id int) (*Post, error) {
-	return c.Query().Where(post.ID(id)).Only(ctx)
+// Get returns a Follow entity by its id.
+func (c *FollowClient) Get(ctx context.Context, id int) (*Follow, error) {
+	return c.Query().Where(follow.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostClient) GetX(ctx context.Context, id int) *Post {
+func (c *FollowClient) GetX(ctx context.Context, id int) *Follow {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1227,131 +1812,131 @@ func (c *PostClient) GetX(ctx context.Context, id int) *Post {
 }
 
 // Hooks returns the client hooks.
-func (c *PostClient) Hooks() []Hook {
-	return c.hooks.Post
+func (c *FollowClient) Hooks() []Hook {
+	return c.hooks.Follow
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostClient) Interceptors() []Interceptor {
-	return c.inters.Post
+func (c *FollowClient) Interceptors() []Interceptor {
+	return c.inters.Follow
 }
 
-func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+func (c *FollowClient) mutate(ctx context.Context, m *FollowMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FollowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FollowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FollowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Follow mutation op: %q", m.Op())
 	}
 }
 
-// PostActionClient is a client for the PostAction schema.
-type PostActionClient struct {
+// InviteCodeClient is a client for the InviteCode schema.
+type InviteCodeClient struct {
 	config
 }
 
-// NewPostActionClient returns a client for the PostAction from the given config.
-func NewPostActionClient(c config) *PostActionClient {
-	return &PostActionClient{config: c}
+// NewInviteCodeClient returns a client for the InviteCode from the given config.
+func NewInviteCodeClient(c config) *InviteCodeClient {
+	return &InviteCodeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `postaction.Hooks(f(g(h())))`.
-func (c *PostActionClient) Use(hooks ...Hook) {
-	c.hooks.PostAction = append(c.hooks.PostAction, hooks...)
+// A call to `Use(f, g, h)` equals to `invitecode.Hooks(f(g(h())))`.
+func (c *InviteCodeClient) Use(hooks ...Hook) {
+	c.hooks.InviteCode = append(c.hooks.InviteCode, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `postaction.Intercept(f(g(h())))`.
-func (c *PostActionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PostAction = append(c.inters.PostAction, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `invitecode.Intercept(f(g(h())))`.
+func (c *InviteCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InviteCode = append(c.inters.InviteCode, interceptors...)
 }
 
-// Create returns a builder for creating a PostAction entity.
-func (c *PostActionClient) Create() *PostActionCreate {
-	mutation := newPostActionMutation(c.config, OpCreate)
-	return &PostActionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a InviteCode entity.
+func (c *InviteCodeClient) Create() *InviteCodeCreate {
+	mutation := newInviteCodeMutation(c.config, OpCreate)
+	return &InviteCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PostAction entities.
-func (c *PostActionClient) CreateBulk(builders ...*PostActionCreate) *PostActionCreateBulk {
-	return &PostActionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of InviteCode entities.
+func (c *InviteCodeClient) CreateBulk(builders ...*InviteCodeCreate) *InviteCodeCreateBulk {
+	return &InviteCodeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostActionClient) MapCreateBulk(slice any, setFunc func(*PostActionCreate, int)) *PostActionCreateBulk {
+func (c *InviteCodeClient) MapCreateBulk(slice any, setFunc func(*InviteCodeCreate, int)) *InviteCodeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostActionCreateBulk{err: fmt.Errorf("calling to PostActionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InviteCodeCreateBulk{err: fmt.Errorf("calling to InviteCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostActionCreate, rv.Len())
+	builders := make([]*InviteCodeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostActionCreateBulk{config: c.config, builders: builders}
+	return &InviteCodeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PostAction.
-func (c *PostActionClient) Update() *PostActionUpdate {
-	mutation := newPostActionMutation(c.config, OpUpdate)
-	return &PostActionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for InviteCode.
+func (c *InviteCodeClient) Update() *InviteCodeUpdate {
+	mutation := newInviteCodeMutation(c.config, OpUpdate)
+	return &InviteCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostActionClient) UpdateOne(_m *PostAction) *PostActionUpdateOne {
-	mutation := newPostActionMutation(c.config, OpUpdateOne, withPostAction(_m))
-	return &PostActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InviteCodeClient) UpdateOne(_m *InviteCode) *InviteCodeUpdateOne {
+	mutation := newInviteCodeMutation(c.config, OpUpdateOne, withInviteCode(_m))
+	return &InviteCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostActionClient) UpdateOneID(id int) *PostActionUpdateOne {
-	mutation := newPostActionMutation(c.config, OpUpdateOne, withPostActionID(id))
-	return &PostActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InviteCodeClient) UpdateOneID(id int) *InviteCodeUpdateOne {
+	mutation := newInviteCodeMutation(c.config, OpUpdateOne, withInviteCodeID(id))
+	return &InviteCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PostAction.
-func (c *PostActionClient) Delete() *PostActionDelete {
-	mutation := newPostActionMutation(c.config, OpDelete)
-	return &PostActionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for InviteCode.
+func (c *InviteCodeClient) Delete() *InviteCodeDelete {
+	mutation := newInviteCodeMutation(c.config, OpDelete)
+	return &InviteCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostActionClient) DeleteOne(_m *PostAction) *PostActionDeleteOne {
+func (c *InviteCodeClient) DeleteOne(_m *InviteCode) *InviteCodeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostActionClient) DeleteOneID(id int) *PostActionDeleteOne {
-	builder := c.Delete().Where(postaction.ID(id))
+func (c *InviteCodeClient) DeleteOneID(id int) *InviteCodeDeleteOne {
+	builder := c.Delete().Where(invitecode.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostActionDeleteOne{builder}
+	return &InviteCodeDeleteOne{builder}
 }
 
-// Query returns a query builder for PostAction.
-func (c *PostActionClient) Query() *PostActionQuery {
-	return &PostActionQuery{
+// Query returns a query builder for InviteCode.
+func (c *InviteCodeClient) Query() *InviteCodeQuery {
+	return &InviteCodeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePostAction},
+		ctx:    &QueryContext{Type: TypeInviteCode},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PostAction entity by its id.
-func (c *PostActionClient) Get(ctx context.Context, id int) (*PostAction, error) {
-	return c.Query().Where(postaction.ID(id)).Only(ctx)
+// Get returns a InviteCode entity by its id.
+func (c *InviteCodeClient) Get(ctx context.Context, id int) (*InviteCode, error) {
+	return c.Query().Where(invitecode.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostActionClient) GetX(ctx context.Context, id int) *PostAction {
+func (c *InviteCodeClient) GetX(ctx context.Context, id int) *InviteCode {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1360,131 +1945,131 @@ func (c *PostActionClient) GetX(ctx context.Context, id int) *PostAction {
 }
 
 // Hooks returns the client hooks.
-func (c *PostActionClient) Hooks() []Hook {
-	return c.hooks.PostAction
+func (c *InviteCodeClient) Hooks() []Hook {
+	return c.hooks.InviteCode
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostActionClient) Interceptors() []Interceptor {
-	return c.inters.PostAction
+func (c *InviteCodeClient) Interceptors() []Interceptor {
+	return c.inters.InviteCode
 }
 
-func (c *PostActionClient) mutate(ctx context.Context, m *PostActionMutation) (Value, error) {
+func (c *InviteCodeClient) mutate(ctx context.Context, m *InviteCodeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostActionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InviteCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostActionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InviteCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InviteCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostActionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InviteCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PostAction mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown InviteCode mutation op: %q", m.Op())
 	}
 }
 
-// SettingsClient is a client for the Settings schema.
-type SettingsClient struct {
+// MentionClient is a client for the Mention schema.
+type MentionClient struct {
 	config
 }
 
-// NewSettingsClient returns a client for the Settings from the given config.
-func NewSettingsClient(c config) *SettingsClient {
-	return &SettingsClient{config: c}
+// NewMentionClient returns a client for the Mention from the given config.
+func NewMentionClient(c config) *MentionClient {
+	return &MentionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `settings.Hooks(f(g(h())))`.
-func (c *SettingsClient) Use(hooks ...Hook) {
-	c.hooks.Settings = append(c.hooks.Settings, hooks...)
+// A call to `Use(f, g, h)` equals to `mention.Hooks(f(g(h())))`.
+func (c *MentionClient) Use(hooks ...Hook) {
+	c.hooks.Mention = append(c.hooks.Mention, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `settings.Intercept(f(g(h())))`.
-func (c *SettingsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Settings = append(c.inters.Settings, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `mention.Intercept(f(g(h())))`.
+func (c *MentionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Mention = append(c.inters.Mention, interceptors...)
 }
 
-// Create returns a builder for creating a Settings entity.
-func (c *SettingsClient) Create() *SettingsCreate {
-	mutation := newSettingsMutation(c.config, OpCreate)
-	return &SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Mention entity.
+func (c *MentionClient) Create() *MentionCreate {
+	mutation := newMentionMutation(c.config, OpCreate)
+	return &MentionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Settings entities.
-func (c *SettingsClient) CreateBulk(builders ...*SettingsCreate) *SettingsCreateBulk {
-	return &SettingsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Mention entities.
+func (c *MentionClient) CreateBulk(builders ...*MentionCreate) *MentionCreateBulk {
+	return &MentionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SettingsClient) MapCreateBulk(slice any, setFunc func(*SettingsCreate, int)) *SettingsCreateBulk {
+func (c *MentionClient) MapCreateBulk(slice any, setFunc func(*MentionCreate, int)) *MentionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SettingsCreateBulk{err: fmt.Errorf("calling to SettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MentionCreateBulk{err: fmt.Errorf("calling to MentionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SettingsCreate, rv.Len())
+	builders := make([]*MentionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SettingsCreateBulk{config: c.config, builders: builders}
+	return &MentionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Settings.
-func (c *SettingsClient) Update() *SettingsUpdate {
-	mutation := newSettingsMutation(c.config, OpUpdate)
-	return &SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Mention.
+func (c *MentionClient) Update() *MentionUpdate {
+	mutation := newMentionMutation(c.config, OpUpdate)
+	return &MentionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SettingsClient) UpdateOne(_m *Settings) *SettingsUpdateOne {
-	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettings(_m))
-	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MentionClient) UpdateOne(_m *Mention) *MentionUpdateOne {
+	mutation := newMentionMutation(c.config, OpUpdateOne, withMention(_m))
+	return &MentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SettingsClient) UpdateOneID(id int) *SettingsUpdateOne {
-	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettingsID(id))
-	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MentionClient) UpdateOneID(id int) *MentionUpdateOne {
+	mutation := newMentionMutation(c.config, OpUpdateOne, withMentionID(id))
+	return &MentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Settings.
-func (c *SettingsClient) Delete() *SettingsDelete {
-	mutation := newSettingsMutation(c.config, OpDelete)
-	return &SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Mention.
+func (c *MentionClient) Delete() *MentionDelete {
+	mutation := newMentionMutation(c.config, OpDelete)
+	return &MentionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SettingsClient) DeleteOne(_m *Settings) *SettingsDeleteOne {
+func (c *MentionClient) DeleteOne(_m *Mention) *MentionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SettingsClient) DeleteOneID(id int) *SettingsDeleteOne {
-	builder := c.Delete().Where(settings.ID(id))
+func (c *MentionClient) DeleteOneID(id int) *MentionDeleteOne {
+	builder := c.Delete().Where(mention.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SettingsDeleteOne{builder}
+	return &MentionDeleteOne{builder}
 }
 
-// Query returns a query builder for Settings.
-func (c *SettingsClient) Query() *SettingsQuery {
-	return &SettingsQuery{
+// Query returns a query builder for Mention.
+func (c *MentionClient) Query() *MentionQuery {
+	return &MentionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSettings},
+		ctx:    &QueryContext{Type: TypeMention},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Settings entity by its id.
-func (c *SettingsClient) Get(ctx context.Context, id int) (*Settings, error) {
-	return c.Query().Where(settings.ID(id)).Only(ctx)
+// Get returns a Mention entity by its id.
+func (c *MentionClient) Get(ctx context.Context, id int) (*Mention, error) {
+	return c.Query().Where(mention.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
+func (c *MentionClient) GetX(ctx context.Context, id int) *Mention {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1493,131 +2078,2392 @@ func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
 }
 
 // Hooks returns the client hooks.
-func (c *SettingsClient) Hooks() []Hook {
-	return c.hooks.Settings
+func (c *MentionClient) Hooks() []Hook {
+	return c.hooks.Mention
 }
 
 // Interceptors returns the client interceptors.
-func (c *SettingsClient) Interceptors() []Interceptor {
-	return c.inters.Settings
+func (c *MentionClient) Interceptors() []Interceptor {
+	return c.inters.Mention
 }
 
-func (c *SettingsClient) mutate(ctx context.Context, m *SettingsMutation) (Value, error) {
+func (c *MentionClient) mutate(ctx context.Context, m *MentionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MentionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MentionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MentionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Settings mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Mention mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id int) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id int) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id int) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(_m *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(_m))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id int) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(_m *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id int) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id int) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id int) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.NotificationPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// OAuthProviderClient is a client for the OAuthProvider schema.
+type OAuthProviderClient struct {
+	config
+}
+
+// NewOAuthProviderClient returns a client for the OAuthProvider from the given config.
+func NewOAuthProviderClient(c config) *OAuthProviderClient {
+	return &OAuthProviderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauthprovider.Hooks(f(g(h())))`.
+func (c *OAuthProviderClient) Use(hooks ...Hook) {
+	c.hooks.OAuthProvider = append(c.hooks.OAuthProvider, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauthprovider.Intercept(f(g(h())))`.
+func (c *OAuthProviderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuthProvider = append(c.inters.OAuthProvider, interceptors...)
+}
+
+// Create returns a builder for creating a OAuthProvider entity.
+func (c *OAuthProviderClient) Create() *OAuthProviderCreate {
+	mutation := newOAuthProviderMutation(c.config, OpCreate)
+	return &OAuthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OAuthProvider entities.
+func (c *OAuthProviderClient) CreateBulk(builders ...*OAuthProviderCreate) *OAuthProviderCreateBulk {
+	return &OAuthProviderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OAuthProviderClient) MapCreateBulk(slice any, setFunc func(*OAuthProviderCreate, int)) *OAuthProviderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OAuthProviderCreateBulk{err: fmt.Errorf("calling to OAuthProviderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OAuthProviderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OAuthProviderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OAuthProvider.
+func (c *OAuthProviderClient) Update() *OAuthProviderUpdate {
+	mutation := newOAuthProviderMutation(c.config, OpUpdate)
+	return &OAuthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OAuthProviderClient) UpdateOne(_m *OAuthProvider) *OAuthProviderUpdateOne {
+	mutation := newOAuthProviderMutation(c.config, OpUpdateOne, withOAuthProvider(_m))
+	return &OAuthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OAuthProviderClient) UpdateOneID(id int) *OAuthProviderUpdateOne {
+	mutation := newOAuthProviderMutation(c.config, OpUpdateOne, withOAuthProviderID(id))
+	return &OAuthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OAuthProvider.
+func (c *OAuthProviderClient) Delete() *OAuthProviderDelete {
+	mutation := newOAuthProviderMutation(c.config, OpDelete)
+	return &OAuthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OAuthProviderClient) DeleteOne(_m *OAuthProvider) *OAuthProviderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OAuthProviderClient) DeleteOneID(id int) *OAuthProviderDeleteOne {
+	builder := c.Delete().Where(oauthprovider.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OAuthProviderDeleteOne{builder}
+}
+
+// Query returns a query builder for OAuthProvider.
+func (c *OAuthProviderClient) Query() *OAuthProviderQuery {
+	return &OAuthProviderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOAuthProvider},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OAuthProvider entity by its id.
+func (c *OAuthProviderClient) Get(ctx context.Context, id int) (*OAuthProvider, error) {
+	return c.Query().Where(oauthprovider.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OAuthProviderClient) GetX(ctx context.Context, id int) *OAuthProvider {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OAuthProviderClient) Hooks() []Hook {
+	return c.hooks.OAuthProvider
+}
+
+// Interceptors returns the client interceptors.
+func (c *OAuthProviderClient) Interceptors() []Interceptor {
+	return c.inters.OAuthProvider
+}
+
+func (c *OAuthProviderClient) mutate(ctx context.Context, m *OAuthProviderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OAuthProviderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OAuthProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OAuthProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OAuthProviderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OAuthProvider mutation op: %q", m.Op())
+	}
+}
+
+// PostClient is a client for the Post schema.
+type PostClient struct {
+	config
+}
+
+// NewPostClient returns a client for the Post from the given config.
+func NewPostClient(c config) *PostClient {
+	return &PostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
+func (c *PostClient) Use(hooks ...Hook) {
+	c.hooks.Post = append(c.hooks.Post, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
+func (c *PostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Post = append(c.inters.Post, interceptors...)
+}
+
+// Create returns a builder for creating a Post entity.
+func (c *PostClient) Create() *PostCreate {
+	mutation := newPostMutation(c.config, OpCreate)
+	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Post entities.
+func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Post.
+func (c *PostClient) Update() *PostUpdate {
+	mutation := newPostMutation(c.config, OpUpdate)
+	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostClient) UpdateOne(_m *Post) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPost(_m))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Post.
+func (c *PostClient) Delete() *PostDelete {
+	mutation := newPostMutation(c.config, OpDelete)
+	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostClient) DeleteOne(_m *Post) *PostDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
+	builder := c.Delete().Where(post.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostDeleteOne{builder}
+}
+
+// Query returns a query builder for Post.
+func (c *PostClient) Query() *PostQuery {
+	return &PostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Post entity by its id.
+func (c *PostClient) Get(ctx context.Context, id int) (*Post, error) {
+	return c.Query().Where(post.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostClient) GetX(ctx context.Context, id int) *Post {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PostClient) Hooks() []Hook {
+	return c.hooks.Post
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostClient) Interceptors() []Interceptor {
+	return c.inters.Post
+}
+
+func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+	}
+}
+
+// PostActionClient is a client for the PostAction schema.
+type PostActionClient struct {
+	config
+}
+
+// NewPostActionClient returns a client for the PostAction from the given config.
+func NewPostActionClient(c config) *PostActionClient {
+	return &PostActionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `postaction.Hooks(f(g(h())))`.
+func (c *PostActionClient) Use(hooks ...Hook) {
+	c.hooks.PostAction = append(c.hooks.PostAction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `postaction.Intercept(f(g(h())))`.
+func (c *PostActionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostAction = append(c.inters.PostAction, interceptors...)
+}
+
+// Create returns a builder for creating a PostAction entity.
+func (c *PostActionClient) Create() *PostActionCreate {
+	mutation := newPostActionMutation(c.config, OpCreate)
+	return &PostActionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostAction entities.
+func (c *PostActionClient) CreateBulk(builders ...*PostActionCreate) *PostActionCreateBulk {
+	return &PostActionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostActionClient) MapCreateBulk(slice any, setFunc func(*PostActionCreate, int)) *PostActionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostActionCreateBulk{err: fmt.Errorf("calling to PostActionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostActionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostActionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostAction.
+func (c *PostActionClient) Update() *PostActionUpdate {
+	mutation := newPostActionMutation(c.config, OpUpdate)
+	return &PostActionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostActionClient) UpdateOne(_m *PostAction) *PostActionUpdateOne {
+	mutation := newPostActionMutation(c.config, OpUpdateOne, withPostAction(_m))
+	return &PostActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostActionClient) UpdateOneID(id int) *PostActionUpdateOne {
+	mutation := newPostActionMutation(c.config, OpUpdateOne, withPostActionID(id))
+	return &PostActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostAction.
+func (c *PostActionClient) Delete() *PostActionDelete {
+	mutation := newPostActionMutation(c.config, OpDelete)
+	return &PostActionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostActionClient) DeleteOne(_m *PostAction) *PostActionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostActionClient) DeleteOneID(id int) *PostActionDeleteOne {
+	builder := c.Delete().Where(postaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostActionDeleteOne{builder}
+}
+
+// Query returns a query builder for PostAction.
+func (c *PostActionClient) Query() *PostActionQuery {
+	return &PostActionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostAction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostAction entity by its id.
+func (c *PostActionClient) Get(ctx context.Context, id int) (*PostAction, error) {
+	return c.Query().Where(postaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostActionClient) GetX(ctx context.Context, id int) *PostAction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PostActionClient) Hooks() []Hook {
+	return c.hooks.PostAction
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostActionClient) Interceptors() []Interceptor {
+	return c.inters.PostAction
+}
+
+func (c *PostActionClient) mutate(ctx context.Context, m *PostActionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostActionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostActionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostActionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostAction mutation op: %q", m.Op())
+	}
+}
+
+// PostPurchaseClient is a client for the PostPurchase schema.
+type PostPurchaseClient struct {
+	config
+}
+
+// NewPostPurchaseClient returns a client for the PostPurchase from the given config.
+func NewPostPurchaseClient(c config) *PostPurchaseClient {
+	return &PostPurchaseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `postpurchase.Hooks(f(g(h())))`.
+func (c *PostPurchaseClient) Use(hooks ...Hook) {
+	c.hooks.PostPurchase = append(c.hooks.PostPurchase, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `postpurchase.Intercept(f(g(h())))`.
+func (c *PostPurchaseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostPurchase = append(c.inters.PostPurchase, interceptors...)
+}
+
+// Create returns a builder for creating a PostPurchase entity.
+func (c *PostPurchaseClient) Create() *PostPurchaseCreate {
+	mutation := newPostPurchaseMutation(c.config, OpCreate)
+	return &PostPurchaseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostPurchase entities.
+func (c *PostPurchaseClient) CreateBulk(builders ...*PostPurchaseCreate) *PostPurchaseCreateBulk {
+	return &PostPurchaseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostPurchaseClient) MapCreateBulk(slice any, setFunc func(*PostPurchaseCreate, int)) *PostPurchaseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostPurchaseCreateBulk{err: fmt.Errorf("calling to PostPurchaseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostPurchaseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostPurchaseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostPurchase.
+func (c *PostPurchaseClient) Update() *PostPurchaseUpdate {
+	mutation := newPostPurchaseMutation(c.config, OpUpdate)
+	return &PostPurchaseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostPurchaseClient) UpdateOne(_m *PostPurchase) *PostPurchaseUpdateOne {
+	mutation := newPostPurchaseMutation(c.config, OpUpdateOne, withPostPurchase(_m))
+	return &PostPurchaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostPurchaseClient) UpdateOneID(id int) *PostPurchaseUpdateOne {
+	mutation := newPostPurchaseMutation(c.config, OpUpdateOne, withPostPurchaseID(id))
+	return &PostPurchaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostPurchase.
+func (c *PostPurchaseClient) Delete() *PostPurchaseDelete {
+	mutation := newPostPurchaseMutation(c.config, OpDelete)
+	return &PostPurchaseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostPurchaseClient) DeleteOne(_m *PostPurchase) *PostPurchaseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostPurchaseClient) DeleteOneID(id int) *PostPurchaseDeleteOne {
+	builder := c.Delete().Where(postpurchase.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostPurchaseDeleteOne{builder}
+}
+
+// Query returns a query builder for PostPurchase.
+func (c *PostPurchaseClient) Query() *PostPurchaseQuery {
+	return &PostPurchaseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostPurchase},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostPurchase entity by its id.
+func (c *PostPurchaseClient) Get(ctx context.Context, id int) (*PostPurchase, error) {
+	return c.Query().Where(postpurchase.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostPurchaseClient) GetX(ctx context.Context, id int) *PostPurchase {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PostPurchaseClient) Hooks() []Hook {
+	return c.hooks.PostPurchase
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostPurchaseClient) Interceptors() []Interceptor {
+	return c.inters.PostPurchase
+}
+
+func (c *PostPurchaseClient) mutate(ctx context.Context, m *PostPurchaseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostPurchaseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostPurchaseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostPurchaseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostPurchaseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostPurchase mutation op: %q", m.Op())
+	}
+}
+
+// PostRevisionClient is a client for the PostRevision schema.
+type PostRevisionClient struct {
+	config
+}
+
+// NewPostRevisionClient returns a client for the PostRevision from the given config.
+func NewPostRevisionClient(c config) *PostRevisionClient {
+	return &PostRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `postrevision.Hooks(f(g(h())))`.
+func (c *PostRevisionClient) Use(hooks ...Hook) {
+	c.hooks.PostRevision = append(c.hooks.PostRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `postrevision.Intercept(f(g(h())))`.
+func (c *PostRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostRevision = append(c.inters.PostRevision, interceptors...)
+}
+
+// Create returns a builder for creating a PostRevision entity.
+func (c *PostRevisionClient) Create() *PostRevisionCreate {
+	mutation := newPostRevisionMutation(c.config, OpCreate)
+	return &PostRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostRevision entities.
+func (c *PostRevisionClient) CreateBulk(builders ...*PostRevisionCreate) *PostRevisionCreateBulk {
+	return &PostRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostRevisionClient) MapCreateBulk(slice any, setFunc func(*PostRevisionCreate, int)) *PostRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostRevisionCreateBulk{err: fmt.Errorf("calling to PostRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostRevision.
+func (c *PostRevisionClient) Update() *PostRevisionUpdate {
+	mutation := newPostRevisionMutation(c.config, OpUpdate)
+	return &PostRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostRevisionClient) UpdateOne(_m *PostRevision) *PostRevisionUpdateOne {
+	mutation := newPostRevisionMutation(c.config, OpUpdateOne, withPostRevision(_m))
+	return &PostRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostRevisionClient) UpdateOneID(id int) *PostRevisionUpdateOne {
+	mutation := newPostRevisionMutation(c.config, OpUpdateOne, withPostRevisionID(id))
+	return &PostRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostRevision.
+func (c *PostRevisionClient) Delete() *PostRevisionDelete {
+	mutation := newPostRevisionMutation(c.config, OpDelete)
+	return &PostRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostRevisionClient) DeleteOne(_m *PostRevision) *PostRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostRevisionClient) DeleteOneID(id int) *PostRevisionDeleteOne {
+	builder := c.Delete().Where(postrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for PostRevision.
+func (c *PostRevisionClient) Query() *PostRevisionQuery {
+	return &PostRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostRevision entity by its id.
+func (c *PostRevisionClient) Get(ctx context.Context, id int) (*PostRevision, error) {
+	return c.Query().Where(postrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostRevisionClient) GetX(ctx context.Context, id int) *PostRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PostRevisionClient) Hooks() []Hook {
+	return c.hooks.PostRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostRevisionClient) Interceptors() []Interceptor {
+	return c.inters.PostRevision
+}
+
+func (c *PostRevisionClient) mutate(ctx context.Context, m *PostRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostRevision mutation op: %q", m.Op())
+	}
+}
+
+// PostTagClient is a client for the PostTag schema.
+type PostTagClient struct {
+	config
+}
+
+// NewPostTagClient returns a client for the PostTag from the given config.
+func NewPostTagClient(c config) *PostTagClient {
+	return &PostTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `posttag.Hooks(f(g(h())))`.
+func (c *PostTagClient) Use(hooks ...Hook) {
+	c.hooks.PostTag = append(c.hooks.PostTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `posttag.Intercept(f(g(h())))`.
+func (c *PostTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostTag = append(c.inters.PostTag, interceptors...)
+}
+
+// Create returns a builder for creating a PostTag entity.
+func (c *PostTagClient) Create() *PostTagCreate {
+	mutation := newPostTagMutation(c.config, OpCreate)
+	return &PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostTag entities.
+func (c *PostTagClient) CreateBulk(builders ...*PostTagCreate) *PostTagCreateBulk {
+	return &PostTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostTagClient) MapCreateBulk(slice any, setFunc func(*PostTagCreate, int)) *PostTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostTagCreateBulk{err: fmt.Errorf("calling to PostTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostTag.
+func (c *PostTagClient) Update() *PostTagUpdate {
+	mutation := newPostTagMutation(c.config, OpUpdate)
+	return &PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostTagClient) UpdateOne(_m *PostTag) *PostTagUpdateOne {
+	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTag(_m))
+	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostTagClient) UpdateOneID(id int) *PostTagUpdateOne {
+	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTagID(id))
+	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostTag.
+func (c *PostTagClient) Delete() *PostTagDelete {
+	mutation := newPostTagMutation(c.config, OpDelete)
+	return &PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostTagClient) DeleteOne(_m *PostTag) *PostTagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostTagClient) DeleteOneID(id int) *PostTagDeleteOne {
+	builder := c.Delete().Where(posttag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostTagDeleteOne{builder}
+}
+
+// Query returns a query builder for PostTag.
+func (c *PostTagClient) Query() *PostTagQuery {
+	return &PostTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostTag entity by its id.
+func (c *PostTagClient) Get(ctx context.Context, id int) (*PostTag, error) {
+	return c.Query().Where(posttag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostTagClient) GetX(ctx context.Context, id int) *PostTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PostTagClient) Hooks() []Hook {
+	return c.hooks.PostTag
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostTagClient) Interceptors() []Interceptor {
+	return c.inters.PostTag
+}
+
+func (c *PostTagClient) mutate(ctx context.Context, m *PostTagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostTag mutation op: %q", m.Op())
+	}
+}
+
+// PrivateMessageClient is a client for the PrivateMessage schema.
+type PrivateMessageClient struct {
+	config
+}
+
+// NewPrivateMessageClient returns a client for the PrivateMessage from the given config.
+func NewPrivateMessageClient(c config) *PrivateMessageClient {
+	return &PrivateMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `privatemessage.Hooks(f(g(h())))`.
+func (c *PrivateMessageClient) Use(hooks ...Hook) {
+	c.hooks.PrivateMessage = append(c.hooks.PrivateMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `privatemessage.Intercept(f(g(h())))`.
+func (c *PrivateMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PrivateMessage = append(c.inters.PrivateMessage, interceptors...)
+}
+
+// Create returns a builder for creating a PrivateMessage entity.
+func (c *PrivateMessageClient) Create() *PrivateMessageCreate {
+	mutation := newPrivateMessageMutation(c.config, OpCreate)
+	return &PrivateMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PrivateMessage entities.
+func (c *PrivateMessageClient) CreateBulk(builders ...*PrivateMessageCreate) *PrivateMessageCreateBulk {
+	return &PrivateMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PrivateMessageClient) MapCreateBulk(slice any, setFunc func(*PrivateMessageCreate, int)) *PrivateMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PrivateMessageCreateBulk{err: fmt.Errorf("calling to PrivateMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PrivateMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PrivateMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PrivateMessage.
+func (c *PrivateMessageClient) Update() *PrivateMessageUpdate {
+	mutation := newPrivateMessageMutation(c.config, OpUpdate)
+	return &PrivateMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PrivateMessageClient) UpdateOne(_m *PrivateMessage) *PrivateMessageUpdateOne {
+	mutation := newPrivateMessageMutation(c.config, OpUpdateOne, withPrivateMessage(_m))
+	return &PrivateMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PrivateMessageClient) UpdateOneID(id int) *PrivateMessageUpdateOne {
+	mutation := newPrivateMessageMutation(c.config, OpUpdateOne, withPrivateMessageID(id))
+	return &PrivateMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PrivateMessage.
+func (c *PrivateMessageClient) Delete() *PrivateMessageDelete {
+	mutation := newPrivateMessageMutation(c.config, OpDelete)
+	return &PrivateMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PrivateMessageClient) DeleteOne(_m *PrivateMessage) *PrivateMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PrivateMessageClient) DeleteOneID(id int) *PrivateMessageDeleteOne {
+	builder := c.Delete().Where(privatemessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PrivateMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for PrivateMessage.
+func (c *PrivateMessageClient) Query() *PrivateMessageQuery {
+	return &PrivateMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePrivateMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PrivateMessage entity by its id.
+func (c *PrivateMessageClient) Get(ctx context.Context, id int) (*PrivateMessage, error) {
+	return c.Query().Where(privatemessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PrivateMessageClient) GetX(ctx context.Context, id int) *PrivateMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PrivateMessageClient) Hooks() []Hook {
+	return c.hooks.PrivateMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *PrivateMessageClient) Interceptors() []Interceptor {
+	return c.inters.PrivateMessage
+}
+
+func (c *PrivateMessageClient) mutate(ctx context.Context, m *PrivateMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PrivateMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PrivateMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PrivateMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PrivateMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PrivateMessage mutation op: %q", m.Op())
+	}
+}
+
+// ReportClient is a client for the Report schema.
+type ReportClient struct {
+	config
+}
+
+// NewReportClient returns a client for the Report from the given config.
+func NewReportClient(c config) *ReportClient {
+	return &ReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `report.Hooks(f(g(h())))`.
+func (c *ReportClient) Use(hooks ...Hook) {
+	c.hooks.Report = append(c.hooks.Report, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `report.Intercept(f(g(h())))`.
+func (c *ReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Report = append(c.inters.Report, interceptors...)
+}
+
+// Create returns a builder for creating a Report entity.
+func (c *ReportClient) Create() *ReportCreate {
+	mutation := newReportMutation(c.config, OpCreate)
+	return &ReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Report entities.
+func (c *ReportClient) CreateBulk(builders ...*ReportCreate) *ReportCreateBulk {
+	return &ReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReportClient) MapCreateBulk(slice any, setFunc func(*ReportCreate, int)) *ReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReportCreateBulk{err: fmt.Errorf("calling to ReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Report.
+func (c *ReportClient) Update() *ReportUpdate {
+	mutation := newReportMutation(c.config, OpUpdate)
+	return &ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReportClient) UpdateOne(_m *Report) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReport(_m))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReportClient) UpdateOneID(id int) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReportID(id))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Report.
+func (c *ReportClient) Delete() *ReportDelete {
+	mutation := newReportMutation(c.config, OpDelete)
+	return &ReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReportClient) DeleteOne(_m *Report) *ReportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReportClient) DeleteOneID(id int) *ReportDeleteOne {
+	builder := c.Delete().Where(report.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReportDeleteOne{builder}
+}
+
+// Query returns a query builder for Report.
+func (c *ReportClient) Query() *ReportQuery {
+	return &ReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Report entity by its id.
+func (c *ReportClient) Get(ctx context.Context, id int) (*Report, error) {
+	return c.Query().Where(report.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReportClient) GetX(ctx context.Context, id int) *Report {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReportClient) Hooks() []Hook {
+	return c.hooks.Report
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReportClient) Interceptors() []Interceptor {
+	return c.inters.Report
+}
+
+func (c *ReportClient) mutate(ctx context.Context, m *ReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Report mutation op: %q", m.Op())
+	}
+}
+
+// SettingsClient is a client for the Settings schema.
+type SettingsClient struct {
+	config
+}
+
+// NewSettingsClient returns a client for the Settings from the given config.
+func NewSettingsClient(c config) *SettingsClient {
+	return &SettingsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settings.Hooks(f(g(h())))`.
+func (c *SettingsClient) Use(hooks ...Hook) {
+	c.hooks.Settings = append(c.hooks.Settings, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settings.Intercept(f(g(h())))`.
+func (c *SettingsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Settings = append(c.inters.Settings, interceptors...)
+}
+
+// Create returns a builder for creating a Settings entity.
+func (c *SettingsClient) Create() *SettingsCreate {
+	mutation := newSettingsMutation(c.config, OpCreate)
+	return &SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Settings entities.
+func (c *SettingsClient) CreateBulk(builders ...*SettingsCreate) *SettingsCreateBulk {
+	return &SettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettingsClient) MapCreateBulk(slice any, setFunc func(*SettingsCreate, int)) *SettingsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettingsCreateBulk{err: fmt.Errorf("calling to SettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettingsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Settings.
+func (c *SettingsClient) Update() *SettingsUpdate {
+	mutation := newSettingsMutation(c.config, OpUpdate)
+	return &SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettingsClient) UpdateOne(_m *Settings) *SettingsUpdateOne {
+	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettings(_m))
+	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettingsClient) UpdateOneID(id int) *SettingsUpdateOne {
+	mutation := newSettingsMutation(c.config, OpUpdateOne, withSettingsID(id))
+	return &SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Settings.
+func (c *SettingsClient) Delete() *SettingsDelete {
+	mutation := newSettingsMutation(c.config, OpDelete)
+	return &SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettingsClient) DeleteOne(_m *Settings) *SettingsDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettingsClient) DeleteOneID(id int) *SettingsDeleteOne {
+	builder := c.Delete().Where(settings.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettingsDeleteOne{builder}
+}
+
+// Query returns a query builder for Settings.
+func (c *SettingsClient) Query() *SettingsQuery {
+	return &SettingsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettings},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Settings entity by its id.
+func (c *SettingsClient) Get(ctx context.Context, id int) (*Settings, error) {
+	return c.Query().Where(settings.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettingsClient) Hooks() []Hook {
+	return c.hooks.Settings
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettingsClient) Interceptors() []Interceptor {
+	return c.inters.Settings
+}
+
+func (c *SettingsClient) mutate(ctx context.Context, m *SettingsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Settings mutation op: %q", m.Op())
+	}
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
+}
+
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(_m *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(_m))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagClient) UpdateOneID(id int) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagClient) DeleteOne(_m *Tag) *TagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagClient) DeleteOneID(id int) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDeleteOne{builder}
+}
+
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id int) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagClient) GetX(ctx context.Context, id int) *Tag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
+}
+
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// UserBadgeClient is a client for the UserBadge schema.
+type UserBadgeClient struct {
+	config
+}
+
+// NewUserBadgeClient returns a client for the UserBadge from the given config.
+func NewUserBadgeClient(c config) *UserBadgeClient {
+	return &UserBadgeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userbadge.Hooks(f(g(h())))`.
+func (c *UserBadgeClient) Use(hooks ...Hook) {
+	c.hooks.UserBadge = append(c.hooks.UserBadge, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userbadge.Intercept(f(g(h())))`.
+func (c *UserBadgeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserBadge = append(c.inters.UserBadge, interceptors...)
+}
+
+// Create returns a builder for creating a UserBadge entity.
+func (c *UserBadgeClient) Create() *UserBadgeCreate {
+	mutation := newUserBadgeMutation(c.config, OpCreate)
+	return &UserBadgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserBadge entities.
+func (c *UserBadgeClient) CreateBulk(builders ...*UserBadgeCreate) *UserBadgeCreateBulk {
+	return &UserBadgeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserBadgeClient) MapCreateBulk(slice any, setFunc func(*UserBadgeCreate, int)) *UserBadgeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserBadgeCreateBulk{err: fmt.Errorf("calling to UserBadgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserBadgeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserBadgeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserBadge.
+func (c *UserBadgeClient) Update() *UserBadgeUpdate {
+	mutation := newUserBadgeMutation(c.config, OpUpdate)
+	return &UserBadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserBadgeClient) UpdateOne(_m *UserBadge) *UserBadgeUpdateOne {
+	mutation := newUserBadgeMutation(c.config, OpUpdateOne, withUserBadge(_m))
+	return &UserBadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserBadgeClient) UpdateOneID(id int) *UserBadgeUpdateOne {
+	mutation := newUserBadgeMutation(c.config, OpUpdateOne, withUserBadgeID(id))
+	return &UserBadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserBadge.
+func (c *UserBadgeClient) Delete() *UserBadgeDelete {
+	mutation := newUserBadgeMutation(c.config, OpDelete)
+	return &UserBadgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserBadgeClient) DeleteOne(_m *UserBadge) *UserBadgeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserBadgeClient) DeleteOneID(id int) *UserBadgeDeleteOne {
+	builder := c.Delete().Where(userbadge.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserBadgeDeleteOne{builder}
+}
+
+// Query returns a query builder for UserBadge.
+func (c *UserBadgeClient) Query() *UserBadgeQuery {
+	return &UserBadgeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserBadge},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserBadge entity by its id.
+func (c *UserBadgeClient) Get(ctx context.Context, id int) (*UserBadge, error) {
+	return c.Query().Where(userbadge.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserBadgeClient) GetX(ctx context.Context, id int) *UserBadge {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserBadgeClient) Hooks() []Hook {
+	return c.hooks.UserBadge
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserBadgeClient) Interceptors() []Interceptor {
+	return c.inters.UserBadge
+}
+
+func (c *UserBadgeClient) mutate(ctx context.Context, m *UserBadgeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserBadgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserBadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserBadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserBadgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserBadge mutation op: %q", m.Op())
+	}
+}
+
+// UserBalanceLogClient is a client for the UserBalanceLog schema.
+type UserBalanceLogClient struct {
+	config
+}
+
+// NewUserBalanceLogClient returns a client for the UserBalanceLog from the given config.
+func NewUserBalanceLogClient(c config) *UserBalanceLogClient {
+	return &UserBalanceLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userbalancelog.Hooks(f(g(h())))`.
+func (c *UserBalanceLogClient) Use(hooks ...Hook) {
+	c.hooks.UserBalanceLog = append(c.hooks.UserBalanceLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userbalancelog.Intercept(f(g(h())))`.
+func (c *UserBalanceLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserBalanceLog = append(c.inters.UserBalanceLog, interceptors...)
+}
+
+// Create returns a builder for creating a UserBalanceLog entity.
+func (c *UserBalanceLogClient) Create() *UserBalanceLogCreate {
+	mutation := newUserBalanceLogMutation(c.config, OpCreate)
+	return &UserBalanceLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserBalanceLog entities.
+func (c *UserBalanceLogClient) CreateBulk(builders ...*UserBalanceLogCreate) *UserBalanceLogCreateBulk {
+	return &UserBalanceLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserBalanceLogClient) MapCreateBulk(slice any, setFunc func(*UserBalanceLogCreate, int)) *UserBalanceLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserBalanceLogCreateBulk{err: fmt.Errorf("calling to UserBalanceLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserBalanceLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserBalanceLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserBalanceLog.
+func (c *UserBalanceLogClient) Update() *UserBalanceLogUpdate {
+	mutation := newUserBalanceLogMutation(c.config, OpUpdate)
+	return &UserBalanceLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserBalanceLogClient) UpdateOne(_m *UserBalanceLog) *UserBalanceLogUpdateOne {
+	mutation := newUserBalanceLogMutation(c.config, OpUpdateOne, withUserBalanceLog(_m))
+	return &UserBalanceLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserBalanceLogClient) UpdateOneID(id int) *UserBalanceLogUpdateOne {
+	mutation := newUserBalanceLogMutation(c.config, OpUpdateOne, withUserBalanceLogID(id))
+	return &UserBalanceLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserBalanceLog.
+func (c *UserBalanceLogClient) Delete() *UserBalanceLogDelete {
+	mutation := newUserBalanceLogMutation(c.config, OpDelete)
+	return &UserBalanceLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserBalanceLogClient) DeleteOne(_m *UserBalanceLog) *UserBalanceLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserBalanceLogClient) DeleteOneID(id int) *UserBalanceLogDeleteOne {
+	builder := c.Delete().Where(userbalancelog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserBalanceLogDeleteOne{builder}
+}
+
+// Query returns a query builder for UserBalanceLog.
+func (c *UserBalanceLogClient) Query() *UserBalanceLogQuery {
+	return &UserBalanceLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserBalanceLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserBalanceLog entity by its id.
+func (c *UserBalanceLogClient) Get(ctx context.Context, id int) (*UserBalanceLog, error) {
+	return c.Query().Where(userbalancelog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserBalanceLogClient) GetX(ctx context.Context, id int) *UserBalanceLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserBalanceLogClient) Hooks() []Hook {
+	return c.hooks.UserBalanceLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserBalanceLogClient) Interceptors() []Interceptor {
+	return c.inters.UserBalanceLog
+}
+
+func (c *UserBalanceLogClient) mutate(ctx context.Context, m *UserBalanceLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserBalanceLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserBalanceLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserBalanceLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserBalanceLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserBalanceLog mutation op: %q", m.Op())
+	}
+}
+
+// UserGroupClient is a client for the UserGroup schema.
+type UserGroupClient struct {
+	config
+}
+
+// NewUserGroupClient returns a client for the UserGroup from the given config.
+func NewUserGroupClient(c config) *UserGroupClient {
+	return &UserGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usergroup.Hooks(f(g(h())))`.
+func (c *UserGroupClient) Use(hooks ...Hook) {
+	c.hooks.UserGroup = append(c.hooks.UserGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usergroup.Intercept(f(g(h())))`.
+func (c *UserGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserGroup = append(c.inters.UserGroup, interceptors...)
+}
+
+// Create returns a builder for creating a UserGroup entity.
+func (c *UserGroupClient) Create() *UserGroupCreate {
+	mutation := newUserGroupMutation(c.config, OpCreate)
+	return &UserGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserGroup entities.
+func (c *UserGroupClient) CreateBulk(builders ...*UserGroupCreate) *UserGroupCreateBulk {
+	return &UserGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserGroupClient) MapCreateBulk(slice any, setFunc func(*UserGroupCreate, int)) *UserGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserGroupCreateBulk{err: fmt.Errorf("calling to UserGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserGroup.
+func (c *UserGroupClient) Update() *UserGroupUpdate {
+	mutation := newUserGroupMutation(c.config, OpUpdate)
+	return &UserGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserGroupClient) UpdateOne(_m *UserGroup) *UserGroupUpdateOne {
+	mutation := newUserGroupMutation(c.config, OpUpdateOne, withUserGroup(_m))
+	return &UserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserGroupClient) UpdateOneID(id int) *UserGroupUpdateOne {
+	mutation := newUserGroupMutation(c.config, OpUpdateOne, withUserGroupID(id))
+	return &UserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserGroup.
+func (c *UserGroupClient) Delete() *UserGroupDelete {
+	mutation := newUserGroupMutation(c.config, OpDelete)
+	return &UserGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserGroupClient) DeleteOne(_m *UserGroup) *UserGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserGroupClient) DeleteOneID(id int) *UserGroupDeleteOne {
+	builder := c.Delete().Where(usergroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for UserGroup.
+func (c *UserGroupClient) Query() *UserGroupQuery {
+	return &UserGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserGroup entity by its id.
+func (c *UserGroupClient) Get(ctx context.Context, id int) (*UserGroup, error) {
+	return c.Query().Where(usergroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserGroupClient) GetX(ctx context.Context, id int) *UserGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserGroupClient) Hooks() []Hook {
+	return c.hooks.UserGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserGroupClient) Interceptors() []Interceptor {
+	return c.inters.UserGroup
+}
+
+func (c *UserGroupClient) mutate(ctx context.Context, m *UserGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserGroup mutation op: %q", m.Op())
+	}
+}
+
+// UserGroupMemberClient is a client for the UserGroupMember schema.
+type UserGroupMemberClient struct {
+	config
+}
+
+// NewUserGroupMemberClient returns a client for the UserGroupMember from the given config.
+func NewUserGroupMemberClient(c config) *UserGroupMemberClient {
+	return &UserGroupMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usergroupmember.Hooks(f(g(h())))`.
+func (c *UserGroupMemberClient) Use(hooks ...Hook) {
+	c.hooks.UserGroupMember = append(c.hooks.UserGroupMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usergroupmember.Intercept(f(g(h())))`.
+func (c *UserGroupMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserGroupMember = append(c.inters.UserGroupMember, interceptors...)
+}
+
+// Create returns a builder for creating a UserGroupMember entity.
+func (c *UserGroupMemberClient) Create() *UserGroupMemberCreate {
+	mutation := newUserGroupMemberMutation(c.config, OpCreate)
+	return &UserGroupMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserGroupMember entities.
+func (c *UserGroupMemberClient) CreateBulk(builders ...*UserGroupMemberCreate) *UserGroupMemberCreateBulk {
+	return &UserGroupMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserGroupMemberClient) MapCreateBulk(slice any, setFunc func(*UserGroupMemberCreate, int)) *UserGroupMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserGroupMemberCreateBulk{err: fmt.Errorf("calling to UserGroupMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserGroupMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserGroupMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserGroupMember.
+func (c *UserGroupMemberClient) Update() *UserGroupMemberUpdate {
+	mutation := newUserGroupMemberMutation(c.config, OpUpdate)
+	return &UserGroupMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserGroupMemberClient) UpdateOne(_m *UserGroupMember) *UserGroupMemberUpdateOne {
+	mutation := newUserGroupMemberMutation(c.config, OpUpdateOne, withUserGroupMember(_m))
+	return &UserGroupMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserGroupMemberClient) UpdateOneID(id int) *UserGroupMemberUpdateOne {
+	mutation := newUserGroupMemberMutation(c.config, OpUpdateOne, withUserGroupMemberID(id))
+	return &UserGroupMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserGroupMember.
+func (c *UserGroupMemberClient) Delete() *UserGroupMemberDelete {
+	mutation := newUserGroupMemberMutation(c.config, OpDelete)
+	return &UserGroupMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserGroupMemberClient) DeleteOne(_m *UserGroupMember) *UserGroupMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserGroupMemberClient) DeleteOneID(id int) *UserGroupMemberDeleteOne {
+	builder := c.Delete().Where(usergroupmember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserGroupMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for UserGroupMember.
+func (c *UserGroupMemberClient) Query() *UserGroupMemberQuery {
+	return &UserGroupMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserGroupMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserGroupMember entity by its id.
+func (c *UserGroupMemberClient) Get(ctx context.Context, id int) (*UserGroupMember, error) {
+	return c.Query().Where(usergroupmember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserGroupMemberClient) GetX(ctx context.Context, id int) *UserGroupMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserGroupMemberClient) Hooks() []Hook {
+	return c.hooks.UserGroupMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserGroupMemberClient) Interceptors() []Interceptor {
+	return c.inters.UserGroupMember
+}
+
+func (c *UserGroupMemberClient) mutate(ctx context.Context, m *UserGroupMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserGroupMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserGroupMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserGroupMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserGroupMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserGroupMember mutation op: %q", m.Op())
+	}
+}
+
+// UserInvitationClient is a client for the UserInvitation schema.
+type UserInvitationClient struct {
+	config
+}
+
+// NewUserInvitationClient returns a client for the UserInvitation from the given config.
+func NewUserInvitationClient(c config) *UserInvitationClient {
+	return &UserInvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userinvitation.Hooks(f(g(h())))`.
+func (c *UserInvitationClient) Use(hooks ...Hook) {
+	c.hooks.UserInvitation = append(c.hooks.UserInvitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userinvitation.Intercept(f(g(h())))`.
+func (c *UserInvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserInvitation = append(c.inters.UserInvitation, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserInvitation entity.
+func (c *UserInvitationClient) Create() *UserInvitationCreate {
+	mutation := newUserInvitationMutation(c.config, OpCreate)
+	return &UserInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserInvitation entities.
+func (c *UserInvitationClient) CreateBulk(builders ...*UserInvitationCreate) *UserInvitationCreateBulk {
+	return &UserInvitationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *UserInvitationClient) MapCreateBulk(slice any, setFunc func(*UserInvitationCreate, int)) *UserInvitationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserInvitationCreateBulk{err: fmt.Errorf("calling to UserInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*UserInvitationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &UserInvitationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserInvitation.
+func (c *UserInvitationClient) Update() *UserInvitationUpdate {
+	mutation := newUserInvitationMutation(c.config, OpUpdate)
+	return &UserInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserInvitationClient) UpdateOne(_m *UserInvitation) *UserInvitationUpdateOne {
+	mutation := newUserInvitationMutation(c.config, OpUpdateOne, withUserInvitation(_m))
+	return &UserInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserInvitationClient) UpdateOneID(id int) *UserInvitationUpdateOne {
+	mutation := newUserInvitationMutation(c.config, OpUpdateOne, withUserInvitationID(id))
+	return &UserInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserInvitation.
+func (c *UserInvitationClient) Delete() *UserInvitationDelete {
+	mutation := newUserInvitationMutation(c.config, OpDelete)
+	return &UserInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *UserInvitationClient) DeleteOne(_m *UserInvitation) *UserInvitationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *UserInvitationClient) DeleteOneID(id int) *UserInvitationDeleteOne {
+	builder := c.Delete().Where(userinvitation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &UserInvitationDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for UserInvitation.
+func (c *UserInvitationClient) Query() *UserInvitationQuery {
+	return &UserInvitationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeUserInvitation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a UserInvitation entity by its id.
+func (c *UserInvitationClient) Get(ctx context.Context, id int) (*UserInvitation, error) {
+	return c.Query().Where(userinvitation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *UserInvitationClient) GetX(ctx context.Context, id int) *UserInvitation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1626,131 +4472,131 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *UserInvitationClient) Hooks() []Hook {
+	return c.hooks.UserInvitation
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *UserInvitationClient) Interceptors() []Interceptor {
+	return c.inters.UserInvitation
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *UserInvitationClient) mutate(ctx context.Context, m *UserInvitationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UserInvitation mutation op: %q", m.Op())
 	}
 }
 
-// UserBalanceLogClient is a client for the UserBalanceLog schema.
-type UserBalanceLogClient struct {
+// UserLevelLogClient is a client for the UserLevelLog schema.
+type UserLevelLogClient struct {
 	config
 }
 
-// NewUserBalanceLogClient returns a client for the UserBalanceLog from the given config.
-func NewUserBalanceLogClient(c config) *UserBalanceLogClient {
-	return &UserBalanceLogClient{config: c}
+// NewUserLevelLogClient returns a client for the UserLevelLog from the given config.
+func NewUserLevelLogClient(c config) *UserLevelLogClient {
+	return &UserLevelLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `userbalancelog.Hooks(f(g(h())))`.
-func (c *UserBalanceLogClient) Use(hooks ...Hook) {
-	c.hooks.UserBalanceLog = append(c.hooks.UserBalanceLog, hooks...)
+// A call to `Use(f, g, h)` equals to `userlevellog.Hooks(f(g(h())))`.
+func (c *UserLevelLogClient) Use(hooks ...Hook) {
+	c.hooks.UserLevelLog = append(c.hooks.UserLevelLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `userbalancelog.Intercept(f(g(h())))`.
-func (c *UserBalanceLogClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UserBalanceLog = append(c.inters.UserBalanceLog, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `userlevellog.Intercept(f(g(h())))`.
+func (c *UserLevelLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserLevelLog = append(c.inters.UserLevelLog, interceptors...)
 }
 
-// Create returns a builder for creating a UserBalanceLog entity.
-func (c *UserBalanceLogClient) Create() *UserBalanceLogCreate {
-	mutation := newUserBalanceLogMutation(c.config, OpCreate)
-	return &UserBalanceLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserLevelLog entity.
+func (c *UserLevelLogClient) Create() *UserLevelLogCreate {
+	mutation := newUserLevelLogMutation(c.config, OpCreate)
+	return &UserLevelLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UserBalanceLog entities.
-func (c *UserBalanceLogClient) CreateBulk(builders ...*UserBalanceLogCreate) *UserBalanceLogCreateBulk {
-	return &UserBalanceLogCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserLevelLog entities.
+func (c *UserLevelLogClient) CreateBulk(builders ...*UserLevelLogCreate) *UserLevelLogCreateBulk {
+	return &UserLevelLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserBalanceLogClient) MapCreateBulk(slice any, setFunc func(*UserBalanceLogCreate, int)) *UserBalanceLogCreateBulk {
+func (c *UserLevelLogClient) MapCreateBulk(slice any, setFunc func(*UserLevelLogCreate, int)) *UserLevelLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserBalanceLogCreateBulk{err: fmt.Errorf("calling to UserBalanceLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserLevelLogCreateBulk{err: fmt.Errorf("calling to UserLevelLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserBalanceLogCreate, rv.Len())
+	builders := make([]*UserLevelLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserBalanceLogCreateBulk{config: c.config, builders: builders}
+	return &UserLevelLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UserBalanceLog.
-func (c *UserBalanceLogClient) Update() *UserBalanceLogUpdate {
-	mutation := newUserBalanceLogMutation(c.config, OpUpdate)
-	return &UserBalanceLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserLevelLog.
+func (c *UserLevelLogClient) Update() *UserLevelLogUpdate {
+	mutation := newUserLevelLogMutation(c.config, OpUpdate)
+	return &UserLevelLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserBalanceLogClient) UpdateOne(_m *UserBalanceLog) *UserBalanceLogUpdateOne {
-	mutation := newUserBalanceLogMutation(c.config, OpUpdateOne, withUserBalanceLog(_m))
-	return &UserBalanceLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserLevelLogClient) UpdateOne(_m *UserLevelLog) *UserLevelLogUpdateOne {
+	mutation := newUserLevelLogMutation(c.config, OpUpdateOne, withUserLevelLog(_m))
+	return &UserLevelLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserBalanceLogClient) UpdateOneID(id int) *UserBalanceLogUpdateOne {
-	mutation := newUserBalanceLogMutation(c.config, OpUpdateOne, withUserBalanceLogID(id))
-	return &UserBalanceLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserLevelLogClient) UpdateOneID(id int) *UserLevelLogUpdateOne {
+	mutation := newUserLevelLogMutation(c.config, OpUpdateOne, withUserLevelLogID(id))
+	return &UserLevelLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UserBalanceLog.
-func (c *UserBalanceLogClient) Delete() *UserBalanceLogDelete {
-	mutation := newUserBalanceLogMutation(c.config, OpDelete)
-	return &UserBalanceLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserLevelLog.
+func (c *UserLevelLogClient) Delete() *UserLevelLogDelete {
+	mutation := newUserLevelLogMutation(c.config, OpDelete)
+	return &UserLevelLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserBalanceLogClient) DeleteOne(_m *UserBalanceLog) *UserBalanceLogDeleteOne {
+func (c *UserLevelLogClient) DeleteOne(_m *UserLevelLog) *UserLevelLogDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserBalanceLogClient) DeleteOneID(id int) *UserBalanceLogDeleteOne {
-	builder := c.Delete().Where(userbalancelog.ID(id))
+func (c *UserLevelLogClient) DeleteOneID(id int) *UserLevelLogDeleteOne {
+	builder := c.Delete().Where(userlevellog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserBalanceLogDeleteOne{builder}
+	return &UserLevelLogDeleteOne{builder}
 }
 
-// Query returns a query builder for UserBalanceLog.
-func (c *UserBalanceLogClient) Query() *UserBalanceLogQuery {
-	return &UserBalanceLogQuery{
+// Query returns a query builder for UserLevelLog.
+func (c *UserLevelLogClient) Query() *UserLevelLogQuery {
+	return &UserLevelLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUserBalanceLog},
+		ctx:    &QueryContext{Type: TypeUserLevelLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UserBalanceLog entity by its id.
-func (c *UserBalanceLogClient) Get(ctx context.Context, id int) (*UserBalanceLog, error) {
-	return c.Query().Where(userbalancelog.ID(id)).Only(ctx)
+// Get returns a UserLevelLog entity by its id.
+func (c *UserLevelLogClient) Get(ctx context.Context, id int) (*UserLevelLog, error) {
+	return c.Query().Where(userlevellog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserBalanceLogClient) GetX(ctx context.Context, id int) *UserBalanceLog {
+func (c *UserLevelLogClient) GetX(ctx context.Context, id int) *UserLevelLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1759,27 +4605,27 @@ func (c *UserBalanceLogClient) GetX(ctx context.Context, id int) *UserBalanceLog
 }
 
 // Hooks returns the client hooks.
-func (c *UserBalanceLogClient) Hooks() []Hook {
-	return c.hooks.UserBalanceLog
+func (c *UserLevelLogClient) Hooks() []Hook {
+	return c.hooks.UserLevelLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserBalanceLogClient) Interceptors() []Interceptor {
-	return c.inters.UserBalanceLog
+func (c *UserLevelLogClient) Interceptors() []Interceptor {
+	return c.inters.UserLevelLog
 }
 
-func (c *UserBalanceLogClient) mutate(ctx context.Context, m *UserBalanceLogMutation) (Value, error) {
+func (c *UserLevelLogClient) mutate(ctx context.Context, m *UserLevelLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserBalanceLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserLevelLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserBalanceLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserLevelLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserBalanceLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserLevelLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserBalanceLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserLevelLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UserBalanceLog mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UserLevelLog mutation op: %q", m.Op())
 	}
 }
 
@@ -2315,16 +5161,290 @@ func (c *UserSigninStatusClient) mutate(ctx context.Context, m *UserSigninStatus
 	}
 }
 
+// UserTwoFactorClient is a client for the UserTwoFactor schema.
+type UserTwoFactorClient struct {
+	config
+}
+
+// NewUserTwoFactorClient returns a client for the UserTwoFactor from the given config.
+func NewUserTwoFactorClient(c config) *UserTwoFactorClient {
+	return &UserTwoFactorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usertwofactor.Hooks(f(g(h())))`.
+func (c *UserTwoFactorClient) Use(hooks ...Hook) {
+	c.hooks.UserTwoFactor = append(c.hooks.UserTwoFactor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usertwofactor.Intercept(f(g(h())))`.
+func (c *UserTwoFactorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserTwoFactor = append(c.inters.UserTwoFactor, interceptors...)
+}
+
+// Create returns a builder for creating a UserTwoFactor entity.
+func (c *UserTwoFactorClient) Create() *UserTwoFactorCreate {
+	mutation := newUserTwoFactorMutation(c.config, OpCreate)
+	return &UserTwoFactorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserTwoFactor entities.
+func (c *UserTwoFactorClient) CreateBulk(builders ...*UserTwoFactorCreate) *UserTwoFactorCreateBulk {
+	return &UserTwoFactorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserTwoFactorClient) MapCreateBulk(slice any, setFunc func(*UserTwoFactorCreate, int)) *UserTwoFactorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserTwoFactorCreateBulk{err: fmt.Errorf("calling to UserTwoFactorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserTwoFactorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserTwoFactorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserTwoFactor.
+func (c *UserTwoFactorClient) Update() *UserTwoFactorUpdate {
+	mutation := newUserTwoFactorMutation(c.config, OpUpdate)
+	return &UserTwoFactorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserTwoFactorClient) UpdateOne(_m *UserTwoFactor) *UserTwoFactorUpdateOne {
+	mutation := newUserTwoFactorMutation(c.config, OpUpdateOne, withUserTwoFactor(_m))
+	return &UserTwoFactorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserTwoFactorClient) UpdateOneID(id int) *UserTwoFactorUpdateOne {
+	mutation := newUserTwoFactorMutation(c.config, OpUpdateOne, withUserTwoFactorID(id))
+	return &UserTwoFactorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserTwoFactor.
+func (c *UserTwoFactorClient) Delete() *UserTwoFactorDelete {
+	mutation := newUserTwoFactorMutation(c.config, OpDelete)
+	return &UserTwoFactorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserTwoFactorClient) DeleteOne(_m *UserTwoFactor) *UserTwoFactorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserTwoFactorClient) DeleteOneID(id int) *UserTwoFactorDeleteOne {
+	builder := c.Delete().Where(usertwofactor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserTwoFactorDeleteOne{builder}
+}
+
+// Query returns a query builder for UserTwoFactor.
+func (c *UserTwoFactorClient) Query() *UserTwoFactorQuery {
+	return &UserTwoFactorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserTwoFactor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserTwoFactor entity by its id.
+func (c *UserTwoFactorClient) Get(ctx context.Context, id int) (*UserTwoFactor, error) {
+	return c.Query().Where(usertwofactor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserTwoFactorClient) GetX(ctx context.Context, id int) *UserTwoFactor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserTwoFactorClient) Hooks() []Hook {
+	return c.hooks.UserTwoFactor
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserTwoFactorClient) Interceptors() []Interceptor {
+	return c.inters.UserTwoFactor
+}
+
+func (c *UserTwoFactorClient) mutate(ctx context.Context, m *UserTwoFactorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserTwoFactorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserTwoFactorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserTwoFactorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserTwoFactorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserTwoFactor mutation op: %q", m.Op())
+	}
+}
+
+// WebAuthnCredentialClient is a client for the WebAuthnCredential schema.
+type WebAuthnCredentialClient struct {
+	config
+}
+
+// NewWebAuthnCredentialClient returns a client for the WebAuthnCredential from the given config.
+func NewWebAuthnCredentialClient(c config) *WebAuthnCredentialClient {
+	return &WebAuthnCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webauthncredential.Hooks(f(g(h())))`.
+func (c *WebAuthnCredentialClient) Use(hooks ...Hook) {
+	c.hooks.WebAuthnCredential = append(c.hooks.WebAuthnCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webauthncredential.Intercept(f(g(h())))`.
+func (c *WebAuthnCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebAuthnCredential = append(c.inters.WebAuthnCredential, interceptors...)
+}
+
+// Create returns a builder for creating a WebAuthnCredential entity.
+func (c *WebAuthnCredentialClient) Create() *WebAuthnCredentialCreate {
+	mutation := newWebAuthnCredentialMutation(c.config, OpCreate)
+	return &WebAuthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebAuthnCredential entities.
+func (c *WebAuthnCredentialClient) CreateBulk(builders ...*WebAuthnCredentialCreate) *WebAuthnCredentialCreateBulk {
+	return &WebAuthnCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebAuthnCredentialClient) MapCreateBulk(slice any, setFunc func(*WebAuthnCredentialCreate, int)) *WebAuthnCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebAuthnCredentialCreateBulk{err: fmt.Errorf("calling to WebAuthnCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebAuthnCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebAuthnCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Update() *WebAuthnCredentialUpdate {
+	mutation := newWebAuthnCredentialMutation(c.config, OpUpdate)
+	return &WebAuthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebAuthnCredentialClient) UpdateOne(_m *WebAuthnCredential) *WebAuthnCredentialUpdateOne {
+	mutation := newWebAuthnCredentialMutation(c.config, OpUpdateOne, withWebAuthnCredential(_m))
+	return &WebAuthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebAuthnCredentialClient) UpdateOneID(id int) *WebAuthnCredentialUpdateOne {
+	mutation := newWebAuthnCredentialMutation(c.config, OpUpdateOne, withWebAuthnCredentialID(id))
+	return &WebAuthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Delete() *WebAuthnCredentialDelete {
+	mutation := newWebAuthnCredentialMutation(c.config, OpDelete)
+	return &WebAuthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebAuthnCredentialClient) DeleteOne(_m *WebAuthnCredential) *WebAuthnCredentialDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebAuthnCredentialClient) DeleteOneID(id int) *WebAuthnCredentialDeleteOne {
+	builder := c.Delete().Where(webauthncredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebAuthnCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Query() *WebAuthnCredentialQuery {
+	return &WebAuthnCredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebAuthnCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebAuthnCredential entity by its id.
+func (c *WebAuthnCredentialClient) Get(ctx context.Context, id int) (*WebAuthnCredential, error) {
+	return c.Query().Where(webauthncredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebAuthnCredentialClient) GetX(ctx context.Context, id int) *WebAuthnCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebAuthnCredentialClient) Hooks() []Hook {
+	return c.hooks.WebAuthnCredential
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebAuthnCredentialClient) Interceptors() []Interceptor {
+	return c.inters.WebAuthnCredential
+}
+
+func (c *WebAuthnCredentialClient) mutate(ctx context.Context, m *WebAuthnCredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebAuthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebAuthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebAuthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebAuthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebAuthnCredential mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Blacklist, Category, CategoryModerator, Comment, CommentAction, OAuthProvider,
-		Post, PostAction, Settings, User, UserBalanceLog, UserLoginLog, UserOAuth,
-		UserSigninLogs, UserSigninStatus []ent.Hook
+		Attachment, Badge, Blacklist, Category, CategoryModerator, CategoryPermission,
+		Comment, CommentAction, Conversation, Follow, InviteCode, Mention,
+		Notification, NotificationPreference, OAuthProvider, Post, PostAction,
+		PostPurchase, PostRevision, PostTag, PrivateMessage, Report, Settings, Tag,
+		User, UserBadge, UserBalanceLog, UserGroup, UserGroupMember, UserInvitation,
+		UserLevelLog, UserLoginLog, UserOAuth, UserSigninLogs, UserSigninStatus,
+		UserTwoFactor, WebAuthnCredential []ent.Hook
 	}
 	inters struct {
-		Blacklist, Category, CategoryModerator, Comment, CommentAction, OAuthProvider,
-		Post, PostAction, Settings, User, UserBalanceLog, UserLoginLog, UserOAuth,
-		UserSigninLogs, UserSigninStatus []ent.Interceptor
+		Attachment, Badge, Blacklist, Category, CategoryModerator, CategoryPermission,
+		Comment, CommentAction, Conversation, Follow, InviteCode, Mention,
+		Notification, NotificationPreference, OAuthProvider, Post, PostAction,
+		PostPurchase, PostRevision, PostTag, PrivateMessage, Report, Settings, Tag,
+		User, UserBadge, UserBalanceLog, UserGroup, UserGroupMember, UserInvitation,
+		UserLevelLog, UserLoginLog, UserOAuth, UserSigninLogs, UserSigninStatus,
+		UserTwoFactor, WebAuthnCredential []ent.Interceptor
 	}
 )
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/conversation"
+)
+
+// Conversation is the model entity for the Conversation schema.
+type Conversation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserAID holds the value of the "user_a_id" field.
+	UserAID int `json:"user_a_id,omitempty"`
+	// UserBID holds the value of the "user_b_id" field.
+	UserBID int `json:"user_b_id,omitempty"`
+	// LastMessageID holds the value of the "last_message_id" field.
+	LastMessageID int `json:"last_message_id,omitempty"`
+	// LastMessageAt holds the value of the "last_message_at" field.
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	// UserAUnread holds the value of the "user_a_unread" field.
+	UserAUnread int `json:"user_a_unread,omitempty"`
+	// UserBUnread holds the value of the "user_b_unread" field.
+	UserBUnread  int `json:"user_b_unread,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Conversation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case conversation.FieldID, conversation.FieldUserAID, conversation.FieldUserBID, conversation.FieldLastMessageID, conversation.FieldUserAUnread, conversation.FieldUserBUnread:
+			values[i] = new(sql.NullInt64)
+		case conversation.FieldCreatedAt, conversation.FieldUpdatedAt, conversation.FieldLastMessageAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Conversation fields.
+func (_m *Conversation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case conversation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case conversation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case conversation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case conversation.FieldUserAID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_a_id", values[i])
+			} else if value.Valid {
+				_m.UserAID = int(value.Int64)
+			}
+		case conversation.FieldUserBID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_b_id", values[i])
+			} else if value.Valid {
+				_m.UserBID = int(value.Int64)
+			}
+		case conversation.FieldLastMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_message_id", values[i])
+			} else if value.Valid {
+				_m.LastMessageID = int(value.Int64)
+			}
+		case conversation.FieldLastMessageAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_message_at", values[i])
+			} else if value.Valid {
+				_m.LastMessageAt = new(time.Time)
+				*_m.LastMessageAt = value.Time
+			}
+		case conversation.FieldUserAUnread:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_a_unread", values[i])
+			} else if value.Valid {
+				_m.UserAUnread = int(value.Int64)
+			}
+		case conversation.FieldUserBUnread:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_b_unread", values[i])
+			} else if value.Valid {
+				_m.UserBUnread = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Conversation.
+// This includes values selected through modifiers, order, etc.
+func (_m *Conversation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Conversation.
+// Note that you need to call Conversation.Unwrap() before calling this method if this Conversation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Conversation) Update() *ConversationUpdateOne {
+	return NewConversationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Conversation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Conversation) Unwrap() *Conversation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Conversation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Conversation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Conversation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_a_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserAID))
+	builder.WriteString(", ")
+	builder.WriteString("user_b_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserBID))
+	builder.WriteString(", ")
+	builder.WriteString("last_message_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastMessageID))
+	builder.WriteString(", ")
+	if v := _m.LastMessageAt; v != nil {
+		builder.WriteString("last_message_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_a_unread=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserAUnread))
+	builder.WriteString(", ")
+	builder.WriteString("user_b_unread=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserBUnread))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Conversations is a parsable slice of Conversation.
+type Conversations []*Conversation
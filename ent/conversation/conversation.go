@@ -0,0 +1,132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the conversation type in the database.
+	Label = "conversation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldUserAID holds the string denoting the user_a_id field in the database.
+	FieldUserAID = "user_a_id"
+	// FieldUserBID holds the string denoting the user_b_id field in the database.
+	FieldUserBID = "user_b_id"
+	// FieldLastMessageID holds the string denoting the last_message_id field in the database.
+	FieldLastMessageID = "last_message_id"
+	// FieldLastMessageAt holds the string denoting the last_message_at field in the database.
+	FieldLastMessageAt = "last_message_at"
+	// FieldUserAUnread holds the string denoting the user_a_unread field in the database.
+	FieldUserAUnread = "user_a_unread"
+	// FieldUserBUnread holds the string denoting the user_b_unread field in the database.
+	FieldUserBUnread = "user_b_unread"
+	// Table holds the table name of the conversation in the database.
+	Table = "conversations"
+)
+
+// Columns holds all SQL columns for conversation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldUserAID,
+	FieldUserBID,
+	FieldLastMessageID,
+	FieldLastMessageAt,
+	FieldUserAUnread,
+	FieldUserBUnread,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// UserAIDValidator is a validator for the "user_a_id" field. It is called by the builders before save.
+	UserAIDValidator func(int) error
+	// UserBIDValidator is a validator for the "user_b_id" field. It is called by the builders before save.
+	UserBIDValidator func(int) error
+	// DefaultLastMessageID holds the default value on creation for the "last_message_id" field.
+	DefaultLastMessageID int
+	// LastMessageIDValidator is a validator for the "last_message_id" field. It is called by the builders before save.
+	LastMessageIDValidator func(int) error
+	// DefaultUserAUnread holds the default value on creation for the "user_a_unread" field.
+	DefaultUserAUnread int
+	// UserAUnreadValidator is a validator for the "user_a_unread" field. It is called by the builders before save.
+	UserAUnreadValidator func(int) error
+	// DefaultUserBUnread holds the default value on creation for the "user_b_unread" field.
+	DefaultUserBUnread int
+	// UserBUnreadValidator is a validator for the "user_b_unread" field. It is called by the builders before save.
+	UserBUnreadValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the Conversation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserAID orders the results by the user_a_id field.
+func ByUserAID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAID, opts...).ToFunc()
+}
+
+// ByUserBID orders the results by the user_b_id field.
+func ByUserBID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserBID, opts...).ToFunc()
+}
+
+// ByLastMessageID orders the results by the last_message_id field.
+func ByLastMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastMessageID, opts...).ToFunc()
+}
+
+// ByLastMessageAt orders the results by the last_message_at field.
+func ByLastMessageAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastMessageAt, opts...).ToFunc()
+}
+
+// ByUserAUnread orders the results by the user_a_unread field.
+func ByUserAUnread(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAUnread, opts...).ToFunc()
+}
+
+// ByUserBUnread orders the results by the user_b_unread field.
+func ByUserBUnread(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserBUnread, opts...).ToFunc()
+}
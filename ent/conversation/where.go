@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserAID applies equality check predicate on the "user_a_id" field. It's identical to UserAIDEQ.
+func UserAID(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserAID, v))
+}
+
+// UserBID applies equality check predicate on the "user_b_id" field. It's identical to UserBIDEQ.
+func UserBID(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserBID, v))
+}
+
+// LastMessageID applies equality check predicate on the "last_message_id" field. It's identical to LastMessageIDEQ.
+func LastMessageID(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldLastMessageID, v))
+}
+
+// LastMessageAt applies equality check predicate on the "last_message_at" field. It's identical to LastMessageAtEQ.
+func LastMessageAt(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldLastMessageAt, v))
+}
+
+// UserAUnread applies equality check predicate on the "user_a_unread" field. It's identical to UserAUnreadEQ.
+func UserAUnread(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserAUnread, v))
+}
+
+// UserBUnread applies equality check predicate on the "user_b_unread" field. It's identical to UserBUnreadEQ.
+func UserBUnread(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserBUnread, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserAIDEQ applies the EQ predicate on the "user_a_id" field.
+func UserAIDEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserAID, v))
+}
+
+// UserAIDNEQ applies the NEQ predicate on the "user_a_id" field.
+func UserAIDNEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldUserAID, v))
+}
+
+// UserAIDIn applies the In predicate on the "user_a_id" field.
+func UserAIDIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldUserAID, vs...))
+}
+
+// UserAIDNotIn applies the NotIn predicate on the "user_a_id" field.
+func UserAIDNotIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldUserAID, vs...))
+}
+
+// UserAIDGT applies the GT predicate on the "user_a_id" field.
+func UserAIDGT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldUserAID, v))
+}
+
+// UserAIDGTE applies the GTE predicate on the "user_a_id" field.
+func UserAIDGTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldUserAID, v))
+}
+
+// UserAIDLT applies the LT predicate on the "user_a_id" field.
+func UserAIDLT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldUserAID, v))
+}
+
+// UserAIDLTE applies the LTE predicate on the "user_a_id" field.
+func UserAIDLTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldUserAID, v))
+}
+
+// UserBIDEQ applies the EQ predicate on the "user_b_id" field.
+func UserBIDEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserBID, v))
+}
+
+// UserBIDNEQ applies the NEQ predicate on the "user_b_id" field.
+func UserBIDNEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldUserBID, v))
+}
+
+// UserBIDIn applies the In predicate on the "user_b_id" field.
+func UserBIDIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldUserBID, vs...))
+}
+
+// UserBIDNotIn applies the NotIn predicate on the "user_b_id" field.
+func UserBIDNotIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldUserBID, vs...))
+}
+
+// UserBIDGT applies the GT predicate on the "user_b_id" field.
+func UserBIDGT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldUserBID, v))
+}
+
+// UserBIDGTE applies the GTE predicate on the "user_b_id" field.
+func UserBIDGTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldUserBID, v))
+}
+
+// UserBIDLT applies the LT predicate on the "user_b_id" field.
+func UserBIDLT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldUserBID, v))
+}
+
+// UserBIDLTE applies the LTE predicate on the "user_b_id" field.
+func UserBIDLTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldUserBID, v))
+}
+
+// LastMessageIDEQ applies the EQ predicate on the "last_message_id" field.
+func LastMessageIDEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldLastMessageID, v))
+}
+
+// LastMessageIDNEQ applies the NEQ predicate on the "last_message_id" field.
+func LastMessageIDNEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldLastMessageID, v))
+}
+
+// LastMessageIDIn applies the In predicate on the "last_message_id" field.
+func LastMessageIDIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldLastMessageID, vs...))
+}
+
+// LastMessageIDNotIn applies the NotIn predicate on the "last_message_id" field.
+func LastMessageIDNotIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldLastMessageID, vs...))
+}
+
+// LastMessageIDGT applies the GT predicate on the "last_message_id" field.
+func LastMessageIDGT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldLastMessageID, v))
+}
+
+// LastMessageIDGTE applies the GTE predicate on the "last_message_id" field.
+func LastMessageIDGTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldLastMessageID, v))
+}
+
+// LastMessageIDLT applies the LT predicate on the "last_message_id" field.
+func LastMessageIDLT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldLastMessageID, v))
+}
+
+// LastMessageIDLTE applies the LTE predicate on the "last_message_id" field.
+func LastMessageIDLTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldLastMessageID, v))
+}
+
+// LastMessageAtEQ applies the EQ predicate on the "last_message_at" field.
+func LastMessageAtEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldLastMessageAt, v))
+}
+
+// LastMessageAtNEQ applies the NEQ predicate on the "last_message_at" field.
+func LastMessageAtNEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldLastMessageAt, v))
+}
+
+// LastMessageAtIn applies the In predicate on the "last_message_at" field.
+func LastMessageAtIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldLastMessageAt, vs...))
+}
+
+// LastMessageAtNotIn applies the NotIn predicate on the "last_message_at" field.
+func LastMessageAtNotIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldLastMessageAt, vs...))
+}
+
+// LastMessageAtGT applies the GT predicate on the "last_message_at" field.
+func LastMessageAtGT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldLastMessageAt, v))
+}
+
+// LastMessageAtGTE applies the GTE predicate on the "last_message_at" field.
+func LastMessageAtGTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldLastMessageAt, v))
+}
+
+// LastMessageAtLT applies the LT predicate on the "last_message_at" field.
+func LastMessageAtLT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldLastMessageAt, v))
+}
+
+// LastMessageAtLTE applies the LTE predicate on the "last_message_at" field.
+func LastMessageAtLTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldLastMessageAt, v))
+}
+
+// LastMessageAtIsNil applies the IsNil predicate on the "last_message_at" field.
+func LastMessageAtIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldLastMessageAt))
+}
+
+// LastMessageAtNotNil applies the NotNil predicate on the "last_message_at" field.
+func LastMessageAtNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldLastMessageAt))
+}
+
+// UserAUnreadEQ applies the EQ predicate on the "user_a_unread" field.
+func UserAUnreadEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserAUnread, v))
+}
+
+// UserAUnreadNEQ applies the NEQ predicate on the "user_a_unread" field.
+func UserAUnreadNEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldUserAUnread, v))
+}
+
+// UserAUnreadIn applies the In predicate on the "user_a_unread" field.
+func UserAUnreadIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldUserAUnread, vs...))
+}
+
+// UserAUnreadNotIn applies the NotIn predicate on the "user_a_unread" field.
+func UserAUnreadNotIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldUserAUnread, vs...))
+}
+
+// UserAUnreadGT applies the GT predicate on the "user_a_unread" field.
+func UserAUnreadGT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldUserAUnread, v))
+}
+
+// UserAUnreadGTE applies the GTE predicate on the "user_a_unread" field.
+func UserAUnreadGTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldUserAUnread, v))
+}
+
+// UserAUnreadLT applies the LT predicate on the "user_a_unread" field.
+func UserAUnreadLT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldUserAUnread, v))
+}
+
+// UserAUnreadLTE applies the LTE predicate on the "user_a_unread" field.
+func UserAUnreadLTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldUserAUnread, v))
+}
+
+// UserBUnreadEQ applies the EQ predicate on the "user_b_unread" field.
+func UserBUnreadEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldUserBUnread, v))
+}
+
+// UserBUnreadNEQ applies the NEQ predicate on the "user_b_unread" field.
+func UserBUnreadNEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldUserBUnread, v))
+}
+
+// UserBUnreadIn applies the In predicate on the "user_b_unread" field.
+func UserBUnreadIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldUserBUnread, vs...))
+}
+
+// UserBUnreadNotIn applies the NotIn predicate on the "user_b_unread" field.
+func UserBUnreadNotIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldUserBUnread, vs...))
+}
+
+// UserBUnreadGT applies the GT predicate on the "user_b_unread" field.
+func UserBUnreadGT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldUserBUnread, v))
+}
+
+// UserBUnreadGTE applies the GTE predicate on the "user_b_unread" field.
+func UserBUnreadGTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldUserBUnread, v))
+}
+
+// UserBUnreadLT applies the LT predicate on the "user_b_unread" field.
+func UserBUnreadLT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldUserBUnread, v))
+}
+
+// UserBUnreadLTE applies the LTE predicate on the "user_b_unread" field.
+func UserBUnreadLTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldUserBUnread, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Conversation) predicate.Conversation {
+	return predicate.Conversation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Conversation) predicate.Conversation {
+	return predicate.Conversation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Conversation) predicate.Conversation {
+	return predicate.Conversation(sql.NotPredicates(p))
+}
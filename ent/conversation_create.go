@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/conversation"
+)
+
+// ConversationCreate is the builder for creating a Conversation entity.
+type ConversationCreate struct {
+	config
+	mutation *ConversationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConversationCreate) SetCreatedAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableCreatedAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConversationCreate) SetUpdatedAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableUpdatedAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserAID sets the "user_a_id" field.
+func (_c *ConversationCreate) SetUserAID(v int) *ConversationCreate {
+	_c.mutation.SetUserAID(v)
+	return _c
+}
+
+// SetUserBID sets the "user_b_id" field.
+func (_c *ConversationCreate) SetUserBID(v int) *ConversationCreate {
+	_c.mutation.SetUserBID(v)
+	return _c
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (_c *ConversationCreate) SetLastMessageID(v int) *ConversationCreate {
+	_c.mutation.SetLastMessageID(v)
+	return _c
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableLastMessageID(v *int) *ConversationCreate {
+	if v != nil {
+		_c.SetLastMessageID(*v)
+	}
+	return _c
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_c *ConversationCreate) SetLastMessageAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetLastMessageAt(v)
+	return _c
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableLastMessageAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetLastMessageAt(*v)
+	}
+	return _c
+}
+
+// SetUserAUnread sets the "user_a_unread" field.
+func (_c *ConversationCreate) SetUserAUnread(v int) *ConversationCreate {
+	_c.mutation.SetUserAUnread(v)
+	return _c
+}
+
+// SetNillableUserAUnread sets the "user_a_unread" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableUserAUnread(v *int) *ConversationCreate {
+	if v != nil {
+		_c.SetUserAUnread(*v)
+	}
+	return _c
+}
+
+// SetUserBUnread sets the "user_b_unread" field.
+func (_c *ConversationCreate) SetUserBUnread(v int) *ConversationCreate {
+	_c.mutation.SetUserBUnread(v)
+	return _c
+}
+
+// SetNillableUserBUnread sets the "user_b_unread" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableUserBUnread(v *int) *ConversationCreate {
+	if v != nil {
+		_c.SetUserBUnread(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConversationCreate) SetID(v int) *ConversationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (_c *ConversationCreate) Mutation() *ConversationMutation {
+	return _c.mutation
+}
+
+// Save creates the Conversation in the database.
+func (_c *ConversationCreate) Save(ctx context.Context) (*Conversation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConversationCreate) SaveX(ctx context.Context) *Conversation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConversationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := conversation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := conversation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.LastMessageID(); !ok {
+		v := conversation.DefaultLastMessageID
+		_c.mutation.SetLastMessageID(v)
+	}
+	if _, ok := _c.mutation.UserAUnread(); !ok {
+		v := conversation.DefaultUserAUnread
+		_c.mutation.SetUserAUnread(v)
+	}
+	if _, ok := _c.mutation.UserBUnread(); !ok {
+		v := conversation.DefaultUserBUnread
+		_c.mutation.SetUserBUnread(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConversationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Conversation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Conversation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserAID(); !ok {
+		return &ValidationError{Name: "user_a_id", err: errors.New(`ent: missing required field "Conversation.user_a_id"`)}
+	}
+	if v, ok := _c.mutation.UserAID(); ok {
+		if err := conversation.UserAIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_a_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_a_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserBID(); !ok {
+		return &ValidationError{Name: "user_b_id", err: errors.New(`ent: missing required field "Conversation.user_b_id"`)}
+	}
+	if v, ok := _c.mutation.UserBID(); ok {
+		if err := conversation.UserBIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_b_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_b_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastMessageID(); !ok {
+		return &ValidationError{Name: "last_message_id", err: errors.New(`ent: missing required field "Conversation.last_message_id"`)}
+	}
+	if v, ok := _c.mutation.LastMessageID(); ok {
+		if err := conversation.LastMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "last_message_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.last_message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserAUnread(); !ok {
+		return &ValidationError{Name: "user_a_unread", err: errors.New(`ent: missing required field "Conversation.user_a_unread"`)}
+	}
+	if v, ok := _c.mutation.UserAUnread(); ok {
+		if err := conversation.UserAUnreadValidator(v); err != nil {
+			return &ValidationError{Name: "user_a_unread", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_a_unread": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserBUnread(); !ok {
+		return &ValidationError{Name: "user_b_unread", err: errors.New(`ent: missing required field "Conversation.user_b_unread"`)}
+	}
+	if v, ok := _c.mutation.UserBUnread(); ok {
+		if err := conversation.UserBUnreadValidator(v); err != nil {
+			return &ValidationError{Name: "user_b_unread", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_b_unread": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := conversation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Conversation.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ConversationCreate) sqlSave(ctx context.Context) (*Conversation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConversationCreate) createSpec() (*Conversation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Conversation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(conversation.Table, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(conversation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserAID(); ok {
+		_spec.SetField(conversation.FieldUserAID, field.TypeInt, value)
+		_node.UserAID = value
+	}
+	if value, ok := _c.mutation.UserBID(); ok {
+		_spec.SetField(conversation.FieldUserBID, field.TypeInt, value)
+		_node.UserBID = value
+	}
+	if value, ok := _c.mutation.LastMessageID(); ok {
+		_spec.SetField(conversation.FieldLastMessageID, field.TypeInt, value)
+		_node.LastMessageID = value
+	}
+	if value, ok := _c.mutation.LastMessageAt(); ok {
+		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
+		_node.LastMessageAt = &value
+	}
+	if value, ok := _c.mutation.UserAUnread(); ok {
+		_spec.SetField(conversation.FieldUserAUnread, field.TypeInt, value)
+		_node.UserAUnread = value
+	}
+	if value, ok := _c.mutation.UserBUnread(); ok {
+		_spec.SetField(conversation.FieldUserBUnread, field.TypeInt, value)
+		_node.UserBUnread = value
+	}
+	return _node, _spec
+}
+
+// ConversationCreateBulk is the builder for creating many Conversation entities in bulk.
+type ConversationCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationCreate
+}
+
+// Save creates the Conversation entities in the database.
+func (_c *ConversationCreateBulk) Save(ctx context.Context) ([]*Conversation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Conversation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConversationCreateBulk) SaveX(ctx context.Context) []*Conversation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/conversation"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ConversationUpdate is the builder for updating Conversation entities.
+type ConversationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConversationMutation
+}
+
+// Where appends a list predicates to the ConversationUpdate builder.
+func (_u *ConversationUpdate) Where(ps ...predicate.Conversation) *ConversationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConversationUpdate) SetUpdatedAt(v time.Time) *ConversationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserAID sets the "user_a_id" field.
+func (_u *ConversationUpdate) SetUserAID(v int) *ConversationUpdate {
+	_u.mutation.ResetUserAID()
+	_u.mutation.SetUserAID(v)
+	return _u
+}
+
+// SetNillableUserAID sets the "user_a_id" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableUserAID(v *int) *ConversationUpdate {
+	if v != nil {
+		_u.SetUserAID(*v)
+	}
+	return _u
+}
+
+// AddUserAID adds value to the "user_a_id" field.
+func (_u *ConversationUpdate) AddUserAID(v int) *ConversationUpdate {
+	_u.mutation.AddUserAID(v)
+	return _u
+}
+
+// SetUserBID sets the "user_b_id" field.
+func (_u *ConversationUpdate) SetUserBID(v int) *ConversationUpdate {
+	_u.mutation.ResetUserBID()
+	_u.mutation.SetUserBID(v)
+	return _u
+}
+
+// SetNillableUserBID sets the "user_b_id" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableUserBID(v *int) *ConversationUpdate {
+	if v != nil {
+		_u.SetUserBID(*v)
+	}
+	return _u
+}
+
+// AddUserBID adds value to the "user_b_id" field.
+func (_u *ConversationUpdate) AddUserBID(v int) *ConversationUpdate {
+	_u.mutation.AddUserBID(v)
+	return _u
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (_u *ConversationUpdate) SetLastMessageID(v int) *ConversationUpdate {
+	_u.mutation.ResetLastMessageID()
+	_u.mutation.SetLastMessageID(v)
+	return _u
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableLastMessageID(v *int) *ConversationUpdate {
+	if v != nil {
+		_u.SetLastMessageID(*v)
+	}
+	return _u
+}
+
+// AddLastMessageID adds value to the "last_message_id" field.
+func (_u *ConversationUpdate) AddLastMessageID(v int) *ConversationUpdate {
+	_u.mutation.AddLastMessageID(v)
+	return _u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_u *ConversationUpdate) SetLastMessageAt(v time.Time) *ConversationUpdate {
+	_u.mutation.SetLastMessageAt(v)
+	return _u
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableLastMessageAt(v *time.Time) *ConversationUpdate {
+	if v != nil {
+		_u.SetLastMessageAt(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (_u *ConversationUpdate) ClearLastMessageAt() *ConversationUpdate {
+	_u.mutation.ClearLastMessageAt()
+	return _u
+}
+
+// SetUserAUnread sets the "user_a_unread" field.
+func (_u *ConversationUpdate) SetUserAUnread(v int) *ConversationUpdate {
+	_u.mutation.ResetUserAUnread()
+	_u.mutation.SetUserAUnread(v)
+	return _u
+}
+
+// SetNillableUserAUnread sets the "user_a_unread" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableUserAUnread(v *int) *ConversationUpdate {
+	if v != nil {
+		_u.SetUserAUnread(*v)
+	}
+	return _u
+}
+
+// AddUserAUnread adds value to the "user_a_unread" field.
+func (_u *ConversationUpdate) AddUserAUnread(v int) *ConversationUpdate {
+	_u.mutation.AddUserAUnread(v)
+	return _u
+}
+
+// SetUserBUnread sets the "user_b_unread" field.
+func (_u *ConversationUpdate) SetUserBUnread(v int) *ConversationUpdate {
+	_u.mutation.ResetUserBUnread()
+	_u.mutation.SetUserBUnread(v)
+	return _u
+}
+
+// SetNillableUserBUnread sets the "user_b_unread" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableUserBUnread(v *int) *ConversationUpdate {
+	if v != nil {
+		_u.SetUserBUnread(*v)
+	}
+	return _u
+}
+
+// AddUserBUnread adds value to the "user_b_unread" field.
+func (_u *ConversationUpdate) AddUserBUnread(v int) *ConversationUpdate {
+	_u.mutation.AddUserBUnread(v)
+	return _u
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (_u *ConversationUpdate) Mutation() *ConversationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConversationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConversationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConversationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := conversation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationUpdate) check() error {
+	if v, ok := _u.mutation.UserAID(); ok {
+		if err := conversation.UserAIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_a_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_a_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserBID(); ok {
+		if err := conversation.UserBIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_b_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_b_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastMessageID(); ok {
+		if err := conversation.LastMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "last_message_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.last_message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAUnread(); ok {
+		if err := conversation.UserAUnreadValidator(v); err != nil {
+			return &ValidationError{Name: "user_a_unread", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_a_unread": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserBUnread(); ok {
+		if err := conversation.UserBUnreadValidator(v); err != nil {
+			return &ValidationError{Name: "user_b_unread", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_b_unread": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConversationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversation.Table, conversation.Columns, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserAID(); ok {
+		_spec.SetField(conversation.FieldUserAID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserAID(); ok {
+		_spec.AddField(conversation.FieldUserAID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserBID(); ok {
+		_spec.SetField(conversation.FieldUserBID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserBID(); ok {
+		_spec.AddField(conversation.FieldUserBID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastMessageID(); ok {
+		_spec.SetField(conversation.FieldLastMessageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastMessageID(); ok {
+		_spec.AddField(conversation.FieldLastMessageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastMessageAt(); ok {
+		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastMessageAtCleared() {
+		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UserAUnread(); ok {
+		_spec.SetField(conversation.FieldUserAUnread, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserAUnread(); ok {
+		_spec.AddField(conversation.FieldUserAUnread, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserBUnread(); ok {
+		_spec.SetField(conversation.FieldUserBUnread, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserBUnread(); ok {
+		_spec.AddField(conversation.FieldUserBUnread, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConversationUpdateOne is the builder for updating a single Conversation entity.
+type ConversationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConversationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConversationUpdateOne) SetUpdatedAt(v time.Time) *ConversationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserAID sets the "user_a_id" field.
+func (_u *ConversationUpdateOne) SetUserAID(v int) *ConversationUpdateOne {
+	_u.mutation.ResetUserAID()
+	_u.mutation.SetUserAID(v)
+	return _u
+}
+
+// SetNillableUserAID sets the "user_a_id" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableUserAID(v *int) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetUserAID(*v)
+	}
+	return _u
+}
+
+// AddUserAID adds value to the "user_a_id" field.
+func (_u *ConversationUpdateOne) AddUserAID(v int) *ConversationUpdateOne {
+	_u.mutation.AddUserAID(v)
+	return _u
+}
+
+// SetUserBID sets the "user_b_id" field.
+func (_u *ConversationUpdateOne) SetUserBID(v int) *ConversationUpdateOne {
+	_u.mutation.ResetUserBID()
+	_u.mutation.SetUserBID(v)
+	return _u
+}
+
+// SetNillableUserBID sets the "user_b_id" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableUserBID(v *int) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetUserBID(*v)
+	}
+	return _u
+}
+
+// AddUserBID adds value to the "user_b_id" field.
+func (_u *ConversationUpdateOne) AddUserBID(v int) *ConversationUpdateOne {
+	_u.mutation.AddUserBID(v)
+	return _u
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (_u *ConversationUpdateOne) SetLastMessageID(v int) *ConversationUpdateOne {
+	_u.mutation.ResetLastMessageID()
+	_u.mutation.SetLastMessageID(v)
+	return _u
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableLastMessageID(v *int) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetLastMessageID(*v)
+	}
+	return _u
+}
+
+// AddLastMessageID adds value to the "last_message_id" field.
+func (_u *ConversationUpdateOne) AddLastMessageID(v int) *ConversationUpdateOne {
+	_u.mutation.AddLastMessageID(v)
+	return _u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_u *ConversationUpdateOne) SetLastMessageAt(v time.Time) *ConversationUpdateOne {
+	_u.mutation.SetLastMessageAt(v)
+	return _u
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableLastMessageAt(v *time.Time) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetLastMessageAt(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (_u *ConversationUpdateOne) ClearLastMessageAt() *ConversationUpdateOne {
+	_u.mutation.ClearLastMessageAt()
+	return _u
+}
+
+// SetUserAUnread sets the "user_a_unread" field.
+func (_u *ConversationUpdateOne) SetUserAUnread(v int) *ConversationUpdateOne {
+	_u.mutation.ResetUserAUnread()
+	_u.mutation.SetUserAUnread(v)
+	return _u
+}
+
+// SetNillableUserAUnread sets the "user_a_unread" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableUserAUnread(v *int) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetUserAUnread(*v)
+	}
+	return _u
+}
+
+// AddUserAUnread adds value to the "user_a_unread" field.
+func (_u *ConversationUpdateOne) AddUserAUnread(v int) *ConversationUpdateOne {
+	_u.mutation.AddUserAUnread(v)
+	return _u
+}
+
+// SetUserBUnread sets the "user_b_unread" field.
+func (_u *ConversationUpdateOne) SetUserBUnread(v int) *ConversationUpdateOne {
+	_u.mutation.ResetUserBUnread()
+	_u.mutation.SetUserBUnread(v)
+	return _u
+}
+
+// SetNillableUserBUnread sets the "user_b_unread" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableUserBUnread(v *int) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetUserBUnread(*v)
+	}
+	return _u
+}
+
+// AddUserBUnread adds value to the "user_b_unread" field.
+func (_u *ConversationUpdateOne) AddUserBUnread(v int) *ConversationUpdateOne {
+	_u.mutation.AddUserBUnread(v)
+	return _u
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (_u *ConversationUpdateOne) Mutation() *ConversationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConversationUpdate builder.
+func (_u *ConversationUpdateOne) Where(ps ...predicate.Conversation) *ConversationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConversationUpdateOne) Select(field string, fields ...string) *ConversationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Conversation entity.
+func (_u *ConversationUpdateOne) Save(ctx context.Context) (*Conversation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationUpdateOne) SaveX(ctx context.Context) *Conversation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConversationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConversationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := conversation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationUpdateOne) check() error {
+	if v, ok := _u.mutation.UserAID(); ok {
+		if err := conversation.UserAIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_a_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_a_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserBID(); ok {
+		if err := conversation.UserBIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_b_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_b_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastMessageID(); ok {
+		if err := conversation.LastMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "last_message_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.last_message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAUnread(); ok {
+		if err := conversation.UserAUnreadValidator(v); err != nil {
+			return &ValidationError{Name: "user_a_unread", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_a_unread": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserBUnread(); ok {
+		if err := conversation.UserBUnreadValidator(v); err != nil {
+			return &ValidationError{Name: "user_b_unread", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_b_unread": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConversationUpdateOne) sqlSave(ctx context.Context) (_node *Conversation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversation.Table, conversation.Columns, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Conversation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversation.FieldID)
+		for _, f := range fields {
+			if !conversation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserAID(); ok {
+		_spec.SetField(conversation.FieldUserAID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserAID(); ok {
+		_spec.AddField(conversation.FieldUserAID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserBID(); ok {
+		_spec.SetField(conversation.FieldUserBID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserBID(); ok {
+		_spec.AddField(conversation.FieldUserBID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastMessageID(); ok {
+		_spec.SetField(conversation.FieldLastMessageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastMessageID(); ok {
+		_spec.AddField(conversation.FieldLastMessageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastMessageAt(); ok {
+		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastMessageAtCleared() {
+		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UserAUnread(); ok {
+		_spec.SetField(conversation.FieldUserAUnread, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserAUnread(); ok {
+		_spec.AddField(conversation.FieldUserAUnread, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserBUnread(); ok {
+		_spec.SetField(conversation.FieldUserBUnread, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserBUnread(); ok {
+		_spec.AddField(conversation.FieldUserBUnread, field.TypeInt, value)
+	}
+	_node = &Conversation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,21 +12,43 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/PokeForum/PokeForum/ent/attachment"
+	"github.com/PokeForum/PokeForum/ent/badge"
 	"github.com/PokeForum/PokeForum/ent/blacklist"
 	"github.com/PokeForum/PokeForum/ent/category"
 	"github.com/PokeForum/PokeForum/ent/categorymoderator"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
 	"github.com/PokeForum/PokeForum/ent/comment"
 	"github.com/PokeForum/PokeForum/ent/commentaction"
+	"github.com/PokeForum/PokeForum/ent/conversation"
+	"github.com/PokeForum/PokeForum/ent/follow"
+	"github.com/PokeForum/PokeForum/ent/invitecode"
+	"github.com/PokeForum/PokeForum/ent/mention"
+	"github.com/PokeForum/PokeForum/ent/notification"
+	"github.com/PokeForum/PokeForum/ent/notificationpreference"
 	"github.com/PokeForum/PokeForum/ent/oauthprovider"
 	"github.com/PokeForum/PokeForum/ent/post"
 	"github.com/PokeForum/PokeForum/ent/postaction"
+	"github.com/PokeForum/PokeForum/ent/postpurchase"
+	"github.com/PokeForum/PokeForum/ent/postrevision"
+	"github.com/PokeForum/PokeForum/ent/posttag"
+	"github.com/PokeForum/PokeForum/ent/privatemessage"
+	"github.com/PokeForum/PokeForum/ent/report"
 	"github.com/PokeForum/PokeForum/ent/settings"
+	"github.com/PokeForum/PokeForum/ent/tag"
 	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/userbadge"
 	"github.com/PokeForum/PokeForum/ent/userbalancelog"
+	"github.com/PokeForum/PokeForum/ent/usergroup"
+	"github.com/PokeForum/PokeForum/ent/usergroupmember"
+	"github.com/PokeForum/PokeForum/ent/userinvitation"
+	"github.com/PokeForum/PokeForum/ent/userlevellog"
 	"github.com/PokeForum/PokeForum/ent/userloginlog"
 	"github.com/PokeForum/PokeForum/ent/useroauth"
 	"github.com/PokeForum/PokeForum/ent/usersigninlogs"
 	"github.com/PokeForum/PokeForum/ent/usersigninstatus"
+	"github.com/PokeForum/PokeForum/ent/usertwofactor"
+	"github.com/PokeForum/PokeForum/ent/webauthncredential"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -87,21 +109,43 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			blacklist.Table:         blacklist.ValidColumn,
-			category.Table:          category.ValidColumn,
-			categorymoderator.Table: categorymoderator.ValidColumn,
-			comment.Table:           comment.ValidColumn,
-			commentaction.Table:     commentaction.ValidColumn,
-			oauthprovider.Table:     oauthprovider.ValidColumn,
-			post.Table:              post.ValidColumn,
-			postaction.Table:        postaction.ValidColumn,
-			settings.Table:          settings.ValidColumn,
-			user.Table:              user.ValidColumn,
-			userbalancelog.Table:    userbalancelog.ValidColumn,
-			userloginlog.Table:      userloginlog.ValidColumn,
-			useroauth.Table:         useroauth.ValidColumn,
-			usersigninlogs.Table:    usersigninlogs.ValidColumn,
-			usersigninstatus.Table:  usersigninstatus.ValidColumn,
+			attachment.Table:             attachment.ValidColumn,
+			badge.Table:                  badge.ValidColumn,
+			blacklist.Table:              blacklist.ValidColumn,
+			category.Table:               category.ValidColumn,
+			categorymoderator.Table:      categorymoderator.ValidColumn,
+			categorypermission.Table:     categorypermission.ValidColumn,
+			comment.Table:                comment.ValidColumn,
+			commentaction.Table:          commentaction.ValidColumn,
+			conversation.Table:           conversation.ValidColumn,
+			follow.Table:                 follow.ValidColumn,
+			invitecode.Table:             invitecode.ValidColumn,
+			mention.Table:                mention.ValidColumn,
+			notification.Table:           notification.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			oauthprovider.Table:          oauthprovider.ValidColumn,
+			post.Table:                   post.ValidColumn,
+			postaction.Table:             postaction.ValidColumn,
+			postpurchase.Table:           postpurchase.ValidColumn,
+			postrevision.Table:           postrevision.ValidColumn,
+			posttag.Table:                posttag.ValidColumn,
+			privatemessage.Table:         privatemessage.ValidColumn,
+			report.Table:                 report.ValidColumn,
+			settings.Table:               settings.ValidColumn,
+			tag.Table:                    tag.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			userbadge.Table:              userbadge.ValidColumn,
+			userbalancelog.Table:         userbalancelog.ValidColumn,
+			usergroup.Table:              usergroup.ValidColumn,
+			usergroupmember.Table:        usergroupmember.ValidColumn,
+			userinvitation.Table:         userinvitation.ValidColumn,
+			userlevellog.Table:           userlevellog.ValidColumn,
+			userloginlog.Table:           userloginlog.ValidColumn,
+			useroauth.Table:              useroauth.ValidColumn,
+			usersigninlogs.Table:         usersigninlogs.ValidColumn,
+			usersigninstatus.Table:       usersigninstatus.ValidColumn,
+			usertwofactor.Table:          usertwofactor.ValidColumn,
+			webauthncredential.Table:     webauthncredential.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mention
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Mention {
+	return predicate.Mention(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Mention {
+	return predicate.Mention(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Mention {
+	return predicate.Mention(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Mention {
+	return predicate.Mention(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Mention {
+	return predicate.Mention(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldUserID, v))
+}
+
+// FromUserID applies equality check predicate on the "from_user_id" field. It's identical to FromUserIDEQ.
+func FromUserID(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldFromUserID, v))
+}
+
+// PostID applies equality check predicate on the "post_id" field. It's identical to PostIDEQ.
+func PostID(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldPostID, v))
+}
+
+// CommentID applies equality check predicate on the "comment_id" field. It's identical to CommentIDEQ.
+func CommentID(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldCommentID, v))
+}
+
+// IsRead applies equality check predicate on the "is_read" field. It's identical to IsReadEQ.
+func IsRead(v bool) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldIsRead, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Mention {
+	return predicate.Mention(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldLTE(FieldUserID, v))
+}
+
+// FromUserIDEQ applies the EQ predicate on the "from_user_id" field.
+func FromUserIDEQ(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldFromUserID, v))
+}
+
+// FromUserIDNEQ applies the NEQ predicate on the "from_user_id" field.
+func FromUserIDNEQ(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldNEQ(FieldFromUserID, v))
+}
+
+// FromUserIDIn applies the In predicate on the "from_user_id" field.
+func FromUserIDIn(vs ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldIn(FieldFromUserID, vs...))
+}
+
+// FromUserIDNotIn applies the NotIn predicate on the "from_user_id" field.
+func FromUserIDNotIn(vs ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldNotIn(FieldFromUserID, vs...))
+}
+
+// FromUserIDGT applies the GT predicate on the "from_user_id" field.
+func FromUserIDGT(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldGT(FieldFromUserID, v))
+}
+
+// FromUserIDGTE applies the GTE predicate on the "from_user_id" field.
+func FromUserIDGTE(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldGTE(FieldFromUserID, v))
+}
+
+// FromUserIDLT applies the LT predicate on the "from_user_id" field.
+func FromUserIDLT(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldLT(FieldFromUserID, v))
+}
+
+// FromUserIDLTE applies the LTE predicate on the "from_user_id" field.
+func FromUserIDLTE(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldLTE(FieldFromUserID, v))
+}
+
+// PostIDEQ applies the EQ predicate on the "post_id" field.
+func PostIDEQ(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldPostID, v))
+}
+
+// PostIDNEQ applies the NEQ predicate on the "post_id" field.
+func PostIDNEQ(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldNEQ(FieldPostID, v))
+}
+
+// PostIDIn applies the In predicate on the "post_id" field.
+func PostIDIn(vs ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldIn(FieldPostID, vs...))
+}
+
+// PostIDNotIn applies the NotIn predicate on the "post_id" field.
+func PostIDNotIn(vs ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldNotIn(FieldPostID, vs...))
+}
+
+// PostIDGT applies the GT predicate on the "post_id" field.
+func PostIDGT(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldGT(FieldPostID, v))
+}
+
+// PostIDGTE applies the GTE predicate on the "post_id" field.
+func PostIDGTE(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldGTE(FieldPostID, v))
+}
+
+// PostIDLT applies the LT predicate on the "post_id" field.
+func PostIDLT(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldLT(FieldPostID, v))
+}
+
+// PostIDLTE applies the LTE predicate on the "post_id" field.
+func PostIDLTE(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldLTE(FieldPostID, v))
+}
+
+// CommentIDEQ applies the EQ predicate on the "comment_id" field.
+func CommentIDEQ(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldCommentID, v))
+}
+
+// CommentIDNEQ applies the NEQ predicate on the "comment_id" field.
+func CommentIDNEQ(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldNEQ(FieldCommentID, v))
+}
+
+// CommentIDIn applies the In predicate on the "comment_id" field.
+func CommentIDIn(vs ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldIn(FieldCommentID, vs...))
+}
+
+// CommentIDNotIn applies the NotIn predicate on the "comment_id" field.
+func CommentIDNotIn(vs ...int) predicate.Mention {
+	return predicate.Mention(sql.FieldNotIn(FieldCommentID, vs...))
+}
+
+// CommentIDGT applies the GT predicate on the "comment_id" field.
+func CommentIDGT(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldGT(FieldCommentID, v))
+}
+
+// CommentIDGTE applies the GTE predicate on the "comment_id" field.
+func CommentIDGTE(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldGTE(FieldCommentID, v))
+}
+
+// CommentIDLT applies the LT predicate on the "comment_id" field.
+func CommentIDLT(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldLT(FieldCommentID, v))
+}
+
+// CommentIDLTE applies the LTE predicate on the "comment_id" field.
+func CommentIDLTE(v int) predicate.Mention {
+	return predicate.Mention(sql.FieldLTE(FieldCommentID, v))
+}
+
+// IsReadEQ applies the EQ predicate on the "is_read" field.
+func IsReadEQ(v bool) predicate.Mention {
+	return predicate.Mention(sql.FieldEQ(FieldIsRead, v))
+}
+
+// IsReadNEQ applies the NEQ predicate on the "is_read" field.
+func IsReadNEQ(v bool) predicate.Mention {
+	return predicate.Mention(sql.FieldNEQ(FieldIsRead, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Mention) predicate.Mention {
+	return predicate.Mention(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Mention) predicate.Mention {
+	return predicate.Mention(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Mention) predicate.Mention {
+	return predicate.Mention(sql.NotPredicates(p))
+}
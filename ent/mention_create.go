@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/mention"
+)
+
+// MentionCreate is the builder for creating a Mention entity.
+type MentionCreate struct {
+	config
+	mutation *MentionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MentionCreate) SetCreatedAt(v time.Time) *MentionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MentionCreate) SetNillableCreatedAt(v *time.Time) *MentionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MentionCreate) SetUpdatedAt(v time.Time) *MentionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MentionCreate) SetNillableUpdatedAt(v *time.Time) *MentionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *MentionCreate) SetUserID(v int) *MentionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (_c *MentionCreate) SetFromUserID(v int) *MentionCreate {
+	_c.mutation.SetFromUserID(v)
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *MentionCreate) SetPostID(v int) *MentionCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetCommentID sets the "comment_id" field.
+func (_c *MentionCreate) SetCommentID(v int) *MentionCreate {
+	_c.mutation.SetCommentID(v)
+	return _c
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (_c *MentionCreate) SetNillableCommentID(v *int) *MentionCreate {
+	if v != nil {
+		_c.SetCommentID(*v)
+	}
+	return _c
+}
+
+// SetIsRead sets the "is_read" field.
+func (_c *MentionCreate) SetIsRead(v bool) *MentionCreate {
+	_c.mutation.SetIsRead(v)
+	return _c
+}
+
+// SetNillableIsRead sets the "is_read" field if the given value is not nil.
+func (_c *MentionCreate) SetNillableIsRead(v *bool) *MentionCreate {
+	if v != nil {
+		_c.SetIsRead(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MentionCreate) SetID(v int) *MentionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the MentionMutation object of the builder.
+func (_c *MentionCreate) Mutation() *MentionMutation {
+	return _c.mutation
+}
+
+// Save creates the Mention in the database.
+func (_c *MentionCreate) Save(ctx context.Context) (*Mention, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MentionCreate) SaveX(ctx context.Context) *Mention {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MentionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MentionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MentionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := mention.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := mention.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CommentID(); !ok {
+		v := mention.DefaultCommentID
+		_c.mutation.SetCommentID(v)
+	}
+	if _, ok := _c.mutation.IsRead(); !ok {
+		v := mention.DefaultIsRead
+		_c.mutation.SetIsRead(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MentionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Mention.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Mention.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Mention.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := mention.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Mention.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FromUserID(); !ok {
+		return &ValidationError{Name: "from_user_id", err: errors.New(`ent: missing required field "Mention.from_user_id"`)}
+	}
+	if v, ok := _c.mutation.FromUserID(); ok {
+		if err := mention.FromUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "from_user_id", err: fmt.Errorf(`ent: validator failed for field "Mention.from_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "Mention.post_id"`)}
+	}
+	if v, ok := _c.mutation.PostID(); ok {
+		if err := mention.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "Mention.post_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CommentID(); !ok {
+		return &ValidationError{Name: "comment_id", err: errors.New(`ent: missing required field "Mention.comment_id"`)}
+	}
+	if v, ok := _c.mutation.CommentID(); ok {
+		if err := mention.CommentIDValidator(v); err != nil {
+			return &ValidationError{Name: "comment_id", err: fmt.Errorf(`ent: validator failed for field "Mention.comment_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsRead(); !ok {
+		return &ValidationError{Name: "is_read", err: errors.New(`ent: missing required field "Mention.is_read"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := mention.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Mention.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *MentionCreate) sqlSave(ctx context.Context) (*Mention, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MentionCreate) createSpec() (*Mention, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Mention{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mention.Table, sqlgraph.NewFieldSpec(mention.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(mention.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(mention.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(mention.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.FromUserID(); ok {
+		_spec.SetField(mention.FieldFromUserID, field.TypeInt, value)
+		_node.FromUserID = value
+	}
+	if value, ok := _c.mutation.PostID(); ok {
+		_spec.SetField(mention.FieldPostID, field.TypeInt, value)
+		_node.PostID = value
+	}
+	if value, ok := _c.mutation.CommentID(); ok {
+		_spec.SetField(mention.FieldCommentID, field.TypeInt, value)
+		_node.CommentID = value
+	}
+	if value, ok := _c.mutation.IsRead(); ok {
+		_spec.SetField(mention.FieldIsRead, field.TypeBool, value)
+		_node.IsRead = value
+	}
+	return _node, _spec
+}
+
+// MentionCreateBulk is the builder for creating many Mention entities in bulk.
+type MentionCreateBulk struct {
+	config
+	err      error
+	builders []*MentionCreate
+}
+
+// Save creates the Mention entities in the database.
+func (_c *MentionCreateBulk) Save(ctx context.Context) ([]*Mention, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Mention, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MentionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MentionCreateBulk) SaveX(ctx context.Context) []*Mention {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MentionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MentionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/mention"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// MentionUpdate is the builder for updating Mention entities.
+type MentionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MentionMutation
+}
+
+// Where appends a list predicates to the MentionUpdate builder.
+func (_u *MentionUpdate) Where(ps ...predicate.Mention) *MentionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MentionUpdate) SetUpdatedAt(v time.Time) *MentionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *MentionUpdate) SetUserID(v int) *MentionUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *MentionUpdate) SetNillableUserID(v *int) *MentionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *MentionUpdate) AddUserID(v int) *MentionUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (_u *MentionUpdate) SetFromUserID(v int) *MentionUpdate {
+	_u.mutation.ResetFromUserID()
+	_u.mutation.SetFromUserID(v)
+	return _u
+}
+
+// SetNillableFromUserID sets the "from_user_id" field if the given value is not nil.
+func (_u *MentionUpdate) SetNillableFromUserID(v *int) *MentionUpdate {
+	if v != nil {
+		_u.SetFromUserID(*v)
+	}
+	return _u
+}
+
+// AddFromUserID adds value to the "from_user_id" field.
+func (_u *MentionUpdate) AddFromUserID(v int) *MentionUpdate {
+	_u.mutation.AddFromUserID(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *MentionUpdate) SetPostID(v int) *MentionUpdate {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *MentionUpdate) SetNillablePostID(v *int) *MentionUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *MentionUpdate) AddPostID(v int) *MentionUpdate {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (_u *MentionUpdate) SetCommentID(v int) *MentionUpdate {
+	_u.mutation.ResetCommentID()
+	_u.mutation.SetCommentID(v)
+	return _u
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (_u *MentionUpdate) SetNillableCommentID(v *int) *MentionUpdate {
+	if v != nil {
+		_u.SetCommentID(*v)
+	}
+	return _u
+}
+
+// AddCommentID adds value to the "comment_id" field.
+func (_u *MentionUpdate) AddCommentID(v int) *MentionUpdate {
+	_u.mutation.AddCommentID(v)
+	return _u
+}
+
+// SetIsRead sets the "is_read" field.
+func (_u *MentionUpdate) SetIsRead(v bool) *MentionUpdate {
+	_u.mutation.SetIsRead(v)
+	return _u
+}
+
+// SetNillableIsRead sets the "is_read" field if the given value is not nil.
+func (_u *MentionUpdate) SetNillableIsRead(v *bool) *MentionUpdate {
+	if v != nil {
+		_u.SetIsRead(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MentionMutation object of the builder.
+func (_u *MentionUpdate) Mutation() *MentionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MentionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MentionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MentionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MentionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MentionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := mention.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MentionUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := mention.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Mention.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FromUserID(); ok {
+		if err := mention.FromUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "from_user_id", err: fmt.Errorf(`ent: validator failed for field "Mention.from_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := mention.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "Mention.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommentID(); ok {
+		if err := mention.CommentIDValidator(v); err != nil {
+			return &ValidationError{Name: "comment_id", err: fmt.Errorf(`ent: validator failed for field "Mention.comment_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MentionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mention.Table, mention.Columns, sqlgraph.NewFieldSpec(mention.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(mention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(mention.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(mention.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FromUserID(); ok {
+		_spec.SetField(mention.FieldFromUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFromUserID(); ok {
+		_spec.AddField(mention.FieldFromUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(mention.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(mention.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CommentID(); ok {
+		_spec.SetField(mention.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommentID(); ok {
+		_spec.AddField(mention.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsRead(); ok {
+		_spec.SetField(mention.FieldIsRead, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MentionUpdateOne is the builder for updating a single Mention entity.
+type MentionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MentionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MentionUpdateOne) SetUpdatedAt(v time.Time) *MentionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *MentionUpdateOne) SetUserID(v int) *MentionUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *MentionUpdateOne) SetNillableUserID(v *int) *MentionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *MentionUpdateOne) AddUserID(v int) *MentionUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (_u *MentionUpdateOne) SetFromUserID(v int) *MentionUpdateOne {
+	_u.mutation.ResetFromUserID()
+	_u.mutation.SetFromUserID(v)
+	return _u
+}
+
+// SetNillableFromUserID sets the "from_user_id" field if the given value is not nil.
+func (_u *MentionUpdateOne) SetNillableFromUserID(v *int) *MentionUpdateOne {
+	if v != nil {
+		_u.SetFromUserID(*v)
+	}
+	return _u
+}
+
+// AddFromUserID adds value to the "from_user_id" field.
+func (_u *MentionUpdateOne) AddFromUserID(v int) *MentionUpdateOne {
+	_u.mutation.AddFromUserID(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *MentionUpdateOne) SetPostID(v int) *MentionUpdateOne {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *MentionUpdateOne) SetNillablePostID(v *int) *MentionUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *MentionUpdateOne) AddPostID(v int) *MentionUpdateOne {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (_u *MentionUpdateOne) SetCommentID(v int) *MentionUpdateOne {
+	_u.mutation.ResetCommentID()
+	_u.mutation.SetCommentID(v)
+	return _u
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (_u *MentionUpdateOne) SetNillableCommentID(v *int) *MentionUpdateOne {
+	if v != nil {
+		_u.SetCommentID(*v)
+	}
+	return _u
+}
+
+// AddCommentID adds value to the "comment_id" field.
+func (_u *MentionUpdateOne) AddCommentID(v int) *MentionUpdateOne {
+	_u.mutation.AddCommentID(v)
+	return _u
+}
+
+// SetIsRead sets the "is_read" field.
+func (_u *MentionUpdateOne) SetIsRead(v bool) *MentionUpdateOne {
+	_u.mutation.SetIsRead(v)
+	return _u
+}
+
+// SetNillableIsRead sets the "is_read" field if the given value is not nil.
+func (_u *MentionUpdateOne) SetNillableIsRead(v *bool) *MentionUpdateOne {
+	if v != nil {
+		_u.SetIsRead(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MentionMutation object of the builder.
+func (_u *MentionUpdateOne) Mutation() *MentionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MentionUpdate builder.
+func (_u *MentionUpdateOne) Where(ps ...predicate.Mention) *MentionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MentionUpdateOne) Select(field string, fields ...string) *MentionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Mention entity.
+func (_u *MentionUpdateOne) Save(ctx context.Context) (*Mention, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MentionUpdateOne) SaveX(ctx context.Context) *Mention {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MentionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MentionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MentionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := mention.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MentionUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := mention.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Mention.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FromUserID(); ok {
+		if err := mention.FromUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "from_user_id", err: fmt.Errorf(`ent: validator failed for field "Mention.from_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := mention.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "Mention.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommentID(); ok {
+		if err := mention.CommentIDValidator(v); err != nil {
+			return &ValidationError{Name: "comment_id", err: fmt.Errorf(`ent: validator failed for field "Mention.comment_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MentionUpdateOne) sqlSave(ctx context.Context) (_node *Mention, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mention.Table, mention.Columns, sqlgraph.NewFieldSpec(mention.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Mention.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mention.FieldID)
+		for _, f := range fields {
+			if !mention.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(mention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(mention.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(mention.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FromUserID(); ok {
+		_spec.SetField(mention.FieldFromUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFromUserID(); ok {
+		_spec.AddField(mention.FieldFromUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(mention.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(mention.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CommentID(); ok {
+		_spec.SetField(mention.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommentID(); ok {
+		_spec.AddField(mention.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IsRead(); ok {
+		_spec.SetField(mention.FieldIsRead, field.TypeBool, value)
+	}
+	_node = &Mention{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
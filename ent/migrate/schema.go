@@ -8,6 +8,76 @@ import (
 )
 
 var (
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "post_id", Type: field.TypeInt, Default: 0},
+		{Name: "comment_id", Type: field.TypeInt, Default: 0},
+		{Name: "filename", Type: field.TypeString, Size: 1020},
+		{Name: "hash", Type: field.TypeString, Size: 64},
+		{Name: "storage_key", Type: field.TypeString, Size: 255},
+		{Name: "mime_type", Type: field.TypeString, Size: 255},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "thumbnail_key", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "width", Type: field.TypeInt, Default: 0},
+		{Name: "height", Type: field.TypeInt, Default: 0},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[3]},
+			},
+			{
+				Name:    "attachment_hash",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[7]},
+			},
+			{
+				Name:    "attachment_post_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[4]},
+			},
+			{
+				Name:    "attachment_comment_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[5]},
+			},
+		},
+	}
+	// BadgesColumns holds the columns for the "badges" table.
+	BadgesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "icon", Type: field.TypeString, Nullable: true},
+		{Name: "criteria", Type: field.TypeEnum, Enums: []string{"manual", "post_count", "likes_received", "signin_streak", "essence_count"}, Default: "manual"},
+		{Name: "threshold", Type: field.TypeInt, Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// BadgesTable holds the schema information for the "badges" table.
+	BadgesTable = &schema.Table{
+		Name:       "badges",
+		Columns:    BadgesColumns,
+		PrimaryKey: []*schema.Column{BadgesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "badge_criteria_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{BadgesColumns[6], BadgesColumns[8]},
+			},
+		},
+	}
 	// BlacklistsColumns holds the columns for the "blacklists" table.
 	BlacklistsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -44,6 +114,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeInt, Default: 0},
 		{Name: "name", Type: field.TypeString},
 		{Name: "slug", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
@@ -61,12 +132,17 @@ var (
 			{
 				Name:    "category_status",
 				Unique:  false,
-				Columns: []*schema.Column{CategoriesColumns[8]},
+				Columns: []*schema.Column{CategoriesColumns[9]},
 			},
 			{
 				Name:    "category_weight",
 				Unique:  false,
-				Columns: []*schema.Column{CategoriesColumns[7]},
+				Columns: []*schema.Column{CategoriesColumns[8]},
+			},
+			{
+				Name:    "category_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{CategoriesColumns[3]},
 			},
 		},
 	}
@@ -101,6 +177,30 @@ var (
 			},
 		},
 	}
+	// CategoryPermissionsColumns holds the columns for the "category_permissions" table.
+	CategoryPermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "category_id", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"View", "Post", "Reply"}},
+		{Name: "min_role", Type: field.TypeEnum, Enums: []string{"User", "Moderator", "Admin", "SuperAdmin"}, Default: "User"},
+		{Name: "min_experience", Type: field.TypeInt, Default: 0},
+		{Name: "allowed_group_ids", Type: field.TypeJSON, Nullable: true},
+	}
+	// CategoryPermissionsTable holds the schema information for the "category_permissions" table.
+	CategoryPermissionsTable = &schema.Table{
+		Name:       "category_permissions",
+		Columns:    CategoryPermissionsColumns,
+		PrimaryKey: []*schema.Column{CategoryPermissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categorypermission_category_id_action",
+				Unique:  true,
+				Columns: []*schema.Column{CategoryPermissionsColumns[3], CategoryPermissionsColumns[4]},
+			},
+		},
+	}
 	// CommentsColumns holds the columns for the "comments" table.
 	CommentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -178,6 +278,179 @@ var (
 			},
 		},
 	}
+	// ConversationsColumns holds the columns for the "conversations" table.
+	ConversationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_a_id", Type: field.TypeInt},
+		{Name: "user_b_id", Type: field.TypeInt},
+		{Name: "last_message_id", Type: field.TypeInt, Default: 0},
+		{Name: "last_message_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_a_unread", Type: field.TypeInt, Default: 0},
+		{Name: "user_b_unread", Type: field.TypeInt, Default: 0},
+	}
+	// ConversationsTable holds the schema information for the "conversations" table.
+	ConversationsTable = &schema.Table{
+		Name:       "conversations",
+		Columns:    ConversationsColumns,
+		PrimaryKey: []*schema.Column{ConversationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversation_user_a_id_user_b_id",
+				Unique:  true,
+				Columns: []*schema.Column{ConversationsColumns[3], ConversationsColumns[4]},
+			},
+			{
+				Name:    "conversation_user_a_id_last_message_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationsColumns[3], ConversationsColumns[6]},
+			},
+			{
+				Name:    "conversation_user_b_id_last_message_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationsColumns[4], ConversationsColumns[6]},
+			},
+		},
+	}
+	// FollowsColumns holds the columns for the "follows" table.
+	FollowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "target_type", Type: field.TypeEnum, Enums: []string{"User", "Category"}},
+		{Name: "target_id", Type: field.TypeInt},
+	}
+	// FollowsTable holds the schema information for the "follows" table.
+	FollowsTable = &schema.Table{
+		Name:       "follows",
+		Columns:    FollowsColumns,
+		PrimaryKey: []*schema.Column{FollowsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "follow_user_id_target_type_target_id",
+				Unique:  true,
+				Columns: []*schema.Column{FollowsColumns[3], FollowsColumns[4], FollowsColumns[5]},
+			},
+			{
+				Name:    "follow_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{FollowsColumns[4], FollowsColumns[5]},
+			},
+		},
+	}
+	// InviteCodesColumns holds the columns for the "invite_codes" table.
+	InviteCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "code", Type: field.TypeString, Size: 32},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "used_count", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Active", "Revoked"}, Default: "Active"},
+	}
+	// InviteCodesTable holds the schema information for the "invite_codes" table.
+	InviteCodesTable = &schema.Table{
+		Name:       "invite_codes",
+		Columns:    InviteCodesColumns,
+		PrimaryKey: []*schema.Column{InviteCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitecode_code",
+				Unique:  true,
+				Columns: []*schema.Column{InviteCodesColumns[4]},
+			},
+			{
+				Name:    "invitecode_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{InviteCodesColumns[3], InviteCodesColumns[7]},
+			},
+		},
+	}
+	// MentionsColumns holds the columns for the "mentions" table.
+	MentionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "from_user_id", Type: field.TypeInt},
+		{Name: "post_id", Type: field.TypeInt},
+		{Name: "comment_id", Type: field.TypeInt, Default: 0},
+		{Name: "is_read", Type: field.TypeBool, Default: false},
+	}
+	// MentionsTable holds the schema information for the "mentions" table.
+	MentionsTable = &schema.Table{
+		Name:       "mentions",
+		Columns:    MentionsColumns,
+		PrimaryKey: []*schema.Column{MentionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mention_user_id_is_read",
+				Unique:  false,
+				Columns: []*schema.Column{MentionsColumns[3], MentionsColumns[7]},
+			},
+			{
+				Name:    "mention_post_id_comment_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MentionsColumns[5], MentionsColumns[6], MentionsColumns[3]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "actor_id", Type: field.TypeInt, Default: 0},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"Reply", "CommentReply", "Mention", "Like", "Moderation", "Balance", "System"}},
+		{Name: "post_id", Type: field.TypeInt, Default: 0},
+		{Name: "comment_id", Type: field.TypeInt, Default: 0},
+		{Name: "content", Type: field.TypeString, Size: 2000, Default: ""},
+		{Name: "is_read", Type: field.TypeBool, Default: false},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_id_is_read",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[3], NotificationsColumns[9]},
+			},
+			{
+				Name:    "notification_user_id_type",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[3], NotificationsColumns[5]},
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"Reply", "CommentReply", "Mention", "Like", "Moderation", "Balance", "System"}},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_user_id_type",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[3], NotificationPreferencesColumns[4]},
+			},
+		},
+	}
 	// OauthProvidersColumns holds the columns for the "oauth_providers" table.
 	OauthProvidersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -313,12 +586,172 @@ var (
 			},
 		},
 	}
+	// PostPurchasesColumns holds the columns for the "post_purchases" table.
+	PostPurchasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "author_id", Type: field.TypeInt},
+		{Name: "price", Type: field.TypeInt},
+	}
+	// PostPurchasesTable holds the schema information for the "post_purchases" table.
+	PostPurchasesTable = &schema.Table{
+		Name:       "post_purchases",
+		Columns:    PostPurchasesColumns,
+		PrimaryKey: []*schema.Column{PostPurchasesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postpurchase_post_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{PostPurchasesColumns[3], PostPurchasesColumns[4]},
+			},
+			{
+				Name:    "postpurchase_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostPurchasesColumns[4]},
+			},
+		},
+	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeInt},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "editor_id", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "reason", Type: field.TypeString, Size: 800, Default: ""},
+		{Name: "rollback_from", Type: field.TypeInt, Default: 0},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postrevision_post_id_revision",
+				Unique:  true,
+				Columns: []*schema.Column{PostRevisionsColumns[3], PostRevisionsColumns[4]},
+			},
+			{
+				Name:    "postrevision_editor_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostRevisionsColumns[5]},
+			},
+		},
+	}
+	// PostTagsColumns holds the columns for the "post_tags" table.
+	PostTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeInt},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// PostTagsTable holds the schema information for the "post_tags" table.
+	PostTagsTable = &schema.Table{
+		Name:       "post_tags",
+		Columns:    PostTagsColumns,
+		PrimaryKey: []*schema.Column{PostTagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "posttag_tag_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostTagsColumns[4]},
+			},
+			{
+				Name:    "posttag_post_id_tag_id",
+				Unique:  true,
+				Columns: []*schema.Column{PostTagsColumns[3], PostTagsColumns[4]},
+			},
+		},
+	}
+	// PrivateMessagesColumns holds the columns for the "private_messages" table.
+	PrivateMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "conversation_id", Type: field.TypeInt},
+		{Name: "sender_id", Type: field.TypeInt},
+		{Name: "receiver_id", Type: field.TypeInt},
+		{Name: "content", Type: field.TypeString, Size: 2000},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PrivateMessagesTable holds the schema information for the "private_messages" table.
+	PrivateMessagesTable = &schema.Table{
+		Name:       "private_messages",
+		Columns:    PrivateMessagesColumns,
+		PrimaryKey: []*schema.Column{PrivateMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "privatemessage_conversation_id_id",
+				Unique:  false,
+				Columns: []*schema.Column{PrivateMessagesColumns[3], PrivateMessagesColumns[0]},
+			},
+			{
+				Name:    "privatemessage_conversation_id_receiver_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{PrivateMessagesColumns[3], PrivateMessagesColumns[5], PrivateMessagesColumns[7]},
+			},
+		},
+	}
+	// ReportsColumns holds the columns for the "reports" table.
+	ReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reporter_id", Type: field.TypeInt},
+		{Name: "target_type", Type: field.TypeEnum, Enums: []string{"Post", "Comment", "User"}},
+		{Name: "target_id", Type: field.TypeInt},
+		{Name: "target_user_id", Type: field.TypeInt},
+		{Name: "category_id", Type: field.TypeInt, Default: 0},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"Spam", "Abuse", "Porn", "Illegal", "Infringement", "Other"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Pending", "Processing", "Resolved", "Dismissed"}, Default: "Pending"},
+		{Name: "handler_id", Type: field.TypeInt, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"None", "BanPost", "DeleteComment", "BanUser"}, Default: "None"},
+		{Name: "handle_note", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "handled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ReportsTable holds the schema information for the "reports" table.
+	ReportsTable = &schema.Table{
+		Name:       "reports",
+		Columns:    ReportsColumns,
+		PrimaryKey: []*schema.Column{ReportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "report_reporter_id_target_type_target_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReportsColumns[3], ReportsColumns[4], ReportsColumns[5]},
+			},
+			{
+				Name:    "report_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[4], ReportsColumns[5]},
+			},
+			{
+				Name:    "report_category_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[7], ReportsColumns[10]},
+			},
+			{
+				Name:    "report_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[10]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "module", Type: field.TypeEnum, Enums: []string{"Site", "HomePage", "Comment", "Seo", "Security", "Function", "Signin"}},
+		{Name: "module", Type: field.TypeEnum, Enums: []string{"Site", "HomePage", "Comment", "Seo", "Security", "Function", "Signin", "Upload", "Level"}},
 		{Name: "key", Type: field.TypeString},
 		{Name: "value", Type: field.TypeString, Nullable: true},
 		{Name: "value_type", Type: field.TypeEnum, Enums: []string{"string", "number", "boolean", "json", "text"}, Default: "string"},
@@ -336,6 +769,33 @@ var (
 			},
 		},
 	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 128},
+		{Name: "post_count", Type: field.TypeInt, Default: 0},
+		{Name: "canonical_id", Type: field.TypeInt, Default: 0},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_name",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[3]},
+			},
+			{
+				Name:    "tag_canonical_id_post_count",
+				Unique:  false,
+				Columns: []*schema.Column{TagsColumns[5], TagsColumns[4]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -349,6 +809,7 @@ var (
 		{Name: "signature", Type: field.TypeString, Nullable: true},
 		{Name: "readme", Type: field.TypeString, Nullable: true},
 		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "mention_email_notify", Type: field.TypeBool, Default: false},
 		{Name: "experience", Type: field.TypeInt, Default: 0},
 		{Name: "points", Type: field.TypeInt, Default: 0},
 		{Name: "currency", Type: field.TypeInt, Default: 0},
@@ -364,12 +825,12 @@ var (
 			{
 				Name:    "user_status",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[14]},
+				Columns: []*schema.Column{UsersColumns[15]},
 			},
 			{
 				Name:    "user_role",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[15]},
+				Columns: []*schema.Column{UsersColumns[16]},
 			},
 			{
 				Name:    "user_email_verified",
@@ -378,6 +839,36 @@ var (
 			},
 		},
 	}
+	// UserBadgesColumns holds the columns for the "user_badges" table.
+	UserBadgesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "badge_id", Type: field.TypeInt},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"auto", "manual"}, Default: "auto"},
+		{Name: "granted_by", Type: field.TypeInt, Default: 0},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "display_order", Type: field.TypeInt, Default: 0},
+	}
+	// UserBadgesTable holds the schema information for the "user_badges" table.
+	UserBadgesTable = &schema.Table{
+		Name:       "user_badges",
+		Columns:    UserBadgesColumns,
+		PrimaryKey: []*schema.Column{UserBadgesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userbadge_badge_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserBadgesColumns[4]},
+			},
+			{
+				Name:    "userbadge_user_id_badge_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserBadgesColumns[3], UserBadgesColumns[4]},
+			},
+		},
+	}
 	// UserBalanceLogsColumns holds the columns for the "user_balance_logs" table.
 	UserBalanceLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -424,6 +915,110 @@ var (
 			},
 		},
 	}
+	// UserGroupsColumns holds the columns for the "user_groups" table.
+	UserGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON, Nullable: true},
+	}
+	// UserGroupsTable holds the schema information for the "user_groups" table.
+	UserGroupsTable = &schema.Table{
+		Name:       "user_groups",
+		Columns:    UserGroupsColumns,
+		PrimaryKey: []*schema.Column{UserGroupsColumns[0]},
+	}
+	// UserGroupMembersColumns holds the columns for the "user_group_members" table.
+	UserGroupMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "group_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// UserGroupMembersTable holds the schema information for the "user_group_members" table.
+	UserGroupMembersTable = &schema.Table{
+		Name:       "user_group_members",
+		Columns:    UserGroupMembersColumns,
+		PrimaryKey: []*schema.Column{UserGroupMembersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usergroupmember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserGroupMembersColumns[4]},
+			},
+			{
+				Name:    "usergroupmember_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserGroupMembersColumns[5]},
+			},
+			{
+				Name:    "usergroupmember_group_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserGroupMembersColumns[3], UserGroupMembersColumns[4]},
+			},
+		},
+	}
+	// UserInvitationsColumns holds the columns for the "user_invitations" table.
+	UserInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "inviter_id", Type: field.TypeInt},
+		{Name: "invitee_id", Type: field.TypeInt},
+		{Name: "invite_code_id", Type: field.TypeInt},
+	}
+	// UserInvitationsTable holds the schema information for the "user_invitations" table.
+	UserInvitationsTable = &schema.Table{
+		Name:       "user_invitations",
+		Columns:    UserInvitationsColumns,
+		PrimaryKey: []*schema.Column{UserInvitationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userinvitation_invitee_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserInvitationsColumns[4]},
+			},
+			{
+				Name:    "userinvitation_inviter_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserInvitationsColumns[3]},
+			},
+			{
+				Name:    "userinvitation_invite_code_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserInvitationsColumns[5]},
+			},
+		},
+	}
+	// UserLevelLogsColumns holds the columns for the "user_level_logs" table.
+	UserLevelLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "from_level", Type: field.TypeInt},
+		{Name: "to_level", Type: field.TypeInt},
+		{Name: "level_name", Type: field.TypeString, Nullable: true},
+		{Name: "experience", Type: field.TypeInt},
+		{Name: "related_type", Type: field.TypeString, Nullable: true},
+	}
+	// UserLevelLogsTable holds the schema information for the "user_level_logs" table.
+	UserLevelLogsTable = &schema.Table{
+		Name:       "user_level_logs",
+		Columns:    UserLevelLogsColumns,
+		PrimaryKey: []*schema.Column{UserLevelLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userlevellog_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserLevelLogsColumns[3]},
+			},
+		},
+	}
 	// UserLoginLogsColumns holds the columns for the "user_login_logs" table.
 	UserLoginLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -554,23 +1149,105 @@ var (
 			},
 		},
 	}
+	// UserTwoFactorsColumns holds the columns for the "user_two_factors" table.
+	UserTwoFactorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: false},
+		{Name: "recovery_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "enabled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// UserTwoFactorsTable holds the schema information for the "user_two_factors" table.
+	UserTwoFactorsTable = &schema.Table{
+		Name:       "user_two_factors",
+		Columns:    UserTwoFactorsColumns,
+		PrimaryKey: []*schema.Column{UserTwoFactorsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usertwofactor_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserTwoFactorsColumns[3]},
+			},
+		},
+	}
+	// WebAuthnCredentialsColumns holds the columns for the "web_authn_credentials" table.
+	WebAuthnCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "credential_id", Type: field.TypeString},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "attestation_type", Type: field.TypeString, Nullable: true},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "flags", Type: field.TypeUint8, Default: 0},
+		{Name: "clone_warning", Type: field.TypeBool, Default: false},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WebAuthnCredentialsTable holds the schema information for the "web_authn_credentials" table.
+	WebAuthnCredentialsTable = &schema.Table{
+		Name:       "web_authn_credentials",
+		Columns:    WebAuthnCredentialsColumns,
+		PrimaryKey: []*schema.Column{WebAuthnCredentialsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webauthncredential_credential_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebAuthnCredentialsColumns[4]},
+			},
+			{
+				Name:    "webauthncredential_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebAuthnCredentialsColumns[3]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AttachmentsTable,
+		BadgesTable,
 		BlacklistsTable,
 		CategoriesTable,
 		CategoryModeratorsTable,
+		CategoryPermissionsTable,
 		CommentsTable,
 		CommentActionsTable,
+		ConversationsTable,
+		FollowsTable,
+		InviteCodesTable,
+		MentionsTable,
+		NotificationsTable,
+		NotificationPreferencesTable,
 		OauthProvidersTable,
 		PostsTable,
 		PostActionsTable,
+		PostPurchasesTable,
+		PostRevisionsTable,
+		PostTagsTable,
+		PrivateMessagesTable,
+		ReportsTable,
 		SettingsTable,
+		TagsTable,
 		UsersTable,
+		UserBadgesTable,
 		UserBalanceLogsTable,
+		UserGroupsTable,
+		UserGroupMembersTable,
+		UserInvitationsTable,
+		UserLevelLogsTable,
 		UserLoginLogsTable,
 		UserOauthsTable,
 		UserSigninLogsTable,
 		UserSigninStatusTable,
+		UserTwoFactorsTable,
+		WebAuthnCredentialsTable,
 	}
 )
 
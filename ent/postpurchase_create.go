@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/postpurchase"
+)
+
+// PostPurchaseCreate is the builder for creating a PostPurchase entity.
+type PostPurchaseCreate struct {
+	config
+	mutation *PostPurchaseMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PostPurchaseCreate) SetCreatedAt(v time.Time) *PostPurchaseCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PostPurchaseCreate) SetNillableCreatedAt(v *time.Time) *PostPurchaseCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PostPurchaseCreate) SetUpdatedAt(v time.Time) *PostPurchaseCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PostPurchaseCreate) SetNillableUpdatedAt(v *time.Time) *PostPurchaseCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *PostPurchaseCreate) SetPostID(v int) *PostPurchaseCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PostPurchaseCreate) SetUserID(v int) *PostPurchaseCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *PostPurchaseCreate) SetAuthorID(v int) *PostPurchaseCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *PostPurchaseCreate) SetPrice(v int) *PostPurchaseCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PostPurchaseCreate) SetID(v int) *PostPurchaseCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PostPurchaseMutation object of the builder.
+func (_c *PostPurchaseCreate) Mutation() *PostPurchaseMutation {
+	return _c.mutation
+}
+
+// Save creates the PostPurchase in the database.
+func (_c *PostPurchaseCreate) Save(ctx context.Context) (*PostPurchase, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostPurchaseCreate) SaveX(ctx context.Context) *PostPurchase {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostPurchaseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostPurchaseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PostPurchaseCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := postpurchase.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := postpurchase.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostPurchaseCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostPurchase.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PostPurchase.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "PostPurchase.post_id"`)}
+	}
+	if v, ok := _c.mutation.PostID(); ok {
+		if err := postpurchase.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.post_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PostPurchase.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := postpurchase.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`ent: missing required field "PostPurchase.author_id"`)}
+	}
+	if v, ok := _c.mutation.AuthorID(); ok {
+		if err := postpurchase.AuthorIDValidator(v); err != nil {
+			return &ValidationError{Name: "author_id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.author_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "PostPurchase.price"`)}
+	}
+	if v, ok := _c.mutation.Price(); ok {
+		if err := postpurchase.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.price": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := postpurchase.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PostPurchaseCreate) sqlSave(ctx context.Context) (*PostPurchase, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PostPurchaseCreate) createSpec() (*PostPurchase, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostPurchase{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(postpurchase.Table, sqlgraph.NewFieldSpec(postpurchase.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(postpurchase.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(postpurchase.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.PostID(); ok {
+		_spec.SetField(postpurchase.FieldPostID, field.TypeInt, value)
+		_node.PostID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(postpurchase.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.AuthorID(); ok {
+		_spec.SetField(postpurchase.FieldAuthorID, field.TypeInt, value)
+		_node.AuthorID = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(postpurchase.FieldPrice, field.TypeInt, value)
+		_node.Price = value
+	}
+	return _node, _spec
+}
+
+// PostPurchaseCreateBulk is the builder for creating many PostPurchase entities in bulk.
+type PostPurchaseCreateBulk struct {
+	config
+	err      error
+	builders []*PostPurchaseCreate
+}
+
+// Save creates the PostPurchase entities in the database.
+func (_c *PostPurchaseCreateBulk) Save(ctx context.Context) ([]*PostPurchase, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PostPurchase, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostPurchaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PostPurchaseCreateBulk) SaveX(ctx context.Context) []*PostPurchase {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostPurchaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostPurchaseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
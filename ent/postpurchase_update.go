@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/postpurchase"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// PostPurchaseUpdate is the builder for updating PostPurchase entities.
+type PostPurchaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostPurchaseMutation
+}
+
+// Where appends a list predicates to the PostPurchaseUpdate builder.
+func (_u *PostPurchaseUpdate) Where(ps ...predicate.PostPurchase) *PostPurchaseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostPurchaseUpdate) SetUpdatedAt(v time.Time) *PostPurchaseUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostPurchaseUpdate) SetPostID(v int) *PostPurchaseUpdate {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostPurchaseUpdate) SetNillablePostID(v *int) *PostPurchaseUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *PostPurchaseUpdate) AddPostID(v int) *PostPurchaseUpdate {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PostPurchaseUpdate) SetUserID(v int) *PostPurchaseUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PostPurchaseUpdate) SetNillableUserID(v *int) *PostPurchaseUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *PostPurchaseUpdate) AddUserID(v int) *PostPurchaseUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *PostPurchaseUpdate) SetAuthorID(v int) *PostPurchaseUpdate {
+	_u.mutation.ResetAuthorID()
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *PostPurchaseUpdate) SetNillableAuthorID(v *int) *PostPurchaseUpdate {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// AddAuthorID adds value to the "author_id" field.
+func (_u *PostPurchaseUpdate) AddAuthorID(v int) *PostPurchaseUpdate {
+	_u.mutation.AddAuthorID(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *PostPurchaseUpdate) SetPrice(v int) *PostPurchaseUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *PostPurchaseUpdate) SetNillablePrice(v *int) *PostPurchaseUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *PostPurchaseUpdate) AddPrice(v int) *PostPurchaseUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// Mutation returns the PostPurchaseMutation object of the builder.
+func (_u *PostPurchaseUpdate) Mutation() *PostPurchaseMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PostPurchaseUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostPurchaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PostPurchaseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostPurchaseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PostPurchaseUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := postpurchase.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostPurchaseUpdate) check() error {
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := postpurchase.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := postpurchase.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthorID(); ok {
+		if err := postpurchase.AuthorIDValidator(v); err != nil {
+			return &ValidationError{Name: "author_id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.author_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := postpurchase.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PostPurchaseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postpurchase.Table, postpurchase.Columns, sqlgraph.NewFieldSpec(postpurchase.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(postpurchase.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(postpurchase.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(postpurchase.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(postpurchase.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(postpurchase.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AuthorID(); ok {
+		_spec.SetField(postpurchase.FieldAuthorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAuthorID(); ok {
+		_spec.AddField(postpurchase.FieldAuthorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(postpurchase.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(postpurchase.FieldPrice, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postpurchase.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PostPurchaseUpdateOne is the builder for updating a single PostPurchase entity.
+type PostPurchaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostPurchaseMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostPurchaseUpdateOne) SetUpdatedAt(v time.Time) *PostPurchaseUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostPurchaseUpdateOne) SetPostID(v int) *PostPurchaseUpdateOne {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostPurchaseUpdateOne) SetNillablePostID(v *int) *PostPurchaseUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *PostPurchaseUpdateOne) AddPostID(v int) *PostPurchaseUpdateOne {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PostPurchaseUpdateOne) SetUserID(v int) *PostPurchaseUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PostPurchaseUpdateOne) SetNillableUserID(v *int) *PostPurchaseUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *PostPurchaseUpdateOne) AddUserID(v int) *PostPurchaseUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *PostPurchaseUpdateOne) SetAuthorID(v int) *PostPurchaseUpdateOne {
+	_u.mutation.ResetAuthorID()
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *PostPurchaseUpdateOne) SetNillableAuthorID(v *int) *PostPurchaseUpdateOne {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// AddAuthorID adds value to the "author_id" field.
+func (_u *PostPurchaseUpdateOne) AddAuthorID(v int) *PostPurchaseUpdateOne {
+	_u.mutation.AddAuthorID(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *PostPurchaseUpdateOne) SetPrice(v int) *PostPurchaseUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *PostPurchaseUpdateOne) SetNillablePrice(v *int) *PostPurchaseUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *PostPurchaseUpdateOne) AddPrice(v int) *PostPurchaseUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// Mutation returns the PostPurchaseMutation object of the builder.
+func (_u *PostPurchaseUpdateOne) Mutation() *PostPurchaseMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PostPurchaseUpdate builder.
+func (_u *PostPurchaseUpdateOne) Where(ps ...predicate.PostPurchase) *PostPurchaseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PostPurchaseUpdateOne) Select(field string, fields ...string) *PostPurchaseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PostPurchase entity.
+func (_u *PostPurchaseUpdateOne) Save(ctx context.Context) (*PostPurchase, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostPurchaseUpdateOne) SaveX(ctx context.Context) *PostPurchase {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PostPurchaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostPurchaseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PostPurchaseUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := postpurchase.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostPurchaseUpdateOne) check() error {
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := postpurchase.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := postpurchase.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthorID(); ok {
+		if err := postpurchase.AuthorIDValidator(v); err != nil {
+			return &ValidationError{Name: "author_id", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.author_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := postpurchase.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "PostPurchase.price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PostPurchaseUpdateOne) sqlSave(ctx context.Context) (_node *PostPurchase, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postpurchase.Table, postpurchase.Columns, sqlgraph.NewFieldSpec(postpurchase.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostPurchase.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postpurchase.FieldID)
+		for _, f := range fields {
+			if !postpurchase.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postpurchase.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(postpurchase.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(postpurchase.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(postpurchase.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(postpurchase.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(postpurchase.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AuthorID(); ok {
+		_spec.SetField(postpurchase.FieldAuthorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAuthorID(); ok {
+		_spec.AddField(postpurchase.FieldAuthorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(postpurchase.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(postpurchase.FieldPrice, field.TypeInt, value)
+	}
+	_node = &PostPurchase{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postpurchase.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/postrevision"
+)
+
+// PostRevisionCreate is the builder for creating a PostRevision entity.
+type PostRevisionCreate struct {
+	config
+	mutation *PostRevisionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PostRevisionCreate) SetCreatedAt(v time.Time) *PostRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableCreatedAt(v *time.Time) *PostRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PostRevisionCreate) SetUpdatedAt(v time.Time) *PostRevisionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableUpdatedAt(v *time.Time) *PostRevisionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *PostRevisionCreate) SetPostID(v int) *PostRevisionCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *PostRevisionCreate) SetRevision(v int) *PostRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetEditorID sets the "editor_id" field.
+func (_c *PostRevisionCreate) SetEditorID(v int) *PostRevisionCreate {
+	_c.mutation.SetEditorID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *PostRevisionCreate) SetTitle(v string) *PostRevisionCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *PostRevisionCreate) SetContent(v string) *PostRevisionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *PostRevisionCreate) SetReason(v string) *PostRevisionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableReason(v *string) *PostRevisionCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetRollbackFrom sets the "rollback_from" field.
+func (_c *PostRevisionCreate) SetRollbackFrom(v int) *PostRevisionCreate {
+	_c.mutation.SetRollbackFrom(v)
+	return _c
+}
+
+// SetNillableRollbackFrom sets the "rollback_from" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableRollbackFrom(v *int) *PostRevisionCreate {
+	if v != nil {
+		_c.SetRollbackFrom(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PostRevisionCreate) SetID(v int) *PostRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_c *PostRevisionCreate) Mutation() *PostRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the PostRevision in the database.
+func (_c *PostRevisionCreate) Save(ctx context.Context) (*PostRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostRevisionCreate) SaveX(ctx context.Context) *PostRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PostRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := postrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := postrevision.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := postrevision.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.RollbackFrom(); !ok {
+		v := postrevision.DefaultRollbackFrom
+		_c.mutation.SetRollbackFrom(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostRevisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PostRevision.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "PostRevision.post_id"`)}
+	}
+	if v, ok := _c.mutation.PostID(); ok {
+		if err := postrevision.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "PostRevision.post_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "PostRevision.revision"`)}
+	}
+	if v, ok := _c.mutation.Revision(); ok {
+		if err := postrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "PostRevision.revision": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EditorID(); !ok {
+		return &ValidationError{Name: "editor_id", err: errors.New(`ent: missing required field "PostRevision.editor_id"`)}
+	}
+	if v, ok := _c.mutation.EditorID(); ok {
+		if err := postrevision.EditorIDValidator(v); err != nil {
+			return &ValidationError{Name: "editor_id", err: fmt.Errorf(`ent: validator failed for field "PostRevision.editor_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "PostRevision.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "PostRevision.content"`)}
+	}
+	if v, ok := _c.mutation.Content(); ok {
+		if err := postrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PostRevision.content": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "PostRevision.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := postrevision.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "PostRevision.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RollbackFrom(); !ok {
+		return &ValidationError{Name: "rollback_from", err: errors.New(`ent: missing required field "PostRevision.rollback_from"`)}
+	}
+	if v, ok := _c.mutation.RollbackFrom(); ok {
+		if err := postrevision.RollbackFromValidator(v); err != nil {
+			return &ValidationError{Name: "rollback_from", err: fmt.Errorf(`ent: validator failed for field "PostRevision.rollback_from": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := postrevision.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PostRevision.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PostRevisionCreate) sqlSave(ctx context.Context) (*PostRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PostRevisionCreate) createSpec() (*PostRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(postrevision.Table, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(postrevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.PostID(); ok {
+		_spec.SetField(postrevision.FieldPostID, field.TypeInt, value)
+		_node.PostID = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(postrevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.EditorID(); ok {
+		_spec.SetField(postrevision.FieldEditorID, field.TypeInt, value)
+		_node.EditorID = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(postrevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(postrevision.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.RollbackFrom(); ok {
+		_spec.SetField(postrevision.FieldRollbackFrom, field.TypeInt, value)
+		_node.RollbackFrom = value
+	}
+	return _node, _spec
+}
+
+// PostRevisionCreateBulk is the builder for creating many PostRevision entities in bulk.
+type PostRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*PostRevisionCreate
+}
+
+// Save creates the PostRevision entities in the database.
+func (_c *PostRevisionCreateBulk) Save(ctx context.Context) ([]*PostRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PostRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PostRevisionCreateBulk) SaveX(ctx context.Context) []*PostRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/postrevision"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// PostRevisionUpdate is the builder for updating PostRevision entities.
+type PostRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostRevisionMutation
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (_u *PostRevisionUpdate) Where(ps ...predicate.PostRevision) *PostRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostRevisionUpdate) SetUpdatedAt(v time.Time) *PostRevisionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostRevisionUpdate) SetPostID(v int) *PostRevisionUpdate {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillablePostID(v *int) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *PostRevisionUpdate) AddPostID(v int) *PostRevisionUpdate {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *PostRevisionUpdate) SetRevision(v int) *PostRevisionUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableRevision(v *int) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *PostRevisionUpdate) AddRevision(v int) *PostRevisionUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetEditorID sets the "editor_id" field.
+func (_u *PostRevisionUpdate) SetEditorID(v int) *PostRevisionUpdate {
+	_u.mutation.ResetEditorID()
+	_u.mutation.SetEditorID(v)
+	return _u
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableEditorID(v *int) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetEditorID(*v)
+	}
+	return _u
+}
+
+// AddEditorID adds value to the "editor_id" field.
+func (_u *PostRevisionUpdate) AddEditorID(v int) *PostRevisionUpdate {
+	_u.mutation.AddEditorID(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *PostRevisionUpdate) SetTitle(v string) *PostRevisionUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableTitle(v *string) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *PostRevisionUpdate) SetContent(v string) *PostRevisionUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableContent(v *string) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PostRevisionUpdate) SetReason(v string) *PostRevisionUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableReason(v *string) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetRollbackFrom sets the "rollback_from" field.
+func (_u *PostRevisionUpdate) SetRollbackFrom(v int) *PostRevisionUpdate {
+	_u.mutation.ResetRollbackFrom()
+	_u.mutation.SetRollbackFrom(v)
+	return _u
+}
+
+// SetNillableRollbackFrom sets the "rollback_from" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableRollbackFrom(v *int) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetRollbackFrom(*v)
+	}
+	return _u
+}
+
+// AddRollbackFrom adds value to the "rollback_from" field.
+func (_u *PostRevisionUpdate) AddRollbackFrom(v int) *PostRevisionUpdate {
+	_u.mutation.AddRollbackFrom(v)
+	return _u
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_u *PostRevisionUpdate) Mutation() *PostRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PostRevisionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PostRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PostRevisionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := postrevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostRevisionUpdate) check() error {
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := postrevision.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "PostRevision.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Revision(); ok {
+		if err := postrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "PostRevision.revision": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EditorID(); ok {
+		if err := postrevision.EditorIDValidator(v); err != nil {
+			return &ValidationError{Name: "editor_id", err: fmt.Errorf(`ent: validator failed for field "PostRevision.editor_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Content(); ok {
+		if err := postrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PostRevision.content": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := postrevision.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "PostRevision.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RollbackFrom(); ok {
+		if err := postrevision.RollbackFromValidator(v); err != nil {
+			return &ValidationError{Name: "rollback_from", err: fmt.Errorf(`ent: validator failed for field "PostRevision.rollback_from": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PostRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(postrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(postrevision.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(postrevision.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(postrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(postrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EditorID(); ok {
+		_spec.SetField(postrevision.FieldEditorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEditorID(); ok {
+		_spec.AddField(postrevision.FieldEditorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(postrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(postrevision.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RollbackFrom(); ok {
+		_spec.SetField(postrevision.FieldRollbackFrom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRollbackFrom(); ok {
+		_spec.AddField(postrevision.FieldRollbackFrom, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PostRevisionUpdateOne is the builder for updating a single PostRevision entity.
+type PostRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostRevisionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostRevisionUpdateOne) SetUpdatedAt(v time.Time) *PostRevisionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostRevisionUpdateOne) SetPostID(v int) *PostRevisionUpdateOne {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillablePostID(v *int) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *PostRevisionUpdateOne) AddPostID(v int) *PostRevisionUpdateOne {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *PostRevisionUpdateOne) SetRevision(v int) *PostRevisionUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableRevision(v *int) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *PostRevisionUpdateOne) AddRevision(v int) *PostRevisionUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetEditorID sets the "editor_id" field.
+func (_u *PostRevisionUpdateOne) SetEditorID(v int) *PostRevisionUpdateOne {
+	_u.mutation.ResetEditorID()
+	_u.mutation.SetEditorID(v)
+	return _u
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableEditorID(v *int) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetEditorID(*v)
+	}
+	return _u
+}
+
+// AddEditorID adds value to the "editor_id" field.
+func (_u *PostRevisionUpdateOne) AddEditorID(v int) *PostRevisionUpdateOne {
+	_u.mutation.AddEditorID(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *PostRevisionUpdateOne) SetTitle(v string) *PostRevisionUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableTitle(v *string) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *PostRevisionUpdateOne) SetContent(v string) *PostRevisionUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableContent(v *string) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PostRevisionUpdateOne) SetReason(v string) *PostRevisionUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableReason(v *string) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetRollbackFrom sets the "rollback_from" field.
+func (_u *PostRevisionUpdateOne) SetRollbackFrom(v int) *PostRevisionUpdateOne {
+	_u.mutation.ResetRollbackFrom()
+	_u.mutation.SetRollbackFrom(v)
+	return _u
+}
+
+// SetNillableRollbackFrom sets the "rollback_from" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableRollbackFrom(v *int) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetRollbackFrom(*v)
+	}
+	return _u
+}
+
+// AddRollbackFrom adds value to the "rollback_from" field.
+func (_u *PostRevisionUpdateOne) AddRollbackFrom(v int) *PostRevisionUpdateOne {
+	_u.mutation.AddRollbackFrom(v)
+	return _u
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_u *PostRevisionUpdateOne) Mutation() *PostRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (_u *PostRevisionUpdateOne) Where(ps ...predicate.PostRevision) *PostRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PostRevisionUpdateOne) Select(field string, fields ...string) *PostRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PostRevision entity.
+func (_u *PostRevisionUpdateOne) Save(ctx context.Context) (*PostRevision, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostRevisionUpdateOne) SaveX(ctx context.Context) *PostRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PostRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PostRevisionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := postrevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostRevisionUpdateOne) check() error {
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := postrevision.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "PostRevision.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Revision(); ok {
+		if err := postrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "PostRevision.revision": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EditorID(); ok {
+		if err := postrevision.EditorIDValidator(v); err != nil {
+			return &ValidationError{Name: "editor_id", err: fmt.Errorf(`ent: validator failed for field "PostRevision.editor_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Content(); ok {
+		if err := postrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PostRevision.content": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := postrevision.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "PostRevision.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RollbackFrom(); ok {
+		if err := postrevision.RollbackFromValidator(v); err != nil {
+			return &ValidationError{Name: "rollback_from", err: fmt.Errorf(`ent: validator failed for field "PostRevision.rollback_from": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PostRevisionUpdateOne) sqlSave(ctx context.Context) (_node *PostRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postrevision.FieldID)
+		for _, f := range fields {
+			if !postrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(postrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(postrevision.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(postrevision.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(postrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(postrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EditorID(); ok {
+		_spec.SetField(postrevision.FieldEditorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEditorID(); ok {
+		_spec.AddField(postrevision.FieldEditorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(postrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(postrevision.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RollbackFrom(); ok {
+		_spec.SetField(postrevision.FieldRollbackFrom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRollbackFrom(); ok {
+		_spec.AddField(postrevision.FieldRollbackFrom, field.TypeInt, value)
+	}
+	_node = &PostRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
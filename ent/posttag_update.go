@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/posttag"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// PostTagUpdate is the builder for updating PostTag entities.
+type PostTagUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostTagMutation
+}
+
+// Where appends a list predicates to the PostTagUpdate builder.
+func (_u *PostTagUpdate) Where(ps ...predicate.PostTag) *PostTagUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostTagUpdate) SetUpdatedAt(v time.Time) *PostTagUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostTagUpdate) SetPostID(v int) *PostTagUpdate {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostTagUpdate) SetNillablePostID(v *int) *PostTagUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *PostTagUpdate) AddPostID(v int) *PostTagUpdate {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *PostTagUpdate) SetTagID(v int) *PostTagUpdate {
+	_u.mutation.ResetTagID()
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *PostTagUpdate) SetNillableTagID(v *int) *PostTagUpdate {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// AddTagID adds value to the "tag_id" field.
+func (_u *PostTagUpdate) AddTagID(v int) *PostTagUpdate {
+	_u.mutation.AddTagID(v)
+	return _u
+}
+
+// Mutation returns the PostTagMutation object of the builder.
+func (_u *PostTagUpdate) Mutation() *PostTagMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PostTagUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PostTagUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostTagUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PostTagUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := posttag.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostTagUpdate) check() error {
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := posttag.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "PostTag.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TagID(); ok {
+		if err := posttag.TagIDValidator(v); err != nil {
+			return &ValidationError{Name: "tag_id", err: fmt.Errorf(`ent: validator failed for field "PostTag.tag_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PostTagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posttag.Table, posttag.Columns, sqlgraph.NewFieldSpec(posttag.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(posttag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(posttag.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(posttag.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TagID(); ok {
+		_spec.SetField(posttag.FieldTagID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTagID(); ok {
+		_spec.AddField(posttag.FieldTagID, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posttag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PostTagUpdateOne is the builder for updating a single PostTag entity.
+type PostTagUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostTagMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostTagUpdateOne) SetUpdatedAt(v time.Time) *PostTagUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPostID sets the "post_id" field.
+func (_u *PostTagUpdateOne) SetPostID(v int) *PostTagUpdateOne {
+	_u.mutation.ResetPostID()
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *PostTagUpdateOne) SetNillablePostID(v *int) *PostTagUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// AddPostID adds value to the "post_id" field.
+func (_u *PostTagUpdateOne) AddPostID(v int) *PostTagUpdateOne {
+	_u.mutation.AddPostID(v)
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *PostTagUpdateOne) SetTagID(v int) *PostTagUpdateOne {
+	_u.mutation.ResetTagID()
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *PostTagUpdateOne) SetNillableTagID(v *int) *PostTagUpdateOne {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// AddTagID adds value to the "tag_id" field.
+func (_u *PostTagUpdateOne) AddTagID(v int) *PostTagUpdateOne {
+	_u.mutation.AddTagID(v)
+	return _u
+}
+
+// Mutation returns the PostTagMutation object of the builder.
+func (_u *PostTagUpdateOne) Mutation() *PostTagMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PostTagUpdate builder.
+func (_u *PostTagUpdateOne) Where(ps ...predicate.PostTag) *PostTagUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PostTagUpdateOne) Select(field string, fields ...string) *PostTagUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PostTag entity.
+func (_u *PostTagUpdateOne) Save(ctx context.Context) (*PostTag, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostTagUpdateOne) SaveX(ctx context.Context) *PostTag {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PostTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostTagUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PostTagUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := posttag.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostTagUpdateOne) check() error {
+	if v, ok := _u.mutation.PostID(); ok {
+		if err := posttag.PostIDValidator(v); err != nil {
+			return &ValidationError{Name: "post_id", err: fmt.Errorf(`ent: validator failed for field "PostTag.post_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TagID(); ok {
+		if err := posttag.TagIDValidator(v); err != nil {
+			return &ValidationError{Name: "tag_id", err: fmt.Errorf(`ent: validator failed for field "PostTag.tag_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PostTagUpdateOne) sqlSave(ctx context.Context) (_node *PostTag, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posttag.Table, posttag.Columns, sqlgraph.NewFieldSpec(posttag.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostTag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, posttag.FieldID)
+		for _, f := range fields {
+			if !posttag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != posttag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(posttag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PostID(); ok {
+		_spec.SetField(posttag.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostID(); ok {
+		_spec.AddField(posttag.FieldPostID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TagID(); ok {
+		_spec.SetField(posttag.FieldTagID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTagID(); ok {
+		_spec.AddField(posttag.FieldTagID, field.TypeInt, value)
+	}
+	_node = &PostTag{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posttag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
+// Badge is the predicate function for badge builders.
+type Badge func(*sql.Selector)
+
 // Blacklist is the predicate function for blacklist builders.
 type Blacklist func(*sql.Selector)
 
@@ -15,12 +21,33 @@ type Category func(*sql.Selector)
 // CategoryModerator is the predicate function for categorymoderator builders.
 type CategoryModerator func(*sql.Selector)
 
+// CategoryPermission is the predicate function for categorypermission builders.
+type CategoryPermission func(*sql.Selector)
+
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
 // CommentAction is the predicate function for commentaction builders.
 type CommentAction func(*sql.Selector)
 
+// Conversation is the predicate function for conversation builders.
+type Conversation func(*sql.Selector)
+
+// Follow is the predicate function for follow builders.
+type Follow func(*sql.Selector)
+
+// InviteCode is the predicate function for invitecode builders.
+type InviteCode func(*sql.Selector)
+
+// Mention is the predicate function for mention builders.
+type Mention func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
 // OAuthProvider is the predicate function for oauthprovider builders.
 type OAuthProvider func(*sql.Selector)
 
@@ -30,15 +57,48 @@ type Post func(*sql.Selector)
 // PostAction is the predicate function for postaction builders.
 type PostAction func(*sql.Selector)
 
+// PostPurchase is the predicate function for postpurchase builders.
+type PostPurchase func(*sql.Selector)
+
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
+// PostTag is the predicate function for posttag builders.
+type PostTag func(*sql.Selector)
+
+// PrivateMessage is the predicate function for privatemessage builders.
+type PrivateMessage func(*sql.Selector)
+
+// Report is the predicate function for report builders.
+type Report func(*sql.Selector)
+
 // Settings is the predicate function for settings builders.
 type Settings func(*sql.Selector)
 
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserBadge is the predicate function for userbadge builders.
+type UserBadge func(*sql.Selector)
+
 // UserBalanceLog is the predicate function for userbalancelog builders.
 type UserBalanceLog func(*sql.Selector)
 
+// UserGroup is the predicate function for usergroup builders.
+type UserGroup func(*sql.Selector)
+
+// UserGroupMember is the predicate function for usergroupmember builders.
+type UserGroupMember func(*sql.Selector)
+
+// UserInvitation is the predicate function for userinvitation builders.
+type UserInvitation func(*sql.Selector)
+
+// UserLevelLog is the predicate function for userlevellog builders.
+type UserLevelLog func(*sql.Selector)
+
 // UserLoginLog is the predicate function for userloginlog builders.
 type UserLoginLog func(*sql.Selector)
 
@@ -50,3 +110,9 @@ type UserSigninLogs func(*sql.Selector)
 
 // UserSigninStatus is the predicate function for usersigninstatus builders.
 type UserSigninStatus func(*sql.Selector)
+
+// UserTwoFactor is the predicate function for usertwofactor builders.
+type UserTwoFactor func(*sql.Selector)
+
+// WebAuthnCredential is the predicate function for webauthncredential builders.
+type WebAuthnCredential func(*sql.Selector)
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/privatemessage"
+)
+
+// PrivateMessage is the model entity for the PrivateMessage schema.
+type PrivateMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID int `json:"conversation_id,omitempty"`
+	// SenderID holds the value of the "sender_id" field.
+	SenderID int `json:"sender_id,omitempty"`
+	// ReceiverID holds the value of the "receiver_id" field.
+	ReceiverID int `json:"receiver_id,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// ReadAt holds the value of the "read_at" field.
+	ReadAt       *time.Time `json:"read_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PrivateMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case privatemessage.FieldID, privatemessage.FieldConversationID, privatemessage.FieldSenderID, privatemessage.FieldReceiverID:
+			values[i] = new(sql.NullInt64)
+		case privatemessage.FieldContent:
+			values[i] = new(sql.NullString)
+		case privatemessage.FieldCreatedAt, privatemessage.FieldUpdatedAt, privatemessage.FieldReadAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PrivateMessage fields.
+func (_m *PrivateMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case privatemessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case privatemessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case privatemessage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case privatemessage.FieldConversationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				_m.ConversationID = int(value.Int64)
+			}
+		case privatemessage.FieldSenderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_id", values[i])
+			} else if value.Valid {
+				_m.SenderID = int(value.Int64)
+			}
+		case privatemessage.FieldReceiverID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field receiver_id", values[i])
+			} else if value.Valid {
+				_m.ReceiverID = int(value.Int64)
+			}
+		case privatemessage.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case privatemessage.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				_m.ReadAt = new(time.Time)
+				*_m.ReadAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PrivateMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *PrivateMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PrivateMessage.
+// Note that you need to call PrivateMessage.Unwrap() before calling this method if this PrivateMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PrivateMessage) Update() *PrivateMessageUpdateOne {
+	return NewPrivateMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PrivateMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PrivateMessage) Unwrap() *PrivateMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PrivateMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PrivateMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("PrivateMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConversationID))
+	builder.WriteString(", ")
+	builder.WriteString("sender_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SenderID))
+	builder.WriteString(", ")
+	builder.WriteString("receiver_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReceiverID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	if v := _m.ReadAt; v != nil {
+		builder.WriteString("read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PrivateMessages is a parsable slice of PrivateMessage.
+type PrivateMessages []*PrivateMessage
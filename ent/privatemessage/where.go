@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package privatemessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// SenderID applies equality check predicate on the "sender_id" field. It's identical to SenderIDEQ.
+func SenderID(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldSenderID, v))
+}
+
+// ReceiverID applies equality check predicate on the "receiver_id" field. It's identical to ReceiverIDEQ.
+func ReceiverID(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldReceiverID, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// ReadAt applies equality check predicate on the "read_at" field. It's identical to ReadAtEQ.
+func ReadAt(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldReadAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// ConversationIDGT applies the GT predicate on the "conversation_id" field.
+func ConversationIDGT(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGT(FieldConversationID, v))
+}
+
+// ConversationIDGTE applies the GTE predicate on the "conversation_id" field.
+func ConversationIDGTE(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGTE(FieldConversationID, v))
+}
+
+// ConversationIDLT applies the LT predicate on the "conversation_id" field.
+func ConversationIDLT(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLT(FieldConversationID, v))
+}
+
+// ConversationIDLTE applies the LTE predicate on the "conversation_id" field.
+func ConversationIDLTE(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLTE(FieldConversationID, v))
+}
+
+// SenderIDEQ applies the EQ predicate on the "sender_id" field.
+func SenderIDEQ(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldSenderID, v))
+}
+
+// SenderIDNEQ applies the NEQ predicate on the "sender_id" field.
+func SenderIDNEQ(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNEQ(FieldSenderID, v))
+}
+
+// SenderIDIn applies the In predicate on the "sender_id" field.
+func SenderIDIn(vs ...int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldIn(FieldSenderID, vs...))
+}
+
+// SenderIDNotIn applies the NotIn predicate on the "sender_id" field.
+func SenderIDNotIn(vs ...int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNotIn(FieldSenderID, vs...))
+}
+
+// SenderIDGT applies the GT predicate on the "sender_id" field.
+func SenderIDGT(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGT(FieldSenderID, v))
+}
+
+// SenderIDGTE applies the GTE predicate on the "sender_id" field.
+func SenderIDGTE(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGTE(FieldSenderID, v))
+}
+
+// SenderIDLT applies the LT predicate on the "sender_id" field.
+func SenderIDLT(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLT(FieldSenderID, v))
+}
+
+// SenderIDLTE applies the LTE predicate on the "sender_id" field.
+func SenderIDLTE(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLTE(FieldSenderID, v))
+}
+
+// ReceiverIDEQ applies the EQ predicate on the "receiver_id" field.
+func ReceiverIDEQ(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldReceiverID, v))
+}
+
+// ReceiverIDNEQ applies the NEQ predicate on the "receiver_id" field.
+func ReceiverIDNEQ(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNEQ(FieldReceiverID, v))
+}
+
+// ReceiverIDIn applies the In predicate on the "receiver_id" field.
+func ReceiverIDIn(vs ...int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldIn(FieldReceiverID, vs...))
+}
+
+// ReceiverIDNotIn applies the NotIn predicate on the "receiver_id" field.
+func ReceiverIDNotIn(vs ...int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNotIn(FieldReceiverID, vs...))
+}
+
+// ReceiverIDGT applies the GT predicate on the "receiver_id" field.
+func ReceiverIDGT(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGT(FieldReceiverID, v))
+}
+
+// ReceiverIDGTE applies the GTE predicate on the "receiver_id" field.
+func ReceiverIDGTE(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGTE(FieldReceiverID, v))
+}
+
+// ReceiverIDLT applies the LT predicate on the "receiver_id" field.
+func ReceiverIDLT(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLT(FieldReceiverID, v))
+}
+
+// ReceiverIDLTE applies the LTE predicate on the "receiver_id" field.
+func ReceiverIDLTE(v int) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLTE(FieldReceiverID, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldContainsFold(FieldContent, v))
+}
+
+// ReadAtEQ applies the EQ predicate on the "read_at" field.
+func ReadAtEQ(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldEQ(FieldReadAt, v))
+}
+
+// ReadAtNEQ applies the NEQ predicate on the "read_at" field.
+func ReadAtNEQ(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNEQ(FieldReadAt, v))
+}
+
+// ReadAtIn applies the In predicate on the "read_at" field.
+func ReadAtIn(vs ...time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldIn(FieldReadAt, vs...))
+}
+
+// ReadAtNotIn applies the NotIn predicate on the "read_at" field.
+func ReadAtNotIn(vs ...time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNotIn(FieldReadAt, vs...))
+}
+
+// ReadAtGT applies the GT predicate on the "read_at" field.
+func ReadAtGT(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGT(FieldReadAt, v))
+}
+
+// ReadAtGTE applies the GTE predicate on the "read_at" field.
+func ReadAtGTE(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldGTE(FieldReadAt, v))
+}
+
+// ReadAtLT applies the LT predicate on the "read_at" field.
+func ReadAtLT(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLT(FieldReadAt, v))
+}
+
+// ReadAtLTE applies the LTE predicate on the "read_at" field.
+func ReadAtLTE(v time.Time) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldLTE(FieldReadAt, v))
+}
+
+// ReadAtIsNil applies the IsNil predicate on the "read_at" field.
+func ReadAtIsNil() predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldIsNull(FieldReadAt))
+}
+
+// ReadAtNotNil applies the NotNil predicate on the "read_at" field.
+func ReadAtNotNil() predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.FieldNotNull(FieldReadAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PrivateMessage) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PrivateMessage) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PrivateMessage) predicate.PrivateMessage {
+	return predicate.PrivateMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/privatemessage"
+)
+
+// PrivateMessageCreate is the builder for creating a PrivateMessage entity.
+type PrivateMessageCreate struct {
+	config
+	mutation *PrivateMessageMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PrivateMessageCreate) SetCreatedAt(v time.Time) *PrivateMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PrivateMessageCreate) SetNillableCreatedAt(v *time.Time) *PrivateMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PrivateMessageCreate) SetUpdatedAt(v time.Time) *PrivateMessageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PrivateMessageCreate) SetNillableUpdatedAt(v *time.Time) *PrivateMessageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *PrivateMessageCreate) SetConversationID(v int) *PrivateMessageCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_c *PrivateMessageCreate) SetSenderID(v int) *PrivateMessageCreate {
+	_c.mutation.SetSenderID(v)
+	return _c
+}
+
+// SetReceiverID sets the "receiver_id" field.
+func (_c *PrivateMessageCreate) SetReceiverID(v int) *PrivateMessageCreate {
+	_c.mutation.SetReceiverID(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *PrivateMessageCreate) SetContent(v string) *PrivateMessageCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetReadAt sets the "read_at" field.
+func (_c *PrivateMessageCreate) SetReadAt(v time.Time) *PrivateMessageCreate {
+	_c.mutation.SetReadAt(v)
+	return _c
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_c *PrivateMessageCreate) SetNillableReadAt(v *time.Time) *PrivateMessageCreate {
+	if v != nil {
+		_c.SetReadAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PrivateMessageCreate) SetID(v int) *PrivateMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PrivateMessageMutation object of the builder.
+func (_c *PrivateMessageCreate) Mutation() *PrivateMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the PrivateMessage in the database.
+func (_c *PrivateMessageCreate) Save(ctx context.Context) (*PrivateMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PrivateMessageCreate) SaveX(ctx context.Context) *PrivateMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PrivateMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PrivateMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PrivateMessageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := privatemessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := privatemessage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PrivateMessageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PrivateMessage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PrivateMessage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "PrivateMessage.conversation_id"`)}
+	}
+	if v, ok := _c.mutation.ConversationID(); ok {
+		if err := privatemessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender_id", err: errors.New(`ent: missing required field "PrivateMessage.sender_id"`)}
+	}
+	if v, ok := _c.mutation.SenderID(); ok {
+		if err := privatemessage.SenderIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.sender_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReceiverID(); !ok {
+		return &ValidationError{Name: "receiver_id", err: errors.New(`ent: missing required field "PrivateMessage.receiver_id"`)}
+	}
+	if v, ok := _c.mutation.ReceiverID(); ok {
+		if err := privatemessage.ReceiverIDValidator(v); err != nil {
+			return &ValidationError{Name: "receiver_id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.receiver_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "PrivateMessage.content"`)}
+	}
+	if v, ok := _c.mutation.Content(); ok {
+		if err := privatemessage.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.content": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := privatemessage.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PrivateMessageCreate) sqlSave(ctx context.Context) (*PrivateMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PrivateMessageCreate) createSpec() (*PrivateMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PrivateMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(privatemessage.Table, sqlgraph.NewFieldSpec(privatemessage.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(privatemessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(privatemessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ConversationID(); ok {
+		_spec.SetField(privatemessage.FieldConversationID, field.TypeInt, value)
+		_node.ConversationID = value
+	}
+	if value, ok := _c.mutation.SenderID(); ok {
+		_spec.SetField(privatemessage.FieldSenderID, field.TypeInt, value)
+		_node.SenderID = value
+	}
+	if value, ok := _c.mutation.ReceiverID(); ok {
+		_spec.SetField(privatemessage.FieldReceiverID, field.TypeInt, value)
+		_node.ReceiverID = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(privatemessage.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.ReadAt(); ok {
+		_spec.SetField(privatemessage.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	return _node, _spec
+}
+
+// PrivateMessageCreateBulk is the builder for creating many PrivateMessage entities in bulk.
+type PrivateMessageCreateBulk struct {
+	config
+	err      error
+	builders []*PrivateMessageCreate
+}
+
+// Save creates the PrivateMessage entities in the database.
+func (_c *PrivateMessageCreateBulk) Save(ctx context.Context) ([]*PrivateMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PrivateMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PrivateMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PrivateMessageCreateBulk) SaveX(ctx context.Context) []*PrivateMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PrivateMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PrivateMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,516 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/privatemessage"
+)
+
+// PrivateMessageUpdate is the builder for updating PrivateMessage entities.
+type PrivateMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PrivateMessageMutation
+}
+
+// Where appends a list predicates to the PrivateMessageUpdate builder.
+func (_u *PrivateMessageUpdate) Where(ps ...predicate.PrivateMessage) *PrivateMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PrivateMessageUpdate) SetUpdatedAt(v time.Time) *PrivateMessageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *PrivateMessageUpdate) SetConversationID(v int) *PrivateMessageUpdate {
+	_u.mutation.ResetConversationID()
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *PrivateMessageUpdate) SetNillableConversationID(v *int) *PrivateMessageUpdate {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// AddConversationID adds value to the "conversation_id" field.
+func (_u *PrivateMessageUpdate) AddConversationID(v int) *PrivateMessageUpdate {
+	_u.mutation.AddConversationID(v)
+	return _u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_u *PrivateMessageUpdate) SetSenderID(v int) *PrivateMessageUpdate {
+	_u.mutation.ResetSenderID()
+	_u.mutation.SetSenderID(v)
+	return _u
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (_u *PrivateMessageUpdate) SetNillableSenderID(v *int) *PrivateMessageUpdate {
+	if v != nil {
+		_u.SetSenderID(*v)
+	}
+	return _u
+}
+
+// AddSenderID adds value to the "sender_id" field.
+func (_u *PrivateMessageUpdate) AddSenderID(v int) *PrivateMessageUpdate {
+	_u.mutation.AddSenderID(v)
+	return _u
+}
+
+// SetReceiverID sets the "receiver_id" field.
+func (_u *PrivateMessageUpdate) SetReceiverID(v int) *PrivateMessageUpdate {
+	_u.mutation.ResetReceiverID()
+	_u.mutation.SetReceiverID(v)
+	return _u
+}
+
+// SetNillableReceiverID sets the "receiver_id" field if the given value is not nil.
+func (_u *PrivateMessageUpdate) SetNillableReceiverID(v *int) *PrivateMessageUpdate {
+	if v != nil {
+		_u.SetReceiverID(*v)
+	}
+	return _u
+}
+
+// AddReceiverID adds value to the "receiver_id" field.
+func (_u *PrivateMessageUpdate) AddReceiverID(v int) *PrivateMessageUpdate {
+	_u.mutation.AddReceiverID(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *PrivateMessageUpdate) SetContent(v string) *PrivateMessageUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *PrivateMessageUpdate) SetNillableContent(v *string) *PrivateMessageUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *PrivateMessageUpdate) SetReadAt(v time.Time) *PrivateMessageUpdate {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *PrivateMessageUpdate) SetNillableReadAt(v *time.Time) *PrivateMessageUpdate {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *PrivateMessageUpdate) ClearReadAt() *PrivateMessageUpdate {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// Mutation returns the PrivateMessageMutation object of the builder.
+func (_u *PrivateMessageUpdate) Mutation() *PrivateMessageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PrivateMessageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrivateMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PrivateMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrivateMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PrivateMessageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := privatemessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PrivateMessageUpdate) check() error {
+	if v, ok := _u.mutation.ConversationID(); ok {
+		if err := privatemessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SenderID(); ok {
+		if err := privatemessage.SenderIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.sender_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReceiverID(); ok {
+		if err := privatemessage.ReceiverIDValidator(v); err != nil {
+			return &ValidationError{Name: "receiver_id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.receiver_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Content(); ok {
+		if err := privatemessage.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.content": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PrivateMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(privatemessage.Table, privatemessage.Columns, sqlgraph.NewFieldSpec(privatemessage.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(privatemessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(privatemessage.FieldConversationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConversationID(); ok {
+		_spec.AddField(privatemessage.FieldConversationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SenderID(); ok {
+		_spec.SetField(privatemessage.FieldSenderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSenderID(); ok {
+		_spec.AddField(privatemessage.FieldSenderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReceiverID(); ok {
+		_spec.SetField(privatemessage.FieldReceiverID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReceiverID(); ok {
+		_spec.AddField(privatemessage.FieldReceiverID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(privatemessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(privatemessage.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(privatemessage.FieldReadAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{privatemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PrivateMessageUpdateOne is the builder for updating a single PrivateMessage entity.
+type PrivateMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PrivateMessageMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PrivateMessageUpdateOne) SetUpdatedAt(v time.Time) *PrivateMessageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *PrivateMessageUpdateOne) SetConversationID(v int) *PrivateMessageUpdateOne {
+	_u.mutation.ResetConversationID()
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *PrivateMessageUpdateOne) SetNillableConversationID(v *int) *PrivateMessageUpdateOne {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// AddConversationID adds value to the "conversation_id" field.
+func (_u *PrivateMessageUpdateOne) AddConversationID(v int) *PrivateMessageUpdateOne {
+	_u.mutation.AddConversationID(v)
+	return _u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_u *PrivateMessageUpdateOne) SetSenderID(v int) *PrivateMessageUpdateOne {
+	_u.mutation.ResetSenderID()
+	_u.mutation.SetSenderID(v)
+	return _u
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (_u *PrivateMessageUpdateOne) SetNillableSenderID(v *int) *PrivateMessageUpdateOne {
+	if v != nil {
+		_u.SetSenderID(*v)
+	}
+	return _u
+}
+
+// AddSenderID adds value to the "sender_id" field.
+func (_u *PrivateMessageUpdateOne) AddSenderID(v int) *PrivateMessageUpdateOne {
+	_u.mutation.AddSenderID(v)
+	return _u
+}
+
+// SetReceiverID sets the "receiver_id" field.
+func (_u *PrivateMessageUpdateOne) SetReceiverID(v int) *PrivateMessageUpdateOne {
+	_u.mutation.ResetReceiverID()
+	_u.mutation.SetReceiverID(v)
+	return _u
+}
+
+// SetNillableReceiverID sets the "receiver_id" field if the given value is not nil.
+func (_u *PrivateMessageUpdateOne) SetNillableReceiverID(v *int) *PrivateMessageUpdateOne {
+	if v != nil {
+		_u.SetReceiverID(*v)
+	}
+	return _u
+}
+
+// AddReceiverID adds value to the "receiver_id" field.
+func (_u *PrivateMessageUpdateOne) AddReceiverID(v int) *PrivateMessageUpdateOne {
+	_u.mutation.AddReceiverID(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *PrivateMessageUpdateOne) SetContent(v string) *PrivateMessageUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *PrivateMessageUpdateOne) SetNillableContent(v *string) *PrivateMessageUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *PrivateMessageUpdateOne) SetReadAt(v time.Time) *PrivateMessageUpdateOne {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *PrivateMessageUpdateOne) SetNillableReadAt(v *time.Time) *PrivateMessageUpdateOne {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *PrivateMessageUpdateOne) ClearReadAt() *PrivateMessageUpdateOne {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// Mutation returns the PrivateMessageMutation object of the builder.
+func (_u *PrivateMessageUpdateOne) Mutation() *PrivateMessageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PrivateMessageUpdate builder.
+func (_u *PrivateMessageUpdateOne) Where(ps ...predicate.PrivateMessage) *PrivateMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PrivateMessageUpdateOne) Select(field string, fields ...string) *PrivateMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PrivateMessage entity.
+func (_u *PrivateMessageUpdateOne) Save(ctx context.Context) (*PrivateMessage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrivateMessageUpdateOne) SaveX(ctx context.Context) *PrivateMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PrivateMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrivateMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PrivateMessageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := privatemessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PrivateMessageUpdateOne) check() error {
+	if v, ok := _u.mutation.ConversationID(); ok {
+		if err := privatemessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SenderID(); ok {
+		if err := privatemessage.SenderIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.sender_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReceiverID(); ok {
+		if err := privatemessage.ReceiverIDValidator(v); err != nil {
+			return &ValidationError{Name: "receiver_id", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.receiver_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Content(); ok {
+		if err := privatemessage.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PrivateMessage.content": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PrivateMessageUpdateOne) sqlSave(ctx context.Context) (_node *PrivateMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(privatemessage.Table, privatemessage.Columns, sqlgraph.NewFieldSpec(privatemessage.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PrivateMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, privatemessage.FieldID)
+		for _, f := range fields {
+			if !privatemessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != privatemessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(privatemessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(privatemessage.FieldConversationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConversationID(); ok {
+		_spec.AddField(privatemessage.FieldConversationID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SenderID(); ok {
+		_spec.SetField(privatemessage.FieldSenderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSenderID(); ok {
+		_spec.AddField(privatemessage.FieldSenderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReceiverID(); ok {
+		_spec.SetField(privatemessage.FieldReceiverID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReceiverID(); ok {
+		_spec.AddField(privatemessage.FieldReceiverID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(privatemessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(privatemessage.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(privatemessage.FieldReadAt, field.TypeTime)
+	}
+	_node = &PrivateMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{privatemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/report"
+)
+
+// Report is the model entity for the Report schema.
+type Report struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ReporterID holds the value of the "reporter_id" field.
+	ReporterID int `json:"reporter_id,omitempty"`
+	// TargetType holds the value of the "target_type" field.
+	TargetType report.TargetType `json:"target_type,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID int `json:"target_id,omitempty"`
+	// TargetUserID holds the value of the "target_user_id" field.
+	TargetUserID int `json:"target_user_id,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID int `json:"category_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason report.Reason `json:"reason,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Status holds the value of the "status" field.
+	Status report.Status `json:"status,omitempty"`
+	// HandlerID holds the value of the "handler_id" field.
+	HandlerID int `json:"handler_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action report.Action `json:"action,omitempty"`
+	// HandleNote holds the value of the "handle_note" field.
+	HandleNote string `json:"handle_note,omitempty"`
+	// HandledAt holds the value of the "handled_at" field.
+	HandledAt    time.Time `json:"handled_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Report) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldID, report.FieldReporterID, report.FieldTargetID, report.FieldTargetUserID, report.FieldCategoryID, report.FieldHandlerID:
+			values[i] = new(sql.NullInt64)
+		case report.FieldTargetType, report.FieldReason, report.FieldDescription, report.FieldStatus, report.FieldAction, report.FieldHandleNote:
+			values[i] = new(sql.NullString)
+		case report.FieldCreatedAt, report.FieldUpdatedAt, report.FieldHandledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Report fields.
+func (_m *Report) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case report.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case report.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case report.FieldReporterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reporter_id", values[i])
+			} else if value.Valid {
+				_m.ReporterID = int(value.Int64)
+			}
+		case report.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				_m.TargetType = report.TargetType(value.String)
+			}
+		case report.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				_m.TargetID = int(value.Int64)
+			}
+		case report.FieldTargetUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_user_id", values[i])
+			} else if value.Valid {
+				_m.TargetUserID = int(value.Int64)
+			}
+		case report.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = int(value.Int64)
+			}
+		case report.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = report.Reason(value.String)
+			}
+		case report.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case report.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = report.Status(value.String)
+			}
+		case report.FieldHandlerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field handler_id", values[i])
+			} else if value.Valid {
+				_m.HandlerID = int(value.Int64)
+			}
+		case report.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = report.Action(value.String)
+			}
+		case report.FieldHandleNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handle_note", values[i])
+			} else if value.Valid {
+				_m.HandleNote = value.String
+			}
+		case report.FieldHandledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field handled_at", values[i])
+			} else if value.Valid {
+				_m.HandledAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Report.
+// This includes values selected through modifiers, order, etc.
+func (_m *Report) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Report.
+// Note that you need to call Report.Unwrap() before calling this method if this Report
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Report) Update() *ReportUpdateOne {
+	return NewReportClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Report entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Report) Unwrap() *Report {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Report is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Report) String() string {
+	var builder strings.Builder
+	builder.WriteString("Report(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reporter_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReporterID))
+	builder.WriteString(", ")
+	builder.WriteString("target_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetType))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("target_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetUserID))
+	builder.WriteString(", ")
+	builder.WriteString("category_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoryID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reason))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("handler_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HandlerID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("handle_note=")
+	builder.WriteString(_m.HandleNote)
+	builder.WriteString(", ")
+	builder.WriteString("handled_at=")
+	builder.WriteString(_m.HandledAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reports is a parsable slice of Report.
+type Reports []*Report
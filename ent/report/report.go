@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package report
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the report type in the database.
+	Label = "report"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldReporterID holds the string denoting the reporter_id field in the database.
+	FieldReporterID = "reporter_id"
+	// FieldTargetType holds the string denoting the target_type field in the database.
+	FieldTargetType = "target_type"
+	// FieldTargetID holds the string denoting the target_id field in the database.
+	FieldTargetID = "target_id"
+	// FieldTargetUserID holds the string denoting the target_user_id field in the database.
+	FieldTargetUserID = "target_user_id"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldHandlerID holds the string denoting the handler_id field in the database.
+	FieldHandlerID = "handler_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldHandleNote holds the string denoting the handle_note field in the database.
+	FieldHandleNote = "handle_note"
+	// FieldHandledAt holds the string denoting the handled_at field in the database.
+	FieldHandledAt = "handled_at"
+	// Table holds the table name of the report in the database.
+	Table = "reports"
+)
+
+// Columns holds all SQL columns for report fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldReporterID,
+	FieldTargetType,
+	FieldTargetID,
+	FieldTargetUserID,
+	FieldCategoryID,
+	FieldReason,
+	FieldDescription,
+	FieldStatus,
+	FieldHandlerID,
+	FieldAction,
+	FieldHandleNote,
+	FieldHandledAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ReporterIDValidator is a validator for the "reporter_id" field. It is called by the builders before save.
+	ReporterIDValidator func(int) error
+	// TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	TargetIDValidator func(int) error
+	// TargetUserIDValidator is a validator for the "target_user_id" field. It is called by the builders before save.
+	TargetUserIDValidator func(int) error
+	// DefaultCategoryID holds the default value on creation for the "category_id" field.
+	DefaultCategoryID int
+	// CategoryIDValidator is a validator for the "category_id" field. It is called by the builders before save.
+	CategoryIDValidator func(int) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// HandleNoteValidator is a validator for the "handle_note" field. It is called by the builders before save.
+	HandleNoteValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// TargetType defines the type for the "target_type" enum field.
+type TargetType string
+
+// TargetType values.
+const (
+	TargetTypePost    TargetType = "Post"
+	TargetTypeComment TargetType = "Comment"
+	TargetTypeUser    TargetType = "User"
+)
+
+func (tt TargetType) String() string {
+	return string(tt)
+}
+
+// TargetTypeValidator is a validator for the "target_type" field enum values. It is called by the builders before save.
+func TargetTypeValidator(tt TargetType) error {
+	switch tt {
+	case TargetTypePost, TargetTypeComment, TargetTypeUser:
+		return nil
+	default:
+		return fmt.Errorf("report: invalid enum value for target_type field: %q", tt)
+	}
+}
+
+// Reason defines the type for the "reason" enum field.
+type Reason string
+
+// Reason values.
+const (
+	ReasonSpam         Reason = "Spam"
+	ReasonAbuse        Reason = "Abuse"
+	ReasonPorn         Reason = "Porn"
+	ReasonIllegal      Reason = "Illegal"
+	ReasonInfringement Reason = "Infringement"
+	ReasonOther        Reason = "Other"
+)
+
+func (r Reason) String() string {
+	return string(r)
+}
+
+// ReasonValidator is a validator for the "reason" field enum values. It is called by the builders before save.
+func ReasonValidator(r Reason) error {
+	switch r {
+	case ReasonSpam, ReasonAbuse, ReasonPorn, ReasonIllegal, ReasonInfringement, ReasonOther:
+		return nil
+	default:
+		return fmt.Errorf("report: invalid enum value for reason field: %q", r)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending    Status = "Pending"
+	StatusProcessing Status = "Processing"
+	StatusResolved   Status = "Resolved"
+	StatusDismissed  Status = "Dismissed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusProcessing, StatusResolved, StatusDismissed:
+		return nil
+	default:
+		return fmt.Errorf("report: invalid enum value for status field: %q", s)
+	}
+}
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// ActionNone is the default value of the Action enum.
+const DefaultAction = ActionNone
+
+// Action values.
+const (
+	ActionNone          Action = "None"
+	ActionBanPost       Action = "BanPost"
+	ActionDeleteComment Action = "DeleteComment"
+	ActionBanUser       Action = "BanUser"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionNone, ActionBanPost, ActionDeleteComment, ActionBanUser:
+		return nil
+	default:
+		return fmt.Errorf("report: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the Report queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByReporterID orders the results by the reporter_id field.
+func ByReporterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReporterID, opts...).ToFunc()
+}
+
+// ByTargetType orders the results by the target_type field.
+func ByTargetType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetType, opts...).ToFunc()
+}
+
+// ByTargetID orders the results by the target_id field.
+func ByTargetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetID, opts...).ToFunc()
+}
+
+// ByTargetUserID orders the results by the target_user_id field.
+func ByTargetUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetUserID, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByHandlerID orders the results by the handler_id field.
+func ByHandlerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandlerID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByHandleNote orders the results by the handle_note field.
+func ByHandleNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandleNote, opts...).ToFunc()
+}
+
+// ByHandledAt orders the results by the handled_at field.
+func ByHandledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandledAt, opts...).ToFunc()
+}
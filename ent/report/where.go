@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package report
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ReporterID applies equality check predicate on the "reporter_id" field. It's identical to ReporterIDEQ.
+func ReporterID(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldReporterID, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetUserID applies equality check predicate on the "target_user_id" field. It's identical to TargetUserIDEQ.
+func TargetUserID(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldTargetUserID, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldDescription, v))
+}
+
+// HandlerID applies equality check predicate on the "handler_id" field. It's identical to HandlerIDEQ.
+func HandlerID(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldHandlerID, v))
+}
+
+// HandleNote applies equality check predicate on the "handle_note" field. It's identical to HandleNoteEQ.
+func HandleNote(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldHandleNote, v))
+}
+
+// HandledAt applies equality check predicate on the "handled_at" field. It's identical to HandledAtEQ.
+func HandledAt(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldHandledAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ReporterIDEQ applies the EQ predicate on the "reporter_id" field.
+func ReporterIDEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldReporterID, v))
+}
+
+// ReporterIDNEQ applies the NEQ predicate on the "reporter_id" field.
+func ReporterIDNEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldReporterID, v))
+}
+
+// ReporterIDIn applies the In predicate on the "reporter_id" field.
+func ReporterIDIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldReporterID, vs...))
+}
+
+// ReporterIDNotIn applies the NotIn predicate on the "reporter_id" field.
+func ReporterIDNotIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldReporterID, vs...))
+}
+
+// ReporterIDGT applies the GT predicate on the "reporter_id" field.
+func ReporterIDGT(v int) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldReporterID, v))
+}
+
+// ReporterIDGTE applies the GTE predicate on the "reporter_id" field.
+func ReporterIDGTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldReporterID, v))
+}
+
+// ReporterIDLT applies the LT predicate on the "reporter_id" field.
+func ReporterIDLT(v int) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldReporterID, v))
+}
+
+// ReporterIDLTE applies the LTE predicate on the "reporter_id" field.
+func ReporterIDLTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldReporterID, v))
+}
+
+// TargetTypeEQ applies the EQ predicate on the "target_type" field.
+func TargetTypeEQ(v TargetType) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldTargetType, v))
+}
+
+// TargetTypeNEQ applies the NEQ predicate on the "target_type" field.
+func TargetTypeNEQ(v TargetType) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldTargetType, v))
+}
+
+// TargetTypeIn applies the In predicate on the "target_type" field.
+func TargetTypeIn(vs ...TargetType) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldTargetType, vs...))
+}
+
+// TargetTypeNotIn applies the NotIn predicate on the "target_type" field.
+func TargetTypeNotIn(vs ...TargetType) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldTargetType, vs...))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v int) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v int) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldTargetID, v))
+}
+
+// TargetUserIDEQ applies the EQ predicate on the "target_user_id" field.
+func TargetUserIDEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldTargetUserID, v))
+}
+
+// TargetUserIDNEQ applies the NEQ predicate on the "target_user_id" field.
+func TargetUserIDNEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldTargetUserID, v))
+}
+
+// TargetUserIDIn applies the In predicate on the "target_user_id" field.
+func TargetUserIDIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldTargetUserID, vs...))
+}
+
+// TargetUserIDNotIn applies the NotIn predicate on the "target_user_id" field.
+func TargetUserIDNotIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldTargetUserID, vs...))
+}
+
+// TargetUserIDGT applies the GT predicate on the "target_user_id" field.
+func TargetUserIDGT(v int) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldTargetUserID, v))
+}
+
+// TargetUserIDGTE applies the GTE predicate on the "target_user_id" field.
+func TargetUserIDGTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldTargetUserID, v))
+}
+
+// TargetUserIDLT applies the LT predicate on the "target_user_id" field.
+func TargetUserIDLT(v int) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldTargetUserID, v))
+}
+
+// TargetUserIDLTE applies the LTE predicate on the "target_user_id" field.
+func TargetUserIDLTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldTargetUserID, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDGT applies the GT predicate on the "category_id" field.
+func CategoryIDGT(v int) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldCategoryID, v))
+}
+
+// CategoryIDGTE applies the GTE predicate on the "category_id" field.
+func CategoryIDGTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldCategoryID, v))
+}
+
+// CategoryIDLT applies the LT predicate on the "category_id" field.
+func CategoryIDLT(v int) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldCategoryID, v))
+}
+
+// CategoryIDLTE applies the LTE predicate on the "category_id" field.
+func CategoryIDLTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldCategoryID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Report {
+	return predicate.Report(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Report {
+	return predicate.Report(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Report {
+	return predicate.Report(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HandlerIDEQ applies the EQ predicate on the "handler_id" field.
+func HandlerIDEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldHandlerID, v))
+}
+
+// HandlerIDNEQ applies the NEQ predicate on the "handler_id" field.
+func HandlerIDNEQ(v int) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldHandlerID, v))
+}
+
+// HandlerIDIn applies the In predicate on the "handler_id" field.
+func HandlerIDIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldHandlerID, vs...))
+}
+
+// HandlerIDNotIn applies the NotIn predicate on the "handler_id" field.
+func HandlerIDNotIn(vs ...int) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldHandlerID, vs...))
+}
+
+// HandlerIDGT applies the GT predicate on the "handler_id" field.
+func HandlerIDGT(v int) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldHandlerID, v))
+}
+
+// HandlerIDGTE applies the GTE predicate on the "handler_id" field.
+func HandlerIDGTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldHandlerID, v))
+}
+
+// HandlerIDLT applies the LT predicate on the "handler_id" field.
+func HandlerIDLT(v int) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldHandlerID, v))
+}
+
+// HandlerIDLTE applies the LTE predicate on the "handler_id" field.
+func HandlerIDLTE(v int) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldHandlerID, v))
+}
+
+// HandlerIDIsNil applies the IsNil predicate on the "handler_id" field.
+func HandlerIDIsNil() predicate.Report {
+	return predicate.Report(sql.FieldIsNull(FieldHandlerID))
+}
+
+// HandlerIDNotNil applies the NotNil predicate on the "handler_id" field.
+func HandlerIDNotNil() predicate.Report {
+	return predicate.Report(sql.FieldNotNull(FieldHandlerID))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// HandleNoteEQ applies the EQ predicate on the "handle_note" field.
+func HandleNoteEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldHandleNote, v))
+}
+
+// HandleNoteNEQ applies the NEQ predicate on the "handle_note" field.
+func HandleNoteNEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldHandleNote, v))
+}
+
+// HandleNoteIn applies the In predicate on the "handle_note" field.
+func HandleNoteIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldHandleNote, vs...))
+}
+
+// HandleNoteNotIn applies the NotIn predicate on the "handle_note" field.
+func HandleNoteNotIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldHandleNote, vs...))
+}
+
+// HandleNoteGT applies the GT predicate on the "handle_note" field.
+func HandleNoteGT(v string) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldHandleNote, v))
+}
+
+// HandleNoteGTE applies the GTE predicate on the "handle_note" field.
+func HandleNoteGTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldHandleNote, v))
+}
+
+// HandleNoteLT applies the LT predicate on the "handle_note" field.
+func HandleNoteLT(v string) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldHandleNote, v))
+}
+
+// HandleNoteLTE applies the LTE predicate on the "handle_note" field.
+func HandleNoteLTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldHandleNote, v))
+}
+
+// HandleNoteContains applies the Contains predicate on the "handle_note" field.
+func HandleNoteContains(v string) predicate.Report {
+	return predicate.Report(sql.FieldContains(FieldHandleNote, v))
+}
+
+// HandleNoteHasPrefix applies the HasPrefix predicate on the "handle_note" field.
+func HandleNoteHasPrefix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasPrefix(FieldHandleNote, v))
+}
+
+// HandleNoteHasSuffix applies the HasSuffix predicate on the "handle_note" field.
+func HandleNoteHasSuffix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasSuffix(FieldHandleNote, v))
+}
+
+// HandleNoteIsNil applies the IsNil predicate on the "handle_note" field.
+func HandleNoteIsNil() predicate.Report {
+	return predicate.Report(sql.FieldIsNull(FieldHandleNote))
+}
+
+// HandleNoteNotNil applies the NotNil predicate on the "handle_note" field.
+func HandleNoteNotNil() predicate.Report {
+	return predicate.Report(sql.FieldNotNull(FieldHandleNote))
+}
+
+// HandleNoteEqualFold applies the EqualFold predicate on the "handle_note" field.
+func HandleNoteEqualFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldEqualFold(FieldHandleNote, v))
+}
+
+// HandleNoteContainsFold applies the ContainsFold predicate on the "handle_note" field.
+func HandleNoteContainsFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldContainsFold(FieldHandleNote, v))
+}
+
+// HandledAtEQ applies the EQ predicate on the "handled_at" field.
+func HandledAtEQ(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldHandledAt, v))
+}
+
+// HandledAtNEQ applies the NEQ predicate on the "handled_at" field.
+func HandledAtNEQ(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldHandledAt, v))
+}
+
+// HandledAtIn applies the In predicate on the "handled_at" field.
+func HandledAtIn(vs ...time.Time) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldHandledAt, vs...))
+}
+
+// HandledAtNotIn applies the NotIn predicate on the "handled_at" field.
+func HandledAtNotIn(vs ...time.Time) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldHandledAt, vs...))
+}
+
+// HandledAtGT applies the GT predicate on the "handled_at" field.
+func HandledAtGT(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldHandledAt, v))
+}
+
+// HandledAtGTE applies the GTE predicate on the "handled_at" field.
+func HandledAtGTE(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldHandledAt, v))
+}
+
+// HandledAtLT applies the LT predicate on the "handled_at" field.
+func HandledAtLT(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldHandledAt, v))
+}
+
+// HandledAtLTE applies the LTE predicate on the "handled_at" field.
+func HandledAtLTE(v time.Time) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldHandledAt, v))
+}
+
+// HandledAtIsNil applies the IsNil predicate on the "handled_at" field.
+func HandledAtIsNil() predicate.Report {
+	return predicate.Report(sql.FieldIsNull(FieldHandledAt))
+}
+
+// HandledAtNotNil applies the NotNil predicate on the "handled_at" field.
+func HandledAtNotNil() predicate.Report {
+	return predicate.Report(sql.FieldNotNull(FieldHandledAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Report) predicate.Report {
+	return predicate.Report(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Report) predicate.Report {
+	return predicate.Report(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Report) predicate.Report {
+	return predicate.Report(sql.NotPredicates(p))
+}
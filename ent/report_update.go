@@ -0,0 +1,908 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/report"
+)
+
+// ReportUpdate is the builder for updating Report entities.
+type ReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (_u *ReportUpdate) Where(ps ...predicate.Report) *ReportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReportUpdate) SetUpdatedAt(v time.Time) *ReportUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (_u *ReportUpdate) SetReporterID(v int) *ReportUpdate {
+	_u.mutation.ResetReporterID()
+	_u.mutation.SetReporterID(v)
+	return _u
+}
+
+// SetNillableReporterID sets the "reporter_id" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableReporterID(v *int) *ReportUpdate {
+	if v != nil {
+		_u.SetReporterID(*v)
+	}
+	return _u
+}
+
+// AddReporterID adds value to the "reporter_id" field.
+func (_u *ReportUpdate) AddReporterID(v int) *ReportUpdate {
+	_u.mutation.AddReporterID(v)
+	return _u
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *ReportUpdate) SetTargetType(v report.TargetType) *ReportUpdate {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableTargetType(v *report.TargetType) *ReportUpdate {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *ReportUpdate) SetTargetID(v int) *ReportUpdate {
+	_u.mutation.ResetTargetID()
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableTargetID(v *int) *ReportUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// AddTargetID adds value to the "target_id" field.
+func (_u *ReportUpdate) AddTargetID(v int) *ReportUpdate {
+	_u.mutation.AddTargetID(v)
+	return _u
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (_u *ReportUpdate) SetTargetUserID(v int) *ReportUpdate {
+	_u.mutation.ResetTargetUserID()
+	_u.mutation.SetTargetUserID(v)
+	return _u
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableTargetUserID(v *int) *ReportUpdate {
+	if v != nil {
+		_u.SetTargetUserID(*v)
+	}
+	return _u
+}
+
+// AddTargetUserID adds value to the "target_user_id" field.
+func (_u *ReportUpdate) AddTargetUserID(v int) *ReportUpdate {
+	_u.mutation.AddTargetUserID(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *ReportUpdate) SetCategoryID(v int) *ReportUpdate {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableCategoryID(v *int) *ReportUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *ReportUpdate) AddCategoryID(v int) *ReportUpdate {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ReportUpdate) SetReason(v report.Reason) *ReportUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableReason(v *report.Reason) *ReportUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ReportUpdate) SetDescription(v string) *ReportUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableDescription(v *string) *ReportUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ReportUpdate) ClearDescription() *ReportUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReportUpdate) SetStatus(v report.Status) *ReportUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableStatus(v *report.Status) *ReportUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHandlerID sets the "handler_id" field.
+func (_u *ReportUpdate) SetHandlerID(v int) *ReportUpdate {
+	_u.mutation.ResetHandlerID()
+	_u.mutation.SetHandlerID(v)
+	return _u
+}
+
+// SetNillableHandlerID sets the "handler_id" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableHandlerID(v *int) *ReportUpdate {
+	if v != nil {
+		_u.SetHandlerID(*v)
+	}
+	return _u
+}
+
+// AddHandlerID adds value to the "handler_id" field.
+func (_u *ReportUpdate) AddHandlerID(v int) *ReportUpdate {
+	_u.mutation.AddHandlerID(v)
+	return _u
+}
+
+// ClearHandlerID clears the value of the "handler_id" field.
+func (_u *ReportUpdate) ClearHandlerID() *ReportUpdate {
+	_u.mutation.ClearHandlerID()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ReportUpdate) SetAction(v report.Action) *ReportUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableAction(v *report.Action) *ReportUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetHandleNote sets the "handle_note" field.
+func (_u *ReportUpdate) SetHandleNote(v string) *ReportUpdate {
+	_u.mutation.SetHandleNote(v)
+	return _u
+}
+
+// SetNillableHandleNote sets the "handle_note" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableHandleNote(v *string) *ReportUpdate {
+	if v != nil {
+		_u.SetHandleNote(*v)
+	}
+	return _u
+}
+
+// ClearHandleNote clears the value of the "handle_note" field.
+func (_u *ReportUpdate) ClearHandleNote() *ReportUpdate {
+	_u.mutation.ClearHandleNote()
+	return _u
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (_u *ReportUpdate) SetHandledAt(v time.Time) *ReportUpdate {
+	_u.mutation.SetHandledAt(v)
+	return _u
+}
+
+// SetNillableHandledAt sets the "handled_at" field if the given value is not nil.
+func (_u *ReportUpdate) SetNillableHandledAt(v *time.Time) *ReportUpdate {
+	if v != nil {
+		_u.SetHandledAt(*v)
+	}
+	return _u
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (_u *ReportUpdate) ClearHandledAt() *ReportUpdate {
+	_u.mutation.ClearHandledAt()
+	return _u
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (_u *ReportUpdate) Mutation() *ReportMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReportUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReportUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := report.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReportUpdate) check() error {
+	if v, ok := _u.mutation.ReporterID(); ok {
+		if err := report.ReporterIDValidator(v); err != nil {
+			return &ValidationError{Name: "reporter_id", err: fmt.Errorf(`ent: validator failed for field "Report.reporter_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := report.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Report.target_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetID(); ok {
+		if err := report.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "Report.target_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetUserID(); ok {
+		if err := report.TargetUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_user_id", err: fmt.Errorf(`ent: validator failed for field "Report.target_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := report.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "Report.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := report.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Report.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := report.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Report.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HandleNote(); ok {
+		if err := report.HandleNoteValidator(v); err != nil {
+			return &ValidationError{Name: "handle_note", err: fmt.Errorf(`ent: validator failed for field "Report.handle_note": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(report.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReporterID(); ok {
+		_spec.SetField(report.FieldReporterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReporterID(); ok {
+		_spec.AddField(report.FieldReporterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(report.FieldTargetType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(report.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetID(); ok {
+		_spec.AddField(report.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TargetUserID(); ok {
+		_spec.SetField(report.FieldTargetUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetUserID(); ok {
+		_spec.AddField(report.FieldTargetUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(report.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(report.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(report.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(report.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(report.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HandlerID(); ok {
+		_spec.SetField(report.FieldHandlerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHandlerID(); ok {
+		_spec.AddField(report.FieldHandlerID, field.TypeInt, value)
+	}
+	if _u.mutation.HandlerIDCleared() {
+		_spec.ClearField(report.FieldHandlerID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(report.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HandleNote(); ok {
+		_spec.SetField(report.FieldHandleNote, field.TypeString, value)
+	}
+	if _u.mutation.HandleNoteCleared() {
+		_spec.ClearField(report.FieldHandleNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandledAt(); ok {
+		_spec.SetField(report.FieldHandledAt, field.TypeTime, value)
+	}
+	if _u.mutation.HandledAtCleared() {
+		_spec.ClearField(report.FieldHandledAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReportUpdateOne is the builder for updating a single Report entity.
+type ReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReportUpdateOne) SetUpdatedAt(v time.Time) *ReportUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (_u *ReportUpdateOne) SetReporterID(v int) *ReportUpdateOne {
+	_u.mutation.ResetReporterID()
+	_u.mutation.SetReporterID(v)
+	return _u
+}
+
+// SetNillableReporterID sets the "reporter_id" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableReporterID(v *int) *ReportUpdateOne {
+	if v != nil {
+		_u.SetReporterID(*v)
+	}
+	return _u
+}
+
+// AddReporterID adds value to the "reporter_id" field.
+func (_u *ReportUpdateOne) AddReporterID(v int) *ReportUpdateOne {
+	_u.mutation.AddReporterID(v)
+	return _u
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *ReportUpdateOne) SetTargetType(v report.TargetType) *ReportUpdateOne {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableTargetType(v *report.TargetType) *ReportUpdateOne {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *ReportUpdateOne) SetTargetID(v int) *ReportUpdateOne {
+	_u.mutation.ResetTargetID()
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableTargetID(v *int) *ReportUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// AddTargetID adds value to the "target_id" field.
+func (_u *ReportUpdateOne) AddTargetID(v int) *ReportUpdateOne {
+	_u.mutation.AddTargetID(v)
+	return _u
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (_u *ReportUpdateOne) SetTargetUserID(v int) *ReportUpdateOne {
+	_u.mutation.ResetTargetUserID()
+	_u.mutation.SetTargetUserID(v)
+	return _u
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableTargetUserID(v *int) *ReportUpdateOne {
+	if v != nil {
+		_u.SetTargetUserID(*v)
+	}
+	return _u
+}
+
+// AddTargetUserID adds value to the "target_user_id" field.
+func (_u *ReportUpdateOne) AddTargetUserID(v int) *ReportUpdateOne {
+	_u.mutation.AddTargetUserID(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *ReportUpdateOne) SetCategoryID(v int) *ReportUpdateOne {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableCategoryID(v *int) *ReportUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *ReportUpdateOne) AddCategoryID(v int) *ReportUpdateOne {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ReportUpdateOne) SetReason(v report.Reason) *ReportUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableReason(v *report.Reason) *ReportUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ReportUpdateOne) SetDescription(v string) *ReportUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableDescription(v *string) *ReportUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ReportUpdateOne) ClearDescription() *ReportUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReportUpdateOne) SetStatus(v report.Status) *ReportUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableStatus(v *report.Status) *ReportUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHandlerID sets the "handler_id" field.
+func (_u *ReportUpdateOne) SetHandlerID(v int) *ReportUpdateOne {
+	_u.mutation.ResetHandlerID()
+	_u.mutation.SetHandlerID(v)
+	return _u
+}
+
+// SetNillableHandlerID sets the "handler_id" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableHandlerID(v *int) *ReportUpdateOne {
+	if v != nil {
+		_u.SetHandlerID(*v)
+	}
+	return _u
+}
+
+// AddHandlerID adds value to the "handler_id" field.
+func (_u *ReportUpdateOne) AddHandlerID(v int) *ReportUpdateOne {
+	_u.mutation.AddHandlerID(v)
+	return _u
+}
+
+// ClearHandlerID clears the value of the "handler_id" field.
+func (_u *ReportUpdateOne) ClearHandlerID() *ReportUpdateOne {
+	_u.mutation.ClearHandlerID()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ReportUpdateOne) SetAction(v report.Action) *ReportUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableAction(v *report.Action) *ReportUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetHandleNote sets the "handle_note" field.
+func (_u *ReportUpdateOne) SetHandleNote(v string) *ReportUpdateOne {
+	_u.mutation.SetHandleNote(v)
+	return _u
+}
+
+// SetNillableHandleNote sets the "handle_note" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableHandleNote(v *string) *ReportUpdateOne {
+	if v != nil {
+		_u.SetHandleNote(*v)
+	}
+	return _u
+}
+
+// ClearHandleNote clears the value of the "handle_note" field.
+func (_u *ReportUpdateOne) ClearHandleNote() *ReportUpdateOne {
+	_u.mutation.ClearHandleNote()
+	return _u
+}
+
+// SetHandledAt sets the "handled_at" field.
+func (_u *ReportUpdateOne) SetHandledAt(v time.Time) *ReportUpdateOne {
+	_u.mutation.SetHandledAt(v)
+	return _u
+}
+
+// SetNillableHandledAt sets the "handled_at" field if the given value is not nil.
+func (_u *ReportUpdateOne) SetNillableHandledAt(v *time.Time) *ReportUpdateOne {
+	if v != nil {
+		_u.SetHandledAt(*v)
+	}
+	return _u
+}
+
+// ClearHandledAt clears the value of the "handled_at" field.
+func (_u *ReportUpdateOne) ClearHandledAt() *ReportUpdateOne {
+	_u.mutation.ClearHandledAt()
+	return _u
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (_u *ReportUpdateOne) Mutation() *ReportMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (_u *ReportUpdateOne) Where(ps ...predicate.Report) *ReportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReportUpdateOne) Select(field string, fields ...string) *ReportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Report entity.
+func (_u *ReportUpdateOne) Save(ctx context.Context) (*Report, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReportUpdateOne) SaveX(ctx context.Context) *Report {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReportUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := report.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReportUpdateOne) check() error {
+	if v, ok := _u.mutation.ReporterID(); ok {
+		if err := report.ReporterIDValidator(v); err != nil {
+			return &ValidationError{Name: "reporter_id", err: fmt.Errorf(`ent: validator failed for field "Report.reporter_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetType(); ok {
+		if err := report.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Report.target_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetID(); ok {
+		if err := report.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "Report.target_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetUserID(); ok {
+		if err := report.TargetUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_user_id", err: fmt.Errorf(`ent: validator failed for field "Report.target_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CategoryID(); ok {
+		if err := report.CategoryIDValidator(v); err != nil {
+			return &ValidationError{Name: "category_id", err: fmt.Errorf(`ent: validator failed for field "Report.category_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := report.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Report.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := report.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Report.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HandleNote(); ok {
+		if err := report.HandleNoteValidator(v); err != nil {
+			return &ValidationError{Name: "handle_note", err: fmt.Errorf(`ent: validator failed for field "Report.handle_note": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReportUpdateOne) sqlSave(ctx context.Context) (_node *Report, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Report.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, report.FieldID)
+		for _, f := range fields {
+			if !report.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != report.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(report.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReporterID(); ok {
+		_spec.SetField(report.FieldReporterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReporterID(); ok {
+		_spec.AddField(report.FieldReporterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(report.FieldTargetType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(report.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetID(); ok {
+		_spec.AddField(report.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TargetUserID(); ok {
+		_spec.SetField(report.FieldTargetUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetUserID(); ok {
+		_spec.AddField(report.FieldTargetUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(report.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(report.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(report.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(report.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(report.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HandlerID(); ok {
+		_spec.SetField(report.FieldHandlerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHandlerID(); ok {
+		_spec.AddField(report.FieldHandlerID, field.TypeInt, value)
+	}
+	if _u.mutation.HandlerIDCleared() {
+		_spec.ClearField(report.FieldHandlerID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(report.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HandleNote(); ok {
+		_spec.SetField(report.FieldHandleNote, field.TypeString, value)
+	}
+	if _u.mutation.HandleNoteCleared() {
+		_spec.ClearField(report.FieldHandleNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandledAt(); ok {
+		_spec.SetField(report.FieldHandledAt, field.TypeTime, value)
+	}
+	if _u.mutation.HandledAtCleared() {
+		_spec.ClearField(report.FieldHandledAt, field.TypeTime)
+	}
+	_node = &Report{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
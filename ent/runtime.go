@@ -5,28 +5,212 @@ package ent
 import (
 	"time"
 
+	"github.com/PokeForum/PokeForum/ent/attachment"
+	"github.com/PokeForum/PokeForum/ent/badge"
 	"github.com/PokeForum/PokeForum/ent/blacklist"
 	"github.com/PokeForum/PokeForum/ent/category"
 	"github.com/PokeForum/PokeForum/ent/categorymoderator"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
 	"github.com/PokeForum/PokeForum/ent/comment"
 	"github.com/PokeForum/PokeForum/ent/commentaction"
+	"github.com/PokeForum/PokeForum/ent/conversation"
+	"github.com/PokeForum/PokeForum/ent/follow"
+	"github.com/PokeForum/PokeForum/ent/invitecode"
+	"github.com/PokeForum/PokeForum/ent/mention"
+	"github.com/PokeForum/PokeForum/ent/notification"
+	"github.com/PokeForum/PokeForum/ent/notificationpreference"
 	"github.com/PokeForum/PokeForum/ent/oauthprovider"
 	"github.com/PokeForum/PokeForum/ent/post"
 	"github.com/PokeForum/PokeForum/ent/postaction"
+	"github.com/PokeForum/PokeForum/ent/postpurchase"
+	"github.com/PokeForum/PokeForum/ent/postrevision"
+	"github.com/PokeForum/PokeForum/ent/posttag"
+	"github.com/PokeForum/PokeForum/ent/privatemessage"
+	"github.com/PokeForum/PokeForum/ent/report"
 	"github.com/PokeForum/PokeForum/ent/schema"
 	"github.com/PokeForum/PokeForum/ent/settings"
+	"github.com/PokeForum/PokeForum/ent/tag"
 	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/userbadge"
 	"github.com/PokeForum/PokeForum/ent/userbalancelog"
+	"github.com/PokeForum/PokeForum/ent/usergroup"
+	"github.com/PokeForum/PokeForum/ent/usergroupmember"
+	"github.com/PokeForum/PokeForum/ent/userinvitation"
+	"github.com/PokeForum/PokeForum/ent/userlevellog"
 	"github.com/PokeForum/PokeForum/ent/userloginlog"
 	"github.com/PokeForum/PokeForum/ent/useroauth"
 	"github.com/PokeForum/PokeForum/ent/usersigninlogs"
 	"github.com/PokeForum/PokeForum/ent/usersigninstatus"
+	"github.com/PokeForum/PokeForum/ent/usertwofactor"
+	"github.com/PokeForum/PokeForum/ent/webauthncredential"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	attachmentMixin := schema.Attachment{}.Mixin()
+	attachmentMixinFields0 := attachmentMixin[0].Fields()
+	_ = attachmentMixinFields0
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentMixinFields0[0].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	// attachmentDescUpdatedAt is the schema descriptor for updated_at field.
+	attachmentDescUpdatedAt := attachmentMixinFields0[1].Descriptor()
+	// attachment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attachment.DefaultUpdatedAt = attachmentDescUpdatedAt.Default.(func() time.Time)
+	// attachment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attachment.UpdateDefaultUpdatedAt = attachmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// attachmentDescUserID is the schema descriptor for user_id field.
+	attachmentDescUserID := attachmentFields[1].Descriptor()
+	// attachment.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	attachment.UserIDValidator = attachmentDescUserID.Validators[0].(func(int) error)
+	// attachmentDescPostID is the schema descriptor for post_id field.
+	attachmentDescPostID := attachmentFields[2].Descriptor()
+	// attachment.DefaultPostID holds the default value on creation for the post_id field.
+	attachment.DefaultPostID = attachmentDescPostID.Default.(int)
+	// attachment.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	attachment.PostIDValidator = attachmentDescPostID.Validators[0].(func(int) error)
+	// attachmentDescCommentID is the schema descriptor for comment_id field.
+	attachmentDescCommentID := attachmentFields[3].Descriptor()
+	// attachment.DefaultCommentID holds the default value on creation for the comment_id field.
+	attachment.DefaultCommentID = attachmentDescCommentID.Default.(int)
+	// attachment.CommentIDValidator is a validator for the "comment_id" field. It is called by the builders before save.
+	attachment.CommentIDValidator = attachmentDescCommentID.Validators[0].(func(int) error)
+	// attachmentDescFilename is the schema descriptor for filename field.
+	attachmentDescFilename := attachmentFields[4].Descriptor()
+	// attachment.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	attachment.FilenameValidator = func() func(string) error {
+		validators := attachmentDescFilename.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(filename string) error {
+			for _, fn := range fns {
+				if err := fn(filename); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescHash is the schema descriptor for hash field.
+	attachmentDescHash := attachmentFields[5].Descriptor()
+	// attachment.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	attachment.HashValidator = func() func(string) error {
+		validators := attachmentDescHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(hash string) error {
+			for _, fn := range fns {
+				if err := fn(hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescStorageKey is the schema descriptor for storage_key field.
+	attachmentDescStorageKey := attachmentFields[6].Descriptor()
+	// attachment.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	attachment.StorageKeyValidator = func() func(string) error {
+		validators := attachmentDescStorageKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(storage_key string) error {
+			for _, fn := range fns {
+				if err := fn(storage_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescMimeType is the schema descriptor for mime_type field.
+	attachmentDescMimeType := attachmentFields[7].Descriptor()
+	// attachment.MimeTypeValidator is a validator for the "mime_type" field. It is called by the builders before save.
+	attachment.MimeTypeValidator = func() func(string) error {
+		validators := attachmentDescMimeType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(mime_type string) error {
+			for _, fn := range fns {
+				if err := fn(mime_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescSize is the schema descriptor for size field.
+	attachmentDescSize := attachmentFields[8].Descriptor()
+	// attachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachment.SizeValidator = attachmentDescSize.Validators[0].(func(int64) error)
+	// attachmentDescThumbnailKey is the schema descriptor for thumbnail_key field.
+	attachmentDescThumbnailKey := attachmentFields[9].Descriptor()
+	// attachment.DefaultThumbnailKey holds the default value on creation for the thumbnail_key field.
+	attachment.DefaultThumbnailKey = attachmentDescThumbnailKey.Default.(string)
+	// attachment.ThumbnailKeyValidator is a validator for the "thumbnail_key" field. It is called by the builders before save.
+	attachment.ThumbnailKeyValidator = attachmentDescThumbnailKey.Validators[0].(func(string) error)
+	// attachmentDescWidth is the schema descriptor for width field.
+	attachmentDescWidth := attachmentFields[10].Descriptor()
+	// attachment.DefaultWidth holds the default value on creation for the width field.
+	attachment.DefaultWidth = attachmentDescWidth.Default.(int)
+	// attachment.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	attachment.WidthValidator = attachmentDescWidth.Validators[0].(func(int) error)
+	// attachmentDescHeight is the schema descriptor for height field.
+	attachmentDescHeight := attachmentFields[11].Descriptor()
+	// attachment.DefaultHeight holds the default value on creation for the height field.
+	attachment.DefaultHeight = attachmentDescHeight.Default.(int)
+	// attachment.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	attachment.HeightValidator = attachmentDescHeight.Validators[0].(func(int) error)
+	// attachmentDescID is the schema descriptor for id field.
+	attachmentDescID := attachmentFields[0].Descriptor()
+	// attachment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	attachment.IDValidator = attachmentDescID.Validators[0].(func(int) error)
+	badgeMixin := schema.Badge{}.Mixin()
+	badgeMixinFields0 := badgeMixin[0].Fields()
+	_ = badgeMixinFields0
+	badgeFields := schema.Badge{}.Fields()
+	_ = badgeFields
+	// badgeDescCreatedAt is the schema descriptor for created_at field.
+	badgeDescCreatedAt := badgeMixinFields0[0].Descriptor()
+	// badge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	badge.DefaultCreatedAt = badgeDescCreatedAt.Default.(func() time.Time)
+	// badgeDescUpdatedAt is the schema descriptor for updated_at field.
+	badgeDescUpdatedAt := badgeMixinFields0[1].Descriptor()
+	// badge.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	badge.DefaultUpdatedAt = badgeDescUpdatedAt.Default.(func() time.Time)
+	// badge.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	badge.UpdateDefaultUpdatedAt = badgeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// badgeDescName is the schema descriptor for name field.
+	badgeDescName := badgeFields[1].Descriptor()
+	// badge.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	badge.NameValidator = badgeDescName.Validators[0].(func(string) error)
+	// badgeDescThreshold is the schema descriptor for threshold field.
+	badgeDescThreshold := badgeFields[5].Descriptor()
+	// badge.DefaultThreshold holds the default value on creation for the threshold field.
+	badge.DefaultThreshold = badgeDescThreshold.Default.(int)
+	// badge.ThresholdValidator is a validator for the "threshold" field. It is called by the builders before save.
+	badge.ThresholdValidator = badgeDescThreshold.Validators[0].(func(int) error)
+	// badgeDescEnabled is the schema descriptor for enabled field.
+	badgeDescEnabled := badgeFields[6].Descriptor()
+	// badge.DefaultEnabled holds the default value on creation for the enabled field.
+	badge.DefaultEnabled = badgeDescEnabled.Default.(bool)
+	// badgeDescID is the schema descriptor for id field.
+	badgeDescID := badgeFields[0].Descriptor()
+	// badge.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	badge.IDValidator = badgeDescID.Validators[0].(func(int) error)
 	blacklistMixin := schema.Blacklist{}.Mixin()
 	blacklistMixinFields0 := blacklistMixin[0].Fields()
 	_ = blacklistMixinFields0
@@ -69,16 +253,22 @@ func init() {
 	category.DefaultUpdatedAt = categoryDescUpdatedAt.Default.(func() time.Time)
 	// category.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	category.UpdateDefaultUpdatedAt = categoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// categoryDescParentID is the schema descriptor for parent_id field.
+	categoryDescParentID := categoryFields[1].Descriptor()
+	// category.DefaultParentID holds the default value on creation for the parent_id field.
+	category.DefaultParentID = categoryDescParentID.Default.(int)
+	// category.ParentIDValidator is a validator for the "parent_id" field. It is called by the builders before save.
+	category.ParentIDValidator = categoryDescParentID.Validators[0].(func(int) error)
 	// categoryDescName is the schema descriptor for name field.
-	categoryDescName := categoryFields[1].Descriptor()
+	categoryDescName := categoryFields[2].Descriptor()
 	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
 	// categoryDescSlug is the schema descriptor for slug field.
-	categoryDescSlug := categoryFields[2].Descriptor()
+	categoryDescSlug := categoryFields[3].Descriptor()
 	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
 	// categoryDescWeight is the schema descriptor for weight field.
-	categoryDescWeight := categoryFields[5].Descriptor()
+	categoryDescWeight := categoryFields[6].Descriptor()
 	// category.DefaultWeight holds the default value on creation for the weight field.
 	category.DefaultWeight = categoryDescWeight.Default.(int)
 	// categoryDescID is the schema descriptor for id field.
@@ -112,6 +302,35 @@ func init() {
 	categorymoderatorDescID := categorymoderatorFields[0].Descriptor()
 	// categorymoderator.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	categorymoderator.IDValidator = categorymoderatorDescID.Validators[0].(func(int) error)
+	categorypermissionMixin := schema.CategoryPermission{}.Mixin()
+	categorypermissionMixinFields0 := categorypermissionMixin[0].Fields()
+	_ = categorypermissionMixinFields0
+	categorypermissionFields := schema.CategoryPermission{}.Fields()
+	_ = categorypermissionFields
+	// categorypermissionDescCreatedAt is the schema descriptor for created_at field.
+	categorypermissionDescCreatedAt := categorypermissionMixinFields0[0].Descriptor()
+	// categorypermission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categorypermission.DefaultCreatedAt = categorypermissionDescCreatedAt.Default.(func() time.Time)
+	// categorypermissionDescUpdatedAt is the schema descriptor for updated_at field.
+	categorypermissionDescUpdatedAt := categorypermissionMixinFields0[1].Descriptor()
+	// categorypermission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	categorypermission.DefaultUpdatedAt = categorypermissionDescUpdatedAt.Default.(func() time.Time)
+	// categorypermission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	categorypermission.UpdateDefaultUpdatedAt = categorypermissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// categorypermissionDescCategoryID is the schema descriptor for category_id field.
+	categorypermissionDescCategoryID := categorypermissionFields[1].Descriptor()
+	// categorypermission.CategoryIDValidator is a validator for the "category_id" field. It is called by the builders before save.
+	categorypermission.CategoryIDValidator = categorypermissionDescCategoryID.Validators[0].(func(int) error)
+	// categorypermissionDescMinExperience is the schema descriptor for min_experience field.
+	categorypermissionDescMinExperience := categorypermissionFields[4].Descriptor()
+	// categorypermission.DefaultMinExperience holds the default value on creation for the min_experience field.
+	categorypermission.DefaultMinExperience = categorypermissionDescMinExperience.Default.(int)
+	// categorypermission.MinExperienceValidator is a validator for the "min_experience" field. It is called by the builders before save.
+	categorypermission.MinExperienceValidator = categorypermissionDescMinExperience.Validators[0].(func(int) error)
+	// categorypermissionDescID is the schema descriptor for id field.
+	categorypermissionDescID := categorypermissionFields[0].Descriptor()
+	// categorypermission.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	categorypermission.IDValidator = categorypermissionDescID.Validators[0].(func(int) error)
 	commentMixin := schema.Comment{}.Mixin()
 	commentMixinFields0 := commentMixin[0].Fields()
 	_ = commentMixinFields0
@@ -190,6 +409,250 @@ func init() {
 	commentactionDescID := commentactionFields[0].Descriptor()
 	// commentaction.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	commentaction.IDValidator = commentactionDescID.Validators[0].(func(int) error)
+	conversationMixin := schema.Conversation{}.Mixin()
+	conversationMixinFields0 := conversationMixin[0].Fields()
+	_ = conversationMixinFields0
+	conversationFields := schema.Conversation{}.Fields()
+	_ = conversationFields
+	// conversationDescCreatedAt is the schema descriptor for created_at field.
+	conversationDescCreatedAt := conversationMixinFields0[0].Descriptor()
+	// conversation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversation.DefaultCreatedAt = conversationDescCreatedAt.Default.(func() time.Time)
+	// conversationDescUpdatedAt is the schema descriptor for updated_at field.
+	conversationDescUpdatedAt := conversationMixinFields0[1].Descriptor()
+	// conversation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	conversation.DefaultUpdatedAt = conversationDescUpdatedAt.Default.(func() time.Time)
+	// conversation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	conversation.UpdateDefaultUpdatedAt = conversationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// conversationDescUserAID is the schema descriptor for user_a_id field.
+	conversationDescUserAID := conversationFields[1].Descriptor()
+	// conversation.UserAIDValidator is a validator for the "user_a_id" field. It is called by the builders before save.
+	conversation.UserAIDValidator = conversationDescUserAID.Validators[0].(func(int) error)
+	// conversationDescUserBID is the schema descriptor for user_b_id field.
+	conversationDescUserBID := conversationFields[2].Descriptor()
+	// conversation.UserBIDValidator is a validator for the "user_b_id" field. It is called by the builders before save.
+	conversation.UserBIDValidator = conversationDescUserBID.Validators[0].(func(int) error)
+	// conversationDescLastMessageID is the schema descriptor for last_message_id field.
+	conversationDescLastMessageID := conversationFields[3].Descriptor()
+	// conversation.DefaultLastMessageID holds the default value on creation for the last_message_id field.
+	conversation.DefaultLastMessageID = conversationDescLastMessageID.Default.(int)
+	// conversation.LastMessageIDValidator is a validator for the "last_message_id" field. It is called by the builders before save.
+	conversation.LastMessageIDValidator = conversationDescLastMessageID.Validators[0].(func(int) error)
+	// conversationDescUserAUnread is the schema descriptor for user_a_unread field.
+	conversationDescUserAUnread := conversationFields[5].Descriptor()
+	// conversation.DefaultUserAUnread holds the default value on creation for the user_a_unread field.
+	conversation.DefaultUserAUnread = conversationDescUserAUnread.Default.(int)
+	// conversation.UserAUnreadValidator is a validator for the "user_a_unread" field. It is called by the builders before save.
+	conversation.UserAUnreadValidator = conversationDescUserAUnread.Validators[0].(func(int) error)
+	// conversationDescUserBUnread is the schema descriptor for user_b_unread field.
+	conversationDescUserBUnread := conversationFields[6].Descriptor()
+	// conversation.DefaultUserBUnread holds the default value on creation for the user_b_unread field.
+	conversation.DefaultUserBUnread = conversationDescUserBUnread.Default.(int)
+	// conversation.UserBUnreadValidator is a validator for the "user_b_unread" field. It is called by the builders before save.
+	conversation.UserBUnreadValidator = conversationDescUserBUnread.Validators[0].(func(int) error)
+	// conversationDescID is the schema descriptor for id field.
+	conversationDescID := conversationFields[0].Descriptor()
+	// conversation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversation.IDValidator = conversationDescID.Validators[0].(func(int) error)
+	followMixin := schema.Follow{}.Mixin()
+	followMixinFields0 := followMixin[0].Fields()
+	_ = followMixinFields0
+	followFields := schema.Follow{}.Fields()
+	_ = followFields
+	// followDescCreatedAt is the schema descriptor for created_at field.
+	followDescCreatedAt := followMixinFields0[0].Descriptor()
+	// follow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	follow.DefaultCreatedAt = followDescCreatedAt.Default.(func() time.Time)
+	// followDescUpdatedAt is the schema descriptor for updated_at field.
+	followDescUpdatedAt := followMixinFields0[1].Descriptor()
+	// follow.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	follow.DefaultUpdatedAt = followDescUpdatedAt.Default.(func() time.Time)
+	// follow.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	follow.UpdateDefaultUpdatedAt = followDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// followDescUserID is the schema descriptor for user_id field.
+	followDescUserID := followFields[1].Descriptor()
+	// follow.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	follow.UserIDValidator = followDescUserID.Validators[0].(func(int) error)
+	// followDescTargetID is the schema descriptor for target_id field.
+	followDescTargetID := followFields[3].Descriptor()
+	// follow.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	follow.TargetIDValidator = followDescTargetID.Validators[0].(func(int) error)
+	// followDescID is the schema descriptor for id field.
+	followDescID := followFields[0].Descriptor()
+	// follow.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	follow.IDValidator = followDescID.Validators[0].(func(int) error)
+	invitecodeMixin := schema.InviteCode{}.Mixin()
+	invitecodeMixinFields0 := invitecodeMixin[0].Fields()
+	_ = invitecodeMixinFields0
+	invitecodeFields := schema.InviteCode{}.Fields()
+	_ = invitecodeFields
+	// invitecodeDescCreatedAt is the schema descriptor for created_at field.
+	invitecodeDescCreatedAt := invitecodeMixinFields0[0].Descriptor()
+	// invitecode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitecode.DefaultCreatedAt = invitecodeDescCreatedAt.Default.(func() time.Time)
+	// invitecodeDescUpdatedAt is the schema descriptor for updated_at field.
+	invitecodeDescUpdatedAt := invitecodeMixinFields0[1].Descriptor()
+	// invitecode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invitecode.DefaultUpdatedAt = invitecodeDescUpdatedAt.Default.(func() time.Time)
+	// invitecode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invitecode.UpdateDefaultUpdatedAt = invitecodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invitecodeDescUserID is the schema descriptor for user_id field.
+	invitecodeDescUserID := invitecodeFields[1].Descriptor()
+	// invitecode.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	invitecode.UserIDValidator = invitecodeDescUserID.Validators[0].(func(int) error)
+	// invitecodeDescCode is the schema descriptor for code field.
+	invitecodeDescCode := invitecodeFields[2].Descriptor()
+	// invitecode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	invitecode.CodeValidator = func() func(string) error {
+		validators := invitecodeDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// invitecodeDescMaxUses is the schema descriptor for max_uses field.
+	invitecodeDescMaxUses := invitecodeFields[3].Descriptor()
+	// invitecode.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invitecode.DefaultMaxUses = invitecodeDescMaxUses.Default.(int)
+	// invitecode.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	invitecode.MaxUsesValidator = invitecodeDescMaxUses.Validators[0].(func(int) error)
+	// invitecodeDescUsedCount is the schema descriptor for used_count field.
+	invitecodeDescUsedCount := invitecodeFields[4].Descriptor()
+	// invitecode.DefaultUsedCount holds the default value on creation for the used_count field.
+	invitecode.DefaultUsedCount = invitecodeDescUsedCount.Default.(int)
+	// invitecode.UsedCountValidator is a validator for the "used_count" field. It is called by the builders before save.
+	invitecode.UsedCountValidator = invitecodeDescUsedCount.Validators[0].(func(int) error)
+	// invitecodeDescID is the schema descriptor for id field.
+	invitecodeDescID := invitecodeFields[0].Descriptor()
+	// invitecode.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	invitecode.IDValidator = invitecodeDescID.Validators[0].(func(int) error)
+	mentionMixin := schema.Mention{}.Mixin()
+	mentionMixinFields0 := mentionMixin[0].Fields()
+	_ = mentionMixinFields0
+	mentionFields := schema.Mention{}.Fields()
+	_ = mentionFields
+	// mentionDescCreatedAt is the schema descriptor for created_at field.
+	mentionDescCreatedAt := mentionMixinFields0[0].Descriptor()
+	// mention.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mention.DefaultCreatedAt = mentionDescCreatedAt.Default.(func() time.Time)
+	// mentionDescUpdatedAt is the schema descriptor for updated_at field.
+	mentionDescUpdatedAt := mentionMixinFields0[1].Descriptor()
+	// mention.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	mention.DefaultUpdatedAt = mentionDescUpdatedAt.Default.(func() time.Time)
+	// mention.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	mention.UpdateDefaultUpdatedAt = mentionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// mentionDescUserID is the schema descriptor for user_id field.
+	mentionDescUserID := mentionFields[1].Descriptor()
+	// mention.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	mention.UserIDValidator = mentionDescUserID.Validators[0].(func(int) error)
+	// mentionDescFromUserID is the schema descriptor for from_user_id field.
+	mentionDescFromUserID := mentionFields[2].Descriptor()
+	// mention.FromUserIDValidator is a validator for the "from_user_id" field. It is called by the builders before save.
+	mention.FromUserIDValidator = mentionDescFromUserID.Validators[0].(func(int) error)
+	// mentionDescPostID is the schema descriptor for post_id field.
+	mentionDescPostID := mentionFields[3].Descriptor()
+	// mention.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	mention.PostIDValidator = mentionDescPostID.Validators[0].(func(int) error)
+	// mentionDescCommentID is the schema descriptor for comment_id field.
+	mentionDescCommentID := mentionFields[4].Descriptor()
+	// mention.DefaultCommentID holds the default value on creation for the comment_id field.
+	mention.DefaultCommentID = mentionDescCommentID.Default.(int)
+	// mention.CommentIDValidator is a validator for the "comment_id" field. It is called by the builders before save.
+	mention.CommentIDValidator = mentionDescCommentID.Validators[0].(func(int) error)
+	// mentionDescIsRead is the schema descriptor for is_read field.
+	mentionDescIsRead := mentionFields[5].Descriptor()
+	// mention.DefaultIsRead holds the default value on creation for the is_read field.
+	mention.DefaultIsRead = mentionDescIsRead.Default.(bool)
+	// mentionDescID is the schema descriptor for id field.
+	mentionDescID := mentionFields[0].Descriptor()
+	// mention.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	mention.IDValidator = mentionDescID.Validators[0].(func(int) error)
+	notificationMixin := schema.Notification{}.Mixin()
+	notificationMixinFields0 := notificationMixin[0].Fields()
+	_ = notificationMixinFields0
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationMixinFields0[0].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationMixinFields0[1].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationDescUserID is the schema descriptor for user_id field.
+	notificationDescUserID := notificationFields[1].Descriptor()
+	// notification.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notification.UserIDValidator = notificationDescUserID.Validators[0].(func(int) error)
+	// notificationDescActorID is the schema descriptor for actor_id field.
+	notificationDescActorID := notificationFields[2].Descriptor()
+	// notification.DefaultActorID holds the default value on creation for the actor_id field.
+	notification.DefaultActorID = notificationDescActorID.Default.(int)
+	// notification.ActorIDValidator is a validator for the "actor_id" field. It is called by the builders before save.
+	notification.ActorIDValidator = notificationDescActorID.Validators[0].(func(int) error)
+	// notificationDescPostID is the schema descriptor for post_id field.
+	notificationDescPostID := notificationFields[4].Descriptor()
+	// notification.DefaultPostID holds the default value on creation for the post_id field.
+	notification.DefaultPostID = notificationDescPostID.Default.(int)
+	// notification.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	notification.PostIDValidator = notificationDescPostID.Validators[0].(func(int) error)
+	// notificationDescCommentID is the schema descriptor for comment_id field.
+	notificationDescCommentID := notificationFields[5].Descriptor()
+	// notification.DefaultCommentID holds the default value on creation for the comment_id field.
+	notification.DefaultCommentID = notificationDescCommentID.Default.(int)
+	// notification.CommentIDValidator is a validator for the "comment_id" field. It is called by the builders before save.
+	notification.CommentIDValidator = notificationDescCommentID.Validators[0].(func(int) error)
+	// notificationDescContent is the schema descriptor for content field.
+	notificationDescContent := notificationFields[6].Descriptor()
+	// notification.DefaultContent holds the default value on creation for the content field.
+	notification.DefaultContent = notificationDescContent.Default.(string)
+	// notification.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	notification.ContentValidator = notificationDescContent.Validators[0].(func(string) error)
+	// notificationDescIsRead is the schema descriptor for is_read field.
+	notificationDescIsRead := notificationFields[7].Descriptor()
+	// notification.DefaultIsRead holds the default value on creation for the is_read field.
+	notification.DefaultIsRead = notificationDescIsRead.Default.(bool)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notification.IDValidator = notificationDescID.Validators[0].(func(int) error)
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	notificationpreferenceDescCreatedAt := notificationpreferenceMixinFields0[0].Descriptor()
+	// notificationpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationpreference.DefaultCreatedAt = notificationpreferenceDescCreatedAt.Default.(func() time.Time)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceMixinFields0[1].Descriptor()
+	// notificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationpreferenceDescUserID is the schema descriptor for user_id field.
+	notificationpreferenceDescUserID := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notificationpreference.UserIDValidator = notificationpreferenceDescUserID.Validators[0].(func(int) error)
+	// notificationpreferenceDescEnabled is the schema descriptor for enabled field.
+	notificationpreferenceDescEnabled := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultEnabled holds the default value on creation for the enabled field.
+	notificationpreference.DefaultEnabled = notificationpreferenceDescEnabled.Default.(bool)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationpreference.IDValidator = notificationpreferenceDescID.Validators[0].(func(int) error)
 	oauthproviderMixin := schema.OAuthProvider{}.Mixin()
 	oauthproviderMixinFields0 := oauthproviderMixin[0].Fields()
 	_ = oauthproviderMixinFields0
@@ -317,6 +780,213 @@ func init() {
 	postactionDescID := postactionFields[0].Descriptor()
 	// postaction.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	postaction.IDValidator = postactionDescID.Validators[0].(func(int) error)
+	postpurchaseMixin := schema.PostPurchase{}.Mixin()
+	postpurchaseMixinFields0 := postpurchaseMixin[0].Fields()
+	_ = postpurchaseMixinFields0
+	postpurchaseFields := schema.PostPurchase{}.Fields()
+	_ = postpurchaseFields
+	// postpurchaseDescCreatedAt is the schema descriptor for created_at field.
+	postpurchaseDescCreatedAt := postpurchaseMixinFields0[0].Descriptor()
+	// postpurchase.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postpurchase.DefaultCreatedAt = postpurchaseDescCreatedAt.Default.(func() time.Time)
+	// postpurchaseDescUpdatedAt is the schema descriptor for updated_at field.
+	postpurchaseDescUpdatedAt := postpurchaseMixinFields0[1].Descriptor()
+	// postpurchase.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	postpurchase.DefaultUpdatedAt = postpurchaseDescUpdatedAt.Default.(func() time.Time)
+	// postpurchase.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	postpurchase.UpdateDefaultUpdatedAt = postpurchaseDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// postpurchaseDescPostID is the schema descriptor for post_id field.
+	postpurchaseDescPostID := postpurchaseFields[1].Descriptor()
+	// postpurchase.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	postpurchase.PostIDValidator = postpurchaseDescPostID.Validators[0].(func(int) error)
+	// postpurchaseDescUserID is the schema descriptor for user_id field.
+	postpurchaseDescUserID := postpurchaseFields[2].Descriptor()
+	// postpurchase.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	postpurchase.UserIDValidator = postpurchaseDescUserID.Validators[0].(func(int) error)
+	// postpurchaseDescAuthorID is the schema descriptor for author_id field.
+	postpurchaseDescAuthorID := postpurchaseFields[3].Descriptor()
+	// postpurchase.AuthorIDValidator is a validator for the "author_id" field. It is called by the builders before save.
+	postpurchase.AuthorIDValidator = postpurchaseDescAuthorID.Validators[0].(func(int) error)
+	// postpurchaseDescPrice is the schema descriptor for price field.
+	postpurchaseDescPrice := postpurchaseFields[4].Descriptor()
+	// postpurchase.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	postpurchase.PriceValidator = postpurchaseDescPrice.Validators[0].(func(int) error)
+	// postpurchaseDescID is the schema descriptor for id field.
+	postpurchaseDescID := postpurchaseFields[0].Descriptor()
+	// postpurchase.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	postpurchase.IDValidator = postpurchaseDescID.Validators[0].(func(int) error)
+	postrevisionMixin := schema.PostRevision{}.Mixin()
+	postrevisionMixinFields0 := postrevisionMixin[0].Fields()
+	_ = postrevisionMixinFields0
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescCreatedAt is the schema descriptor for created_at field.
+	postrevisionDescCreatedAt := postrevisionMixinFields0[0].Descriptor()
+	// postrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postrevision.DefaultCreatedAt = postrevisionDescCreatedAt.Default.(func() time.Time)
+	// postrevisionDescUpdatedAt is the schema descriptor for updated_at field.
+	postrevisionDescUpdatedAt := postrevisionMixinFields0[1].Descriptor()
+	// postrevision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	postrevision.DefaultUpdatedAt = postrevisionDescUpdatedAt.Default.(func() time.Time)
+	// postrevision.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	postrevision.UpdateDefaultUpdatedAt = postrevisionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// postrevisionDescPostID is the schema descriptor for post_id field.
+	postrevisionDescPostID := postrevisionFields[1].Descriptor()
+	// postrevision.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	postrevision.PostIDValidator = postrevisionDescPostID.Validators[0].(func(int) error)
+	// postrevisionDescRevision is the schema descriptor for revision field.
+	postrevisionDescRevision := postrevisionFields[2].Descriptor()
+	// postrevision.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	postrevision.RevisionValidator = postrevisionDescRevision.Validators[0].(func(int) error)
+	// postrevisionDescEditorID is the schema descriptor for editor_id field.
+	postrevisionDescEditorID := postrevisionFields[3].Descriptor()
+	// postrevision.EditorIDValidator is a validator for the "editor_id" field. It is called by the builders before save.
+	postrevision.EditorIDValidator = postrevisionDescEditorID.Validators[0].(func(int) error)
+	// postrevisionDescTitle is the schema descriptor for title field.
+	postrevisionDescTitle := postrevisionFields[4].Descriptor()
+	// postrevision.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	postrevision.TitleValidator = postrevisionDescTitle.Validators[0].(func(string) error)
+	// postrevisionDescContent is the schema descriptor for content field.
+	postrevisionDescContent := postrevisionFields[5].Descriptor()
+	// postrevision.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	postrevision.ContentValidator = postrevisionDescContent.Validators[0].(func(string) error)
+	// postrevisionDescReason is the schema descriptor for reason field.
+	postrevisionDescReason := postrevisionFields[6].Descriptor()
+	// postrevision.DefaultReason holds the default value on creation for the reason field.
+	postrevision.DefaultReason = postrevisionDescReason.Default.(string)
+	// postrevision.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	postrevision.ReasonValidator = postrevisionDescReason.Validators[0].(func(string) error)
+	// postrevisionDescRollbackFrom is the schema descriptor for rollback_from field.
+	postrevisionDescRollbackFrom := postrevisionFields[7].Descriptor()
+	// postrevision.DefaultRollbackFrom holds the default value on creation for the rollback_from field.
+	postrevision.DefaultRollbackFrom = postrevisionDescRollbackFrom.Default.(int)
+	// postrevision.RollbackFromValidator is a validator for the "rollback_from" field. It is called by the builders before save.
+	postrevision.RollbackFromValidator = postrevisionDescRollbackFrom.Validators[0].(func(int) error)
+	// postrevisionDescID is the schema descriptor for id field.
+	postrevisionDescID := postrevisionFields[0].Descriptor()
+	// postrevision.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	postrevision.IDValidator = postrevisionDescID.Validators[0].(func(int) error)
+	posttagMixin := schema.PostTag{}.Mixin()
+	posttagMixinFields0 := posttagMixin[0].Fields()
+	_ = posttagMixinFields0
+	posttagFields := schema.PostTag{}.Fields()
+	_ = posttagFields
+	// posttagDescCreatedAt is the schema descriptor for created_at field.
+	posttagDescCreatedAt := posttagMixinFields0[0].Descriptor()
+	// posttag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	posttag.DefaultCreatedAt = posttagDescCreatedAt.Default.(func() time.Time)
+	// posttagDescUpdatedAt is the schema descriptor for updated_at field.
+	posttagDescUpdatedAt := posttagMixinFields0[1].Descriptor()
+	// posttag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	posttag.DefaultUpdatedAt = posttagDescUpdatedAt.Default.(func() time.Time)
+	// posttag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	posttag.UpdateDefaultUpdatedAt = posttagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// posttagDescPostID is the schema descriptor for post_id field.
+	posttagDescPostID := posttagFields[1].Descriptor()
+	// posttag.PostIDValidator is a validator for the "post_id" field. It is called by the builders before save.
+	posttag.PostIDValidator = posttagDescPostID.Validators[0].(func(int) error)
+	// posttagDescTagID is the schema descriptor for tag_id field.
+	posttagDescTagID := posttagFields[2].Descriptor()
+	// posttag.TagIDValidator is a validator for the "tag_id" field. It is called by the builders before save.
+	posttag.TagIDValidator = posttagDescTagID.Validators[0].(func(int) error)
+	// posttagDescID is the schema descriptor for id field.
+	posttagDescID := posttagFields[0].Descriptor()
+	// posttag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	posttag.IDValidator = posttagDescID.Validators[0].(func(int) error)
+	privatemessageMixin := schema.PrivateMessage{}.Mixin()
+	privatemessageMixinFields0 := privatemessageMixin[0].Fields()
+	_ = privatemessageMixinFields0
+	privatemessageFields := schema.PrivateMessage{}.Fields()
+	_ = privatemessageFields
+	// privatemessageDescCreatedAt is the schema descriptor for created_at field.
+	privatemessageDescCreatedAt := privatemessageMixinFields0[0].Descriptor()
+	// privatemessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	privatemessage.DefaultCreatedAt = privatemessageDescCreatedAt.Default.(func() time.Time)
+	// privatemessageDescUpdatedAt is the schema descriptor for updated_at field.
+	privatemessageDescUpdatedAt := privatemessageMixinFields0[1].Descriptor()
+	// privatemessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	privatemessage.DefaultUpdatedAt = privatemessageDescUpdatedAt.Default.(func() time.Time)
+	// privatemessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	privatemessage.UpdateDefaultUpdatedAt = privatemessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// privatemessageDescConversationID is the schema descriptor for conversation_id field.
+	privatemessageDescConversationID := privatemessageFields[1].Descriptor()
+	// privatemessage.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	privatemessage.ConversationIDValidator = privatemessageDescConversationID.Validators[0].(func(int) error)
+	// privatemessageDescSenderID is the schema descriptor for sender_id field.
+	privatemessageDescSenderID := privatemessageFields[2].Descriptor()
+	// privatemessage.SenderIDValidator is a validator for the "sender_id" field. It is called by the builders before save.
+	privatemessage.SenderIDValidator = privatemessageDescSenderID.Validators[0].(func(int) error)
+	// privatemessageDescReceiverID is the schema descriptor for receiver_id field.
+	privatemessageDescReceiverID := privatemessageFields[3].Descriptor()
+	// privatemessage.ReceiverIDValidator is a validator for the "receiver_id" field. It is called by the builders before save.
+	privatemessage.ReceiverIDValidator = privatemessageDescReceiverID.Validators[0].(func(int) error)
+	// privatemessageDescContent is the schema descriptor for content field.
+	privatemessageDescContent := privatemessageFields[4].Descriptor()
+	// privatemessage.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	privatemessage.ContentValidator = func() func(string) error {
+		validators := privatemessageDescContent.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(content string) error {
+			for _, fn := range fns {
+				if err := fn(content); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// privatemessageDescID is the schema descriptor for id field.
+	privatemessageDescID := privatemessageFields[0].Descriptor()
+	// privatemessage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	privatemessage.IDValidator = privatemessageDescID.Validators[0].(func(int) error)
+	reportMixin := schema.Report{}.Mixin()
+	reportMixinFields0 := reportMixin[0].Fields()
+	_ = reportMixinFields0
+	reportFields := schema.Report{}.Fields()
+	_ = reportFields
+	// reportDescCreatedAt is the schema descriptor for created_at field.
+	reportDescCreatedAt := reportMixinFields0[0].Descriptor()
+	// report.DefaultCreatedAt holds the default value on creation for the created_at field.
+	report.DefaultCreatedAt = reportDescCreatedAt.Default.(func() time.Time)
+	// reportDescUpdatedAt is the schema descriptor for updated_at field.
+	reportDescUpdatedAt := reportMixinFields0[1].Descriptor()
+	// report.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	report.DefaultUpdatedAt = reportDescUpdatedAt.Default.(func() time.Time)
+	// report.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	report.UpdateDefaultUpdatedAt = reportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reportDescReporterID is the schema descriptor for reporter_id field.
+	reportDescReporterID := reportFields[1].Descriptor()
+	// report.ReporterIDValidator is a validator for the "reporter_id" field. It is called by the builders before save.
+	report.ReporterIDValidator = reportDescReporterID.Validators[0].(func(int) error)
+	// reportDescTargetID is the schema descriptor for target_id field.
+	reportDescTargetID := reportFields[3].Descriptor()
+	// report.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	report.TargetIDValidator = reportDescTargetID.Validators[0].(func(int) error)
+	// reportDescTargetUserID is the schema descriptor for target_user_id field.
+	reportDescTargetUserID := reportFields[4].Descriptor()
+	// report.TargetUserIDValidator is a validator for the "target_user_id" field. It is called by the builders before save.
+	report.TargetUserIDValidator = reportDescTargetUserID.Validators[0].(func(int) error)
+	// reportDescCategoryID is the schema descriptor for category_id field.
+	reportDescCategoryID := reportFields[5].Descriptor()
+	// report.DefaultCategoryID holds the default value on creation for the category_id field.
+	report.DefaultCategoryID = reportDescCategoryID.Default.(int)
+	// report.CategoryIDValidator is a validator for the "category_id" field. It is called by the builders before save.
+	report.CategoryIDValidator = reportDescCategoryID.Validators[0].(func(int) error)
+	// reportDescDescription is the schema descriptor for description field.
+	reportDescDescription := reportFields[7].Descriptor()
+	// report.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	report.DescriptionValidator = reportDescDescription.Validators[0].(func(string) error)
+	// reportDescHandleNote is the schema descriptor for handle_note field.
+	reportDescHandleNote := reportFields[11].Descriptor()
+	// report.HandleNoteValidator is a validator for the "handle_note" field. It is called by the builders before save.
+	report.HandleNoteValidator = reportDescHandleNote.Validators[0].(func(string) error)
+	// reportDescID is the schema descriptor for id field.
+	reportDescID := reportFields[0].Descriptor()
+	// report.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	report.IDValidator = reportDescID.Validators[0].(func(int) error)
 	settingsMixin := schema.Settings{}.Mixin()
 	settingsMixinFields0 := settingsMixin[0].Fields()
 	_ = settingsMixinFields0
@@ -340,6 +1010,55 @@ func init() {
 	settingsDescID := settingsFields[0].Descriptor()
 	// settings.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	settings.IDValidator = settingsDescID.Validators[0].(func(int) error)
+	tagMixin := schema.Tag{}.Mixin()
+	tagMixinFields0 := tagMixin[0].Fields()
+	_ = tagMixinFields0
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagMixinFields0[0].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagMixinFields0[1].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescPostCount is the schema descriptor for post_count field.
+	tagDescPostCount := tagFields[2].Descriptor()
+	// tag.DefaultPostCount holds the default value on creation for the post_count field.
+	tag.DefaultPostCount = tagDescPostCount.Default.(int)
+	// tag.PostCountValidator is a validator for the "post_count" field. It is called by the builders before save.
+	tag.PostCountValidator = tagDescPostCount.Validators[0].(func(int) error)
+	// tagDescCanonicalID is the schema descriptor for canonical_id field.
+	tagDescCanonicalID := tagFields[3].Descriptor()
+	// tag.DefaultCanonicalID holds the default value on creation for the canonical_id field.
+	tag.DefaultCanonicalID = tagDescCanonicalID.Default.(int)
+	// tag.CanonicalIDValidator is a validator for the "canonical_id" field. It is called by the builders before save.
+	tag.CanonicalIDValidator = tagDescCanonicalID.Validators[0].(func(int) error)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tag.IDValidator = tagDescID.Validators[0].(func(int) error)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -375,20 +1094,24 @@ func init() {
 	userDescEmailVerified := userFields[8].Descriptor()
 	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
 	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
+	// userDescMentionEmailNotify is the schema descriptor for mention_email_notify field.
+	userDescMentionEmailNotify := userFields[9].Descriptor()
+	// user.DefaultMentionEmailNotify holds the default value on creation for the mention_email_notify field.
+	user.DefaultMentionEmailNotify = userDescMentionEmailNotify.Default.(bool)
 	// userDescExperience is the schema descriptor for experience field.
-	userDescExperience := userFields[9].Descriptor()
+	userDescExperience := userFields[10].Descriptor()
 	// user.DefaultExperience holds the default value on creation for the experience field.
 	user.DefaultExperience = userDescExperience.Default.(int)
 	// user.ExperienceValidator is a validator for the "experience" field. It is called by the builders before save.
 	user.ExperienceValidator = userDescExperience.Validators[0].(func(int) error)
 	// userDescPoints is the schema descriptor for points field.
-	userDescPoints := userFields[10].Descriptor()
+	userDescPoints := userFields[11].Descriptor()
 	// user.DefaultPoints holds the default value on creation for the points field.
 	user.DefaultPoints = userDescPoints.Default.(int)
 	// user.PointsValidator is a validator for the "points" field. It is called by the builders before save.
 	user.PointsValidator = userDescPoints.Validators[0].(func(int) error)
 	// userDescCurrency is the schema descriptor for currency field.
-	userDescCurrency := userFields[11].Descriptor()
+	userDescCurrency := userFields[12].Descriptor()
 	// user.DefaultCurrency holds the default value on creation for the currency field.
 	user.DefaultCurrency = userDescCurrency.Default.(int)
 	// user.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
@@ -397,6 +1120,45 @@ func init() {
 	userDescID := userFields[0].Descriptor()
 	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	user.IDValidator = userDescID.Validators[0].(func(int) error)
+	userbadgeMixin := schema.UserBadge{}.Mixin()
+	userbadgeMixinFields0 := userbadgeMixin[0].Fields()
+	_ = userbadgeMixinFields0
+	userbadgeFields := schema.UserBadge{}.Fields()
+	_ = userbadgeFields
+	// userbadgeDescCreatedAt is the schema descriptor for created_at field.
+	userbadgeDescCreatedAt := userbadgeMixinFields0[0].Descriptor()
+	// userbadge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userbadge.DefaultCreatedAt = userbadgeDescCreatedAt.Default.(func() time.Time)
+	// userbadgeDescUpdatedAt is the schema descriptor for updated_at field.
+	userbadgeDescUpdatedAt := userbadgeMixinFields0[1].Descriptor()
+	// userbadge.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userbadge.DefaultUpdatedAt = userbadgeDescUpdatedAt.Default.(func() time.Time)
+	// userbadge.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userbadge.UpdateDefaultUpdatedAt = userbadgeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userbadgeDescUserID is the schema descriptor for user_id field.
+	userbadgeDescUserID := userbadgeFields[1].Descriptor()
+	// userbadge.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	userbadge.UserIDValidator = userbadgeDescUserID.Validators[0].(func(int) error)
+	// userbadgeDescBadgeID is the schema descriptor for badge_id field.
+	userbadgeDescBadgeID := userbadgeFields[2].Descriptor()
+	// userbadge.BadgeIDValidator is a validator for the "badge_id" field. It is called by the builders before save.
+	userbadge.BadgeIDValidator = userbadgeDescBadgeID.Validators[0].(func(int) error)
+	// userbadgeDescGrantedBy is the schema descriptor for granted_by field.
+	userbadgeDescGrantedBy := userbadgeFields[4].Descriptor()
+	// userbadge.DefaultGrantedBy holds the default value on creation for the granted_by field.
+	userbadge.DefaultGrantedBy = userbadgeDescGrantedBy.Default.(int)
+	// userbadge.GrantedByValidator is a validator for the "granted_by" field. It is called by the builders before save.
+	userbadge.GrantedByValidator = userbadgeDescGrantedBy.Validators[0].(func(int) error)
+	// userbadgeDescDisplayOrder is the schema descriptor for display_order field.
+	userbadgeDescDisplayOrder := userbadgeFields[6].Descriptor()
+	// userbadge.DefaultDisplayOrder holds the default value on creation for the display_order field.
+	userbadge.DefaultDisplayOrder = userbadgeDescDisplayOrder.Default.(int)
+	// userbadge.DisplayOrderValidator is a validator for the "display_order" field. It is called by the builders before save.
+	userbadge.DisplayOrderValidator = userbadgeDescDisplayOrder.Validators[0].(func(int) error)
+	// userbadgeDescID is the schema descriptor for id field.
+	userbadgeDescID := userbadgeFields[0].Descriptor()
+	// userbadge.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userbadge.IDValidator = userbadgeDescID.Validators[0].(func(int) error)
 	userbalancelogMixin := schema.UserBalanceLog{}.Mixin()
 	userbalancelogMixinFields0 := userbalancelogMixin[0].Fields()
 	_ = userbalancelogMixinFields0
@@ -424,6 +1186,122 @@ func init() {
 	userbalancelogDescID := userbalancelogFields[0].Descriptor()
 	// userbalancelog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	userbalancelog.IDValidator = userbalancelogDescID.Validators[0].(func(int) error)
+	usergroupMixin := schema.UserGroup{}.Mixin()
+	usergroupMixinFields0 := usergroupMixin[0].Fields()
+	_ = usergroupMixinFields0
+	usergroupFields := schema.UserGroup{}.Fields()
+	_ = usergroupFields
+	// usergroupDescCreatedAt is the schema descriptor for created_at field.
+	usergroupDescCreatedAt := usergroupMixinFields0[0].Descriptor()
+	// usergroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usergroup.DefaultCreatedAt = usergroupDescCreatedAt.Default.(func() time.Time)
+	// usergroupDescUpdatedAt is the schema descriptor for updated_at field.
+	usergroupDescUpdatedAt := usergroupMixinFields0[1].Descriptor()
+	// usergroup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usergroup.DefaultUpdatedAt = usergroupDescUpdatedAt.Default.(func() time.Time)
+	// usergroup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usergroup.UpdateDefaultUpdatedAt = usergroupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usergroupDescName is the schema descriptor for name field.
+	usergroupDescName := usergroupFields[1].Descriptor()
+	// usergroup.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	usergroup.NameValidator = usergroupDescName.Validators[0].(func(string) error)
+	// usergroupDescID is the schema descriptor for id field.
+	usergroupDescID := usergroupFields[0].Descriptor()
+	// usergroup.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	usergroup.IDValidator = usergroupDescID.Validators[0].(func(int) error)
+	usergroupmemberMixin := schema.UserGroupMember{}.Mixin()
+	usergroupmemberMixinFields0 := usergroupmemberMixin[0].Fields()
+	_ = usergroupmemberMixinFields0
+	usergroupmemberFields := schema.UserGroupMember{}.Fields()
+	_ = usergroupmemberFields
+	// usergroupmemberDescCreatedAt is the schema descriptor for created_at field.
+	usergroupmemberDescCreatedAt := usergroupmemberMixinFields0[0].Descriptor()
+	// usergroupmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usergroupmember.DefaultCreatedAt = usergroupmemberDescCreatedAt.Default.(func() time.Time)
+	// usergroupmemberDescUpdatedAt is the schema descriptor for updated_at field.
+	usergroupmemberDescUpdatedAt := usergroupmemberMixinFields0[1].Descriptor()
+	// usergroupmember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usergroupmember.DefaultUpdatedAt = usergroupmemberDescUpdatedAt.Default.(func() time.Time)
+	// usergroupmember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usergroupmember.UpdateDefaultUpdatedAt = usergroupmemberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usergroupmemberDescGroupID is the schema descriptor for group_id field.
+	usergroupmemberDescGroupID := usergroupmemberFields[1].Descriptor()
+	// usergroupmember.GroupIDValidator is a validator for the "group_id" field. It is called by the builders before save.
+	usergroupmember.GroupIDValidator = usergroupmemberDescGroupID.Validators[0].(func(int) error)
+	// usergroupmemberDescUserID is the schema descriptor for user_id field.
+	usergroupmemberDescUserID := usergroupmemberFields[2].Descriptor()
+	// usergroupmember.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	usergroupmember.UserIDValidator = usergroupmemberDescUserID.Validators[0].(func(int) error)
+	// usergroupmemberDescID is the schema descriptor for id field.
+	usergroupmemberDescID := usergroupmemberFields[0].Descriptor()
+	// usergroupmember.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	usergroupmember.IDValidator = usergroupmemberDescID.Validators[0].(func(int) error)
+	userinvitationMixin := schema.UserInvitation{}.Mixin()
+	userinvitationMixinFields0 := userinvitationMixin[0].Fields()
+	_ = userinvitationMixinFields0
+	userinvitationFields := schema.UserInvitation{}.Fields()
+	_ = userinvitationFields
+	// userinvitationDescCreatedAt is the schema descriptor for created_at field.
+	userinvitationDescCreatedAt := userinvitationMixinFields0[0].Descriptor()
+	// userinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userinvitation.DefaultCreatedAt = userinvitationDescCreatedAt.Default.(func() time.Time)
+	// userinvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	userinvitationDescUpdatedAt := userinvitationMixinFields0[1].Descriptor()
+	// userinvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userinvitation.DefaultUpdatedAt = userinvitationDescUpdatedAt.Default.(func() time.Time)
+	// userinvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userinvitation.UpdateDefaultUpdatedAt = userinvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userinvitationDescInviterID is the schema descriptor for inviter_id field.
+	userinvitationDescInviterID := userinvitationFields[1].Descriptor()
+	// userinvitation.InviterIDValidator is a validator for the "inviter_id" field. It is called by the builders before save.
+	userinvitation.InviterIDValidator = userinvitationDescInviterID.Validators[0].(func(int) error)
+	// userinvitationDescInviteeID is the schema descriptor for invitee_id field.
+	userinvitationDescInviteeID := userinvitationFields[2].Descriptor()
+	// userinvitation.InviteeIDValidator is a validator for the "invitee_id" field. It is called by the builders before save.
+	userinvitation.InviteeIDValidator = userinvitationDescInviteeID.Validators[0].(func(int) error)
+	// userinvitationDescInviteCodeID is the schema descriptor for invite_code_id field.
+	userinvitationDescInviteCodeID := userinvitationFields[3].Descriptor()
+	// userinvitation.InviteCodeIDValidator is a validator for the "invite_code_id" field. It is called by the builders before save.
+	userinvitation.InviteCodeIDValidator = userinvitationDescInviteCodeID.Validators[0].(func(int) error)
+	// userinvitationDescID is the schema descriptor for id field.
+	userinvitationDescID := userinvitationFields[0].Descriptor()
+	// userinvitation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userinvitation.IDValidator = userinvitationDescID.Validators[0].(func(int) error)
+	userlevellogMixin := schema.UserLevelLog{}.Mixin()
+	userlevellogMixinFields0 := userlevellogMixin[0].Fields()
+	_ = userlevellogMixinFields0
+	userlevellogFields := schema.UserLevelLog{}.Fields()
+	_ = userlevellogFields
+	// userlevellogDescCreatedAt is the schema descriptor for created_at field.
+	userlevellogDescCreatedAt := userlevellogMixinFields0[0].Descriptor()
+	// userlevellog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userlevellog.DefaultCreatedAt = userlevellogDescCreatedAt.Default.(func() time.Time)
+	// userlevellogDescUpdatedAt is the schema descriptor for updated_at field.
+	userlevellogDescUpdatedAt := userlevellogMixinFields0[1].Descriptor()
+	// userlevellog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userlevellog.DefaultUpdatedAt = userlevellogDescUpdatedAt.Default.(func() time.Time)
+	// userlevellog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userlevellog.UpdateDefaultUpdatedAt = userlevellogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userlevellogDescUserID is the schema descriptor for user_id field.
+	userlevellogDescUserID := userlevellogFields[1].Descriptor()
+	// userlevellog.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	userlevellog.UserIDValidator = userlevellogDescUserID.Validators[0].(func(int) error)
+	// userlevellogDescFromLevel is the schema descriptor for from_level field.
+	userlevellogDescFromLevel := userlevellogFields[2].Descriptor()
+	// userlevellog.FromLevelValidator is a validator for the "from_level" field. It is called by the builders before save.
+	userlevellog.FromLevelValidator = userlevellogDescFromLevel.Validators[0].(func(int) error)
+	// userlevellogDescToLevel is the schema descriptor for to_level field.
+	userlevellogDescToLevel := userlevellogFields[3].Descriptor()
+	// userlevellog.ToLevelValidator is a validator for the "to_level" field. It is called by the builders before save.
+	userlevellog.ToLevelValidator = userlevellogDescToLevel.Validators[0].(func(int) error)
+	// userlevellogDescExperience is the schema descriptor for experience field.
+	userlevellogDescExperience := userlevellogFields[5].Descriptor()
+	// userlevellog.ExperienceValidator is a validator for the "experience" field. It is called by the builders before save.
+	userlevellog.ExperienceValidator = userlevellogDescExperience.Validators[0].(func(int) error)
+	// userlevellogDescID is the schema descriptor for id field.
+	userlevellogDescID := userlevellogFields[0].Descriptor()
+	// userlevellog.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userlevellog.IDValidator = userlevellogDescID.Validators[0].(func(int) error)
 	userloginlogMixin := schema.UserLoginLog{}.Mixin()
 	userloginlogMixinFields0 := userloginlogMixin[0].Fields()
 	_ = userloginlogMixinFields0
@@ -532,4 +1410,86 @@ func init() {
 	usersigninstatus.DefaultTotalDays = usersigninstatusDescTotalDays.Default.(int)
 	// usersigninstatus.TotalDaysValidator is a validator for the "total_days" field. It is called by the builders before save.
 	usersigninstatus.TotalDaysValidator = usersigninstatusDescTotalDays.Validators[0].(func(int) error)
+	usertwofactorMixin := schema.UserTwoFactor{}.Mixin()
+	usertwofactorMixinFields0 := usertwofactorMixin[0].Fields()
+	_ = usertwofactorMixinFields0
+	usertwofactorFields := schema.UserTwoFactor{}.Fields()
+	_ = usertwofactorFields
+	// usertwofactorDescCreatedAt is the schema descriptor for created_at field.
+	usertwofactorDescCreatedAt := usertwofactorMixinFields0[0].Descriptor()
+	// usertwofactor.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertwofactor.DefaultCreatedAt = usertwofactorDescCreatedAt.Default.(func() time.Time)
+	// usertwofactorDescUpdatedAt is the schema descriptor for updated_at field.
+	usertwofactorDescUpdatedAt := usertwofactorMixinFields0[1].Descriptor()
+	// usertwofactor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usertwofactor.DefaultUpdatedAt = usertwofactorDescUpdatedAt.Default.(func() time.Time)
+	// usertwofactor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usertwofactor.UpdateDefaultUpdatedAt = usertwofactorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usertwofactorDescUserID is the schema descriptor for user_id field.
+	usertwofactorDescUserID := usertwofactorFields[1].Descriptor()
+	// usertwofactor.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	usertwofactor.UserIDValidator = usertwofactorDescUserID.Validators[0].(func(int) error)
+	// usertwofactorDescSecret is the schema descriptor for secret field.
+	usertwofactorDescSecret := usertwofactorFields[2].Descriptor()
+	// usertwofactor.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	usertwofactor.SecretValidator = usertwofactorDescSecret.Validators[0].(func(string) error)
+	// usertwofactorDescEnabled is the schema descriptor for enabled field.
+	usertwofactorDescEnabled := usertwofactorFields[3].Descriptor()
+	// usertwofactor.DefaultEnabled holds the default value on creation for the enabled field.
+	usertwofactor.DefaultEnabled = usertwofactorDescEnabled.Default.(bool)
+	// usertwofactorDescLastUsedStep is the schema descriptor for last_used_step field.
+	usertwofactorDescLastUsedStep := usertwofactorFields[5].Descriptor()
+	// usertwofactor.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	usertwofactor.DefaultLastUsedStep = usertwofactorDescLastUsedStep.Default.(int64)
+	// usertwofactorDescID is the schema descriptor for id field.
+	usertwofactorDescID := usertwofactorFields[0].Descriptor()
+	// usertwofactor.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	usertwofactor.IDValidator = usertwofactorDescID.Validators[0].(func(int) error)
+	webauthncredentialMixin := schema.WebAuthnCredential{}.Mixin()
+	webauthncredentialMixinFields0 := webauthncredentialMixin[0].Fields()
+	_ = webauthncredentialMixinFields0
+	webauthncredentialFields := schema.WebAuthnCredential{}.Fields()
+	_ = webauthncredentialFields
+	// webauthncredentialDescCreatedAt is the schema descriptor for created_at field.
+	webauthncredentialDescCreatedAt := webauthncredentialMixinFields0[0].Descriptor()
+	// webauthncredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthncredential.DefaultCreatedAt = webauthncredentialDescCreatedAt.Default.(func() time.Time)
+	// webauthncredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	webauthncredentialDescUpdatedAt := webauthncredentialMixinFields0[1].Descriptor()
+	// webauthncredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webauthncredential.DefaultUpdatedAt = webauthncredentialDescUpdatedAt.Default.(func() time.Time)
+	// webauthncredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webauthncredential.UpdateDefaultUpdatedAt = webauthncredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webauthncredentialDescUserID is the schema descriptor for user_id field.
+	webauthncredentialDescUserID := webauthncredentialFields[1].Descriptor()
+	// webauthncredential.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	webauthncredential.UserIDValidator = webauthncredentialDescUserID.Validators[0].(func(int) error)
+	// webauthncredentialDescCredentialID is the schema descriptor for credential_id field.
+	webauthncredentialDescCredentialID := webauthncredentialFields[2].Descriptor()
+	// webauthncredential.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	webauthncredential.CredentialIDValidator = webauthncredentialDescCredentialID.Validators[0].(func(string) error)
+	// webauthncredentialDescPublicKey is the schema descriptor for public_key field.
+	webauthncredentialDescPublicKey := webauthncredentialFields[3].Descriptor()
+	// webauthncredential.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	webauthncredential.PublicKeyValidator = webauthncredentialDescPublicKey.Validators[0].(func([]byte) error)
+	// webauthncredentialDescSignCount is the schema descriptor for sign_count field.
+	webauthncredentialDescSignCount := webauthncredentialFields[6].Descriptor()
+	// webauthncredential.DefaultSignCount holds the default value on creation for the sign_count field.
+	webauthncredential.DefaultSignCount = webauthncredentialDescSignCount.Default.(uint32)
+	// webauthncredentialDescFlags is the schema descriptor for flags field.
+	webauthncredentialDescFlags := webauthncredentialFields[8].Descriptor()
+	// webauthncredential.DefaultFlags holds the default value on creation for the flags field.
+	webauthncredential.DefaultFlags = webauthncredentialDescFlags.Default.(uint8)
+	// webauthncredentialDescCloneWarning is the schema descriptor for clone_warning field.
+	webauthncredentialDescCloneWarning := webauthncredentialFields[9].Descriptor()
+	// webauthncredential.DefaultCloneWarning holds the default value on creation for the clone_warning field.
+	webauthncredential.DefaultCloneWarning = webauthncredentialDescCloneWarning.Default.(bool)
+	// webauthncredentialDescName is the schema descriptor for name field.
+	webauthncredentialDescName := webauthncredentialFields[10].Descriptor()
+	// webauthncredential.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webauthncredential.NameValidator = webauthncredentialDescName.Validators[0].(func(string) error)
+	// webauthncredentialDescID is the schema descriptor for id field.
+	webauthncredentialDescID := webauthncredentialFields[0].Descriptor()
+	// webauthncredential.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webauthncredential.IDValidator = webauthncredentialDescID.Validators[0].(func(int) error)
 }
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Attachment holds the schema definition for the Attachment entity.
+// 用户上传的附件，相同内容的文件共用同一个存储对象
+type Attachment struct {
+	ent.Schema
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		// 附件ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 上传者用户ID，关联users表
+		field.Int("user_id").
+			Positive(),
+		// 所属帖子ID，关联posts表，0表示未关联
+		field.Int("post_id").
+			Default(0).
+			NonNegative(),
+		// 所属评论ID，关联comments表，0表示未关联
+		field.Int("comment_id").
+			Default(0).
+			NonNegative(),
+		// 原始文件名，MaxLen按字节校验，按4字节UTF-8预留，字符数上限（255）由应用层截断
+		field.String("filename").
+			NotEmpty().
+			MaxLen(1020),
+		// 文件内容SHA-256哈希（十六进制），用于去重
+		field.String("hash").
+			NotEmpty().
+			MaxLen(64),
+		// 存储驱动中的文件键名，相同哈希的附件共用
+		field.String("storage_key").
+			NotEmpty().
+			MaxLen(255),
+		// 根据文件内容识别的MIME类型
+		field.String("mime_type").
+			NotEmpty().
+			MaxLen(255),
+		// 文件大小（字节）
+		field.Int64("size").
+			NonNegative(),
+		// 图片缩略图存储键名，图片处理完成前为空
+		field.String("thumbnail_key").
+			Default("").
+			MaxLen(255),
+		// 图片宽度（像素），非图片或处理完成前为0
+		field.Int("width").
+			Default(0).
+			NonNegative(),
+		// 图片高度（像素），非图片或处理完成前为0
+		field.Int("height").
+			Default(0).
+			NonNegative(),
+	}
+}
+
+// Edges of the Attachment.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// user_id字段关联users表，post_id字段关联posts表，comment_id字段关联comments表，关联逻辑在应用层维护
+func (Attachment) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Attachment.
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		// 上传者索引，用于查询用户附件与统计容量
+		index.Fields("user_id"),
+		// 哈希索引，用于去重与判断存储对象是否仍被引用
+		index.Fields("hash"),
+		// 帖子索引，用于查询帖子附件
+		index.Fields("post_id"),
+		// 评论索引，用于查询评论附件
+		index.Fields("comment_id"),
+	}
+}
+
+// Mixin of the Attachment.
+func (Attachment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Badge holds the schema definition for the Badge entity.
+// 徽章，由管理员定义获得条件，满足条件时由业务事件异步授予，也可由管理员手动授予
+type Badge struct {
+	ent.Schema
+}
+
+// Fields of the Badge.
+func (Badge) Fields() []ent.Field {
+	return []ent.Field{
+		// 主键ID
+		field.Int("id").
+			Positive(),
+		// 徽章名称，唯一
+		field.String("name").
+			Unique().
+			NotEmpty().
+			Comment("徽章名称"),
+		// 徽章描述
+		field.String("description").
+			Optional().
+			Comment("徽章描述"),
+		// 徽章图标地址
+		field.String("icon").
+			Optional().
+			Comment("徽章图标地址"),
+		// 获得条件类型：manual-仅手动授予，post_count-发帖数，likes_received-帖子获赞数，signin_streak-连续签到天数，essence_count-精华帖数
+		field.Enum("criteria").
+			Values("manual", "post_count", "likes_received", "signin_streak", "essence_count").
+			Default("manual").
+			Comment("获得条件类型"),
+		// 获得条件阈值，指标达到该值时授予
+		field.Int("threshold").
+			NonNegative().
+			Default(0).
+			Comment("获得条件阈值"),
+		// 是否启用，停用的徽章不再自动授予
+		field.Bool("enabled").
+			Default(true).
+			Comment("是否启用"),
+	}
+}
+
+// Edges of the Badge.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// 用户获得的徽章通过中间表在应用层维护
+func (Badge) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Badge.
+func (Badge) Indexes() []ent.Index {
+	return []ent.Index{
+		// 事件触发时按条件类型查询徽章
+		index.Fields("criteria", "enabled"),
+	}
+}
+
+// Mixin of the Badge.
+func (Badge) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -17,6 +17,10 @@ func (Category) Fields() []ent.Field {
 		// 主键ID
 		field.Int("id").
 			Positive(),
+		// 父版块ID，0表示顶级版块
+		field.Int("parent_id").
+			Default(0).
+			NonNegative(),
 		// 版块名称
 		field.String("name").
 			NotEmpty(),
@@ -56,6 +60,7 @@ func (Category) Indexes() []ent.Index {
 		// 为常用查询字段创建索引
 		index.Fields("status"),
 		index.Fields("weight"),
+		index.Fields("parent_id"),
 		// slug已经是唯一字段，会自动创建唯一索引
 	}
 }
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CategoryPermission holds the schema definition for the CategoryPermission entity.
+// 版块访问控制规则，查看、发帖、回复分别设置，规则沿版块树向下继承
+type CategoryPermission struct {
+	ent.Schema
+}
+
+// Fields of the CategoryPermission.
+func (CategoryPermission) Fields() []ent.Field {
+	return []ent.Field{
+		// 主键ID
+		field.Int("id").
+			Positive(),
+		// 版块ID
+		field.Int("category_id").
+			Positive().
+			Comment("版块ID"),
+		// 规则作用的操作：查看、发帖、回复
+		field.Enum("action").
+			Values("View", "Post", "Reply").
+			Comment("规则作用的操作"),
+		// 最低身份要求
+		field.Enum("min_role").
+			Values("User", "Moderator", "Admin", "SuperAdmin").
+			Default("User").
+			Comment("最低身份要求"),
+		// 最低经验值要求
+		field.Int("min_experience").
+			Default(0).
+			NonNegative().
+			Comment("最低经验值要求"),
+		// 允许的用户组ID列表，为空表示不限制用户组
+		field.JSON("allowed_group_ids", []int{}).
+			Optional().
+			Comment("允许的用户组ID列表"),
+	}
+}
+
+// Edges of the CategoryPermission.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// 数据完整性由应用层逻辑保证
+func (CategoryPermission) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CategoryPermission.
+func (CategoryPermission) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个版块的每种操作只有一条规则
+		index.Fields("category_id", "action").
+			Unique(),
+	}
+}
+
+// Mixin of the CategoryPermission.
+func (CategoryPermission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
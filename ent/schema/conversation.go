@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Conversation holds the schema definition for the Conversation entity.
+// 两个用户之间的私信会话，user_a_id始终小于user_b_id，保证同一对用户只有一个会话
+type Conversation struct {
+	ent.Schema
+}
+
+// Fields of the Conversation.
+func (Conversation) Fields() []ent.Field {
+	return []ent.Field{
+		// 会话ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 会话中ID较小的用户，关联users表
+		field.Int("user_a_id").
+			Positive(),
+		// 会话中ID较大的用户，关联users表
+		field.Int("user_b_id").
+			Positive(),
+		// 最后一条消息ID，关联private_messages表
+		field.Int("last_message_id").
+			Default(0).
+			NonNegative(),
+		// 最后一条消息时间，用于会话列表排序
+		field.Time("last_message_at").
+			Optional().
+			Nillable(),
+		// user_a的未读消息数
+		field.Int("user_a_unread").
+			Default(0).
+			NonNegative(),
+		// user_b的未读消息数
+		field.Int("user_b_unread").
+			Default(0).
+			NonNegative(),
+	}
+}
+
+// Edges of the Conversation.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// user_a_id与user_b_id字段关联users表，关联逻辑在应用层维护
+func (Conversation) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Conversation.
+func (Conversation) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一对用户只有一个会话
+		index.Fields("user_a_id", "user_b_id").
+			Unique(),
+		// 用于按最后消息时间查询会话列表
+		index.Fields("user_a_id", "last_message_at"),
+		index.Fields("user_b_id", "last_message_at"),
+	}
+}
+
+// Mixin of the Conversation.
+func (Conversation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
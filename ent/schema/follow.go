@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Follow holds the schema definition for the Follow entity.
+type Follow struct {
+	ent.Schema
+}
+
+// Fields of the Follow.
+func (Follow) Fields() []ent.Field {
+	return []ent.Field{
+		// 关注记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 用户ID，发起关注的用户
+		field.Int("user_id").
+			Positive().
+			Comment("发起关注的用户ID"),
+		// 关注对象类型：User(用户)、Category(版块)
+		field.Enum("target_type").
+			Values("User", "Category").
+			Comment("关注对象类型"),
+		// 关注对象ID，根据类型对应用户ID或版块ID
+		field.Int("target_id").
+			Positive().
+			Comment("关注对象ID"),
+	}
+}
+
+// Edges of the Follow.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// 数据完整性由应用层逻辑保证
+func (Follow) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Follow.
+func (Follow) Indexes() []ent.Index {
+	return []ent.Index{
+		// 创建复合索引，防止重复关注，同时优化关注列表查询
+		index.Fields("user_id", "target_type", "target_id").
+			Unique(),
+		// 优化粉丝列表与粉丝数查询
+		index.Fields("target_type", "target_id"),
+	}
+}
+
+// Mixin of the Follow.
+func (Follow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InviteCode holds the schema definition for the InviteCode entity.
+// 存储用户创建的注册邀请码
+type InviteCode struct {
+	ent.Schema
+}
+
+// Fields of the InviteCode.
+func (InviteCode) Fields() []ent.Field {
+	return []ent.Field{
+		// 邀请码记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 创建者用户ID，关联users表
+		field.Int("user_id").
+			Positive(),
+		// 邀请码，全局唯一
+		field.String("code").
+			NotEmpty().
+			MaxLen(32),
+		// 最大使用次数，0表示不限制，创建时取自安全设置
+		field.Int("max_uses").
+			NonNegative().
+			Default(0),
+		// 已使用次数
+		field.Int("used_count").
+			NonNegative().
+			Default(0),
+		// 邀请码状态：Active、Revoked
+		field.Enum("status").
+			Values("Active", "Revoked").
+			Default("Active"),
+	}
+}
+
+// Edges of the InviteCode.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// user_id字段关联users表，但不创建外键约束，关联逻辑在应用层维护
+func (InviteCode) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InviteCode.
+func (InviteCode) Indexes() []ent.Index {
+	return []ent.Index{
+		// 邀请码唯一索引，注册时按邀请码查找
+		index.Fields("code").
+			Unique(),
+		// 创建者与状态复合索引，用于查询用户的邀请码及统计数量
+		index.Fields("user_id", "status"),
+	}
+}
+
+// Mixin of the InviteCode.
+func (InviteCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Mention holds the schema definition for the Mention entity.
+// 帖子或评论正文中的 @用户名 提及记录
+type Mention struct {
+	ent.Schema
+}
+
+// Fields of the Mention.
+func (Mention) Fields() []ent.Field {
+	return []ent.Field{
+		// 提及记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 被提及的用户ID，关联users表
+		field.Int("user_id").
+			Positive(),
+		// 发起提及的用户ID，关联users表
+		field.Int("from_user_id").
+			Positive(),
+		// 帖子ID，关联posts表
+		field.Int("post_id").
+			Positive(),
+		// 评论ID，关联comments表，0表示在帖子正文中提及
+		field.Int("comment_id").
+			Default(0).
+			NonNegative(),
+		// 是否已读
+		field.Bool("is_read").
+			Default(false),
+	}
+}
+
+// Edges of the Mention.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// user_id与from_user_id字段关联users表，post_id与comment_id字段关联posts、comments表，关联逻辑在应用层维护
+func (Mention) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Mention.
+func (Mention) Indexes() []ent.Index {
+	return []ent.Index{
+		// 被提及用户与已读状态复合索引，用于查询提及列表与未读数
+		index.Fields("user_id", "is_read"),
+		// 同一正文对同一用户只记录一次提及
+		index.Fields("post_id", "comment_id", "user_id").
+			Unique(),
+	}
+}
+
+// Mixin of the Mention.
+func (Mention) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification holds the schema definition for the Notification entity.
+// 站内通知，由异步任务创建
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		// 通知ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 接收通知的用户ID，关联users表
+		field.Int("user_id").
+			Positive(),
+		// 触发通知的用户ID，关联users表，0表示系统
+		field.Int("actor_id").
+			Default(0).
+			NonNegative(),
+		// 通知类型：Reply回复我的帖子、CommentReply回复我的评论、Mention提及、Like点赞、Moderation版主操作、Balance余额变动、System系统公告
+		field.Enum("type").
+			Values("Reply", "CommentReply", "Mention", "Like", "Moderation", "Balance", "System"),
+		// 关联帖子ID，0表示无关联
+		field.Int("post_id").
+			Default(0).
+			NonNegative(),
+		// 关联评论ID，0表示无关联
+		field.Int("comment_id").
+			Default(0).
+			NonNegative(),
+		// 通知内容摘要
+		field.String("content").
+			MaxLen(2000).
+			Default(""),
+		// 是否已读
+		field.Bool("is_read").
+			Default(false),
+	}
+}
+
+// Edges of the Notification.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// user_id与actor_id字段关联users表，post_id与comment_id字段关联posts、comments表，关联逻辑在应用层维护
+func (Notification) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		// 接收者与已读状态复合索引，用于查询通知列表与未读数
+		index.Fields("user_id", "is_read"),
+		// 接收者与类型复合索引，用于按类型筛选
+		index.Fields("user_id", "type"),
+	}
+}
+
+// Mixin of the Notification.
+func (Notification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NotificationPreference holds the schema definition for the NotificationPreference entity.
+// 用户按通知类型的接收偏好，没有记录时默认接收
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		// 偏好记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 用户ID，关联users表
+		field.Int("user_id").
+			Positive(),
+		// 通知类型，与Notification.type取值一致
+		field.Enum("type").
+			Values("Reply", "CommentReply", "Mention", "Like", "Moderation", "Balance", "System"),
+		// 是否接收该类型通知
+		field.Bool("enabled").
+			Default(true),
+	}
+}
+
+// Edges of the NotificationPreference.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// user_id字段关联users表，关联逻辑在应用层维护
+func (NotificationPreference) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the NotificationPreference.
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个用户每种类型只有一条偏好记录
+		index.Fields("user_id", "type").
+			Unique(),
+	}
+}
+
+// Mixin of the NotificationPreference.
+func (NotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -29,7 +29,7 @@ func (Post) Fields() []ent.Field {
 		// 帖子正文内容，MarkDown格式
 		field.Text("content").
 			NotEmpty(),
-		// 阅读限制
+		// 阅读限制，格式为 "模式:参数"，可选模式见 internal/consts/post.go
 		field.String("read_permission").
 			Default("public").
 			Optional(),
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PostPurchase holds the schema definition for the PostPurchase entity.
+// 付费阅读购买记录，购买后可永久查看帖子正文
+type PostPurchase struct {
+	ent.Schema
+}
+
+// Fields of the PostPurchase.
+func (PostPurchase) Fields() []ent.Field {
+	return []ent.Field{
+		// 购买记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 帖子ID，关联posts表
+		field.Int("post_id").
+			Positive(),
+		// 购买者用户ID，关联users表
+		field.Int("user_id").
+			Positive(),
+		// 帖子作者用户ID，关联users表，货币转入该用户
+		field.Int("author_id").
+			Positive(),
+		// 购买时支付的货币数量
+		field.Int("price").
+			Positive(),
+	}
+}
+
+// Edges of the PostPurchase.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// post_id字段关联posts表，user_id与author_id字段关联users表，关联逻辑在应用层维护
+func (PostPurchase) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PostPurchase.
+func (PostPurchase) Indexes() []ent.Index {
+	return []ent.Index{
+		// 帖子与购买者复合唯一索引，防止重复购买
+		index.Fields("post_id", "user_id").
+			Unique(),
+		// 购买者索引，用于查询用户的购买记录
+		index.Fields("user_id"),
+	}
+}
+
+// Mixin of the PostPurchase.
+func (PostPurchase) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
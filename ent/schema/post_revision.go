@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PostRevision holds the schema definition for the PostRevision entity.
+// 帖子修订历史，每次编辑或回滚后保存一份完整的标题与正文快照
+type PostRevision struct {
+	ent.Schema
+}
+
+// Fields of the PostRevision.
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		// 修订记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 帖子ID，关联posts表
+		field.Int("post_id").
+			Positive(),
+		// 修订版本号，同一帖子内从1开始递增，版本1为首次编辑前的原始内容
+		field.Int("revision").
+			Positive(),
+		// 编辑者用户ID，关联users表，可能是作者、版主或管理员
+		field.Int("editor_id").
+			Positive(),
+		// 该版本的帖子标题
+		field.String("title").
+			NotEmpty(),
+		// 该版本的帖子正文内容
+		field.Text("content").
+			NotEmpty(),
+		// 编辑原因
+		// MaxLen按字节校验，按4字节UTF-8预留，字符数上限（200）由请求参数校验
+		field.String("reason").
+			MaxLen(800).
+			Default(""),
+		// 回滚来源版本号，0表示普通编辑
+		field.Int("rollback_from").
+			NonNegative().
+			Default(0),
+	}
+}
+
+// Edges of the PostRevision.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// post_id字段关联posts表，editor_id字段关联users表，关联逻辑在应用层维护
+func (PostRevision) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PostRevision.
+func (PostRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		// 帖子与版本号复合唯一索引，确保版本号不重复
+		index.Fields("post_id", "revision").
+			Unique(),
+		// 编辑者索引，用于查询用户的编辑记录
+		index.Fields("editor_id"),
+	}
+}
+
+// Mixin of the PostRevision.
+func (PostRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PostTag holds the schema definition for the PostTag entity.
+// 帖子标签关联表，用于维护帖子和标签的多对多关系，仅关联规范标签
+type PostTag struct {
+	ent.Schema
+}
+
+// Fields of the PostTag.
+func (PostTag) Fields() []ent.Field {
+	return []ent.Field{
+		// 主键ID
+		field.Int("id").
+			Positive(),
+		// 帖子ID
+		field.Int("post_id").
+			Positive().
+			Comment("帖子ID"),
+		// 标签ID
+		field.Int("tag_id").
+			Positive().
+			Comment("标签ID"),
+	}
+}
+
+// Edges of the PostTag.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// post_id字段关联posts表，tag_id字段关联tags表，关联逻辑在应用层维护
+func (PostTag) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PostTag.
+func (PostTag) Indexes() []ent.Index {
+	return []ent.Index{
+		// 标签索引，用于按标签筛选帖子
+		index.Fields("tag_id"),
+		// 创建复合唯一索引，确保同一帖子的同一标签只有一条记录
+		index.Fields("post_id", "tag_id").
+			Unique(),
+	}
+}
+
+// Mixin of the PostTag.
+func (PostTag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PrivateMessage holds the schema definition for the PrivateMessage entity.
+// 私信会话中的单条消息
+type PrivateMessage struct {
+	ent.Schema
+}
+
+// Fields of the PrivateMessage.
+func (PrivateMessage) Fields() []ent.Field {
+	return []ent.Field{
+		// 消息ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 所属会话ID，关联conversations表
+		field.Int("conversation_id").
+			Positive(),
+		// 发送者ID，关联users表
+		field.Int("sender_id").
+			Positive(),
+		// 接收者ID，关联users表
+		field.Int("receiver_id").
+			Positive(),
+		// 消息内容
+		field.Text("content").
+			MaxLen(2000).
+			NotEmpty(),
+		// 接收者阅读时间，为空表示未读，用于已读回执
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the PrivateMessage.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// conversation_id字段关联conversations表，sender_id与receiver_id字段关联users表，关联逻辑在应用层维护
+func (PrivateMessage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PrivateMessage.
+func (PrivateMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		// 用于按ID倒序分页查询会话消息
+		index.Fields("conversation_id", "id"),
+		// 用于标记接收者的未读消息
+		index.Fields("conversation_id", "receiver_id", "read_at"),
+	}
+}
+
+// Mixin of the PrivateMessage.
+func (PrivateMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Report holds the schema definition for the Report entity.
+// 存储用户对帖子、评论、用户的举报及处理结果
+type Report struct {
+	ent.Schema
+}
+
+// Fields of the Report.
+func (Report) Fields() []ent.Field {
+	return []ent.Field{
+		// 举报记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 举报人用户ID，关联users表
+		field.Int("reporter_id").
+			Positive(),
+		// 举报目标类型：Post、Comment、User
+		field.Enum("target_type").
+			Values("Post", "Comment", "User"),
+		// 举报目标ID
+		field.Int("target_id").
+			Positive(),
+		// 被举报内容的作者ID，举报用户时与target_id相同
+		field.Int("target_user_id").
+			Positive(),
+		// 目标所属版块ID，用于版主按版块处理举报，举报用户时为0
+		field.Int("category_id").
+			NonNegative().
+			Default(0),
+		// 举报原因分类：Spam、Abuse、Porn、Illegal、Infringement、Other
+		field.Enum("reason").
+			Values("Spam", "Abuse", "Porn", "Illegal", "Infringement", "Other"),
+		// 举报补充说明
+		// MaxLen按字节校验，按4字节UTF-8预留，字符数上限（500）由请求参数校验
+		field.String("description").
+			Optional().
+			MaxLen(2000),
+		// 处理状态：Pending、Processing、Resolved、Dismissed
+		field.Enum("status").
+			Values("Pending", "Processing", "Resolved", "Dismissed").
+			Default("Pending"),
+		// 处理人用户ID，认领后写入
+		field.Int("handler_id").
+			Optional(),
+		// 处理动作：None、BanPost、DeleteComment、BanUser
+		field.Enum("action").
+			Values("None", "BanPost", "DeleteComment", "BanUser").
+			Default("None"),
+		// 处理备注
+		// MaxLen按字节校验，按4字节UTF-8预留，字符数上限（500）由请求参数校验
+		field.String("handle_note").
+			Optional().
+			MaxLen(2000),
+		// 处理完成时间
+		field.Time("handled_at").
+			Optional(),
+	}
+}
+
+// Edges of the Report.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// reporter_id、target_user_id、handler_id字段关联users表，但不创建外键约束，关联逻辑在应用层维护
+func (Report) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Report.
+func (Report) Indexes() []ent.Index {
+	return []ent.Index{
+		// 举报人与目标唯一索引，同一用户对同一目标只能举报一次
+		index.Fields("reporter_id", "target_type", "target_id").
+			Unique(),
+		// 目标索引，用于结案时处理同一目标的其他举报
+		index.Fields("target_type", "target_id"),
+		// 版块与状态复合索引，用于版主举报队列
+		index.Fields("category_id", "status"),
+		// 状态索引，用于管理员举报队列
+		index.Fields("status"),
+	}
+}
+
+// Mixin of the Report.
+func (Report) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
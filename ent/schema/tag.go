@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Tag holds the schema definition for the Tag entity.
+// 帖子标签，同义词标签通过canonical_id指向规范标签
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		// 标签ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 标签名称，归一化后全局唯一，字符数上限由应用层校验
+		field.String("name").
+			NotEmpty().
+			MaxLen(128),
+		// 关联帖子数，仅规范标签维护
+		field.Int("post_count").
+			NonNegative().
+			Default(0),
+		// 规范标签ID，0表示本身为规范标签，大于0表示为该标签的同义词
+		field.Int("canonical_id").
+			NonNegative().
+			Default(0),
+	}
+}
+
+// Edges of the Tag.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// canonical_id字段关联tags表，但不创建外键约束，关联逻辑在应用层维护
+func (Tag) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Tag.
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		// 标签名称唯一索引，发帖时按名称查找
+		index.Fields("name").
+			Unique(),
+		// 规范标签与帖子数复合索引，用于热门标签查询
+		index.Fields("canonical_id", "post_count"),
+	}
+}
+
+// Mixin of the Tag.
+func (Tag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
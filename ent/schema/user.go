@@ -43,6 +43,9 @@ func (User) Fields() []ent.Field {
 		// 邮箱是否已验证，默认false
 		field.Bool("email_verified").
 			Default(false),
+		// 被 @提及 时是否发送邮件提醒，默认false
+		field.Bool("mention_email_notify").
+			Default(false),
 		// 经验值，默认为0
 		field.Int("experience").
 			Default(0).
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserBadge holds the schema definition for the UserBadge entity.
+// 用户获得的徽章，用于维护用户和徽章的多对多关系
+type UserBadge struct {
+	ent.Schema
+}
+
+// Fields of the UserBadge.
+func (UserBadge) Fields() []ent.Field {
+	return []ent.Field{
+		// 主键ID
+		field.Int("id").
+			Positive(),
+		// 用户ID
+		field.Int("user_id").
+			Positive().
+			Comment("用户ID"),
+		// 徽章ID
+		field.Int("badge_id").
+			Positive().
+			Comment("徽章ID"),
+		// 获得方式：auto-满足条件自动授予，manual-管理员手动授予
+		field.Enum("source").
+			Values("auto", "manual").
+			Default("auto").
+			Comment("获得方式"),
+		// 授予徽章的管理员ID，自动授予时为0
+		field.Int("granted_by").
+			NonNegative().
+			Default(0).
+			Comment("授予徽章的管理员ID"),
+		// 授予原因
+		field.String("reason").
+			Optional().
+			Comment("授予原因"),
+		// 展示顺序，1-3表示展示在用户名旁的位置，0表示不展示
+		field.Int("display_order").
+			NonNegative().
+			Default(0).
+			Comment("展示顺序"),
+	}
+}
+
+// Edges of the UserBadge.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// 数据完整性由应用层逻辑保证
+func (UserBadge) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserBadge.
+func (UserBadge) Indexes() []ent.Index {
+	return []ent.Index{
+		// 为关联字段创建索引以优化查询性能
+		index.Fields("badge_id"),
+		// 创建复合唯一索引，确保同一用户只能获得一次同一徽章
+		index.Fields("user_id", "badge_id").
+			Unique(),
+	}
+}
+
+// Mixin of the UserBadge.
+func (UserBadge) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// UserGroup holds the schema definition for the UserGroup entity.
+// 用户组，由管理员定义，可作为版块访问控制的授权对象，并为成员授予额外的权限
+type UserGroup struct {
+	ent.Schema
+}
+
+// Fields of the UserGroup.
+func (UserGroup) Fields() []ent.Field {
+	return []ent.Field{
+		// 主键ID
+		field.Int("id").
+			Positive(),
+		// 用户组名称，唯一
+		field.String("name").
+			Unique().
+			NotEmpty().
+			Comment("用户组名称"),
+		// 用户组描述
+		field.String("description").
+			Optional().
+			Comment("用户组描述"),
+		// 用户组权限标识，以"-"开头的标识表示禁止该权限
+		field.JSON("permissions", []string{}).
+			Optional().
+			Comment("用户组权限标识"),
+	}
+}
+
+// Edges of the UserGroup.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// 用户组成员通过中间表在应用层维护
+func (UserGroup) Edges() []ent.Edge {
+	return nil
+}
+
+// Mixin of the UserGroup.
+func (UserGroup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
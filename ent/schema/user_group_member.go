@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserGroupMember holds the schema definition for the UserGroupMember entity.
+// 用户组成员关联表，用于维护用户组和用户的多对多关系
+type UserGroupMember struct {
+	ent.Schema
+}
+
+// Fields of the UserGroupMember.
+func (UserGroupMember) Fields() []ent.Field {
+	return []ent.Field{
+		// 主键ID
+		field.Int("id").
+			Positive(),
+		// 用户组ID
+		field.Int("group_id").
+			Positive().
+			Comment("用户组ID"),
+		// 成员用户ID
+		field.Int("user_id").
+			Positive().
+			Comment("成员用户ID"),
+		// 成员资格过期时间，为空表示永久有效
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("成员资格过期时间"),
+	}
+}
+
+// Edges of the UserGroupMember.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// 数据完整性由应用层逻辑保证
+func (UserGroupMember) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserGroupMember.
+func (UserGroupMember) Indexes() []ent.Index {
+	return []ent.Index{
+		// 为关联字段创建索引以优化查询性能
+		index.Fields("user_id"),
+		// 用于定期清理过期成员
+		index.Fields("expires_at"),
+		// 创建复合唯一索引，确保同一用户在同一用户组中只有一条记录
+		index.Fields("group_id", "user_id").
+			Unique(),
+	}
+}
+
+// Mixin of the UserGroupMember.
+func (UserGroupMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserInvitation holds the schema definition for the UserInvitation entity.
+// 记录邀请人与被邀请人的关系
+type UserInvitation struct {
+	ent.Schema
+}
+
+// Fields of the UserInvitation.
+func (UserInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		// 记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 邀请人用户ID，关联users表
+		field.Int("inviter_id").
+			Positive(),
+		// 被邀请人用户ID，关联users表，每个用户仅有一个邀请人
+		field.Int("invitee_id").
+			Positive(),
+		// 使用的邀请码ID，关联invite_codes表
+		field.Int("invite_code_id").
+			Positive(),
+	}
+}
+
+// Edges of the UserInvitation.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// inviter_id、invitee_id字段关联users表，invite_code_id字段关联invite_codes表，但不创建外键约束，关联逻辑在应用层维护
+func (UserInvitation) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserInvitation.
+func (UserInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		// 被邀请人唯一索引
+		index.Fields("invitee_id").
+			Unique(),
+		// 邀请人索引，用于查询用户邀请的成员
+		index.Fields("inviter_id"),
+		// 邀请码索引，用于查询邀请码的使用记录
+		index.Fields("invite_code_id"),
+	}
+}
+
+// Mixin of the UserInvitation.
+func (UserInvitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
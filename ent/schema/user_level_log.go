@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserLevelLog holds the schema definition for the UserLevelLog entity.
+// 用户等级变动记录，在经验值变动导致等级提升时写入
+type UserLevelLog struct {
+	ent.Schema
+}
+
+// Fields of the UserLevelLog.
+func (UserLevelLog) Fields() []ent.Field {
+	return []ent.Field{
+		// 主键ID
+		field.Int("id").
+			Positive(),
+		// 用户ID，关联到用户表
+		field.Int("user_id").
+			Positive().
+			Comment("用户ID，关联到用户表"),
+		// 变动前等级
+		field.Int("from_level").
+			NonNegative().
+			Comment("变动前等级"),
+		// 变动后等级
+		field.Int("to_level").
+			Positive().
+			Comment("变动后等级"),
+		// 变动后等级名称，等级表调整后仍可追溯
+		field.String("level_name").
+			Optional().
+			Comment("变动后等级名称"),
+		// 变动后经验值
+		field.Int("experience").
+			NonNegative().
+			Comment("变动后经验值"),
+		// 关联的业务类型，如signin
+		field.String("related_type").
+			Optional().
+			Comment("关联的业务类型"),
+	}
+}
+
+// Edges of the UserLevelLog.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// 数据完整性由应用层逻辑保证
+func (UserLevelLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserLevelLog.
+func (UserLevelLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// 为关联字段创建索引以优化查询性能
+		index.Fields("user_id"),
+	}
+}
+
+// Mixin of the UserLevelLog.
+func (UserLevelLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserTwoFactor holds the schema definition for the UserTwoFactor entity.
+// 存储用户的TOTP两步验证配置与恢复码
+type UserTwoFactor struct {
+	ent.Schema
+}
+
+// Fields of the UserTwoFactor.
+func (UserTwoFactor) Fields() []ent.Field {
+	return []ent.Field{
+		// 记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 用户ID，关联users表，每个用户仅一条记录
+		field.Int("user_id").
+			Positive(),
+		// TOTP密钥，Base32编码
+		field.String("secret").
+			NotEmpty().
+			Sensitive(),
+		// 是否已启用
+		field.Bool("enabled").
+			Default(false),
+		// 恢复码哈希列表，每个恢复码仅可使用一次
+		field.JSON("recovery_codes", []string{}).
+			Optional().
+			Sensitive(),
+		// 最后一次通过校验的时间步，用于防止验证码重放
+		field.Int64("last_used_step").
+			Default(0),
+		// 启用时间
+		field.Time("enabled_at").
+			Optional(),
+	}
+}
+
+// Edges of the UserTwoFactor.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// user_id字段关联users表，但不创建外键约束，关联逻辑在应用层维护
+func (UserTwoFactor) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserTwoFactor.
+func (UserTwoFactor) Indexes() []ent.Index {
+	return []ent.Index{
+		// 用户ID唯一索引
+		index.Fields("user_id").
+			Unique(),
+	}
+}
+
+// Mixin of the UserTwoFactor.
+func (UserTwoFactor) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
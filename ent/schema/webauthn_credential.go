@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebAuthnCredential holds the schema definition for the WebAuthnCredential entity.
+// 存储用户注册的WebAuthn/Passkey公钥凭证
+type WebAuthnCredential struct {
+	ent.Schema
+}
+
+// Fields of the WebAuthnCredential.
+func (WebAuthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		// 凭证记录ID，数据库主键自增
+		field.Int("id").
+			Positive(),
+		// 用户ID，关联users表
+		field.Int("user_id").
+			Positive(),
+		// 凭证ID，Base64URL编码，全局唯一
+		field.String("credential_id").
+			NotEmpty(),
+		// 凭证公钥，COSE格式
+		field.Bytes("public_key").
+			NotEmpty(),
+		// 注册时使用的证明格式
+		field.String("attestation_type").
+			Optional(),
+		// 认证器型号标识
+		field.Bytes("aaguid").
+			Optional(),
+		// 签名计数器，用于检测克隆的认证器
+		field.Uint32("sign_count").
+			Default(0),
+		// 认证器支持的传输方式：usb、nfc、ble、internal、hybrid
+		field.JSON("transports", []string{}).
+			Optional(),
+		// 认证器标志位（UP、UV、BE、BS）原始值
+		field.Uint8("flags").
+			Default(0),
+		// 是否检测到克隆警告
+		field.Bool("clone_warning").
+			Default(false),
+		// 凭证名称，由用户自定义，便于区分设备
+		// MaxLen按字节校验，按4字节UTF-8预留，字符数上限（64）由请求参数校验
+		field.String("name").
+			Optional().
+			MaxLen(256),
+		// 最后使用时间
+		field.Time("last_used_at").
+			Optional(),
+	}
+}
+
+// Edges of the WebAuthnCredential.
+// 注意: 所有关联关系仅用于ORM查询，不会在数据库层面创建外键
+// user_id字段关联users表，但不创建外键约束，关联逻辑在应用层维护
+func (WebAuthnCredential) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WebAuthnCredential.
+func (WebAuthnCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		// 凭证ID唯一索引，登录时按凭证ID查找
+		index.Fields("credential_id").
+			Unique(),
+		// 用户ID索引，用于查询用户的所有凭证
+		index.Fields("user_id"),
+	}
+}
+
+// Mixin of the WebAuthnCredential.
+func (WebAuthnCredential) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
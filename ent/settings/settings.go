@@ -76,6 +76,8 @@ const (
 	ModuleSecurity Module = "Security"
 	ModuleFunction Module = "Function"
 	ModuleSignin   Module = "Signin"
+	ModuleUpload   Module = "Upload"
+	ModuleLevel    Module = "Level"
 )
 
 func (m Module) String() string {
@@ -85,7 +87,7 @@ func (m Module) String() string {
 // ModuleValidator is a validator for the "module" field enum values. It is called by the builders before save.
 func ModuleValidator(m Module) error {
 	switch m {
-	case ModuleSite, ModuleHomePage, ModuleComment, ModuleSeo, ModuleSecurity, ModuleFunction, ModuleSignin:
+	case ModuleSite, ModuleHomePage, ModuleComment, ModuleSeo, ModuleSecurity, ModuleFunction, ModuleSignin, ModuleUpload, ModuleLevel:
 		return nil
 	default:
 		return fmt.Errorf("settings: invalid enum value for module field: %q", m)
@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/tag"
+)
+
+// TagUpdate is the builder for updating Tag entities.
+type TagUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TagMutation
+}
+
+// Where appends a list predicates to the TagUpdate builder.
+func (_u *TagUpdate) Where(ps ...predicate.Tag) *TagUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TagUpdate) SetUpdatedAt(v time.Time) *TagUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TagUpdate) SetName(v string) *TagUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableName(v *string) *TagUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPostCount sets the "post_count" field.
+func (_u *TagUpdate) SetPostCount(v int) *TagUpdate {
+	_u.mutation.ResetPostCount()
+	_u.mutation.SetPostCount(v)
+	return _u
+}
+
+// SetNillablePostCount sets the "post_count" field if the given value is not nil.
+func (_u *TagUpdate) SetNillablePostCount(v *int) *TagUpdate {
+	if v != nil {
+		_u.SetPostCount(*v)
+	}
+	return _u
+}
+
+// AddPostCount adds value to the "post_count" field.
+func (_u *TagUpdate) AddPostCount(v int) *TagUpdate {
+	_u.mutation.AddPostCount(v)
+	return _u
+}
+
+// SetCanonicalID sets the "canonical_id" field.
+func (_u *TagUpdate) SetCanonicalID(v int) *TagUpdate {
+	_u.mutation.ResetCanonicalID()
+	_u.mutation.SetCanonicalID(v)
+	return _u
+}
+
+// SetNillableCanonicalID sets the "canonical_id" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableCanonicalID(v *int) *TagUpdate {
+	if v != nil {
+		_u.SetCanonicalID(*v)
+	}
+	return _u
+}
+
+// AddCanonicalID adds value to the "canonical_id" field.
+func (_u *TagUpdate) AddCanonicalID(v int) *TagUpdate {
+	_u.mutation.AddCanonicalID(v)
+	return _u
+}
+
+// Mutation returns the TagMutation object of the builder.
+func (_u *TagUpdate) Mutation() *TagMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TagUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TagUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TagUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tag.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tag.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostCount(); ok {
+		if err := tag.PostCountValidator(v); err != nil {
+			return &ValidationError{Name: "post_count", err: fmt.Errorf(`ent: validator failed for field "Tag.post_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CanonicalID(); ok {
+		if err := tag.CanonicalIDValidator(v); err != nil {
+			return &ValidationError{Name: "canonical_id", err: fmt.Errorf(`ent: validator failed for field "Tag.canonical_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tag.Table, tag.Columns, sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tag.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PostCount(); ok {
+		_spec.SetField(tag.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostCount(); ok {
+		_spec.AddField(tag.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CanonicalID(); ok {
+		_spec.SetField(tag.FieldCanonicalID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCanonicalID(); ok {
+		_spec.AddField(tag.FieldCanonicalID, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TagUpdateOne is the builder for updating a single Tag entity.
+type TagUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TagMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TagUpdateOne) SetUpdatedAt(v time.Time) *TagUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TagUpdateOne) SetName(v string) *TagUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableName(v *string) *TagUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPostCount sets the "post_count" field.
+func (_u *TagUpdateOne) SetPostCount(v int) *TagUpdateOne {
+	_u.mutation.ResetPostCount()
+	_u.mutation.SetPostCount(v)
+	return _u
+}
+
+// SetNillablePostCount sets the "post_count" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillablePostCount(v *int) *TagUpdateOne {
+	if v != nil {
+		_u.SetPostCount(*v)
+	}
+	return _u
+}
+
+// AddPostCount adds value to the "post_count" field.
+func (_u *TagUpdateOne) AddPostCount(v int) *TagUpdateOne {
+	_u.mutation.AddPostCount(v)
+	return _u
+}
+
+// SetCanonicalID sets the "canonical_id" field.
+func (_u *TagUpdateOne) SetCanonicalID(v int) *TagUpdateOne {
+	_u.mutation.ResetCanonicalID()
+	_u.mutation.SetCanonicalID(v)
+	return _u
+}
+
+// SetNillableCanonicalID sets the "canonical_id" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableCanonicalID(v *int) *TagUpdateOne {
+	if v != nil {
+		_u.SetCanonicalID(*v)
+	}
+	return _u
+}
+
+// AddCanonicalID adds value to the "canonical_id" field.
+func (_u *TagUpdateOne) AddCanonicalID(v int) *TagUpdateOne {
+	_u.mutation.AddCanonicalID(v)
+	return _u
+}
+
+// Mutation returns the TagMutation object of the builder.
+func (_u *TagUpdateOne) Mutation() *TagMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TagUpdate builder.
+func (_u *TagUpdateOne) Where(ps ...predicate.Tag) *TagUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TagUpdateOne) Select(field string, fields ...string) *TagUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Tag entity.
+func (_u *TagUpdateOne) Save(ctx context.Context) (*Tag, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagUpdateOne) SaveX(ctx context.Context) *Tag {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TagUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TagUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tag.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tag.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostCount(); ok {
+		if err := tag.PostCountValidator(v); err != nil {
+			return &ValidationError{Name: "post_count", err: fmt.Errorf(`ent: validator failed for field "Tag.post_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CanonicalID(); ok {
+		if err := tag.CanonicalIDValidator(v); err != nil {
+			return &ValidationError{Name: "canonical_id", err: fmt.Errorf(`ent: validator failed for field "Tag.canonical_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tag.Table, tag.Columns, sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tag.FieldID)
+		for _, f := range fields {
+			if !tag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tag.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PostCount(); ok {
+		_spec.SetField(tag.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPostCount(); ok {
+		_spec.AddField(tag.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CanonicalID(); ok {
+		_spec.SetField(tag.FieldCanonicalID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCanonicalID(); ok {
+		_spec.AddField(tag.FieldCanonicalID, field.TypeInt, value)
+	}
+	_node = &Tag{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
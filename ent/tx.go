@@ -12,28 +12,68 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// Badge is the client for interacting with the Badge builders.
+	Badge *BadgeClient
 	// Blacklist is the client for interacting with the Blacklist builders.
 	Blacklist *BlacklistClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// CategoryModerator is the client for interacting with the CategoryModerator builders.
 	CategoryModerator *CategoryModeratorClient
+	// CategoryPermission is the client for interacting with the CategoryPermission builders.
+	CategoryPermission *CategoryPermissionClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
 	// CommentAction is the client for interacting with the CommentAction builders.
 	CommentAction *CommentActionClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
+	// Follow is the client for interacting with the Follow builders.
+	Follow *FollowClient
+	// InviteCode is the client for interacting with the InviteCode builders.
+	InviteCode *InviteCodeClient
+	// Mention is the client for interacting with the Mention builders.
+	Mention *MentionClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
 	// OAuthProvider is the client for interacting with the OAuthProvider builders.
 	OAuthProvider *OAuthProviderClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostAction is the client for interacting with the PostAction builders.
 	PostAction *PostActionClient
+	// PostPurchase is the client for interacting with the PostPurchase builders.
+	PostPurchase *PostPurchaseClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// PostTag is the client for interacting with the PostTag builders.
+	PostTag *PostTagClient
+	// PrivateMessage is the client for interacting with the PrivateMessage builders.
+	PrivateMessage *PrivateMessageClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserBadge is the client for interacting with the UserBadge builders.
+	UserBadge *UserBadgeClient
 	// UserBalanceLog is the client for interacting with the UserBalanceLog builders.
 	UserBalanceLog *UserBalanceLogClient
+	// UserGroup is the client for interacting with the UserGroup builders.
+	UserGroup *UserGroupClient
+	// UserGroupMember is the client for interacting with the UserGroupMember builders.
+	UserGroupMember *UserGroupMemberClient
+	// UserInvitation is the client for interacting with the UserInvitation builders.
+	UserInvitation *UserInvitationClient
+	// UserLevelLog is the client for interacting with the UserLevelLog builders.
+	UserLevelLog *UserLevelLogClient
 	// UserLoginLog is the client for interacting with the UserLoginLog builders.
 	UserLoginLog *UserLoginLogClient
 	// UserOAuth is the client for interacting with the UserOAuth builders.
@@ -42,6 +82,10 @@ type Tx struct {
 	UserSigninLogs *UserSigninLogsClient
 	// UserSigninStatus is the client for interacting with the UserSigninStatus builders.
 	UserSigninStatus *UserSigninStatusClient
+	// UserTwoFactor is the client for interacting with the UserTwoFactor builders.
+	UserTwoFactor *UserTwoFactorClient
+	// WebAuthnCredential is the client for interacting with the WebAuthnCredential builders.
+	WebAuthnCredential *WebAuthnCredentialClient
 
 	// lazily loaded.
 	client     *Client
@@ -173,21 +217,43 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.Badge = NewBadgeClient(tx.config)
 	tx.Blacklist = NewBlacklistClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
 	tx.CategoryModerator = NewCategoryModeratorClient(tx.config)
+	tx.CategoryPermission = NewCategoryPermissionClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
 	tx.CommentAction = NewCommentActionClient(tx.config)
+	tx.Conversation = NewConversationClient(tx.config)
+	tx.Follow = NewFollowClient(tx.config)
+	tx.InviteCode = NewInviteCodeClient(tx.config)
+	tx.Mention = NewMentionClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
 	tx.OAuthProvider = NewOAuthProviderClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
 	tx.PostAction = NewPostActionClient(tx.config)
+	tx.PostPurchase = NewPostPurchaseClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
+	tx.PostTag = NewPostTagClient(tx.config)
+	tx.PrivateMessage = NewPrivateMessageClient(tx.config)
+	tx.Report = NewReportClient(tx.config)
 	tx.Settings = NewSettingsClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserBadge = NewUserBadgeClient(tx.config)
 	tx.UserBalanceLog = NewUserBalanceLogClient(tx.config)
+	tx.UserGroup = NewUserGroupClient(tx.config)
+	tx.UserGroupMember = NewUserGroupMemberClient(tx.config)
+	tx.UserInvitation = NewUserInvitationClient(tx.config)
+	tx.UserLevelLog = NewUserLevelLogClient(tx.config)
 	tx.UserLoginLog = NewUserLoginLogClient(tx.config)
 	tx.UserOAuth = NewUserOAuthClient(tx.config)
 	tx.UserSigninLogs = NewUserSigninLogsClient(tx.config)
 	tx.UserSigninStatus = NewUserSigninStatusClient(tx.config)
+	tx.UserTwoFactor = NewUserTwoFactorClient(tx.config)
+	tx.WebAuthnCredential = NewWebAuthnCredentialClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -197,7 +263,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Blacklist.QueryXXX(), the query will be executed
+// applies a query, for example: Attachment.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
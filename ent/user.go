@@ -37,6 +37,8 @@ type User struct {
 	Readme string `json:"readme,omitempty"`
 	// EmailVerified holds the value of the "email_verified" field.
 	EmailVerified bool `json:"email_verified,omitempty"`
+	// MentionEmailNotify holds the value of the "mention_email_notify" field.
+	MentionEmailNotify bool `json:"mention_email_notify,omitempty"`
 	// Experience holds the value of the "experience" field.
 	Experience int `json:"experience,omitempty"`
 	// Points holds the value of the "points" field.
@@ -55,7 +57,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldEmailVerified:
+		case user.FieldEmailVerified, user.FieldMentionEmailNotify:
 			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldExperience, user.FieldPoints, user.FieldCurrency:
 			values[i] = new(sql.NullInt64)
@@ -144,6 +146,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EmailVerified = value.Bool
 			}
+		case user.FieldMentionEmailNotify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mention_email_notify", values[i])
+			} else if value.Valid {
+				_m.MentionEmailNotify = value.Bool
+			}
 		case user.FieldExperience:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field experience", values[i])
@@ -240,6 +248,9 @@ func (_m *User) String() string {
 	builder.WriteString("email_verified=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EmailVerified))
 	builder.WriteString(", ")
+	builder.WriteString("mention_email_notify=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MentionEmailNotify))
+	builder.WriteString(", ")
 	builder.WriteString("experience=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Experience))
 	builder.WriteString(", ")
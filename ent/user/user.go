@@ -34,6 +34,8 @@ const (
 	FieldReadme = "readme"
 	// FieldEmailVerified holds the string denoting the email_verified field in the database.
 	FieldEmailVerified = "email_verified"
+	// FieldMentionEmailNotify holds the string denoting the mention_email_notify field in the database.
+	FieldMentionEmailNotify = "mention_email_notify"
 	// FieldExperience holds the string denoting the experience field in the database.
 	FieldExperience = "experience"
 	// FieldPoints holds the string denoting the points field in the database.
@@ -61,6 +63,7 @@ var Columns = []string{
 	FieldSignature,
 	FieldReadme,
 	FieldEmailVerified,
+	FieldMentionEmailNotify,
 	FieldExperience,
 	FieldPoints,
 	FieldCurrency,
@@ -95,6 +98,8 @@ var (
 	UsernameValidator func(string) error
 	// DefaultEmailVerified holds the default value on creation for the "email_verified" field.
 	DefaultEmailVerified bool
+	// DefaultMentionEmailNotify holds the default value on creation for the "mention_email_notify" field.
+	DefaultMentionEmailNotify bool
 	// DefaultExperience holds the default value on creation for the "experience" field.
 	DefaultExperience int
 	// ExperienceValidator is a validator for the "experience" field. It is called by the builders before save.
@@ -225,6 +230,11 @@ func ByEmailVerified(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmailVerified, opts...).ToFunc()
 }
 
+// ByMentionEmailNotify orders the results by the mention_email_notify field.
+func ByMentionEmailNotify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMentionEmailNotify, opts...).ToFunc()
+}
+
 // ByExperience orders the results by the experience field.
 func ByExperience(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExperience, opts...).ToFunc()
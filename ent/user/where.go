@@ -104,6 +104,11 @@ func EmailVerified(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmailVerified, v))
 }
 
+// MentionEmailNotify applies equality check predicate on the "mention_email_notify" field. It's identical to MentionEmailNotifyEQ.
+func MentionEmailNotify(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMentionEmailNotify, v))
+}
+
 // Experience applies equality check predicate on the "experience" field. It's identical to ExperienceEQ.
 func Experience(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldExperience, v))
@@ -694,6 +699,16 @@ func EmailVerifiedNEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldNEQ(FieldEmailVerified, v))
 }
 
+// MentionEmailNotifyEQ applies the EQ predicate on the "mention_email_notify" field.
+func MentionEmailNotifyEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMentionEmailNotify, v))
+}
+
+// MentionEmailNotifyNEQ applies the NEQ predicate on the "mention_email_notify" field.
+func MentionEmailNotifyNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMentionEmailNotify, v))
+}
+
 // ExperienceEQ applies the EQ predicate on the "experience" field.
 func ExperienceEQ(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldExperience, v))
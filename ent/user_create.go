@@ -128,6 +128,20 @@ func (_c *UserCreate) SetNillableEmailVerified(v *bool) *UserCreate {
 	return _c
 }
 
+// SetMentionEmailNotify sets the "mention_email_notify" field.
+func (_c *UserCreate) SetMentionEmailNotify(v bool) *UserCreate {
+	_c.mutation.SetMentionEmailNotify(v)
+	return _c
+}
+
+// SetNillableMentionEmailNotify sets the "mention_email_notify" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMentionEmailNotify(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetMentionEmailNotify(*v)
+	}
+	return _c
+}
+
 // SetExperience sets the "experience" field.
 func (_c *UserCreate) SetExperience(v int) *UserCreate {
 	_c.mutation.SetExperience(v)
@@ -251,6 +265,10 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultEmailVerified
 		_c.mutation.SetEmailVerified(v)
 	}
+	if _, ok := _c.mutation.MentionEmailNotify(); !ok {
+		v := user.DefaultMentionEmailNotify
+		_c.mutation.SetMentionEmailNotify(v)
+	}
 	if _, ok := _c.mutation.Experience(); !ok {
 		v := user.DefaultExperience
 		_c.mutation.SetExperience(v)
@@ -316,6 +334,9 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.EmailVerified(); !ok {
 		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "User.email_verified"`)}
 	}
+	if _, ok := _c.mutation.MentionEmailNotify(); !ok {
+		return &ValidationError{Name: "mention_email_notify", err: errors.New(`ent: missing required field "User.mention_email_notify"`)}
+	}
 	if _, ok := _c.mutation.Experience(); !ok {
 		return &ValidationError{Name: "experience", err: errors.New(`ent: missing required field "User.experience"`)}
 	}
@@ -433,6 +454,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
 		_node.EmailVerified = value
 	}
+	if value, ok := _c.mutation.MentionEmailNotify(); ok {
+		_spec.SetField(user.FieldMentionEmailNotify, field.TypeBool, value)
+		_node.MentionEmailNotify = value
+	}
 	if value, ok := _c.mutation.Experience(); ok {
 		_spec.SetField(user.FieldExperience, field.TypeInt, value)
 		_node.Experience = value
@@ -164,6 +164,20 @@ func (_u *UserUpdate) SetNillableEmailVerified(v *bool) *UserUpdate {
 	return _u
 }
 
+// SetMentionEmailNotify sets the "mention_email_notify" field.
+func (_u *UserUpdate) SetMentionEmailNotify(v bool) *UserUpdate {
+	_u.mutation.SetMentionEmailNotify(v)
+	return _u
+}
+
+// SetNillableMentionEmailNotify sets the "mention_email_notify" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMentionEmailNotify(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetMentionEmailNotify(*v)
+	}
+	return _u
+}
+
 // SetExperience sets the "experience" field.
 func (_u *UserUpdate) SetExperience(v int) *UserUpdate {
 	_u.mutation.ResetExperience()
@@ -394,6 +408,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.EmailVerified(); ok {
 		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.MentionEmailNotify(); ok {
+		_spec.SetField(user.FieldMentionEmailNotify, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Experience(); ok {
 		_spec.SetField(user.FieldExperience, field.TypeInt, value)
 	}
@@ -574,6 +591,20 @@ func (_u *UserUpdateOne) SetNillableEmailVerified(v *bool) *UserUpdateOne {
 	return _u
 }
 
+// SetMentionEmailNotify sets the "mention_email_notify" field.
+func (_u *UserUpdateOne) SetMentionEmailNotify(v bool) *UserUpdateOne {
+	_u.mutation.SetMentionEmailNotify(v)
+	return _u
+}
+
+// SetNillableMentionEmailNotify sets the "mention_email_notify" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMentionEmailNotify(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetMentionEmailNotify(*v)
+	}
+	return _u
+}
+
 // SetExperience sets the "experience" field.
 func (_u *UserUpdateOne) SetExperience(v int) *UserUpdateOne {
 	_u.mutation.ResetExperience()
@@ -834,6 +865,9 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.EmailVerified(); ok {
 		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.MentionEmailNotify(); ok {
+		_spec.SetField(user.FieldMentionEmailNotify, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Experience(); ok {
 		_spec.SetField(user.FieldExperience, field.TypeInt, value)
 	}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/userbadge"
+)
+
+// UserBadge is the model entity for the UserBadge schema.
+type UserBadge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 用户ID
+	UserID int `json:"user_id,omitempty"`
+	// 徽章ID
+	BadgeID int `json:"badge_id,omitempty"`
+	// 获得方式
+	Source userbadge.Source `json:"source,omitempty"`
+	// 授予徽章的管理员ID
+	GrantedBy int `json:"granted_by,omitempty"`
+	// 授予原因
+	Reason string `json:"reason,omitempty"`
+	// 展示顺序
+	DisplayOrder int `json:"display_order,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserBadge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userbadge.FieldID, userbadge.FieldUserID, userbadge.FieldBadgeID, userbadge.FieldGrantedBy, userbadge.FieldDisplayOrder:
+			values[i] = new(sql.NullInt64)
+		case userbadge.FieldSource, userbadge.FieldReason:
+			values[i] = new(sql.NullString)
+		case userbadge.FieldCreatedAt, userbadge.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserBadge fields.
+func (_m *UserBadge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userbadge.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case userbadge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case userbadge.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case userbadge.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case userbadge.FieldBadgeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field badge_id", values[i])
+			} else if value.Valid {
+				_m.BadgeID = int(value.Int64)
+			}
+		case userbadge.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = userbadge.Source(value.String)
+			}
+		case userbadge.FieldGrantedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_by", values[i])
+			} else if value.Valid {
+				_m.GrantedBy = int(value.Int64)
+			}
+		case userbadge.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case userbadge.FieldDisplayOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field display_order", values[i])
+			} else if value.Valid {
+				_m.DisplayOrder = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserBadge.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserBadge) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserBadge.
+// Note that you need to call UserBadge.Unwrap() before calling this method if this UserBadge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserBadge) Update() *UserBadgeUpdateOne {
+	return NewUserBadgeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserBadge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserBadge) Unwrap() *UserBadge {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserBadge is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserBadge) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserBadge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("badge_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BadgeID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Source))
+	builder.WriteString(", ")
+	builder.WriteString("granted_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrantedBy))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("display_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisplayOrder))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserBadges is a parsable slice of UserBadge.
+type UserBadges []*UserBadge
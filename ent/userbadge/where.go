@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userbadge
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldUserID, v))
+}
+
+// BadgeID applies equality check predicate on the "badge_id" field. It's identical to BadgeIDEQ.
+func BadgeID(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldBadgeID, v))
+}
+
+// GrantedBy applies equality check predicate on the "granted_by" field. It's identical to GrantedByEQ.
+func GrantedBy(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldReason, v))
+}
+
+// DisplayOrder applies equality check predicate on the "display_order" field. It's identical to DisplayOrderEQ.
+func DisplayOrder(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLTE(FieldUserID, v))
+}
+
+// BadgeIDEQ applies the EQ predicate on the "badge_id" field.
+func BadgeIDEQ(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldBadgeID, v))
+}
+
+// BadgeIDNEQ applies the NEQ predicate on the "badge_id" field.
+func BadgeIDNEQ(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNEQ(FieldBadgeID, v))
+}
+
+// BadgeIDIn applies the In predicate on the "badge_id" field.
+func BadgeIDIn(vs ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIn(FieldBadgeID, vs...))
+}
+
+// BadgeIDNotIn applies the NotIn predicate on the "badge_id" field.
+func BadgeIDNotIn(vs ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotIn(FieldBadgeID, vs...))
+}
+
+// BadgeIDGT applies the GT predicate on the "badge_id" field.
+func BadgeIDGT(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGT(FieldBadgeID, v))
+}
+
+// BadgeIDGTE applies the GTE predicate on the "badge_id" field.
+func BadgeIDGTE(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGTE(FieldBadgeID, v))
+}
+
+// BadgeIDLT applies the LT predicate on the "badge_id" field.
+func BadgeIDLT(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLT(FieldBadgeID, v))
+}
+
+// BadgeIDLTE applies the LTE predicate on the "badge_id" field.
+func BadgeIDLTE(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLTE(FieldBadgeID, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// GrantedByEQ applies the EQ predicate on the "granted_by" field.
+func GrantedByEQ(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// GrantedByNEQ applies the NEQ predicate on the "granted_by" field.
+func GrantedByNEQ(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNEQ(FieldGrantedBy, v))
+}
+
+// GrantedByIn applies the In predicate on the "granted_by" field.
+func GrantedByIn(vs ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByNotIn applies the NotIn predicate on the "granted_by" field.
+func GrantedByNotIn(vs ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByGT applies the GT predicate on the "granted_by" field.
+func GrantedByGT(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGT(FieldGrantedBy, v))
+}
+
+// GrantedByGTE applies the GTE predicate on the "granted_by" field.
+func GrantedByGTE(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGTE(FieldGrantedBy, v))
+}
+
+// GrantedByLT applies the LT predicate on the "granted_by" field.
+func GrantedByLT(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLT(FieldGrantedBy, v))
+}
+
+// GrantedByLTE applies the LTE predicate on the "granted_by" field.
+func GrantedByLTE(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLTE(FieldGrantedBy, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldContainsFold(FieldReason, v))
+}
+
+// DisplayOrderEQ applies the EQ predicate on the "display_order" field.
+func DisplayOrderEQ(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderNEQ applies the NEQ predicate on the "display_order" field.
+func DisplayOrderNEQ(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNEQ(FieldDisplayOrder, v))
+}
+
+// DisplayOrderIn applies the In predicate on the "display_order" field.
+func DisplayOrderIn(vs ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderNotIn applies the NotIn predicate on the "display_order" field.
+func DisplayOrderNotIn(vs ...int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldNotIn(FieldDisplayOrder, vs...))
+}
+
+// DisplayOrderGT applies the GT predicate on the "display_order" field.
+func DisplayOrderGT(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderGTE applies the GTE predicate on the "display_order" field.
+func DisplayOrderGTE(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldGTE(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLT applies the LT predicate on the "display_order" field.
+func DisplayOrderLT(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLT(FieldDisplayOrder, v))
+}
+
+// DisplayOrderLTE applies the LTE predicate on the "display_order" field.
+func DisplayOrderLTE(v int) predicate.UserBadge {
+	return predicate.UserBadge(sql.FieldLTE(FieldDisplayOrder, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserBadge) predicate.UserBadge {
+	return predicate.UserBadge(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserBadge) predicate.UserBadge {
+	return predicate.UserBadge(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserBadge) predicate.UserBadge {
+	return predicate.UserBadge(sql.NotPredicates(p))
+}
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/userbadge"
+)
+
+// UserBadgeCreate is the builder for creating a UserBadge entity.
+type UserBadgeCreate struct {
+	config
+	mutation *UserBadgeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserBadgeCreate) SetCreatedAt(v time.Time) *UserBadgeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserBadgeCreate) SetNillableCreatedAt(v *time.Time) *UserBadgeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserBadgeCreate) SetUpdatedAt(v time.Time) *UserBadgeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserBadgeCreate) SetNillableUpdatedAt(v *time.Time) *UserBadgeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserBadgeCreate) SetUserID(v int) *UserBadgeCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetBadgeID sets the "badge_id" field.
+func (_c *UserBadgeCreate) SetBadgeID(v int) *UserBadgeCreate {
+	_c.mutation.SetBadgeID(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *UserBadgeCreate) SetSource(v userbadge.Source) *UserBadgeCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *UserBadgeCreate) SetNillableSource(v *userbadge.Source) *UserBadgeCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_c *UserBadgeCreate) SetGrantedBy(v int) *UserBadgeCreate {
+	_c.mutation.SetGrantedBy(v)
+	return _c
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_c *UserBadgeCreate) SetNillableGrantedBy(v *int) *UserBadgeCreate {
+	if v != nil {
+		_c.SetGrantedBy(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *UserBadgeCreate) SetReason(v string) *UserBadgeCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *UserBadgeCreate) SetNillableReason(v *string) *UserBadgeCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_c *UserBadgeCreate) SetDisplayOrder(v int) *UserBadgeCreate {
+	_c.mutation.SetDisplayOrder(v)
+	return _c
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_c *UserBadgeCreate) SetNillableDisplayOrder(v *int) *UserBadgeCreate {
+	if v != nil {
+		_c.SetDisplayOrder(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserBadgeCreate) SetID(v int) *UserBadgeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UserBadgeMutation object of the builder.
+func (_c *UserBadgeCreate) Mutation() *UserBadgeMutation {
+	return _c.mutation
+}
+
+// Save creates the UserBadge in the database.
+func (_c *UserBadgeCreate) Save(ctx context.Context) (*UserBadge, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserBadgeCreate) SaveX(ctx context.Context) *UserBadge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserBadgeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserBadgeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserBadgeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userbadge.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := userbadge.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := userbadge.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.GrantedBy(); !ok {
+		v := userbadge.DefaultGrantedBy
+		_c.mutation.SetGrantedBy(v)
+	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		v := userbadge.DefaultDisplayOrder
+		_c.mutation.SetDisplayOrder(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserBadgeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserBadge.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserBadge.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserBadge.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := userbadge.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserBadge.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BadgeID(); !ok {
+		return &ValidationError{Name: "badge_id", err: errors.New(`ent: missing required field "UserBadge.badge_id"`)}
+	}
+	if v, ok := _c.mutation.BadgeID(); ok {
+		if err := userbadge.BadgeIDValidator(v); err != nil {
+			return &ValidationError{Name: "badge_id", err: fmt.Errorf(`ent: validator failed for field "UserBadge.badge_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "UserBadge.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := userbadge.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "UserBadge.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GrantedBy(); !ok {
+		return &ValidationError{Name: "granted_by", err: errors.New(`ent: missing required field "UserBadge.granted_by"`)}
+	}
+	if v, ok := _c.mutation.GrantedBy(); ok {
+		if err := userbadge.GrantedByValidator(v); err != nil {
+			return &ValidationError{Name: "granted_by", err: fmt.Errorf(`ent: validator failed for field "UserBadge.granted_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DisplayOrder(); !ok {
+		return &ValidationError{Name: "display_order", err: errors.New(`ent: missing required field "UserBadge.display_order"`)}
+	}
+	if v, ok := _c.mutation.DisplayOrder(); ok {
+		if err := userbadge.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "UserBadge.display_order": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := userbadge.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "UserBadge.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UserBadgeCreate) sqlSave(ctx context.Context) (*UserBadge, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserBadgeCreate) createSpec() (*UserBadge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserBadge{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userbadge.Table, sqlgraph.NewFieldSpec(userbadge.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userbadge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(userbadge.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(userbadge.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.BadgeID(); ok {
+		_spec.SetField(userbadge.FieldBadgeID, field.TypeInt, value)
+		_node.BadgeID = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(userbadge.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.GrantedBy(); ok {
+		_spec.SetField(userbadge.FieldGrantedBy, field.TypeInt, value)
+		_node.GrantedBy = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(userbadge.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.DisplayOrder(); ok {
+		_spec.SetField(userbadge.FieldDisplayOrder, field.TypeInt, value)
+		_node.DisplayOrder = value
+	}
+	return _node, _spec
+}
+
+// UserBadgeCreateBulk is the builder for creating many UserBadge entities in bulk.
+type UserBadgeCreateBulk struct {
+	config
+	err      error
+	builders []*UserBadgeCreate
+}
+
+// Save creates the UserBadge entities in the database.
+func (_c *UserBadgeCreateBulk) Save(ctx context.Context) ([]*UserBadge, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserBadge, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserBadgeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserBadgeCreateBulk) SaveX(ctx context.Context) []*UserBadge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserBadgeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserBadgeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
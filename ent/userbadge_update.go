@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/userbadge"
+)
+
+// UserBadgeUpdate is the builder for updating UserBadge entities.
+type UserBadgeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserBadgeMutation
+}
+
+// Where appends a list predicates to the UserBadgeUpdate builder.
+func (_u *UserBadgeUpdate) Where(ps ...predicate.UserBadge) *UserBadgeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserBadgeUpdate) SetUpdatedAt(v time.Time) *UserBadgeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserBadgeUpdate) SetUserID(v int) *UserBadgeUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserBadgeUpdate) SetNillableUserID(v *int) *UserBadgeUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserBadgeUpdate) AddUserID(v int) *UserBadgeUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetBadgeID sets the "badge_id" field.
+func (_u *UserBadgeUpdate) SetBadgeID(v int) *UserBadgeUpdate {
+	_u.mutation.ResetBadgeID()
+	_u.mutation.SetBadgeID(v)
+	return _u
+}
+
+// SetNillableBadgeID sets the "badge_id" field if the given value is not nil.
+func (_u *UserBadgeUpdate) SetNillableBadgeID(v *int) *UserBadgeUpdate {
+	if v != nil {
+		_u.SetBadgeID(*v)
+	}
+	return _u
+}
+
+// AddBadgeID adds value to the "badge_id" field.
+func (_u *UserBadgeUpdate) AddBadgeID(v int) *UserBadgeUpdate {
+	_u.mutation.AddBadgeID(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *UserBadgeUpdate) SetSource(v userbadge.Source) *UserBadgeUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *UserBadgeUpdate) SetNillableSource(v *userbadge.Source) *UserBadgeUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *UserBadgeUpdate) SetGrantedBy(v int) *UserBadgeUpdate {
+	_u.mutation.ResetGrantedBy()
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *UserBadgeUpdate) SetNillableGrantedBy(v *int) *UserBadgeUpdate {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// AddGrantedBy adds value to the "granted_by" field.
+func (_u *UserBadgeUpdate) AddGrantedBy(v int) *UserBadgeUpdate {
+	_u.mutation.AddGrantedBy(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *UserBadgeUpdate) SetReason(v string) *UserBadgeUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *UserBadgeUpdate) SetNillableReason(v *string) *UserBadgeUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *UserBadgeUpdate) ClearReason() *UserBadgeUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_u *UserBadgeUpdate) SetDisplayOrder(v int) *UserBadgeUpdate {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *UserBadgeUpdate) SetNillableDisplayOrder(v *int) *UserBadgeUpdate {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *UserBadgeUpdate) AddDisplayOrder(v int) *UserBadgeUpdate {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// Mutation returns the UserBadgeMutation object of the builder.
+func (_u *UserBadgeUpdate) Mutation() *UserBadgeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserBadgeUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserBadgeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserBadgeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserBadgeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserBadgeUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userbadge.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserBadgeUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := userbadge.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserBadge.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BadgeID(); ok {
+		if err := userbadge.BadgeIDValidator(v); err != nil {
+			return &ValidationError{Name: "badge_id", err: fmt.Errorf(`ent: validator failed for field "UserBadge.badge_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := userbadge.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "UserBadge.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GrantedBy(); ok {
+		if err := userbadge.GrantedByValidator(v); err != nil {
+			return &ValidationError{Name: "granted_by", err: fmt.Errorf(`ent: validator failed for field "UserBadge.granted_by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := userbadge.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "UserBadge.display_order": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserBadgeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userbadge.Table, userbadge.Columns, sqlgraph.NewFieldSpec(userbadge.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userbadge.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userbadge.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(userbadge.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BadgeID(); ok {
+		_spec.SetField(userbadge.FieldBadgeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBadgeID(); ok {
+		_spec.AddField(userbadge.FieldBadgeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(userbadge.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(userbadge.FieldGrantedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGrantedBy(); ok {
+		_spec.AddField(userbadge.FieldGrantedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(userbadge.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(userbadge.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(userbadge.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(userbadge.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userbadge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserBadgeUpdateOne is the builder for updating a single UserBadge entity.
+type UserBadgeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserBadgeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserBadgeUpdateOne) SetUpdatedAt(v time.Time) *UserBadgeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserBadgeUpdateOne) SetUserID(v int) *UserBadgeUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserBadgeUpdateOne) SetNillableUserID(v *int) *UserBadgeUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserBadgeUpdateOne) AddUserID(v int) *UserBadgeUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetBadgeID sets the "badge_id" field.
+func (_u *UserBadgeUpdateOne) SetBadgeID(v int) *UserBadgeUpdateOne {
+	_u.mutation.ResetBadgeID()
+	_u.mutation.SetBadgeID(v)
+	return _u
+}
+
+// SetNillableBadgeID sets the "badge_id" field if the given value is not nil.
+func (_u *UserBadgeUpdateOne) SetNillableBadgeID(v *int) *UserBadgeUpdateOne {
+	if v != nil {
+		_u.SetBadgeID(*v)
+	}
+	return _u
+}
+
+// AddBadgeID adds value to the "badge_id" field.
+func (_u *UserBadgeUpdateOne) AddBadgeID(v int) *UserBadgeUpdateOne {
+	_u.mutation.AddBadgeID(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *UserBadgeUpdateOne) SetSource(v userbadge.Source) *UserBadgeUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *UserBadgeUpdateOne) SetNillableSource(v *userbadge.Source) *UserBadgeUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *UserBadgeUpdateOne) SetGrantedBy(v int) *UserBadgeUpdateOne {
+	_u.mutation.ResetGrantedBy()
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *UserBadgeUpdateOne) SetNillableGrantedBy(v *int) *UserBadgeUpdateOne {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// AddGrantedBy adds value to the "granted_by" field.
+func (_u *UserBadgeUpdateOne) AddGrantedBy(v int) *UserBadgeUpdateOne {
+	_u.mutation.AddGrantedBy(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *UserBadgeUpdateOne) SetReason(v string) *UserBadgeUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *UserBadgeUpdateOne) SetNillableReason(v *string) *UserBadgeUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *UserBadgeUpdateOne) ClearReason() *UserBadgeUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetDisplayOrder sets the "display_order" field.
+func (_u *UserBadgeUpdateOne) SetDisplayOrder(v int) *UserBadgeUpdateOne {
+	_u.mutation.ResetDisplayOrder()
+	_u.mutation.SetDisplayOrder(v)
+	return _u
+}
+
+// SetNillableDisplayOrder sets the "display_order" field if the given value is not nil.
+func (_u *UserBadgeUpdateOne) SetNillableDisplayOrder(v *int) *UserBadgeUpdateOne {
+	if v != nil {
+		_u.SetDisplayOrder(*v)
+	}
+	return _u
+}
+
+// AddDisplayOrder adds value to the "display_order" field.
+func (_u *UserBadgeUpdateOne) AddDisplayOrder(v int) *UserBadgeUpdateOne {
+	_u.mutation.AddDisplayOrder(v)
+	return _u
+}
+
+// Mutation returns the UserBadgeMutation object of the builder.
+func (_u *UserBadgeUpdateOne) Mutation() *UserBadgeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserBadgeUpdate builder.
+func (_u *UserBadgeUpdateOne) Where(ps ...predicate.UserBadge) *UserBadgeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserBadgeUpdateOne) Select(field string, fields ...string) *UserBadgeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserBadge entity.
+func (_u *UserBadgeUpdateOne) Save(ctx context.Context) (*UserBadge, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserBadgeUpdateOne) SaveX(ctx context.Context) *UserBadge {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserBadgeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserBadgeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserBadgeUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userbadge.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserBadgeUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := userbadge.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserBadge.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BadgeID(); ok {
+		if err := userbadge.BadgeIDValidator(v); err != nil {
+			return &ValidationError{Name: "badge_id", err: fmt.Errorf(`ent: validator failed for field "UserBadge.badge_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := userbadge.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "UserBadge.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GrantedBy(); ok {
+		if err := userbadge.GrantedByValidator(v); err != nil {
+			return &ValidationError{Name: "granted_by", err: fmt.Errorf(`ent: validator failed for field "UserBadge.granted_by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayOrder(); ok {
+		if err := userbadge.DisplayOrderValidator(v); err != nil {
+			return &ValidationError{Name: "display_order", err: fmt.Errorf(`ent: validator failed for field "UserBadge.display_order": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserBadgeUpdateOne) sqlSave(ctx context.Context) (_node *UserBadge, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userbadge.Table, userbadge.Columns, sqlgraph.NewFieldSpec(userbadge.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserBadge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userbadge.FieldID)
+		for _, f := range fields {
+			if !userbadge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userbadge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userbadge.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userbadge.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(userbadge.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BadgeID(); ok {
+		_spec.SetField(userbadge.FieldBadgeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBadgeID(); ok {
+		_spec.AddField(userbadge.FieldBadgeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(userbadge.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(userbadge.FieldGrantedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGrantedBy(); ok {
+		_spec.AddField(userbadge.FieldGrantedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(userbadge.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(userbadge.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisplayOrder(); ok {
+		_spec.SetField(userbadge.FieldDisplayOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayOrder(); ok {
+		_spec.AddField(userbadge.FieldDisplayOrder, field.TypeInt, value)
+	}
+	_node = &UserBadge{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userbadge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
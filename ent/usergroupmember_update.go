@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/usergroupmember"
+)
+
+// UserGroupMemberUpdate is the builder for updating UserGroupMember entities.
+type UserGroupMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserGroupMemberMutation
+}
+
+// Where appends a list predicates to the UserGroupMemberUpdate builder.
+func (_u *UserGroupMemberUpdate) Where(ps ...predicate.UserGroupMember) *UserGroupMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserGroupMemberUpdate) SetUpdatedAt(v time.Time) *UserGroupMemberUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *UserGroupMemberUpdate) SetGroupID(v int) *UserGroupMemberUpdate {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *UserGroupMemberUpdate) SetNillableGroupID(v *int) *UserGroupMemberUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *UserGroupMemberUpdate) AddGroupID(v int) *UserGroupMemberUpdate {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserGroupMemberUpdate) SetUserID(v int) *UserGroupMemberUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserGroupMemberUpdate) SetNillableUserID(v *int) *UserGroupMemberUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserGroupMemberUpdate) AddUserID(v int) *UserGroupMemberUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *UserGroupMemberUpdate) SetExpiresAt(v time.Time) *UserGroupMemberUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *UserGroupMemberUpdate) SetNillableExpiresAt(v *time.Time) *UserGroupMemberUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *UserGroupMemberUpdate) ClearExpiresAt() *UserGroupMemberUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the UserGroupMemberMutation object of the builder.
+func (_u *UserGroupMemberUpdate) Mutation() *UserGroupMemberMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserGroupMemberUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserGroupMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserGroupMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserGroupMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserGroupMemberUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usergroupmember.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserGroupMemberUpdate) check() error {
+	if v, ok := _u.mutation.GroupID(); ok {
+		if err := usergroupmember.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`ent: validator failed for field "UserGroupMember.group_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := usergroupmember.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserGroupMember.user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserGroupMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usergroupmember.Table, usergroupmember.Columns, sqlgraph.NewFieldSpec(usergroupmember.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usergroupmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(usergroupmember.FieldGroupID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(usergroupmember.FieldGroupID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(usergroupmember.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(usergroupmember.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(usergroupmember.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(usergroupmember.FieldExpiresAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usergroupmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserGroupMemberUpdateOne is the builder for updating a single UserGroupMember entity.
+type UserGroupMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserGroupMemberMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserGroupMemberUpdateOne) SetUpdatedAt(v time.Time) *UserGroupMemberUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *UserGroupMemberUpdateOne) SetGroupID(v int) *UserGroupMemberUpdateOne {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *UserGroupMemberUpdateOne) SetNillableGroupID(v *int) *UserGroupMemberUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *UserGroupMemberUpdateOne) AddGroupID(v int) *UserGroupMemberUpdateOne {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserGroupMemberUpdateOne) SetUserID(v int) *UserGroupMemberUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserGroupMemberUpdateOne) SetNillableUserID(v *int) *UserGroupMemberUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserGroupMemberUpdateOne) AddUserID(v int) *UserGroupMemberUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *UserGroupMemberUpdateOne) SetExpiresAt(v time.Time) *UserGroupMemberUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *UserGroupMemberUpdateOne) SetNillableExpiresAt(v *time.Time) *UserGroupMemberUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *UserGroupMemberUpdateOne) ClearExpiresAt() *UserGroupMemberUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the UserGroupMemberMutation object of the builder.
+func (_u *UserGroupMemberUpdateOne) Mutation() *UserGroupMemberMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserGroupMemberUpdate builder.
+func (_u *UserGroupMemberUpdateOne) Where(ps ...predicate.UserGroupMember) *UserGroupMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserGroupMemberUpdateOne) Select(field string, fields ...string) *UserGroupMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserGroupMember entity.
+func (_u *UserGroupMemberUpdateOne) Save(ctx context.Context) (*UserGroupMember, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserGroupMemberUpdateOne) SaveX(ctx context.Context) *UserGroupMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserGroupMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserGroupMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserGroupMemberUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usergroupmember.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserGroupMemberUpdateOne) check() error {
+	if v, ok := _u.mutation.GroupID(); ok {
+		if err := usergroupmember.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`ent: validator failed for field "UserGroupMember.group_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := usergroupmember.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserGroupMember.user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserGroupMemberUpdateOne) sqlSave(ctx context.Context) (_node *UserGroupMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usergroupmember.Table, usergroupmember.Columns, sqlgraph.NewFieldSpec(usergroupmember.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserGroupMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usergroupmember.FieldID)
+		for _, f := range fields {
+			if !usergroupmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usergroupmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usergroupmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(usergroupmember.FieldGroupID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(usergroupmember.FieldGroupID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(usergroupmember.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(usergroupmember.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(usergroupmember.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(usergroupmember.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &UserGroupMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usergroupmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/userinvitation"
+)
+
+// UserInvitation is the model entity for the UserInvitation schema.
+type UserInvitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// InviterID holds the value of the "inviter_id" field.
+	InviterID int `json:"inviter_id,omitempty"`
+	// InviteeID holds the value of the "invitee_id" field.
+	InviteeID int `json:"invitee_id,omitempty"`
+	// InviteCodeID holds the value of the "invite_code_id" field.
+	InviteCodeID int `json:"invite_code_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserInvitation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userinvitation.FieldID, userinvitation.FieldInviterID, userinvitation.FieldInviteeID, userinvitation.FieldInviteCodeID:
+			values[i] = new(sql.NullInt64)
+		case userinvitation.FieldCreatedAt, userinvitation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserInvitation fields.
+func (_m *UserInvitation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userinvitation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case userinvitation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case userinvitation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case userinvitation.FieldInviterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field inviter_id", values[i])
+			} else if value.Valid {
+				_m.InviterID = int(value.Int64)
+			}
+		case userinvitation.FieldInviteeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invitee_id", values[i])
+			} else if value.Valid {
+				_m.InviteeID = int(value.Int64)
+			}
+		case userinvitation.FieldInviteCodeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invite_code_id", values[i])
+			} else if value.Valid {
+				_m.InviteCodeID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserInvitation.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserInvitation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserInvitation.
+// Note that you need to call UserInvitation.Unwrap() before calling this method if this UserInvitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserInvitation) Update() *UserInvitationUpdateOne {
+	return NewUserInvitationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserInvitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserInvitation) Unwrap() *UserInvitation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserInvitation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserInvitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserInvitation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("inviter_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InviterID))
+	builder.WriteString(", ")
+	builder.WriteString("invitee_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InviteeID))
+	builder.WriteString(", ")
+	builder.WriteString("invite_code_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InviteCodeID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserInvitations is a parsable slice of UserInvitation.
+type UserInvitations []*UserInvitation
@@ -0,0 +1,98 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userinvitation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the userinvitation type in the database.
+	Label = "user_invitation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldInviterID holds the string denoting the inviter_id field in the database.
+	FieldInviterID = "inviter_id"
+	// FieldInviteeID holds the string denoting the invitee_id field in the database.
+	FieldInviteeID = "invitee_id"
+	// FieldInviteCodeID holds the string denoting the invite_code_id field in the database.
+	FieldInviteCodeID = "invite_code_id"
+	// Table holds the table name of the userinvitation in the database.
+	Table = "user_invitations"
+)
+
+// Columns holds all SQL columns for userinvitation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldInviterID,
+	FieldInviteeID,
+	FieldInviteCodeID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// InviterIDValidator is a validator for the "inviter_id" field. It is called by the builders before save.
+	InviterIDValidator func(int) error
+	// InviteeIDValidator is a validator for the "invitee_id" field. It is called by the builders before save.
+	InviteeIDValidator func(int) error
+	// InviteCodeIDValidator is a validator for the "invite_code_id" field. It is called by the builders before save.
+	InviteCodeIDValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the UserInvitation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByInviterID orders the results by the inviter_id field.
+func ByInviterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInviterID, opts...).ToFunc()
+}
+
+// ByInviteeID orders the results by the invitee_id field.
+func ByInviteeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInviteeID, opts...).ToFunc()
+}
+
+// ByInviteCodeID orders the results by the invite_code_id field.
+func ByInviteCodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInviteCodeID, opts...).ToFunc()
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userinvitation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// InviterID applies equality check predicate on the "inviter_id" field. It's identical to InviterIDEQ.
+func InviterID(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldInviterID, v))
+}
+
+// InviteeID applies equality check predicate on the "invitee_id" field. It's identical to InviteeIDEQ.
+func InviteeID(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldInviteeID, v))
+}
+
+// InviteCodeID applies equality check predicate on the "invite_code_id" field. It's identical to InviteCodeIDEQ.
+func InviteCodeID(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldInviteCodeID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// InviterIDEQ applies the EQ predicate on the "inviter_id" field.
+func InviterIDEQ(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldInviterID, v))
+}
+
+// InviterIDNEQ applies the NEQ predicate on the "inviter_id" field.
+func InviterIDNEQ(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNEQ(FieldInviterID, v))
+}
+
+// InviterIDIn applies the In predicate on the "inviter_id" field.
+func InviterIDIn(vs ...int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldIn(FieldInviterID, vs...))
+}
+
+// InviterIDNotIn applies the NotIn predicate on the "inviter_id" field.
+func InviterIDNotIn(vs ...int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNotIn(FieldInviterID, vs...))
+}
+
+// InviterIDGT applies the GT predicate on the "inviter_id" field.
+func InviterIDGT(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGT(FieldInviterID, v))
+}
+
+// InviterIDGTE applies the GTE predicate on the "inviter_id" field.
+func InviterIDGTE(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGTE(FieldInviterID, v))
+}
+
+// InviterIDLT applies the LT predicate on the "inviter_id" field.
+func InviterIDLT(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLT(FieldInviterID, v))
+}
+
+// InviterIDLTE applies the LTE predicate on the "inviter_id" field.
+func InviterIDLTE(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLTE(FieldInviterID, v))
+}
+
+// InviteeIDEQ applies the EQ predicate on the "invitee_id" field.
+func InviteeIDEQ(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldInviteeID, v))
+}
+
+// InviteeIDNEQ applies the NEQ predicate on the "invitee_id" field.
+func InviteeIDNEQ(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNEQ(FieldInviteeID, v))
+}
+
+// InviteeIDIn applies the In predicate on the "invitee_id" field.
+func InviteeIDIn(vs ...int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldIn(FieldInviteeID, vs...))
+}
+
+// InviteeIDNotIn applies the NotIn predicate on the "invitee_id" field.
+func InviteeIDNotIn(vs ...int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNotIn(FieldInviteeID, vs...))
+}
+
+// InviteeIDGT applies the GT predicate on the "invitee_id" field.
+func InviteeIDGT(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGT(FieldInviteeID, v))
+}
+
+// InviteeIDGTE applies the GTE predicate on the "invitee_id" field.
+func InviteeIDGTE(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGTE(FieldInviteeID, v))
+}
+
+// InviteeIDLT applies the LT predicate on the "invitee_id" field.
+func InviteeIDLT(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLT(FieldInviteeID, v))
+}
+
+// InviteeIDLTE applies the LTE predicate on the "invitee_id" field.
+func InviteeIDLTE(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLTE(FieldInviteeID, v))
+}
+
+// InviteCodeIDEQ applies the EQ predicate on the "invite_code_id" field.
+func InviteCodeIDEQ(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldEQ(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDNEQ applies the NEQ predicate on the "invite_code_id" field.
+func InviteCodeIDNEQ(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNEQ(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDIn applies the In predicate on the "invite_code_id" field.
+func InviteCodeIDIn(vs ...int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldIn(FieldInviteCodeID, vs...))
+}
+
+// InviteCodeIDNotIn applies the NotIn predicate on the "invite_code_id" field.
+func InviteCodeIDNotIn(vs ...int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldNotIn(FieldInviteCodeID, vs...))
+}
+
+// InviteCodeIDGT applies the GT predicate on the "invite_code_id" field.
+func InviteCodeIDGT(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGT(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDGTE applies the GTE predicate on the "invite_code_id" field.
+func InviteCodeIDGTE(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldGTE(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDLT applies the LT predicate on the "invite_code_id" field.
+func InviteCodeIDLT(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLT(FieldInviteCodeID, v))
+}
+
+// InviteCodeIDLTE applies the LTE predicate on the "invite_code_id" field.
+func InviteCodeIDLTE(v int) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.FieldLTE(FieldInviteCodeID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserInvitation) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserInvitation) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserInvitation) predicate.UserInvitation {
+	return predicate.UserInvitation(sql.NotPredicates(p))
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/userinvitation"
+)
+
+// UserInvitationCreate is the builder for creating a UserInvitation entity.
+type UserInvitationCreate struct {
+	config
+	mutation *UserInvitationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserInvitationCreate) SetCreatedAt(v time.Time) *UserInvitationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserInvitationCreate) SetNillableCreatedAt(v *time.Time) *UserInvitationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserInvitationCreate) SetUpdatedAt(v time.Time) *UserInvitationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserInvitationCreate) SetNillableUpdatedAt(v *time.Time) *UserInvitationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetInviterID sets the "inviter_id" field.
+func (_c *UserInvitationCreate) SetInviterID(v int) *UserInvitationCreate {
+	_c.mutation.SetInviterID(v)
+	return _c
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (_c *UserInvitationCreate) SetInviteeID(v int) *UserInvitationCreate {
+	_c.mutation.SetInviteeID(v)
+	return _c
+}
+
+// SetInviteCodeID sets the "invite_code_id" field.
+func (_c *UserInvitationCreate) SetInviteCodeID(v int) *UserInvitationCreate {
+	_c.mutation.SetInviteCodeID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserInvitationCreate) SetID(v int) *UserInvitationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UserInvitationMutation object of the builder.
+func (_c *UserInvitationCreate) Mutation() *UserInvitationMutation {
+	return _c.mutation
+}
+
+// Save creates the UserInvitation in the database.
+func (_c *UserInvitationCreate) Save(ctx context.Context) (*UserInvitation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserInvitationCreate) SaveX(ctx context.Context) *UserInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserInvitationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserInvitationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserInvitationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userinvitation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := userinvitation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserInvitationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserInvitation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserInvitation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.InviterID(); !ok {
+		return &ValidationError{Name: "inviter_id", err: errors.New(`ent: missing required field "UserInvitation.inviter_id"`)}
+	}
+	if v, ok := _c.mutation.InviterID(); ok {
+		if err := userinvitation.InviterIDValidator(v); err != nil {
+			return &ValidationError{Name: "inviter_id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.inviter_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InviteeID(); !ok {
+		return &ValidationError{Name: "invitee_id", err: errors.New(`ent: missing required field "UserInvitation.invitee_id"`)}
+	}
+	if v, ok := _c.mutation.InviteeID(); ok {
+		if err := userinvitation.InviteeIDValidator(v); err != nil {
+			return &ValidationError{Name: "invitee_id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.invitee_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InviteCodeID(); !ok {
+		return &ValidationError{Name: "invite_code_id", err: errors.New(`ent: missing required field "UserInvitation.invite_code_id"`)}
+	}
+	if v, ok := _c.mutation.InviteCodeID(); ok {
+		if err := userinvitation.InviteCodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "invite_code_id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.invite_code_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := userinvitation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UserInvitationCreate) sqlSave(ctx context.Context) (*UserInvitation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserInvitationCreate) createSpec() (*UserInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserInvitation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userinvitation.Table, sqlgraph.NewFieldSpec(userinvitation.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userinvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(userinvitation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.InviterID(); ok {
+		_spec.SetField(userinvitation.FieldInviterID, field.TypeInt, value)
+		_node.InviterID = value
+	}
+	if value, ok := _c.mutation.InviteeID(); ok {
+		_spec.SetField(userinvitation.FieldInviteeID, field.TypeInt, value)
+		_node.InviteeID = value
+	}
+	if value, ok := _c.mutation.InviteCodeID(); ok {
+		_spec.SetField(userinvitation.FieldInviteCodeID, field.TypeInt, value)
+		_node.InviteCodeID = value
+	}
+	return _node, _spec
+}
+
+// UserInvitationCreateBulk is the builder for creating many UserInvitation entities in bulk.
+type UserInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*UserInvitationCreate
+}
+
+// Save creates the UserInvitation entities in the database.
+func (_c *UserInvitationCreateBulk) Save(ctx context.Context) ([]*UserInvitation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserInvitation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserInvitationCreateBulk) SaveX(ctx context.Context) []*UserInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/userinvitation"
+)
+
+// UserInvitationUpdate is the builder for updating UserInvitation entities.
+type UserInvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserInvitationMutation
+}
+
+// Where appends a list predicates to the UserInvitationUpdate builder.
+func (_u *UserInvitationUpdate) Where(ps ...predicate.UserInvitation) *UserInvitationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserInvitationUpdate) SetUpdatedAt(v time.Time) *UserInvitationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetInviterID sets the "inviter_id" field.
+func (_u *UserInvitationUpdate) SetInviterID(v int) *UserInvitationUpdate {
+	_u.mutation.ResetInviterID()
+	_u.mutation.SetInviterID(v)
+	return _u
+}
+
+// SetNillableInviterID sets the "inviter_id" field if the given value is not nil.
+func (_u *UserInvitationUpdate) SetNillableInviterID(v *int) *UserInvitationUpdate {
+	if v != nil {
+		_u.SetInviterID(*v)
+	}
+	return _u
+}
+
+// AddInviterID adds value to the "inviter_id" field.
+func (_u *UserInvitationUpdate) AddInviterID(v int) *UserInvitationUpdate {
+	_u.mutation.AddInviterID(v)
+	return _u
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (_u *UserInvitationUpdate) SetInviteeID(v int) *UserInvitationUpdate {
+	_u.mutation.ResetInviteeID()
+	_u.mutation.SetInviteeID(v)
+	return _u
+}
+
+// SetNillableInviteeID sets the "invitee_id" field if the given value is not nil.
+func (_u *UserInvitationUpdate) SetNillableInviteeID(v *int) *UserInvitationUpdate {
+	if v != nil {
+		_u.SetInviteeID(*v)
+	}
+	return _u
+}
+
+// AddInviteeID adds value to the "invitee_id" field.
+func (_u *UserInvitationUpdate) AddInviteeID(v int) *UserInvitationUpdate {
+	_u.mutation.AddInviteeID(v)
+	return _u
+}
+
+// SetInviteCodeID sets the "invite_code_id" field.
+func (_u *UserInvitationUpdate) SetInviteCodeID(v int) *UserInvitationUpdate {
+	_u.mutation.ResetInviteCodeID()
+	_u.mutation.SetInviteCodeID(v)
+	return _u
+}
+
+// SetNillableInviteCodeID sets the "invite_code_id" field if the given value is not nil.
+func (_u *UserInvitationUpdate) SetNillableInviteCodeID(v *int) *UserInvitationUpdate {
+	if v != nil {
+		_u.SetInviteCodeID(*v)
+	}
+	return _u
+}
+
+// AddInviteCodeID adds value to the "invite_code_id" field.
+func (_u *UserInvitationUpdate) AddInviteCodeID(v int) *UserInvitationUpdate {
+	_u.mutation.AddInviteCodeID(v)
+	return _u
+}
+
+// Mutation returns the UserInvitationMutation object of the builder.
+func (_u *UserInvitationUpdate) Mutation() *UserInvitationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserInvitationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserInvitationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserInvitationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserInvitationUpdate) check() error {
+	if v, ok := _u.mutation.InviterID(); ok {
+		if err := userinvitation.InviterIDValidator(v); err != nil {
+			return &ValidationError{Name: "inviter_id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.inviter_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InviteeID(); ok {
+		if err := userinvitation.InviteeIDValidator(v); err != nil {
+			return &ValidationError{Name: "invitee_id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.invitee_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InviteCodeID(); ok {
+		if err := userinvitation.InviteCodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "invite_code_id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.invite_code_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserInvitationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userinvitation.Table, userinvitation.Columns, sqlgraph.NewFieldSpec(userinvitation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.InviterID(); ok {
+		_spec.SetField(userinvitation.FieldInviterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviterID(); ok {
+		_spec.AddField(userinvitation.FieldInviterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InviteeID(); ok {
+		_spec.SetField(userinvitation.FieldInviteeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviteeID(); ok {
+		_spec.AddField(userinvitation.FieldInviteeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InviteCodeID(); ok {
+		_spec.SetField(userinvitation.FieldInviteCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviteCodeID(); ok {
+		_spec.AddField(userinvitation.FieldInviteCodeID, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserInvitationUpdateOne is the builder for updating a single UserInvitation entity.
+type UserInvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserInvitationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserInvitationUpdateOne) SetUpdatedAt(v time.Time) *UserInvitationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetInviterID sets the "inviter_id" field.
+func (_u *UserInvitationUpdateOne) SetInviterID(v int) *UserInvitationUpdateOne {
+	_u.mutation.ResetInviterID()
+	_u.mutation.SetInviterID(v)
+	return _u
+}
+
+// SetNillableInviterID sets the "inviter_id" field if the given value is not nil.
+func (_u *UserInvitationUpdateOne) SetNillableInviterID(v *int) *UserInvitationUpdateOne {
+	if v != nil {
+		_u.SetInviterID(*v)
+	}
+	return _u
+}
+
+// AddInviterID adds value to the "inviter_id" field.
+func (_u *UserInvitationUpdateOne) AddInviterID(v int) *UserInvitationUpdateOne {
+	_u.mutation.AddInviterID(v)
+	return _u
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (_u *UserInvitationUpdateOne) SetInviteeID(v int) *UserInvitationUpdateOne {
+	_u.mutation.ResetInviteeID()
+	_u.mutation.SetInviteeID(v)
+	return _u
+}
+
+// SetNillableInviteeID sets the "invitee_id" field if the given value is not nil.
+func (_u *UserInvitationUpdateOne) SetNillableInviteeID(v *int) *UserInvitationUpdateOne {
+	if v != nil {
+		_u.SetInviteeID(*v)
+	}
+	return _u
+}
+
+// AddInviteeID adds value to the "invitee_id" field.
+func (_u *UserInvitationUpdateOne) AddInviteeID(v int) *UserInvitationUpdateOne {
+	_u.mutation.AddInviteeID(v)
+	return _u
+}
+
+// SetInviteCodeID sets the "invite_code_id" field.
+func (_u *UserInvitationUpdateOne) SetInviteCodeID(v int) *UserInvitationUpdateOne {
+	_u.mutation.ResetInviteCodeID()
+	_u.mutation.SetInviteCodeID(v)
+	return _u
+}
+
+// SetNillableInviteCodeID sets the "invite_code_id" field if the given value is not nil.
+func (_u *UserInvitationUpdateOne) SetNillableInviteCodeID(v *int) *UserInvitationUpdateOne {
+	if v != nil {
+		_u.SetInviteCodeID(*v)
+	}
+	return _u
+}
+
+// AddInviteCodeID adds value to the "invite_code_id" field.
+func (_u *UserInvitationUpdateOne) AddInviteCodeID(v int) *UserInvitationUpdateOne {
+	_u.mutation.AddInviteCodeID(v)
+	return _u
+}
+
+// Mutation returns the UserInvitationMutation object of the builder.
+func (_u *UserInvitationUpdateOne) Mutation() *UserInvitationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserInvitationUpdate builder.
+func (_u *UserInvitationUpdateOne) Where(ps ...predicate.UserInvitation) *UserInvitationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserInvitationUpdateOne) Select(field string, fields ...string) *UserInvitationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserInvitation entity.
+func (_u *UserInvitationUpdateOne) Save(ctx context.Context) (*UserInvitation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserInvitationUpdateOne) SaveX(ctx context.Context) *UserInvitation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserInvitationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserInvitationUpdateOne) check() error {
+	if v, ok := _u.mutation.InviterID(); ok {
+		if err := userinvitation.InviterIDValidator(v); err != nil {
+			return &ValidationError{Name: "inviter_id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.inviter_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InviteeID(); ok {
+		if err := userinvitation.InviteeIDValidator(v); err != nil {
+			return &ValidationError{Name: "invitee_id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.invitee_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InviteCodeID(); ok {
+		if err := userinvitation.InviteCodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "invite_code_id", err: fmt.Errorf(`ent: validator failed for field "UserInvitation.invite_code_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserInvitationUpdateOne) sqlSave(ctx context.Context) (_node *UserInvitation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userinvitation.Table, userinvitation.Columns, sqlgraph.NewFieldSpec(userinvitation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userinvitation.FieldID)
+		for _, f := range fields {
+			if !userinvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.InviterID(); ok {
+		_spec.SetField(userinvitation.FieldInviterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviterID(); ok {
+		_spec.AddField(userinvitation.FieldInviterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InviteeID(); ok {
+		_spec.SetField(userinvitation.FieldInviteeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviteeID(); ok {
+		_spec.AddField(userinvitation.FieldInviteeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InviteCodeID(); ok {
+		_spec.SetField(userinvitation.FieldInviteCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInviteCodeID(); ok {
+		_spec.AddField(userinvitation.FieldInviteCodeID, field.TypeInt, value)
+	}
+	_node = &UserInvitation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/userlevellog"
+)
+
+// UserLevelLog is the model entity for the UserLevelLog schema.
+type UserLevelLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 用户ID，关联到用户表
+	UserID int `json:"user_id,omitempty"`
+	// 变动前等级
+	FromLevel int `json:"from_level,omitempty"`
+	// 变动后等级
+	ToLevel int `json:"to_level,omitempty"`
+	// 变动后等级名称
+	LevelName string `json:"level_name,omitempty"`
+	// 变动后经验值
+	Experience int `json:"experience,omitempty"`
+	// 关联的业务类型
+	RelatedType  string `json:"related_type,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserLevelLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userlevellog.FieldID, userlevellog.FieldUserID, userlevellog.FieldFromLevel, userlevellog.FieldToLevel, userlevellog.FieldExperience:
+			values[i] = new(sql.NullInt64)
+		case userlevellog.FieldLevelName, userlevellog.FieldRelatedType:
+			values[i] = new(sql.NullString)
+		case userlevellog.FieldCreatedAt, userlevellog.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserLevelLog fields.
+func (_m *UserLevelLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userlevellog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case userlevellog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case userlevellog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case userlevellog.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case userlevellog.FieldFromLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field from_level", values[i])
+			} else if value.Valid {
+				_m.FromLevel = int(value.Int64)
+			}
+		case userlevellog.FieldToLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field to_level", values[i])
+			} else if value.Valid {
+				_m.ToLevel = int(value.Int64)
+			}
+		case userlevellog.FieldLevelName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field level_name", values[i])
+			} else if value.Valid {
+				_m.LevelName = value.String
+			}
+		case userlevellog.FieldExperience:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field experience", values[i])
+			} else if value.Valid {
+				_m.Experience = int(value.Int64)
+			}
+		case userlevellog.FieldRelatedType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field related_type", values[i])
+			} else if value.Valid {
+				_m.RelatedType = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserLevelLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserLevelLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserLevelLog.
+// Note that you need to call UserLevelLog.Unwrap() before calling this method if this UserLevelLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserLevelLog) Update() *UserLevelLogUpdateOne {
+	return NewUserLevelLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserLevelLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserLevelLog) Unwrap() *UserLevelLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserLevelLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserLevelLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserLevelLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("from_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromLevel))
+	builder.WriteString(", ")
+	builder.WriteString("to_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToLevel))
+	builder.WriteString(", ")
+	builder.WriteString("level_name=")
+	builder.WriteString(_m.LevelName)
+	builder.WriteString(", ")
+	builder.WriteString("experience=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Experience))
+	builder.WriteString(", ")
+	builder.WriteString("related_type=")
+	builder.WriteString(_m.RelatedType)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserLevelLogs is a parsable slice of UserLevelLog.
+type UserLevelLogs []*UserLevelLog
@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userlevellog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the userlevellog type in the database.
+	Label = "user_level_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldFromLevel holds the string denoting the from_level field in the database.
+	FieldFromLevel = "from_level"
+	// FieldToLevel holds the string denoting the to_level field in the database.
+	FieldToLevel = "to_level"
+	// FieldLevelName holds the string denoting the level_name field in the database.
+	FieldLevelName = "level_name"
+	// FieldExperience holds the string denoting the experience field in the database.
+	FieldExperience = "experience"
+	// FieldRelatedType holds the string denoting the related_type field in the database.
+	FieldRelatedType = "related_type"
+	// Table holds the table name of the userlevellog in the database.
+	Table = "user_level_logs"
+)
+
+// Columns holds all SQL columns for userlevellog fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldUserID,
+	FieldFromLevel,
+	FieldToLevel,
+	FieldLevelName,
+	FieldExperience,
+	FieldRelatedType,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(int) error
+	// FromLevelValidator is a validator for the "from_level" field. It is called by the builders before save.
+	FromLevelValidator func(int) error
+	// ToLevelValidator is a validator for the "to_level" field. It is called by the builders before save.
+	ToLevelValidator func(int) error
+	// ExperienceValidator is a validator for the "experience" field. It is called by the builders before save.
+	ExperienceValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the UserLevelLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByFromLevel orders the results by the from_level field.
+func ByFromLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromLevel, opts...).ToFunc()
+}
+
+// ByToLevel orders the results by the to_level field.
+func ByToLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToLevel, opts...).ToFunc()
+}
+
+// ByLevelName orders the results by the level_name field.
+func ByLevelName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevelName, opts...).ToFunc()
+}
+
+// ByExperience orders the results by the experience field.
+func ByExperience(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExperience, opts...).ToFunc()
+}
+
+// ByRelatedType orders the results by the related_type field.
+func ByRelatedType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRelatedType, opts...).ToFunc()
+}
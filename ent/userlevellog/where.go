@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userlevellog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// FromLevel applies equality check predicate on the "from_level" field. It's identical to FromLevelEQ.
+func FromLevel(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldFromLevel, v))
+}
+
+// ToLevel applies equality check predicate on the "to_level" field. It's identical to ToLevelEQ.
+func ToLevel(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldToLevel, v))
+}
+
+// LevelName applies equality check predicate on the "level_name" field. It's identical to LevelNameEQ.
+func LevelName(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldLevelName, v))
+}
+
+// Experience applies equality check predicate on the "experience" field. It's identical to ExperienceEQ.
+func Experience(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldExperience, v))
+}
+
+// RelatedType applies equality check predicate on the "related_type" field. It's identical to RelatedTypeEQ.
+func RelatedType(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldRelatedType, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLTE(FieldUserID, v))
+}
+
+// FromLevelEQ applies the EQ predicate on the "from_level" field.
+func FromLevelEQ(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldFromLevel, v))
+}
+
+// FromLevelNEQ applies the NEQ predicate on the "from_level" field.
+func FromLevelNEQ(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNEQ(FieldFromLevel, v))
+}
+
+// FromLevelIn applies the In predicate on the "from_level" field.
+func FromLevelIn(vs ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIn(FieldFromLevel, vs...))
+}
+
+// FromLevelNotIn applies the NotIn predicate on the "from_level" field.
+func FromLevelNotIn(vs ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotIn(FieldFromLevel, vs...))
+}
+
+// FromLevelGT applies the GT predicate on the "from_level" field.
+func FromLevelGT(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGT(FieldFromLevel, v))
+}
+
+// FromLevelGTE applies the GTE predicate on the "from_level" field.
+func FromLevelGTE(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGTE(FieldFromLevel, v))
+}
+
+// FromLevelLT applies the LT predicate on the "from_level" field.
+func FromLevelLT(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLT(FieldFromLevel, v))
+}
+
+// FromLevelLTE applies the LTE predicate on the "from_level" field.
+func FromLevelLTE(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLTE(FieldFromLevel, v))
+}
+
+// ToLevelEQ applies the EQ predicate on the "to_level" field.
+func ToLevelEQ(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldToLevel, v))
+}
+
+// ToLevelNEQ applies the NEQ predicate on the "to_level" field.
+func ToLevelNEQ(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNEQ(FieldToLevel, v))
+}
+
+// ToLevelIn applies the In predicate on the "to_level" field.
+func ToLevelIn(vs ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIn(FieldToLevel, vs...))
+}
+
+// ToLevelNotIn applies the NotIn predicate on the "to_level" field.
+func ToLevelNotIn(vs ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotIn(FieldToLevel, vs...))
+}
+
+// ToLevelGT applies the GT predicate on the "to_level" field.
+func ToLevelGT(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGT(FieldToLevel, v))
+}
+
+// ToLevelGTE applies the GTE predicate on the "to_level" field.
+func ToLevelGTE(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGTE(FieldToLevel, v))
+}
+
+// ToLevelLT applies the LT predicate on the "to_level" field.
+func ToLevelLT(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLT(FieldToLevel, v))
+}
+
+// ToLevelLTE applies the LTE predicate on the "to_level" field.
+func ToLevelLTE(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLTE(FieldToLevel, v))
+}
+
+// LevelNameEQ applies the EQ predicate on the "level_name" field.
+func LevelNameEQ(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldLevelName, v))
+}
+
+// LevelNameNEQ applies the NEQ predicate on the "level_name" field.
+func LevelNameNEQ(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNEQ(FieldLevelName, v))
+}
+
+// LevelNameIn applies the In predicate on the "level_name" field.
+func LevelNameIn(vs ...string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIn(FieldLevelName, vs...))
+}
+
+// LevelNameNotIn applies the NotIn predicate on the "level_name" field.
+func LevelNameNotIn(vs ...string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotIn(FieldLevelName, vs...))
+}
+
+// LevelNameGT applies the GT predicate on the "level_name" field.
+func LevelNameGT(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGT(FieldLevelName, v))
+}
+
+// LevelNameGTE applies the GTE predicate on the "level_name" field.
+func LevelNameGTE(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGTE(FieldLevelName, v))
+}
+
+// LevelNameLT applies the LT predicate on the "level_name" field.
+func LevelNameLT(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLT(FieldLevelName, v))
+}
+
+// LevelNameLTE applies the LTE predicate on the "level_name" field.
+func LevelNameLTE(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLTE(FieldLevelName, v))
+}
+
+// LevelNameContains applies the Contains predicate on the "level_name" field.
+func LevelNameContains(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldContains(FieldLevelName, v))
+}
+
+// LevelNameHasPrefix applies the HasPrefix predicate on the "level_name" field.
+func LevelNameHasPrefix(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldHasPrefix(FieldLevelName, v))
+}
+
+// LevelNameHasSuffix applies the HasSuffix predicate on the "level_name" field.
+func LevelNameHasSuffix(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldHasSuffix(FieldLevelName, v))
+}
+
+// LevelNameIsNil applies the IsNil predicate on the "level_name" field.
+func LevelNameIsNil() predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIsNull(FieldLevelName))
+}
+
+// LevelNameNotNil applies the NotNil predicate on the "level_name" field.
+func LevelNameNotNil() predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotNull(FieldLevelName))
+}
+
+// LevelNameEqualFold applies the EqualFold predicate on the "level_name" field.
+func LevelNameEqualFold(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEqualFold(FieldLevelName, v))
+}
+
+// LevelNameContainsFold applies the ContainsFold predicate on the "level_name" field.
+func LevelNameContainsFold(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldContainsFold(FieldLevelName, v))
+}
+
+// ExperienceEQ applies the EQ predicate on the "experience" field.
+func ExperienceEQ(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldExperience, v))
+}
+
+// ExperienceNEQ applies the NEQ predicate on the "experience" field.
+func ExperienceNEQ(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNEQ(FieldExperience, v))
+}
+
+// ExperienceIn applies the In predicate on the "experience" field.
+func ExperienceIn(vs ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIn(FieldExperience, vs...))
+}
+
+// ExperienceNotIn applies the NotIn predicate on the "experience" field.
+func ExperienceNotIn(vs ...int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotIn(FieldExperience, vs...))
+}
+
+// ExperienceGT applies the GT predicate on the "experience" field.
+func ExperienceGT(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGT(FieldExperience, v))
+}
+
+// ExperienceGTE applies the GTE predicate on the "experience" field.
+func ExperienceGTE(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGTE(FieldExperience, v))
+}
+
+// ExperienceLT applies the LT predicate on the "experience" field.
+func ExperienceLT(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLT(FieldExperience, v))
+}
+
+// ExperienceLTE applies the LTE predicate on the "experience" field.
+func ExperienceLTE(v int) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLTE(FieldExperience, v))
+}
+
+// RelatedTypeEQ applies the EQ predicate on the "related_type" field.
+func RelatedTypeEQ(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEQ(FieldRelatedType, v))
+}
+
+// RelatedTypeNEQ applies the NEQ predicate on the "related_type" field.
+func RelatedTypeNEQ(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNEQ(FieldRelatedType, v))
+}
+
+// RelatedTypeIn applies the In predicate on the "related_type" field.
+func RelatedTypeIn(vs ...string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIn(FieldRelatedType, vs...))
+}
+
+// RelatedTypeNotIn applies the NotIn predicate on the "related_type" field.
+func RelatedTypeNotIn(vs ...string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotIn(FieldRelatedType, vs...))
+}
+
+// RelatedTypeGT applies the GT predicate on the "related_type" field.
+func RelatedTypeGT(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGT(FieldRelatedType, v))
+}
+
+// RelatedTypeGTE applies the GTE predicate on the "related_type" field.
+func RelatedTypeGTE(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldGTE(FieldRelatedType, v))
+}
+
+// RelatedTypeLT applies the LT predicate on the "related_type" field.
+func RelatedTypeLT(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLT(FieldRelatedType, v))
+}
+
+// RelatedTypeLTE applies the LTE predicate on the "related_type" field.
+func RelatedTypeLTE(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldLTE(FieldRelatedType, v))
+}
+
+// RelatedTypeContains applies the Contains predicate on the "related_type" field.
+func RelatedTypeContains(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldContains(FieldRelatedType, v))
+}
+
+// RelatedTypeHasPrefix applies the HasPrefix predicate on the "related_type" field.
+func RelatedTypeHasPrefix(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldHasPrefix(FieldRelatedType, v))
+}
+
+// RelatedTypeHasSuffix applies the HasSuffix predicate on the "related_type" field.
+func RelatedTypeHasSuffix(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldHasSuffix(FieldRelatedType, v))
+}
+
+// RelatedTypeIsNil applies the IsNil predicate on the "related_type" field.
+func RelatedTypeIsNil() predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldIsNull(FieldRelatedType))
+}
+
+// RelatedTypeNotNil applies the NotNil predicate on the "related_type" field.
+func RelatedTypeNotNil() predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldNotNull(FieldRelatedType))
+}
+
+// RelatedTypeEqualFold applies the EqualFold predicate on the "related_type" field.
+func RelatedTypeEqualFold(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldEqualFold(FieldRelatedType, v))
+}
+
+// RelatedTypeContainsFold applies the ContainsFold predicate on the "related_type" field.
+func RelatedTypeContainsFold(v string) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.FieldContainsFold(FieldRelatedType, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserLevelLog) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserLevelLog) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserLevelLog) predicate.UserLevelLog {
+	return predicate.UserLevelLog(sql.NotPredicates(p))
+}
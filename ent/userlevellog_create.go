@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/userlevellog"
+)
+
+// UserLevelLogCreate is the builder for creating a UserLevelLog entity.
+type UserLevelLogCreate struct {
+	config
+	mutation *UserLevelLogMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserLevelLogCreate) SetCreatedAt(v time.Time) *UserLevelLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserLevelLogCreate) SetNillableCreatedAt(v *time.Time) *UserLevelLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserLevelLogCreate) SetUpdatedAt(v time.Time) *UserLevelLogCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserLevelLogCreate) SetNillableUpdatedAt(v *time.Time) *UserLevelLogCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserLevelLogCreate) SetUserID(v int) *UserLevelLogCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetFromLevel sets the "from_level" field.
+func (_c *UserLevelLogCreate) SetFromLevel(v int) *UserLevelLogCreate {
+	_c.mutation.SetFromLevel(v)
+	return _c
+}
+
+// SetToLevel sets the "to_level" field.
+func (_c *UserLevelLogCreate) SetToLevel(v int) *UserLevelLogCreate {
+	_c.mutation.SetToLevel(v)
+	return _c
+}
+
+// SetLevelName sets the "level_name" field.
+func (_c *UserLevelLogCreate) SetLevelName(v string) *UserLevelLogCreate {
+	_c.mutation.SetLevelName(v)
+	return _c
+}
+
+// SetNillableLevelName sets the "level_name" field if the given value is not nil.
+func (_c *UserLevelLogCreate) SetNillableLevelName(v *string) *UserLevelLogCreate {
+	if v != nil {
+		_c.SetLevelName(*v)
+	}
+	return _c
+}
+
+// SetExperience sets the "experience" field.
+func (_c *UserLevelLogCreate) SetExperience(v int) *UserLevelLogCreate {
+	_c.mutation.SetExperience(v)
+	return _c
+}
+
+// SetRelatedType sets the "related_type" field.
+func (_c *UserLevelLogCreate) SetRelatedType(v string) *UserLevelLogCreate {
+	_c.mutation.SetRelatedType(v)
+	return _c
+}
+
+// SetNillableRelatedType sets the "related_type" field if the given value is not nil.
+func (_c *UserLevelLogCreate) SetNillableRelatedType(v *string) *UserLevelLogCreate {
+	if v != nil {
+		_c.SetRelatedType(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserLevelLogCreate) SetID(v int) *UserLevelLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UserLevelLogMutation object of the builder.
+func (_c *UserLevelLogCreate) Mutation() *UserLevelLogMutation {
+	return _c.mutation
+}
+
+// Save creates the UserLevelLog in the database.
+func (_c *UserLevelLogCreate) Save(ctx context.Context) (*UserLevelLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserLevelLogCreate) SaveX(ctx context.Context) *UserLevelLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserLevelLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserLevelLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserLevelLogCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userlevellog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := userlevellog.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserLevelLogCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserLevelLog.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserLevelLog.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserLevelLog.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := userlevellog.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FromLevel(); !ok {
+		return &ValidationError{Name: "from_level", err: errors.New(`ent: missing required field "UserLevelLog.from_level"`)}
+	}
+	if v, ok := _c.mutation.FromLevel(); ok {
+		if err := userlevellog.FromLevelValidator(v); err != nil {
+			return &ValidationError{Name: "from_level", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.from_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToLevel(); !ok {
+		return &ValidationError{Name: "to_level", err: errors.New(`ent: missing required field "UserLevelLog.to_level"`)}
+	}
+	if v, ok := _c.mutation.ToLevel(); ok {
+		if err := userlevellog.ToLevelValidator(v); err != nil {
+			return &ValidationError{Name: "to_level", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.to_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Experience(); !ok {
+		return &ValidationError{Name: "experience", err: errors.New(`ent: missing required field "UserLevelLog.experience"`)}
+	}
+	if v, ok := _c.mutation.Experience(); ok {
+		if err := userlevellog.ExperienceValidator(v); err != nil {
+			return &ValidationError{Name: "experience", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.experience": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := userlevellog.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UserLevelLogCreate) sqlSave(ctx context.Context) (*UserLevelLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserLevelLogCreate) createSpec() (*UserLevelLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserLevelLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userlevellog.Table, sqlgraph.NewFieldSpec(userlevellog.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userlevellog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(userlevellog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(userlevellog.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.FromLevel(); ok {
+		_spec.SetField(userlevellog.FieldFromLevel, field.TypeInt, value)
+		_node.FromLevel = value
+	}
+	if value, ok := _c.mutation.ToLevel(); ok {
+		_spec.SetField(userlevellog.FieldToLevel, field.TypeInt, value)
+		_node.ToLevel = value
+	}
+	if value, ok := _c.mutation.LevelName(); ok {
+		_spec.SetField(userlevellog.FieldLevelName, field.TypeString, value)
+		_node.LevelName = value
+	}
+	if value, ok := _c.mutation.Experience(); ok {
+		_spec.SetField(userlevellog.FieldExperience, field.TypeInt, value)
+		_node.Experience = value
+	}
+	if value, ok := _c.mutation.RelatedType(); ok {
+		_spec.SetField(userlevellog.FieldRelatedType, field.TypeString, value)
+		_node.RelatedType = value
+	}
+	return _node, _spec
+}
+
+// UserLevelLogCreateBulk is the builder for creating many UserLevelLog entities in bulk.
+type UserLevelLogCreateBulk struct {
+	config
+	err      error
+	builders []*UserLevelLogCreate
+}
+
+// Save creates the UserLevelLog entities in the database.
+func (_c *UserLevelLogCreateBulk) Save(ctx context.Context) ([]*UserLevelLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserLevelLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserLevelLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserLevelLogCreateBulk) SaveX(ctx context.Context) []*UserLevelLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserLevelLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserLevelLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/userlevellog"
+)
+
+// UserLevelLogUpdate is the builder for updating UserLevelLog entities.
+type UserLevelLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserLevelLogMutation
+}
+
+// Where appends a list predicates to the UserLevelLogUpdate builder.
+func (_u *UserLevelLogUpdate) Where(ps ...predicate.UserLevelLog) *UserLevelLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserLevelLogUpdate) SetUpdatedAt(v time.Time) *UserLevelLogUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserLevelLogUpdate) SetUserID(v int) *UserLevelLogUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserLevelLogUpdate) SetNillableUserID(v *int) *UserLevelLogUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserLevelLogUpdate) AddUserID(v int) *UserLevelLogUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetFromLevel sets the "from_level" field.
+func (_u *UserLevelLogUpdate) SetFromLevel(v int) *UserLevelLogUpdate {
+	_u.mutation.ResetFromLevel()
+	_u.mutation.SetFromLevel(v)
+	return _u
+}
+
+// SetNillableFromLevel sets the "from_level" field if the given value is not nil.
+func (_u *UserLevelLogUpdate) SetNillableFromLevel(v *int) *UserLevelLogUpdate {
+	if v != nil {
+		_u.SetFromLevel(*v)
+	}
+	return _u
+}
+
+// AddFromLevel adds value to the "from_level" field.
+func (_u *UserLevelLogUpdate) AddFromLevel(v int) *UserLevelLogUpdate {
+	_u.mutation.AddFromLevel(v)
+	return _u
+}
+
+// SetToLevel sets the "to_level" field.
+func (_u *UserLevelLogUpdate) SetToLevel(v int) *UserLevelLogUpdate {
+	_u.mutation.ResetToLevel()
+	_u.mutation.SetToLevel(v)
+	return _u
+}
+
+// SetNillableToLevel sets the "to_level" field if the given value is not nil.
+func (_u *UserLevelLogUpdate) SetNillableToLevel(v *int) *UserLevelLogUpdate {
+	if v != nil {
+		_u.SetToLevel(*v)
+	}
+	return _u
+}
+
+// AddToLevel adds value to the "to_level" field.
+func (_u *UserLevelLogUpdate) AddToLevel(v int) *UserLevelLogUpdate {
+	_u.mutation.AddToLevel(v)
+	return _u
+}
+
+// SetLevelName sets the "level_name" field.
+func (_u *UserLevelLogUpdate) SetLevelName(v string) *UserLevelLogUpdate {
+	_u.mutation.SetLevelName(v)
+	return _u
+}
+
+// SetNillableLevelName sets the "level_name" field if the given value is not nil.
+func (_u *UserLevelLogUpdate) SetNillableLevelName(v *string) *UserLevelLogUpdate {
+	if v != nil {
+		_u.SetLevelName(*v)
+	}
+	return _u
+}
+
+// ClearLevelName clears the value of the "level_name" field.
+func (_u *UserLevelLogUpdate) ClearLevelName() *UserLevelLogUpdate {
+	_u.mutation.ClearLevelName()
+	return _u
+}
+
+// SetExperience sets the "experience" field.
+func (_u *UserLevelLogUpdate) SetExperience(v int) *UserLevelLogUpdate {
+	_u.mutation.ResetExperience()
+	_u.mutation.SetExperience(v)
+	return _u
+}
+
+// SetNillableExperience sets the "experience" field if the given value is not nil.
+func (_u *UserLevelLogUpdate) SetNillableExperience(v *int) *UserLevelLogUpdate {
+	if v != nil {
+		_u.SetExperience(*v)
+	}
+	return _u
+}
+
+// AddExperience adds value to the "experience" field.
+func (_u *UserLevelLogUpdate) AddExperience(v int) *UserLevelLogUpdate {
+	_u.mutation.AddExperience(v)
+	return _u
+}
+
+// SetRelatedType sets the "related_type" field.
+func (_u *UserLevelLogUpdate) SetRelatedType(v string) *UserLevelLogUpdate {
+	_u.mutation.SetRelatedType(v)
+	return _u
+}
+
+// SetNillableRelatedType sets the "related_type" field if the given value is not nil.
+func (_u *UserLevelLogUpdate) SetNillableRelatedType(v *string) *UserLevelLogUpdate {
+	if v != nil {
+		_u.SetRelatedType(*v)
+	}
+	return _u
+}
+
+// ClearRelatedType clears the value of the "related_type" field.
+func (_u *UserLevelLogUpdate) ClearRelatedType() *UserLevelLogUpdate {
+	_u.mutation.ClearRelatedType()
+	return _u
+}
+
+// Mutation returns the UserLevelLogMutation object of the builder.
+func (_u *UserLevelLogUpdate) Mutation() *UserLevelLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserLevelLogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserLevelLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserLevelLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserLevelLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserLevelLogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userlevellog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserLevelLogUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := userlevellog.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FromLevel(); ok {
+		if err := userlevellog.FromLevelValidator(v); err != nil {
+			return &ValidationError{Name: "from_level", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.from_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToLevel(); ok {
+		if err := userlevellog.ToLevelValidator(v); err != nil {
+			return &ValidationError{Name: "to_level", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.to_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Experience(); ok {
+		if err := userlevellog.ExperienceValidator(v); err != nil {
+			return &ValidationError{Name: "experience", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.experience": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserLevelLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userlevellog.Table, userlevellog.Columns, sqlgraph.NewFieldSpec(userlevellog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userlevellog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userlevellog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(userlevellog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FromLevel(); ok {
+		_spec.SetField(userlevellog.FieldFromLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFromLevel(); ok {
+		_spec.AddField(userlevellog.FieldFromLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ToLevel(); ok {
+		_spec.SetField(userlevellog.FieldToLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedToLevel(); ok {
+		_spec.AddField(userlevellog.FieldToLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LevelName(); ok {
+		_spec.SetField(userlevellog.FieldLevelName, field.TypeString, value)
+	}
+	if _u.mutation.LevelNameCleared() {
+		_spec.ClearField(userlevellog.FieldLevelName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Experience(); ok {
+		_spec.SetField(userlevellog.FieldExperience, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExperience(); ok {
+		_spec.AddField(userlevellog.FieldExperience, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RelatedType(); ok {
+		_spec.SetField(userlevellog.FieldRelatedType, field.TypeString, value)
+	}
+	if _u.mutation.RelatedTypeCleared() {
+		_spec.ClearField(userlevellog.FieldRelatedType, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userlevellog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserLevelLogUpdateOne is the builder for updating a single UserLevelLog entity.
+type UserLevelLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserLevelLogMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserLevelLogUpdateOne) SetUpdatedAt(v time.Time) *UserLevelLogUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserLevelLogUpdateOne) SetUserID(v int) *UserLevelLogUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserLevelLogUpdateOne) SetNillableUserID(v *int) *UserLevelLogUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserLevelLogUpdateOne) AddUserID(v int) *UserLevelLogUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetFromLevel sets the "from_level" field.
+func (_u *UserLevelLogUpdateOne) SetFromLevel(v int) *UserLevelLogUpdateOne {
+	_u.mutation.ResetFromLevel()
+	_u.mutation.SetFromLevel(v)
+	return _u
+}
+
+// SetNillableFromLevel sets the "from_level" field if the given value is not nil.
+func (_u *UserLevelLogUpdateOne) SetNillableFromLevel(v *int) *UserLevelLogUpdateOne {
+	if v != nil {
+		_u.SetFromLevel(*v)
+	}
+	return _u
+}
+
+// AddFromLevel adds value to the "from_level" field.
+func (_u *UserLevelLogUpdateOne) AddFromLevel(v int) *UserLevelLogUpdateOne {
+	_u.mutation.AddFromLevel(v)
+	return _u
+}
+
+// SetToLevel sets the "to_level" field.
+func (_u *UserLevelLogUpdateOne) SetToLevel(v int) *UserLevelLogUpdateOne {
+	_u.mutation.ResetToLevel()
+	_u.mutation.SetToLevel(v)
+	return _u
+}
+
+// SetNillableToLevel sets the "to_level" field if the given value is not nil.
+func (_u *UserLevelLogUpdateOne) SetNillableToLevel(v *int) *UserLevelLogUpdateOne {
+	if v != nil {
+		_u.SetToLevel(*v)
+	}
+	return _u
+}
+
+// AddToLevel adds value to the "to_level" field.
+func (_u *UserLevelLogUpdateOne) AddToLevel(v int) *UserLevelLogUpdateOne {
+	_u.mutation.AddToLevel(v)
+	return _u
+}
+
+// SetLevelName sets the "level_name" field.
+func (_u *UserLevelLogUpdateOne) SetLevelName(v string) *UserLevelLogUpdateOne {
+	_u.mutation.SetLevelName(v)
+	return _u
+}
+
+// SetNillableLevelName sets the "level_name" field if the given value is not nil.
+func (_u *UserLevelLogUpdateOne) SetNillableLevelName(v *string) *UserLevelLogUpdateOne {
+	if v != nil {
+		_u.SetLevelName(*v)
+	}
+	return _u
+}
+
+// ClearLevelName clears the value of the "level_name" field.
+func (_u *UserLevelLogUpdateOne) ClearLevelName() *UserLevelLogUpdateOne {
+	_u.mutation.ClearLevelName()
+	return _u
+}
+
+// SetExperience sets the "experience" field.
+func (_u *UserLevelLogUpdateOne) SetExperience(v int) *UserLevelLogUpdateOne {
+	_u.mutation.ResetExperience()
+	_u.mutation.SetExperience(v)
+	return _u
+}
+
+// SetNillableExperience sets the "experience" field if the given value is not nil.
+func (_u *UserLevelLogUpdateOne) SetNillableExperience(v *int) *UserLevelLogUpdateOne {
+	if v != nil {
+		_u.SetExperience(*v)
+	}
+	return _u
+}
+
+// AddExperience adds value to the "experience" field.
+func (_u *UserLevelLogUpdateOne) AddExperience(v int) *UserLevelLogUpdateOne {
+	_u.mutation.AddExperience(v)
+	return _u
+}
+
+// SetRelatedType sets the "related_type" field.
+func (_u *UserLevelLogUpdateOne) SetRelatedType(v string) *UserLevelLogUpdateOne {
+	_u.mutation.SetRelatedType(v)
+	return _u
+}
+
+// SetNillableRelatedType sets the "related_type" field if the given value is not nil.
+func (_u *UserLevelLogUpdateOne) SetNillableRelatedType(v *string) *UserLevelLogUpdateOne {
+	if v != nil {
+		_u.SetRelatedType(*v)
+	}
+	return _u
+}
+
+// ClearRelatedType clears the value of the "related_type" field.
+func (_u *UserLevelLogUpdateOne) ClearRelatedType() *UserLevelLogUpdateOne {
+	_u.mutation.ClearRelatedType()
+	return _u
+}
+
+// Mutation returns the UserLevelLogMutation object of the builder.
+func (_u *UserLevelLogUpdateOne) Mutation() *UserLevelLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserLevelLogUpdate builder.
+func (_u *UserLevelLogUpdateOne) Where(ps ...predicate.UserLevelLog) *UserLevelLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserLevelLogUpdateOne) Select(field string, fields ...string) *UserLevelLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserLevelLog entity.
+func (_u *UserLevelLogUpdateOne) Save(ctx context.Context) (*UserLevelLog, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserLevelLogUpdateOne) SaveX(ctx context.Context) *UserLevelLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserLevelLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserLevelLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserLevelLogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userlevellog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserLevelLogUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := userlevellog.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FromLevel(); ok {
+		if err := userlevellog.FromLevelValidator(v); err != nil {
+			return &ValidationError{Name: "from_level", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.from_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToLevel(); ok {
+		if err := userlevellog.ToLevelValidator(v); err != nil {
+			return &ValidationError{Name: "to_level", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.to_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Experience(); ok {
+		if err := userlevellog.ExperienceValidator(v); err != nil {
+			return &ValidationError{Name: "experience", err: fmt.Errorf(`ent: validator failed for field "UserLevelLog.experience": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserLevelLogUpdateOne) sqlSave(ctx context.Context) (_node *UserLevelLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userlevellog.Table, userlevellog.Columns, sqlgraph.NewFieldSpec(userlevellog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserLevelLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userlevellog.FieldID)
+		for _, f := range fields {
+			if !userlevellog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userlevellog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userlevellog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userlevellog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(userlevellog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FromLevel(); ok {
+		_spec.SetField(userlevellog.FieldFromLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFromLevel(); ok {
+		_spec.AddField(userlevellog.FieldFromLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ToLevel(); ok {
+		_spec.SetField(userlevellog.FieldToLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedToLevel(); ok {
+		_spec.AddField(userlevellog.FieldToLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LevelName(); ok {
+		_spec.SetField(userlevellog.FieldLevelName, field.TypeString, value)
+	}
+	if _u.mutation.LevelNameCleared() {
+		_spec.ClearField(userlevellog.FieldLevelName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Experience(); ok {
+		_spec.SetField(userlevellog.FieldExperience, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExperience(); ok {
+		_spec.AddField(userlevellog.FieldExperience, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RelatedType(); ok {
+		_spec.SetField(userlevellog.FieldRelatedType, field.TypeString, value)
+	}
+	if _u.mutation.RelatedTypeCleared() {
+		_spec.ClearField(userlevellog.FieldRelatedType, field.TypeString)
+	}
+	_node = &UserLevelLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userlevellog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usertwofactor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldUserID, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldSecret, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldEnabled, v))
+}
+
+// LastUsedStep applies equality check predicate on the "last_used_step" field. It's identical to LastUsedStepEQ.
+func LastUsedStep(v int64) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldLastUsedStep, v))
+}
+
+// EnabledAt applies equality check predicate on the "enabled_at" field. It's identical to EnabledAtEQ.
+func EnabledAt(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldEnabledAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLTE(FieldUserID, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// RecoveryCodesIsNil applies the IsNil predicate on the "recovery_codes" field.
+func RecoveryCodesIsNil() predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldIsNull(FieldRecoveryCodes))
+}
+
+// RecoveryCodesNotNil applies the NotNil predicate on the "recovery_codes" field.
+func RecoveryCodesNotNil() predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNotNull(FieldRecoveryCodes))
+}
+
+// LastUsedStepEQ applies the EQ predicate on the "last_used_step" field.
+func LastUsedStepEQ(v int64) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldLastUsedStep, v))
+}
+
+// LastUsedStepNEQ applies the NEQ predicate on the "last_used_step" field.
+func LastUsedStepNEQ(v int64) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNEQ(FieldLastUsedStep, v))
+}
+
+// LastUsedStepIn applies the In predicate on the "last_used_step" field.
+func LastUsedStepIn(vs ...int64) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldIn(FieldLastUsedStep, vs...))
+}
+
+// LastUsedStepNotIn applies the NotIn predicate on the "last_used_step" field.
+func LastUsedStepNotIn(vs ...int64) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNotIn(FieldLastUsedStep, vs...))
+}
+
+// LastUsedStepGT applies the GT predicate on the "last_used_step" field.
+func LastUsedStepGT(v int64) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGT(FieldLastUsedStep, v))
+}
+
+// LastUsedStepGTE applies the GTE predicate on the "last_used_step" field.
+func LastUsedStepGTE(v int64) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGTE(FieldLastUsedStep, v))
+}
+
+// LastUsedStepLT applies the LT predicate on the "last_used_step" field.
+func LastUsedStepLT(v int64) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLT(FieldLastUsedStep, v))
+}
+
+// LastUsedStepLTE applies the LTE predicate on the "last_used_step" field.
+func LastUsedStepLTE(v int64) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLTE(FieldLastUsedStep, v))
+}
+
+// EnabledAtEQ applies the EQ predicate on the "enabled_at" field.
+func EnabledAtEQ(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldEQ(FieldEnabledAt, v))
+}
+
+// EnabledAtNEQ applies the NEQ predicate on the "enabled_at" field.
+func EnabledAtNEQ(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNEQ(FieldEnabledAt, v))
+}
+
+// EnabledAtIn applies the In predicate on the "enabled_at" field.
+func EnabledAtIn(vs ...time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldIn(FieldEnabledAt, vs...))
+}
+
+// EnabledAtNotIn applies the NotIn predicate on the "enabled_at" field.
+func EnabledAtNotIn(vs ...time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNotIn(FieldEnabledAt, vs...))
+}
+
+// EnabledAtGT applies the GT predicate on the "enabled_at" field.
+func EnabledAtGT(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGT(FieldEnabledAt, v))
+}
+
+// EnabledAtGTE applies the GTE predicate on the "enabled_at" field.
+func EnabledAtGTE(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldGTE(FieldEnabledAt, v))
+}
+
+// EnabledAtLT applies the LT predicate on the "enabled_at" field.
+func EnabledAtLT(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLT(FieldEnabledAt, v))
+}
+
+// EnabledAtLTE applies the LTE predicate on the "enabled_at" field.
+func EnabledAtLTE(v time.Time) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldLTE(FieldEnabledAt, v))
+}
+
+// EnabledAtIsNil applies the IsNil predicate on the "enabled_at" field.
+func EnabledAtIsNil() predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldIsNull(FieldEnabledAt))
+}
+
+// EnabledAtNotNil applies the NotNil predicate on the "enabled_at" field.
+func EnabledAtNotNil() predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.FieldNotNull(FieldEnabledAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserTwoFactor) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserTwoFactor) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserTwoFactor) predicate.UserTwoFactor {
+	return predicate.UserTwoFactor(sql.NotPredicates(p))
+}
@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/usertwofactor"
+)
+
+// UserTwoFactorCreate is the builder for creating a UserTwoFactor entity.
+type UserTwoFactorCreate struct {
+	config
+	mutation *UserTwoFactorMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserTwoFactorCreate) SetCreatedAt(v time.Time) *UserTwoFactorCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserTwoFactorCreate) SetNillableCreatedAt(v *time.Time) *UserTwoFactorCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserTwoFactorCreate) SetUpdatedAt(v time.Time) *UserTwoFactorCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserTwoFactorCreate) SetNillableUpdatedAt(v *time.Time) *UserTwoFactorCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserTwoFactorCreate) SetUserID(v int) *UserTwoFactorCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *UserTwoFactorCreate) SetSecret(v string) *UserTwoFactorCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *UserTwoFactorCreate) SetEnabled(v bool) *UserTwoFactorCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *UserTwoFactorCreate) SetNillableEnabled(v *bool) *UserTwoFactorCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (_c *UserTwoFactorCreate) SetRecoveryCodes(v []string) *UserTwoFactorCreate {
+	_c.mutation.SetRecoveryCodes(v)
+	return _c
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_c *UserTwoFactorCreate) SetLastUsedStep(v int64) *UserTwoFactorCreate {
+	_c.mutation.SetLastUsedStep(v)
+	return _c
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_c *UserTwoFactorCreate) SetNillableLastUsedStep(v *int64) *UserTwoFactorCreate {
+	if v != nil {
+		_c.SetLastUsedStep(*v)
+	}
+	return _c
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (_c *UserTwoFactorCreate) SetEnabledAt(v time.Time) *UserTwoFactorCreate {
+	_c.mutation.SetEnabledAt(v)
+	return _c
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (_c *UserTwoFactorCreate) SetNillableEnabledAt(v *time.Time) *UserTwoFactorCreate {
+	if v != nil {
+		_c.SetEnabledAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserTwoFactorCreate) SetID(v int) *UserTwoFactorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UserTwoFactorMutation object of the builder.
+func (_c *UserTwoFactorCreate) Mutation() *UserTwoFactorMutation {
+	return _c.mutation
+}
+
+// Save creates the UserTwoFactor in the database.
+func (_c *UserTwoFactorCreate) Save(ctx context.Context) (*UserTwoFactor, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserTwoFactorCreate) SaveX(ctx context.Context) *UserTwoFactor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserTwoFactorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserTwoFactorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserTwoFactorCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := usertwofactor.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := usertwofactor.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := usertwofactor.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.LastUsedStep(); !ok {
+		v := usertwofactor.DefaultLastUsedStep
+		_c.mutation.SetLastUsedStep(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserTwoFactorCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserTwoFactor.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserTwoFactor.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserTwoFactor.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := usertwofactor.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "UserTwoFactor.secret"`)}
+	}
+	if v, ok := _c.mutation.Secret(); ok {
+		if err := usertwofactor.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "UserTwoFactor.enabled"`)}
+	}
+	if _, ok := _c.mutation.LastUsedStep(); !ok {
+		return &ValidationError{Name: "last_used_step", err: errors.New(`ent: missing required field "UserTwoFactor.last_used_step"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := usertwofactor.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UserTwoFactorCreate) sqlSave(ctx context.Context) (*UserTwoFactor, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserTwoFactorCreate) createSpec() (*UserTwoFactor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserTwoFactor{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usertwofactor.Table, sqlgraph.NewFieldSpec(usertwofactor.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(usertwofactor.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertwofactor.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(usertwofactor.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(usertwofactor.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(usertwofactor.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usertwofactor.FieldRecoveryCodes, field.TypeJSON, value)
+		_node.RecoveryCodes = value
+	}
+	if value, ok := _c.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+		_node.LastUsedStep = value
+	}
+	if value, ok := _c.mutation.EnabledAt(); ok {
+		_spec.SetField(usertwofactor.FieldEnabledAt, field.TypeTime, value)
+		_node.EnabledAt = value
+	}
+	return _node, _spec
+}
+
+// UserTwoFactorCreateBulk is the builder for creating many UserTwoFactor entities in bulk.
+type UserTwoFactorCreateBulk struct {
+	config
+	err      error
+	builders []*UserTwoFactorCreate
+}
+
+// Save creates the UserTwoFactor entities in the database.
+func (_c *UserTwoFactorCreateBulk) Save(ctx context.Context) ([]*UserTwoFactor, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserTwoFactor, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserTwoFactorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserTwoFactorCreateBulk) SaveX(ctx context.Context) []*UserTwoFactor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserTwoFactorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserTwoFactorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
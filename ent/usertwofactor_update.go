@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/usertwofactor"
+)
+
+// UserTwoFactorUpdate is the builder for updating UserTwoFactor entities.
+type UserTwoFactorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserTwoFactorMutation
+}
+
+// Where appends a list predicates to the UserTwoFactorUpdate builder.
+func (_u *UserTwoFactorUpdate) Where(ps ...predicate.UserTwoFactor) *UserTwoFactorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserTwoFactorUpdate) SetUpdatedAt(v time.Time) *UserTwoFactorUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserTwoFactorUpdate) SetUserID(v int) *UserTwoFactorUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserTwoFactorUpdate) SetNillableUserID(v *int) *UserTwoFactorUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserTwoFactorUpdate) AddUserID(v int) *UserTwoFactorUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *UserTwoFactorUpdate) SetSecret(v string) *UserTwoFactorUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *UserTwoFactorUpdate) SetNillableSecret(v *string) *UserTwoFactorUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *UserTwoFactorUpdate) SetEnabled(v bool) *UserTwoFactorUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *UserTwoFactorUpdate) SetNillableEnabled(v *bool) *UserTwoFactorUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (_u *UserTwoFactorUpdate) SetRecoveryCodes(v []string) *UserTwoFactorUpdate {
+	_u.mutation.SetRecoveryCodes(v)
+	return _u
+}
+
+// AppendRecoveryCodes appends value to the "recovery_codes" field.
+func (_u *UserTwoFactorUpdate) AppendRecoveryCodes(v []string) *UserTwoFactorUpdate {
+	_u.mutation.AppendRecoveryCodes(v)
+	return _u
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (_u *UserTwoFactorUpdate) ClearRecoveryCodes() *UserTwoFactorUpdate {
+	_u.mutation.ClearRecoveryCodes()
+	return _u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_u *UserTwoFactorUpdate) SetLastUsedStep(v int64) *UserTwoFactorUpdate {
+	_u.mutation.ResetLastUsedStep()
+	_u.mutation.SetLastUsedStep(v)
+	return _u
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_u *UserTwoFactorUpdate) SetNillableLastUsedStep(v *int64) *UserTwoFactorUpdate {
+	if v != nil {
+		_u.SetLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddLastUsedStep adds value to the "last_used_step" field.
+func (_u *UserTwoFactorUpdate) AddLastUsedStep(v int64) *UserTwoFactorUpdate {
+	_u.mutation.AddLastUsedStep(v)
+	return _u
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (_u *UserTwoFactorUpdate) SetEnabledAt(v time.Time) *UserTwoFactorUpdate {
+	_u.mutation.SetEnabledAt(v)
+	return _u
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (_u *UserTwoFactorUpdate) SetNillableEnabledAt(v *time.Time) *UserTwoFactorUpdate {
+	if v != nil {
+		_u.SetEnabledAt(*v)
+	}
+	return _u
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (_u *UserTwoFactorUpdate) ClearEnabledAt() *UserTwoFactorUpdate {
+	_u.mutation.ClearEnabledAt()
+	return _u
+}
+
+// Mutation returns the UserTwoFactorMutation object of the builder.
+func (_u *UserTwoFactorUpdate) Mutation() *UserTwoFactorMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserTwoFactorUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserTwoFactorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserTwoFactorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserTwoFactorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserTwoFactorUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usertwofactor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserTwoFactorUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := usertwofactor.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := usertwofactor.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserTwoFactorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertwofactor.Table, usertwofactor.Columns, sqlgraph.NewFieldSpec(usertwofactor.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertwofactor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(usertwofactor.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(usertwofactor.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(usertwofactor.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(usertwofactor.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usertwofactor.FieldRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usertwofactor.FieldRecoveryCodes, value)
+		})
+	}
+	if _u.mutation.RecoveryCodesCleared() {
+		_spec.ClearField(usertwofactor.FieldRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EnabledAt(); ok {
+		_spec.SetField(usertwofactor.FieldEnabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.EnabledAtCleared() {
+		_spec.ClearField(usertwofactor.FieldEnabledAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertwofactor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserTwoFactorUpdateOne is the builder for updating a single UserTwoFactor entity.
+type UserTwoFactorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserTwoFactorMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserTwoFactorUpdateOne) SetUpdatedAt(v time.Time) *UserTwoFactorUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserTwoFactorUpdateOne) SetUserID(v int) *UserTwoFactorUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserTwoFactorUpdateOne) SetNillableUserID(v *int) *UserTwoFactorUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserTwoFactorUpdateOne) AddUserID(v int) *UserTwoFactorUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *UserTwoFactorUpdateOne) SetSecret(v string) *UserTwoFactorUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *UserTwoFactorUpdateOne) SetNillableSecret(v *string) *UserTwoFactorUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *UserTwoFactorUpdateOne) SetEnabled(v bool) *UserTwoFactorUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *UserTwoFactorUpdateOne) SetNillableEnabled(v *bool) *UserTwoFactorUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (_u *UserTwoFactorUpdateOne) SetRecoveryCodes(v []string) *UserTwoFactorUpdateOne {
+	_u.mutation.SetRecoveryCodes(v)
+	return _u
+}
+
+// AppendRecoveryCodes appends value to the "recovery_codes" field.
+func (_u *UserTwoFactorUpdateOne) AppendRecoveryCodes(v []string) *UserTwoFactorUpdateOne {
+	_u.mutation.AppendRecoveryCodes(v)
+	return _u
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (_u *UserTwoFactorUpdateOne) ClearRecoveryCodes() *UserTwoFactorUpdateOne {
+	_u.mutation.ClearRecoveryCodes()
+	return _u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_u *UserTwoFactorUpdateOne) SetLastUsedStep(v int64) *UserTwoFactorUpdateOne {
+	_u.mutation.ResetLastUsedStep()
+	_u.mutation.SetLastUsedStep(v)
+	return _u
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_u *UserTwoFactorUpdateOne) SetNillableLastUsedStep(v *int64) *UserTwoFactorUpdateOne {
+	if v != nil {
+		_u.SetLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddLastUsedStep adds value to the "last_used_step" field.
+func (_u *UserTwoFactorUpdateOne) AddLastUsedStep(v int64) *UserTwoFactorUpdateOne {
+	_u.mutation.AddLastUsedStep(v)
+	return _u
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (_u *UserTwoFactorUpdateOne) SetEnabledAt(v time.Time) *UserTwoFactorUpdateOne {
+	_u.mutation.SetEnabledAt(v)
+	return _u
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (_u *UserTwoFactorUpdateOne) SetNillableEnabledAt(v *time.Time) *UserTwoFactorUpdateOne {
+	if v != nil {
+		_u.SetEnabledAt(*v)
+	}
+	return _u
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (_u *UserTwoFactorUpdateOne) ClearEnabledAt() *UserTwoFactorUpdateOne {
+	_u.mutation.ClearEnabledAt()
+	return _u
+}
+
+// Mutation returns the UserTwoFactorMutation object of the builder.
+func (_u *UserTwoFactorUpdateOne) Mutation() *UserTwoFactorMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserTwoFactorUpdate builder.
+func (_u *UserTwoFactorUpdateOne) Where(ps ...predicate.UserTwoFactor) *UserTwoFactorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserTwoFactorUpdateOne) Select(field string, fields ...string) *UserTwoFactorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserTwoFactor entity.
+func (_u *UserTwoFactorUpdateOne) Save(ctx context.Context) (*UserTwoFactor, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserTwoFactorUpdateOne) SaveX(ctx context.Context) *UserTwoFactor {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserTwoFactorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserTwoFactorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserTwoFactorUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usertwofactor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserTwoFactorUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := usertwofactor.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := usertwofactor.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserTwoFactorUpdateOne) sqlSave(ctx context.Context) (_node *UserTwoFactor, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertwofactor.Table, usertwofactor.Columns, sqlgraph.NewFieldSpec(usertwofactor.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserTwoFactor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usertwofactor.FieldID)
+		for _, f := range fields {
+			if !usertwofactor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usertwofactor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertwofactor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(usertwofactor.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(usertwofactor.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(usertwofactor.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(usertwofactor.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usertwofactor.FieldRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usertwofactor.FieldRecoveryCodes, value)
+		})
+	}
+	if _u.mutation.RecoveryCodesCleared() {
+		_spec.ClearField(usertwofactor.FieldRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.EnabledAt(); ok {
+		_spec.SetField(usertwofactor.FieldEnabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.EnabledAtCleared() {
+		_spec.ClearField(usertwofactor.FieldEnabledAt, field.TypeTime)
+	}
+	_node = &UserTwoFactor{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertwofactor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
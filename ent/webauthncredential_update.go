@@ -0,0 +1,767 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/webauthncredential"
+)
+
+// WebAuthnCredentialUpdate is the builder for updating WebAuthnCredential entities.
+type WebAuthnCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebAuthnCredentialMutation
+}
+
+// Where appends a list predicates to the WebAuthnCredentialUpdate builder.
+func (_u *WebAuthnCredentialUpdate) Where(ps ...predicate.WebAuthnCredential) *WebAuthnCredentialUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebAuthnCredentialUpdate) SetUpdatedAt(v time.Time) *WebAuthnCredentialUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WebAuthnCredentialUpdate) SetUserID(v int) *WebAuthnCredentialUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdate) SetNillableUserID(v *int) *WebAuthnCredentialUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *WebAuthnCredentialUpdate) AddUserID(v int) *WebAuthnCredentialUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *WebAuthnCredentialUpdate) SetCredentialID(v string) *WebAuthnCredentialUpdate {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdate) SetNillableCredentialID(v *string) *WebAuthnCredentialUpdate {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *WebAuthnCredentialUpdate) SetPublicKey(v []byte) *WebAuthnCredentialUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *WebAuthnCredentialUpdate) SetAttestationType(v string) *WebAuthnCredentialUpdate {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdate) SetNillableAttestationType(v *string) *WebAuthnCredentialUpdate {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *WebAuthnCredentialUpdate) ClearAttestationType() *WebAuthnCredentialUpdate {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *WebAuthnCredentialUpdate) SetAaguid(v []byte) *WebAuthnCredentialUpdate {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *WebAuthnCredentialUpdate) ClearAaguid() *WebAuthnCredentialUpdate {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *WebAuthnCredentialUpdate) SetSignCount(v uint32) *WebAuthnCredentialUpdate {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdate) SetNillableSignCount(v *uint32) *WebAuthnCredentialUpdate {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *WebAuthnCredentialUpdate) AddSignCount(v int32) *WebAuthnCredentialUpdate {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *WebAuthnCredentialUpdate) SetTransports(v []string) *WebAuthnCredentialUpdate {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *WebAuthnCredentialUpdate) AppendTransports(v []string) *WebAuthnCredentialUpdate {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *WebAuthnCredentialUpdate) ClearTransports() *WebAuthnCredentialUpdate {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetFlags sets the "flags" field.
+func (_u *WebAuthnCredentialUpdate) SetFlags(v uint8) *WebAuthnCredentialUpdate {
+	_u.mutation.ResetFlags()
+	_u.mutation.SetFlags(v)
+	return _u
+}
+
+// SetNillableFlags sets the "flags" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdate) SetNillableFlags(v *uint8) *WebAuthnCredentialUpdate {
+	if v != nil {
+		_u.SetFlags(*v)
+	}
+	return _u
+}
+
+// AddFlags adds value to the "flags" field.
+func (_u *WebAuthnCredentialUpdate) AddFlags(v int8) *WebAuthnCredentialUpdate {
+	_u.mutation.AddFlags(v)
+	return _u
+}
+
+// SetCloneWarning sets the "clone_warning" field.
+func (_u *WebAuthnCredentialUpdate) SetCloneWarning(v bool) *WebAuthnCredentialUpdate {
+	_u.mutation.SetCloneWarning(v)
+	return _u
+}
+
+// SetNillableCloneWarning sets the "clone_warning" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdate) SetNillableCloneWarning(v *bool) *WebAuthnCredentialUpdate {
+	if v != nil {
+		_u.SetCloneWarning(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WebAuthnCredentialUpdate) SetName(v string) *WebAuthnCredentialUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdate) SetNillableName(v *string) *WebAuthnCredentialUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *WebAuthnCredentialUpdate) ClearName() *WebAuthnCredentialUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *WebAuthnCredentialUpdate) SetLastUsedAt(v time.Time) *WebAuthnCredentialUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdate) SetNillableLastUsedAt(v *time.Time) *WebAuthnCredentialUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *WebAuthnCredentialUpdate) ClearLastUsedAt() *WebAuthnCredentialUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// Mutation returns the WebAuthnCredentialMutation object of the builder.
+func (_u *WebAuthnCredentialUpdate) Mutation() *WebAuthnCredentialMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebAuthnCredentialUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebAuthnCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebAuthnCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebAuthnCredentialUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebAuthnCredentialUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webauthncredential.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebAuthnCredentialUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := webauthncredential.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := webauthncredential.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PublicKey(); ok {
+		if err := webauthncredential.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.public_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := webauthncredential.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WebAuthnCredentialUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(webauthncredential.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(webauthncredential.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(webauthncredential.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(webauthncredential.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(webauthncredential.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(webauthncredential.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(webauthncredential.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(webauthncredential.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Flags(); ok {
+		_spec.SetField(webauthncredential.FieldFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedFlags(); ok {
+		_spec.AddField(webauthncredential.FieldFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.CloneWarning(); ok {
+		_spec.SetField(webauthncredential.FieldCloneWarning, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(webauthncredential.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebAuthnCredentialUpdateOne is the builder for updating a single WebAuthnCredential entity.
+type WebAuthnCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebAuthnCredentialMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebAuthnCredentialUpdateOne) SetUpdatedAt(v time.Time) *WebAuthnCredentialUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WebAuthnCredentialUpdateOne) SetUserID(v int) *WebAuthnCredentialUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdateOne) SetNillableUserID(v *int) *WebAuthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *WebAuthnCredentialUpdateOne) AddUserID(v int) *WebAuthnCredentialUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *WebAuthnCredentialUpdateOne) SetCredentialID(v string) *WebAuthnCredentialUpdateOne {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdateOne) SetNillableCredentialID(v *string) *WebAuthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *WebAuthnCredentialUpdateOne) SetPublicKey(v []byte) *WebAuthnCredentialUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *WebAuthnCredentialUpdateOne) SetAttestationType(v string) *WebAuthnCredentialUpdateOne {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdateOne) SetNillableAttestationType(v *string) *WebAuthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *WebAuthnCredentialUpdateOne) ClearAttestationType() *WebAuthnCredentialUpdateOne {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *WebAuthnCredentialUpdateOne) SetAaguid(v []byte) *WebAuthnCredentialUpdateOne {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *WebAuthnCredentialUpdateOne) ClearAaguid() *WebAuthnCredentialUpdateOne {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *WebAuthnCredentialUpdateOne) SetSignCount(v uint32) *WebAuthnCredentialUpdateOne {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdateOne) SetNillableSignCount(v *uint32) *WebAuthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *WebAuthnCredentialUpdateOne) AddSignCount(v int32) *WebAuthnCredentialUpdateOne {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *WebAuthnCredentialUpdateOne) SetTransports(v []string) *WebAuthnCredentialUpdateOne {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *WebAuthnCredentialUpdateOne) AppendTransports(v []string) *WebAuthnCredentialUpdateOne {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *WebAuthnCredentialUpdateOne) ClearTransports() *WebAuthnCredentialUpdateOne {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetFlags sets the "flags" field.
+func (_u *WebAuthnCredentialUpdateOne) SetFlags(v uint8) *WebAuthnCredentialUpdateOne {
+	_u.mutation.ResetFlags()
+	_u.mutation.SetFlags(v)
+	return _u
+}
+
+// SetNillableFlags sets the "flags" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdateOne) SetNillableFlags(v *uint8) *WebAuthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetFlags(*v)
+	}
+	return _u
+}
+
+// AddFlags adds value to the "flags" field.
+func (_u *WebAuthnCredentialUpdateOne) AddFlags(v int8) *WebAuthnCredentialUpdateOne {
+	_u.mutation.AddFlags(v)
+	return _u
+}
+
+// SetCloneWarning sets the "clone_warning" field.
+func (_u *WebAuthnCredentialUpdateOne) SetCloneWarning(v bool) *WebAuthnCredentialUpdateOne {
+	_u.mutation.SetCloneWarning(v)
+	return _u
+}
+
+// SetNillableCloneWarning sets the "clone_warning" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdateOne) SetNillableCloneWarning(v *bool) *WebAuthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetCloneWarning(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WebAuthnCredentialUpdateOne) SetName(v string) *WebAuthnCredentialUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdateOne) SetNillableName(v *string) *WebAuthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *WebAuthnCredentialUpdateOne) ClearName() *WebAuthnCredentialUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *WebAuthnCredentialUpdateOne) SetLastUsedAt(v time.Time) *WebAuthnCredentialUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *WebAuthnCredentialUpdateOne) SetNillableLastUsedAt(v *time.Time) *WebAuthnCredentialUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *WebAuthnCredentialUpdateOne) ClearLastUsedAt() *WebAuthnCredentialUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// Mutation returns the WebAuthnCredentialMutation object of the builder.
+func (_u *WebAuthnCredentialUpdateOne) Mutation() *WebAuthnCredentialMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WebAuthnCredentialUpdate builder.
+func (_u *WebAuthnCredentialUpdateOne) Where(ps ...predicate.WebAuthnCredential) *WebAuthnCredentialUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebAuthnCredentialUpdateOne) Select(field string, fields ...string) *WebAuthnCredentialUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebAuthnCredential entity.
+func (_u *WebAuthnCredentialUpdateOne) Save(ctx context.Context) (*WebAuthnCredential, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebAuthnCredentialUpdateOne) SaveX(ctx context.Context) *WebAuthnCredential {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebAuthnCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebAuthnCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebAuthnCredentialUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webauthncredential.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebAuthnCredentialUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := webauthncredential.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := webauthncredential.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PublicKey(); ok {
+		if err := webauthncredential.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.public_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := webauthncredential.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WebAuthnCredential.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WebAuthnCredentialUpdateOne) sqlSave(ctx context.Context) (_node *WebAuthnCredential, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthncredential.Table, webauthncredential.Columns, sqlgraph.NewFieldSpec(webauthncredential.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebAuthnCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthncredential.FieldID)
+		for _, f := range fields {
+			if !webauthncredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webauthncredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webauthncredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(webauthncredential.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(webauthncredential.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(webauthncredential.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(webauthncredential.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(webauthncredential.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(webauthncredential.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(webauthncredential.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(webauthncredential.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(webauthncredential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(webauthncredential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webauthncredential.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(webauthncredential.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Flags(); ok {
+		_spec.SetField(webauthncredential.FieldFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedFlags(); ok {
+		_spec.AddField(webauthncredential.FieldFlags, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.CloneWarning(); ok {
+		_spec.SetField(webauthncredential.FieldCloneWarning, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(webauthncredential.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(webauthncredential.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(webauthncredential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(webauthncredential.FieldLastUsedAt, field.TypeTime)
+	}
+	_node = &WebAuthnCredential{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthncredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,5 @@
+package autoload
+
+type Proxy struct {
+	TrustedProxies []string `mapstructure:"trusted_proxies" json:"trusted_proxies" yaml:"trusted_proxies"`
+}
@@ -0,0 +1,23 @@
+package autoload
+
+type Storage struct {
+	Driver string    `mapstructure:"driver" json:"driver" yaml:"driver"`
+	Local  LocalDisk `mapstructure:"local" json:"local" yaml:"local"`
+	S3     S3        `mapstructure:"s3" json:"s3" yaml:"s3"`
+}
+
+type LocalDisk struct {
+	Path      string `mapstructure:"path" json:"path" yaml:"path"`
+	PublicURL string `mapstructure:"public_url" json:"public_url" yaml:"public_url"`
+}
+
+type S3 struct {
+	Endpoint  string `mapstructure:"endpoint" json:"endpoint" yaml:"endpoint"`
+	Region    string `mapstructure:"region" json:"region" yaml:"region"`
+	Bucket    string `mapstructure:"bucket" json:"bucket" yaml:"bucket"`
+	AccessKey string `mapstructure:"access_key" json:"access_key" yaml:"access_key"`
+	SecretKey string `mapstructure:"secret_key" json:"secret_key" yaml:"secret_key"`
+	UseSSL    bool   `mapstructure:"use_ssl" json:"use_ssl" yaml:"use_ssl"`
+	PathStyle bool   `mapstructure:"path_style" json:"path_style" yaml:"path_style"`
+	PublicURL string `mapstructure:"public_url" json:"public_url" yaml:"public_url"`
+}
@@ -19,8 +19,10 @@ var (
 )
 
 type Configuration struct {
-	DB    autoload.DB    `mapstructure:"db" json:"db" yaml:"db"`
-	Cache autoload.Cache `mapstructure:"cache" json:"cache" yaml:"cache"`
+	DB      autoload.DB      `mapstructure:"db" json:"db" yaml:"db"`
+	Cache   autoload.Cache   `mapstructure:"cache" json:"cache" yaml:"cache"`
+	Proxy   autoload.Proxy   `mapstructure:"proxy" json:"proxy" yaml:"proxy"`
+	Storage autoload.Storage `mapstructure:"storage" json:"storage" yaml:"storage"`
 }
 
 // 全局方法
@@ -0,0 +1,26 @@
+package _const
+
+// 帖子阅读权限模式，带参数的模式格式为 "模式:参数"，如 pay_to_view:10
+const (
+	// ReadPermissionPublic 公开可见
+	ReadPermissionPublic = "public"
+	// ReadPermissionLogin 登录后可见
+	ReadPermissionLogin = "login"
+	// ReadPermissionMinExperience 累计经验值达到门槛后可见，参数为最低经验值（原始经验值，不换算等级）
+	ReadPermissionMinExperience = "min_experience"
+	// ReadPermissionMinLevel 用户等级达到门槛后可见，参数为最低等级，按等级表换算，等级从1开始
+	ReadPermissionMinLevel = "min_level"
+	// ReadPermissionMinRole 身份达到门槛后可见，参数为最低身份（User、Moderator、Admin、SuperAdmin）
+	ReadPermissionMinRole = "min_role"
+	// ReadPermissionReplyToView 回复后可见
+	ReadPermissionReplyToView = "reply_to_view"
+	// ReadPermissionPayToView 付费后可见，参数为所需货币数量
+	ReadPermissionPayToView = "pay_to_view"
+)
+
+const (
+	// ReadPermissionTeaserLength 无阅读权限时展示的正文预览最大字符数
+	ReadPermissionTeaserLength = 100
+	// ReadPermissionMaxPrice 付费阅读的最高价格
+	ReadPermissionMaxPrice = 100000
+)
@@ -0,0 +1,5 @@
+package _const
+
+// SearchTextConfig 全文检索使用的PostgreSQL文本搜索配置
+// simple 仅按空白与标点切分，不做词干处理；安装 zhparser 等中文分词扩展后可替换为对应配置并重建检索列
+const SearchTextConfig = "simple"
@@ -76,6 +76,8 @@ const (
 	EmailAccountActivationTemplate = "email:account_activation_template"
 	// EmailPasswordResetTemplate 重置密码模板
 	EmailPasswordResetTemplate = "email:password_reset_template"
+	// EmailMentionTemplate 提及提醒模板
+	EmailMentionTemplate = "email:mention_template"
 )
 
 // SEO设置
@@ -108,6 +110,16 @@ const (
 	SafeEmailWhitelist = "safe:email_whitelist"
 	// SafeVerifyEmail 是否验证邮箱
 	SafeVerifyEmail = "safe:verify_email"
+	// SafeForceTwoFactor 是否强制版主及以上身份启用两步验证
+	SafeForceTwoFactor = "safe:force_two_factor"
+	// SafeIsEnableInviteCode 是否开启邀请码注册
+	SafeIsEnableInviteCode = "safe:is_enable_invite_code"
+	// SafeInviteCodeUserLimit 每个用户可创建的邀请码总数，已作废或已用尽的邀请码同样计入，0表示不限制
+	SafeInviteCodeUserLimit = "safe:invite_code_user_limit"
+	// SafeInviteCodeMaxUses 每个邀请码可使用次数，0表示不限制
+	SafeInviteCodeMaxUses = "safe:invite_code_max_uses"
+	// SafeMessageMinExperience 发起私信所需的最低经验值，0表示不限制
+	SafeMessageMinExperience = "safe:message_min_experience"
 )
 
 // 签到设置
@@ -131,3 +143,28 @@ const (
 	// SigninExperienceReward 经验值奖励
 	SigninExperienceReward = "signin:experience_reward"
 )
+
+// 上传设置
+const (
+	// UploadMaxFileSize 单个附件大小上限（KB）
+	UploadMaxFileSize = "upload:max_file_size"
+	// UploadAllowedMimeTypes 允许上传的MIME类型，逗号分隔，支持 image/* 形式的通配
+	UploadAllowedMimeTypes = "upload:allowed_mime_types"
+	// UploadUserQuota 每个用户的附件总容量（MB），0表示不限制
+	UploadUserQuota = "upload:user_quota"
+)
+
+const (
+	// UploadDefaultMaxFileSize 默认单个附件大小上限（KB）
+	UploadDefaultMaxFileSize = 10240
+	// UploadDefaultAllowedMimeTypes 默认允许上传的MIME类型
+	UploadDefaultAllowedMimeTypes = "image/jpeg,image/png,image/gif,image/webp,application/pdf,application/zip,text/plain"
+	// UploadDefaultUserQuota 默认每个用户的附件总容量（MB）
+	UploadDefaultUserQuota = 1024
+)
+
+// 等级设置
+const (
+	// LevelTable 等级表，JSON格式，按经验值阈值升序排列
+	LevelTable = "level:table"
+)
@@ -0,0 +1,254 @@
+package controller
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/middleware"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// AttachmentController 附件控制器
+type AttachmentController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewAttachmentController 创建附件控制器实例
+func NewAttachmentController(injector *do.Injector) *AttachmentController {
+	return &AttachmentController{
+		injector: injector,
+	}
+}
+
+// AttachmentRouter 附件相关路由注册
+func (ctrl *AttachmentController) AttachmentRouter(router *gin.RouterGroup) {
+	userGroupService := do.MustInvoke[service.IUserGroupService](ctrl.injector)
+
+	// 上传附件
+	router.POST("", saGin.CheckRole(user.RoleUser.String()), middleware.CheckPermission(userGroupService, satoken.PermAttachmentUpload), ctrl.Upload)
+	// 获取我的附件列表
+	router.GET("", saGin.CheckRole(user.RoleUser.String()), ctrl.GetMyAttachments)
+	// 删除附件
+	router.DELETE("/:id", saGin.CheckRole(user.RoleUser.String()), ctrl.DeleteAttachment)
+	// 下载附件文件
+	router.GET("/file/*key", ctrl.GetFile)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *AttachmentController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// Upload 上传附件
+// @Summary 上传附件
+// @Description 上传图片或文件，文件类型按内容识别，大小、类型与用户容量由后台上传设置限制；相同内容的文件只存储一份。上传后通过attachment_ids关联到帖子或评论
+// @Tags [用户]附件
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "附件文件"
+// @Success 200 {object} response.Data{data=schema.AttachmentItem} "上传成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /attachments [post]
+// @Security Bearer
+func (ctrl *AttachmentController) Upload(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请选择要上传的文件")
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // 上传文件关闭失败无需处理
+	}()
+
+	// 获取服务
+	attachmentService, err := do.Invoke[service.IAttachmentService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := attachmentService.Upload(c.Request.Context(), userID, fileHeader.Filename, file, fileHeader.Size)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetMyAttachments 获取我的附件列表
+// @Summary 获取我的附件列表
+// @Description 分页获取当前用户上传的附件及容量使用情况
+// @Tags [用户]附件
+// @Accept json
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(20)
+// @Param unbound query bool false "仅返回未关联帖子或评论的附件"
+// @Success 200 {object} response.Data{data=schema.AttachmentListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /attachments [get]
+// @Security Bearer
+func (ctrl *AttachmentController) GetMyAttachments(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.AttachmentListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	attachmentService, err := do.Invoke[service.IAttachmentService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := attachmentService.GetMyAttachments(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// DeleteAttachment 删除附件
+// @Summary 删除附件
+// @Description 删除当前用户上传的附件，已关联到帖子或评论的附件删除后将不再展示
+// @Tags [用户]附件
+// @Accept json
+// @Produce json
+// @Param id path int true "附件ID"
+// @Success 200 {object} response.Data "删除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /attachments/{id} [delete]
+// @Security Bearer
+func (ctrl *AttachmentController) DeleteAttachment(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.AttachmentDeleteRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	attachmentService, err := do.Invoke[service.IAttachmentService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = attachmentService.DeleteAttachment(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// GetFile 下载附件文件
+// @Summary 下载附件文件
+// @Description 存储驱动未配置公开访问地址时，通过该接口读取附件内容；仅常见图片类型在浏览器内直接展示，其余类型以下载方式返回
+// @Tags [用户]附件
+// @Produce octet-stream
+// @Param key path string true "附件存储键名"
+// @Success 200 {file} file "附件内容"
+// @Failure 500 {object} response.Data "附件不存在"
+// @Router /attachments/file/{key} [get]
+func (ctrl *AttachmentController) GetFile(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	// 获取服务
+	attachmentService, err := do.Invoke[service.IAttachmentService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	reader, file, err := attachmentService.OpenFile(c.Request.Context(), key)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+	defer func() {
+		_ = reader.Close() //nolint:errcheck // 附件读取器关闭失败无需处理
+	}()
+
+	// 非图片类型强制下载，避免上传的文件在站点域名下被浏览器执行
+	disposition := "attachment"
+	switch file.MimeType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		disposition = "inline"
+	}
+
+	c.DataFromReader(http.StatusOK, file.Size, file.MimeType, reader, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType(disposition, map[string]string{"filename": file.Filename}),
+		"X-Content-Type-Options": "nosniff",
+		"Cache-Control":          "public, max-age=31536000, immutable",
+	})
+}
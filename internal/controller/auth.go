@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"strconv"
 
 	saGin "github.com/click33/sa-token-go/integrations/gin"
 	"github.com/click33/sa-token-go/stputil"
@@ -9,11 +10,13 @@ import (
 	"github.com/samber/do"
 	"go.uber.org/zap"
 
+	"github.com/PokeForum/PokeForum/ent"
 	"github.com/PokeForum/PokeForum/internal/configs"
 	"github.com/PokeForum/PokeForum/internal/pkg/response"
 	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
 	"github.com/PokeForum/PokeForum/internal/schema"
 	"github.com/PokeForum/PokeForum/internal/service"
+	"github.com/PokeForum/PokeForum/internal/utils"
 )
 
 // AuthController 认证控制器
@@ -41,11 +44,23 @@ func (ctrl *AuthController) AuthRouter(router *gin.RouterGroup) {
 	router.POST("/forgot-password", ctrl.ForgotPassword)
 	// 重置密码
 	router.POST("/reset-password", ctrl.ResetPassword)
+	// 获取第三方授权地址
+	router.GET("/oauth/:provider/authorize", ctrl.OAuthAuthorize)
+	// 第三方授权回调登录
+	router.GET("/oauth/:provider/callback", ctrl.OAuthCallback)
+	// 获取通行密钥登录选项
+	router.POST("/webauthn/login/options", ctrl.WebAuthnLoginOptions)
+	// 通行密钥登录
+	router.POST("/webauthn/login", ctrl.WebAuthnLogin)
+	// 登录流程中获取两步验证绑定信息
+	router.POST("/2fa/setup", ctrl.TwoFactorSetup)
+	// 提交两步验证码完成登录
+	router.POST("/2fa/verify", ctrl.TwoFactorVerify)
 }
 
 // Register 用户注册接口
 // @Summary 用户注册
-// @Description 创建新用户账户
+// @Description 创建新用户账户，开启邀请码注册时需提供有效邀请码
 // @Tags 认证
 // @Accept json
 // @Produce json
@@ -90,7 +105,7 @@ func (ctrl *AuthController) Register(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param request body schema.LoginRequest true "登录信息"
-// @Success 200 {object} response.Data{data=schema.LoginResponse} "登录成功"
+// @Success 200 {object} response.Data{data=schema.LoginResponse} "登录成功，需要两步验证时返回pending_token"
 // @Failure 400 {object} response.Data "请求参数错误"
 // @Failure 500 {object} response.Data "服务器错误"
 // @Router /auth/login [post]
@@ -115,52 +130,15 @@ func (ctrl *AuthController) Login(c *gin.Context) {
 		return
 	}
 
-	// 请求UA
-	ua := c.GetHeader("User-Agent")
-	// 创建状态Token
-	token, err := saGin.Login(user.ID, ua)
+	// 检查两步验证并签发登录Token
+	result, err := ctrl.completeLogin(c, user)
 	if err != nil {
 		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
 		return
 	}
-	// 设置用户身份
-	if err = stputil.SetRoles(user.ID, satoken.GetUserRole(user.Role.String())); err != nil {
-		configs.Log.Warn(err.Error())
-	}
-
-	// 记录登录日志 - 使用协程异步保存
-	go func() {
-		// 获取客户端IP地址
-		clientIP := c.ClientIP()
-		// 获取设备信息
-		deviceInfo := c.GetHeader("User-Agent")
-		if deviceInfo == "" {
-			deviceInfo = "Unknown"
-		}
-
-		// 创建登录记录
-		_, err := configs.DB.UserLoginLog.Create().
-			SetUserID(user.ID).
-			SetIPAddress(clientIP).
-			SetDeviceInfo(deviceInfo).
-			SetSuccess(true).
-			Save(context.Background())
-
-		if err != nil {
-			// 记录错误日志，但不影响主流程
-			configs.Log.Error("保存登录日志失败",
-				zap.Int("user_id", user.ID),
-				zap.String("ip_address", clientIP),
-				zap.Error(err))
-		}
-	}()
 
 	// 返回成功响应
-	response.ResSuccess(c, schema.LoginResponse{
-		ID:       user.ID,
-		Username: user.Username,
-		Token:    token,
-	})
+	response.ResSuccess(c, result)
 }
 
 // Logout 用户退出登录接口
@@ -175,6 +153,8 @@ func (ctrl *AuthController) Login(c *gin.Context) {
 // @Security Bearer
 func (ctrl *AuthController) Logout(c *gin.Context) {
 	token := c.GetHeader("Authorization")
+	// 登出前解析用户ID，用于移除会话记录
+	loginID, _ := stputil.GetLoginID(token) //nolint:errcheck // 已通过登录校验，解析失败时仅跳过会话清理
 
 	// 执行登出操作，清除 Token
 	logoutErr := saGin.LogoutByToken(token)
@@ -183,6 +163,13 @@ func (ctrl *AuthController) Logout(c *gin.Context) {
 		return
 	}
 
+	// 移除会话记录
+	if userID, err := strconv.Atoi(loginID); err == nil {
+		if sessionService, err := do.Invoke[service.IUserSessionService](ctrl.injector); err == nil {
+			_ = sessionService.RemoveSession(c.Request.Context(), userID, token) //nolint:errcheck // 会话记录清理失败不影响登出
+		}
+	}
+
 	// 返回成功响应
 	response.ResSuccess(c, nil)
 }
@@ -261,3 +248,316 @@ func (ctrl *AuthController) ResetPassword(c *gin.Context) {
 
 	response.ResSuccess(c, result)
 }
+
+// OAuthAuthorize 获取第三方授权地址
+// @Summary 获取第三方授权地址
+// @Description 生成第三方OAuth授权地址，state写入缓存用于回调校验
+// @Tags 认证
+// @Accept json
+// @Produce json
+// @Param provider path string true "第三方提供商" Enums(QQ, GitHub, Apple, Google, Telegram)
+// @Success 200 {object} response.Data{data=schema.OAuthAuthorizeResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /auth/oauth/{provider}/authorize [get]
+func (ctrl *AuthController) OAuthAuthorize(c *gin.Context) {
+	var req schema.OAuthAuthorizeRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 从注入器获取 OAuthLoginService
+	oauthLoginService, err := do.Invoke[service.IOAuthLoginService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	result, err := oauthLoginService.GetAuthorizeURL(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// OAuthCallback 第三方授权回调登录
+// @Summary 第三方授权回调登录
+// @Description 校验state后使用授权码获取第三方用户信息，已绑定则直接登录，未绑定则自动注册并登录
+// @Tags 认证
+// @Accept json
+// @Produce json
+// @Param provider path string true "第三方提供商" Enums(QQ, GitHub, Apple, Google, Telegram)
+// @Param code query string true "授权码"
+// @Param state query string true "防CSRF随机串"
+// @Success 200 {object} response.Data{data=schema.LoginResponse} "登录成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /auth/oauth/{provider}/callback [get]
+func (ctrl *AuthController) OAuthCallback(c *gin.Context) {
+	var req schema.OAuthCallbackRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+	req.Provider = c.Param("provider")
+
+	// 从注入器获取 OAuthLoginService
+	oauthLoginService, err := do.Invoke[service.IOAuthLoginService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	user, err := oauthLoginService.HandleCallback(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	// 检查两步验证并签发登录Token
+	result, err := ctrl.completeLogin(c, user)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// WebAuthnLoginOptions 获取通行密钥登录选项
+// @Summary 获取通行密钥登录选项
+// @Description 生成WebAuthn断言选项，返回的session_id需在完成登录时回传
+// @Tags 认证
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.WebAuthnLoginOptionsResponse} "获取成功"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /auth/webauthn/login/options [post]
+func (ctrl *AuthController) WebAuthnLoginOptions(c *gin.Context) {
+	// 从注入器获取 WebAuthnService
+	webAuthnService, err := do.Invoke[service.IWebAuthnService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	result, err := webAuthnService.BeginLogin(c.Request.Context())
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// WebAuthnLogin 通行密钥登录
+// @Summary 通行密钥登录
+// @Description 校验WebAuthn断言，已启用或被强制启用两步验证时返回待验证登录凭证，否则签发登录Token
+// @Tags 认证
+// @Accept json
+// @Produce json
+// @Param request body schema.WebAuthnLoginRequest true "登录断言"
+// @Success 200 {object} response.Data{data=schema.LoginResponse} "登录成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /auth/webauthn/login [post]
+func (ctrl *AuthController) WebAuthnLogin(c *gin.Context) {
+	var req schema.WebAuthnLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 从注入器获取 WebAuthnService
+	webAuthnService, err := do.Invoke[service.IWebAuthnService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	user, err := webAuthnService.FinishLogin(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	// 通行密钥仅视为第一步认证，仍需遵循两步验证策略
+	result, err := ctrl.completeLogin(c, user)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// TwoFactorSetup 登录流程中获取两步验证绑定信息
+// @Summary 登录流程中获取两步验证绑定信息
+// @Description 被强制启用两步验证但尚未绑定的用户，凭待验证登录凭证获取TOTP密钥与otpauth地址
+// @Tags 认证
+// @Accept json
+// @Produce json
+// @Param request body schema.TwoFactorPendingSetupRequest true "待验证登录凭证"
+// @Success 200 {object} response.Data{data=schema.TwoFactorSetupResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /auth/2fa/setup [post]
+func (ctrl *AuthController) TwoFactorSetup(c *gin.Context) {
+	var req schema.TwoFactorPendingSetupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 从注入器获取 TwoFactorService
+	twoFactorService, err := do.Invoke[service.ITwoFactorService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	result, err := twoFactorService.SetupPendingLogin(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// TwoFactorVerify 提交两步验证码完成登录
+// @Summary 提交两步验证码完成登录
+// @Description 校验动态验证码或恢复码后签发登录Token；登录流程中完成绑定时同时返回恢复码
+// @Tags 认证
+// @Accept json
+// @Produce json
+// @Param request body schema.TwoFactorLoginRequest true "待验证登录凭证与验证码"
+// @Success 200 {object} response.Data{data=schema.LoginResponse} "登录成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /auth/2fa/verify [post]
+func (ctrl *AuthController) TwoFactorVerify(c *gin.Context) {
+	var req schema.TwoFactorLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 从注入器获取 TwoFactorService
+	twoFactorService, err := do.Invoke[service.ITwoFactorService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	user, recoveryCodes, err := twoFactorService.FinishLogin(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	// 签发登录Token
+	token, err := ctrl.issueLoginToken(c, user)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, schema.LoginResponse{
+		ID:            user.ID,
+		Username:      user.Username,
+		Token:         token,
+		RecoveryCodes: recoveryCodes,
+	})
+}
+
+// completeLogin 第一步认证通过后检查两步验证，需要时返回待验证登录凭证，否则直接签发登录Token
+func (ctrl *AuthController) completeLogin(c *gin.Context, user *ent.User) (*schema.LoginResponse, error) {
+	twoFactorService, err := do.Invoke[service.ITwoFactorService](ctrl.injector)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := twoFactorService.BeginLogin(c.Request.Context(), user)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return &schema.LoginResponse{
+			ID:                     user.ID,
+			Username:               user.Username,
+			TwoFactorRequired:      true,
+			TwoFactorSetupRequired: pending.SetupRequired,
+			PendingToken:           pending.PendingToken,
+		}, nil
+	}
+
+	token, err := ctrl.issueLoginToken(c, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.LoginResponse{
+		ID:       user.ID,
+		Username: user.Username,
+		Token:    token,
+	}, nil
+}
+
+// issueLoginToken 签发登录Token、设置用户身份并异步记录登录日志
+func (ctrl *AuthController) issueLoginToken(c *gin.Context, user *ent.User) (string, error) {
+	// 请求UA
+	ua := c.GetHeader("User-Agent")
+	// 创建状态Token
+	token, err := saGin.Login(user.ID, ua)
+	if err != nil {
+		return "", err
+	}
+	// 设置用户身份
+	if err = stputil.SetRoles(user.ID, satoken.GetUserRole(user.Role.String())); err != nil {
+		configs.Log.Warn(err.Error())
+	}
+
+	// 获取客户端IP地址
+	clientIP := c.ClientIP()
+	// 获取IP归属地，由前置CDN注入，仅在直连地址为受信任代理时读取，防止伪造
+	ipCountry, ipCity := utils.TrustedGeoHeaders(c.RemoteIP(), configs.Config.Proxy.TrustedProxies, c.Request.Header)
+	// 获取设备信息
+	deviceInfo := ua
+	if deviceInfo == "" {
+		deviceInfo = "Unknown"
+	}
+
+	// 记录登录会话，用于会话管理
+	if sessionService, err := do.Invoke[service.IUserSessionService](ctrl.injector); err == nil {
+		if err = sessionService.RecordSession(c.Request.Context(), user.ID, token, deviceInfo, clientIP, ipCountry, ipCity); err != nil {
+			configs.Log.Warn("记录登录会话失败", zap.Int("user_id", user.ID), zap.Error(err))
+		}
+	}
+
+	// 记录登录日志 - 使用协程异步保存
+	go func() {
+		// 创建登录记录
+		_, err := configs.DB.UserLoginLog.Create().
+			SetUserID(user.ID).
+			SetIPAddress(clientIP).
+			SetIPCountry(ipCountry).
+			SetIPCity(ipCity).
+			SetDeviceInfo(deviceInfo).
+			SetSuccess(true).
+			Save(context.Background())
+
+		if err != nil {
+			// 记录错误日志，但不影响主流程
+			configs.Log.Error("保存登录日志失败",
+				zap.Int("user_id", user.ID),
+				zap.String("ip_address", clientIP),
+				zap.Error(err))
+		}
+	}()
+
+	return token, nil
+}
@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// BadgeController 徽章控制器
+type BadgeController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewBadgeController 创建徽章控制器实例
+func NewBadgeController(injector *do.Injector) *BadgeController {
+	return &BadgeController{
+		injector: injector,
+	}
+}
+
+// BadgeRouter 徽章相关路由注册
+func (ctrl *BadgeController) BadgeRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取我的徽章
+	router.GET("", ctrl.GetMyBadges)
+	// 设置展示徽章
+	router.PUT("/display", ctrl.SetDisplayBadges)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *BadgeController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetMyBadges 获取我的徽章
+// @Summary 获取我的徽章
+// @Description 获取当前用户获得的全部徽章，display_order大于0的徽章展示在用户名旁
+// @Tags [用户]徽章
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.UserBadgeListResponse} "获取成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/badges [get]
+// @Security Bearer
+func (ctrl *BadgeController) GetMyBadges(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	badgeService, err := do.Invoke[service.IBadgeService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := badgeService.GetUserBadges(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// SetDisplayBadges 设置展示徽章
+// @Summary 设置展示徽章
+// @Description 从已获得的徽章中选择最多3个按顺序展示在用户名旁，传空列表表示不展示
+// @Tags [用户]徽章
+// @Accept json
+// @Produce json
+// @Param request body schema.UserBadgeDisplayRequest true "展示的徽章"
+// @Success 200 {object} response.Data "设置成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/badges/display [put]
+// @Security Bearer
+func (ctrl *BadgeController) SetDisplayBadges(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.UserBadgeDisplayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	badgeService, err := do.Invoke[service.IBadgeService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = badgeService.SetDisplayBadges(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
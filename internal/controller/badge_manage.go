@@ -0,0 +1,282 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// BadgeManageController 徽章管理控制器
+type BadgeManageController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewBadgeManageController 创建徽章管理控制器实例
+func NewBadgeManageController(injector *do.Injector) *BadgeManageController {
+	return &BadgeManageController{
+		injector: injector,
+	}
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *BadgeManageController) getUserID(c *gin.Context) (int, error) {
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// BadgeManageRouter 徽章管理相关路由注册
+func (ctrl *BadgeManageController) BadgeManageRouter(router *gin.RouterGroup) {
+	// 徽章列表
+	router.GET("", ctrl.GetBadgeList)
+	// 创建徽章
+	router.POST("", ctrl.CreateBadge)
+	// 更新徽章
+	router.PUT("", ctrl.UpdateBadge)
+	// 删除徽章
+	router.DELETE("/:id", ctrl.DeleteBadge)
+
+	// 手动授予与收回徽章
+	router.POST("/award", ctrl.AwardBadge)
+	router.DELETE("/award", ctrl.RevokeBadge)
+}
+
+// GetBadgeList 获取徽章列表
+// @Summary 获取徽章列表
+// @Description 分页获取徽章列表，支持名称关键词与获得条件类型筛选
+// @Tags [管理员]徽章管理
+// @Accept json
+// @Produce json
+// @Param page query int true "页码" example("1")
+// @Param page_size query int true "每页数量" example("20")
+// @Param keyword query string false "名称关键词" example("签到")
+// @Param criteria query string false "获得条件类型" Enums(manual, post_count, likes_received, signin_streak, essence_count)
+// @Success 200 {object} response.Data{data=schema.BadgeListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/badges [get]
+func (ctrl *BadgeManageController) GetBadgeList(c *gin.Context) {
+	var req schema.BadgeListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	badgeService, err := do.Invoke[service.IBadgeService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := badgeService.GetBadgeList(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// CreateBadge 创建徽章
+// @Summary 创建徽章
+// @Description 创建徽章，非手动类型的徽章会在用户发帖、帖子获赞、签到或帖子被设为精华后异步评估并自动授予
+// @Tags [管理员]徽章管理
+// @Accept json
+// @Produce json
+// @Param request body schema.BadgeCreateRequest true "徽章信息"
+// @Success 200 {object} response.Data{data=schema.BadgeItem} "创建成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/badges [post]
+func (ctrl *BadgeManageController) CreateBadge(c *gin.Context) {
+	var req schema.BadgeCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	badgeService, err := do.Invoke[service.IBadgeService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := badgeService.CreateBadge(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// UpdateBadge 更新徽章
+// @Summary 更新徽章
+// @Description 更新徽章信息与获得条件，修改条件不会收回已授予的徽章
+// @Tags [管理员]徽章管理
+// @Accept json
+// @Produce json
+// @Param request body schema.BadgeUpdateRequest true "徽章信息"
+// @Success 200 {object} response.Data{data=schema.BadgeItem} "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/badges [put]
+func (ctrl *BadgeManageController) UpdateBadge(c *gin.Context) {
+	var req schema.BadgeUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	badgeService, err := do.Invoke[service.IBadgeService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := badgeService.UpdateBadge(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// DeleteBadge 删除徽章
+// @Summary 删除徽章
+// @Description 删除徽章，同时收回全部用户已获得的该徽章
+// @Tags [管理员]徽章管理
+// @Accept json
+// @Produce json
+// @Param id path int true "徽章ID" example("1")
+// @Success 200 {object} response.Data "删除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/badges/{id} [delete]
+func (ctrl *BadgeManageController) DeleteBadge(c *gin.Context) {
+	var req struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	badgeService, err := do.Invoke[service.IBadgeService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = badgeService.DeleteBadge(c.Request.Context(), req.ID); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// AwardBadge 手动授予徽章
+// @Summary 手动授予徽章
+// @Description 手动授予用户徽章，不受徽章启用状态与获得条件限制
+// @Tags [管理员]徽章管理
+// @Accept json
+// @Produce json
+// @Param request body schema.BadgeAwardRequest true "授予信息"
+// @Success 200 {object} response.Data "授予成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/badges/award [post]
+func (ctrl *BadgeManageController) AwardBadge(c *gin.Context) {
+	var req schema.BadgeAwardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, err.Error())
+		return
+	}
+
+	// 获取服务
+	badgeService, err := do.Invoke[service.IBadgeService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = badgeService.AwardBadge(c.Request.Context(), operatorID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// RevokeBadge 收回徽章
+// @Summary 收回徽章
+// @Description 收回用户已获得的徽章，自动授予的徽章在用户再次满足条件时会被重新授予
+// @Tags [管理员]徽章管理
+// @Accept json
+// @Produce json
+// @Param request body schema.BadgeRevokeRequest true "收回信息"
+// @Success 200 {object} response.Data "收回成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/badges/award [delete]
+func (ctrl *BadgeManageController) RevokeBadge(c *gin.Context) {
+	var req schema.BadgeRevokeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	badgeService, err := do.Invoke[service.IBadgeService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = badgeService.RevokeBadge(c.Request.Context(), req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
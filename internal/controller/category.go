@@ -29,7 +29,7 @@ func (ctrl *CategoryController) CategoryRouter(router *gin.RouterGroup) {
 
 // GetUserCategories 获取用户可见的版块列表
 // @Summary 获取版块列表
-// @Description 获取用户可见的版块列表，包括正常、登录可见和锁定状态的版块，隐藏版块不可见
+// @Description 以树形结构获取用户可见的版块，包括正常、登录可见和锁定状态的版块，隐藏版块及其子版块不可见，版块状态沿版块树向下继承
 // @Tags [用户]版块
 // @Accept json
 // @Produce json
@@ -41,6 +41,10 @@ func (ctrl *CategoryManageController) CategoryManageRouter(router *gin.RouterGro
 
 	// 版块版主管理
 	router.PUT("/moderators", ctrl.SetCategoryModerators)
+
+	// 版块访问控制规则管理
+	router.GET("/:id/permissions", ctrl.GetCategoryPermissions)
+	router.PUT("/permissions", ctrl.UpdateCategoryPermissions)
 }
 
 // GetCategoryList 获取版块列表
@@ -53,6 +57,7 @@ func (ctrl *CategoryManageController) CategoryManageRouter(router *gin.RouterGro
 // @Param page_size query int true "每页数量" example("20")
 // @Param keyword query string false "搜索关键词" example("技术")
 // @Param status query string false "版块状态" example("Normal")
+// @Param parent_id query int false "父版块ID，0表示顶级版块" example("0")
 // @Success 200 {object} response.Data{data=schema.CategoryListResponse} "获取成功"
 // @Failure 400 {object} response.Data "请求参数错误"
 // @Failure 500 {object} response.Data "服务器错误"
@@ -320,3 +325,74 @@ func (ctrl *CategoryManageController) SetCategoryModerators(c *gin.Context) {
 
 	response.ResSuccess(c, nil)
 }
+
+// GetCategoryPermissions 获取版块访问控制规则
+// @Summary 获取版块访问控制规则
+// @Description 获取版块查看、发帖、回复的访问控制规则，未设置规则的操作不做额外限制
+// @Tags [管理员]版块管理
+// @Accept json
+// @Produce json
+// @Param id path int true "版块ID" example("1")
+// @Success 200 {object} response.Data{data=schema.CategoryPermissionResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/categories/{id}/permissions [get]
+func (ctrl *CategoryManageController) GetCategoryPermissions(c *gin.Context) {
+	var req struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	categoryManageService, err := do.Invoke[service.ICategoryManageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := categoryManageService.GetCategoryPermissions(c.Request.Context(), req.ID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// UpdateCategoryPermissions 设置版块访问控制规则
+// @Summary 设置版块访问控制规则
+// @Description 按最低身份、最低经验值与允许的用户组分别设置版块的查看、发帖、回复规则，整体替换已有规则，规则对子版块同样生效
+// @Tags [管理员]版块管理
+// @Accept json
+// @Produce json
+// @Param request body schema.CategoryPermissionUpdateRequest true "访问控制规则"
+// @Success 200 {object} response.Data "设置成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/categories/permissions [put]
+func (ctrl *CategoryManageController) UpdateCategoryPermissions(c *gin.Context) {
+	var req schema.CategoryPermissionUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	categoryManageService, err := do.Invoke[service.ICategoryManageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = categoryManageService.UpdateCategoryPermissions(c.Request.Context(), req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
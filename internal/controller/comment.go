@@ -5,7 +5,9 @@ import (
 	"strconv"
 
 	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/middleware"
 	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
 	"github.com/PokeForum/PokeForum/internal/schema"
 	"github.com/PokeForum/PokeForum/internal/service"
 	saGin "github.com/click33/sa-token-go/integrations/gin"
@@ -29,8 +31,10 @@ func NewCommentController(injector *do.Injector) *CommentController {
 
 // CommentRouter 评论相关路由注册
 func (ctrl *CommentController) CommentRouter(router *gin.RouterGroup) {
+	userGroupService := do.MustInvoke[service.IUserGroupService](ctrl.injector)
+
 	// 发布评论
-	router.POST("", saGin.CheckRole(user.RoleUser.String()), ctrl.CreateComment)
+	router.POST("", saGin.CheckRole(user.RoleUser.String()), middleware.CheckPermission(userGroupService, satoken.PermCommentCreate), ctrl.CreateComment)
 	// 编辑评论
 	router.PUT("", saGin.CheckRole(user.RoleUser.String()), ctrl.UpdateComment)
 	// 获取评论列表
@@ -39,6 +43,8 @@ func (ctrl *CommentController) CommentRouter(router *gin.RouterGroup) {
 	router.POST("/like", saGin.CheckRole(user.RoleUser.String()), ctrl.LikeComment)
 	// 点踩评论
 	router.POST("/dislike", saGin.CheckRole(user.RoleUser.String()), ctrl.DislikeComment)
+	// 置顶评论，需拥有评论置顶权限，且仅限楼主与版主
+	router.PUT("/pin", middleware.CheckPermission(userGroupService, satoken.PermCommentPin), ctrl.PinComment)
 }
 
 // getUserID 从Header中获取token并解析用户ID
@@ -272,3 +278,45 @@ func (ctrl *CommentController) GetCommentList(c *gin.Context) {
 	// 返回成功响应
 	response.ResSuccess(c, result)
 }
+
+// PinComment 置顶评论
+// @Summary 置顶评论
+// @Description 拥有评论置顶权限的楼主或版主设置或取消评论的置顶状态
+// @Tags [用户]评论
+// @Accept json
+// @Produce json
+// @Param request body schema.CommentPinUpdateRequest true "置顶信息"
+// @Success 200 {object} response.Data "设置成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /comments/pin [put]
+func (ctrl *CommentController) PinComment(c *gin.Context) {
+	// 获取用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, 401, "获取用户信息失败", err.Error())
+		return
+	}
+
+	var req schema.CommentPinUpdateRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	commentService, err := do.Invoke[service.ICommentService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = commentService.PinComment(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
@@ -28,7 +28,7 @@ func (ctrl *ConfigController) ConfigRouter(router *gin.RouterGroup) {
 
 // GetPublicConfig 获取公开配置
 // @Summary 获取公开配置
-// @Description 获取客户端所需的公开配置，包括常规、首页、SEO、安全、代码、评论配置
+// @Description 获取客户端所需的公开配置，包括常规、首页、SEO、安全、代码、评论、上传配置
 // @Tags 公开配置
 // @Accept json
 // @Produce json
@@ -81,6 +81,18 @@ func (ctrl *ConfigController) GetPublicConfig(c *gin.Context) {
 		return
 	}
 
+	upload, err := settingsService.GetUploadSettings(ctx)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	level, err := settingsService.GetLevelSettings(ctx)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
 	response.ResSuccess(c, schema.PublicConfigResponse{
 		Routine: routine,
 		Home:    home,
@@ -88,5 +100,7 @@ func (ctrl *ConfigController) GetPublicConfig(c *gin.Context) {
 		Safe:    safe,
 		Code:    code,
 		Comment: comment,
+		Upload:  upload,
+		Level:   level,
 	})
 }
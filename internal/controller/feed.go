@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// FeedController 发现控制器
+type FeedController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewFeedController 创建发现控制器实例
+func NewFeedController(injector *do.Injector) *FeedController {
+	return &FeedController{
+		injector: injector,
+	}
+}
+
+// FeedRouter 发现相关路由注册
+func (ctrl *FeedController) FeedRouter(router *gin.RouterGroup) {
+	// 关注动态
+	router.GET("", saGin.CheckRole(user.RoleUser.String()), ctrl.GetFeed)
+	// 热门帖子
+	router.GET("/hot", ctrl.GetHotPosts)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *FeedController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetFeed 获取关注动态
+// @Summary 获取关注动态
+// @Description 合并关注的用户与版块的最新帖子，按发布时间倒序，使用cursor向后翻页，不包含黑名单用户的帖子
+// @Tags [用户]发现
+// @Accept json
+// @Produce json
+// @Param cursor query int false "游标，取上一页返回的next_cursor"
+// @Param limit query int false "返回数量" default(20)
+// @Success 200 {object} response.Data{data=schema.FeedResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /feed [get]
+// @Security Bearer
+func (ctrl *FeedController) GetFeed(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.FeedRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	postService, err := do.Invoke[service.IPostService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := postService.GetFeed(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetHotPosts 获取热门帖子
+// @Summary 获取热门帖子
+// @Description 按热度从高到低获取最近7天的帖子，热度综合点赞、点踩、收藏、评论与浏览数并随发布时间衰减，传category_id时返回该版块的热门帖子
+// @Tags [用户]发现
+// @Accept json
+// @Produce json
+// @Param category_id query int false "版块ID，不传则返回全站热门"
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(20)
+// @Success 200 {object} response.Data{data=schema.UserPostListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /feed/hot [get]
+func (ctrl *FeedController) GetHotPosts(c *gin.Context) {
+	var req schema.UserPostHotListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	postService, err := do.Invoke[service.IPostService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := postService.GetHotPosts(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
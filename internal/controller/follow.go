@@ -0,0 +1,259 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// FollowController 关注控制器
+type FollowController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewFollowController 创建关注控制器实例
+func NewFollowController(injector *do.Injector) *FollowController {
+	return &FollowController{
+		injector: injector,
+	}
+}
+
+// FollowRouter 关注相关路由注册
+func (ctrl *FollowController) FollowRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 关注用户或版块
+	router.POST("", ctrl.Follow)
+	// 取消关注
+	router.DELETE("", ctrl.Unfollow)
+	// 获取关注列表
+	router.GET("/following", ctrl.GetFollowing)
+	// 获取粉丝列表
+	router.GET("/followers", ctrl.GetFollowers)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *FollowController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// Follow 关注用户或版块
+// @Summary 关注用户或版块
+// @Description 关注用户或版块，关注后其新帖子会出现在关注动态中，已关注时直接返回成功
+// @Tags [用户]关注
+// @Accept json
+// @Produce json
+// @Param request body schema.FollowRequest true "关注对象"
+// @Success 200 {object} response.Data{data=schema.FollowResponse} "关注成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/follows [post]
+// @Security Bearer
+func (ctrl *FollowController) Follow(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.FollowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	followService, err := do.Invoke[service.IFollowService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := followService.Follow(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// Unfollow 取消关注
+// @Summary 取消关注
+// @Description 取消关注用户或版块
+// @Tags [用户]关注
+// @Accept json
+// @Produce json
+// @Param request body schema.FollowRequest true "关注对象"
+// @Success 200 {object} response.Data{data=schema.FollowResponse} "取消成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/follows [delete]
+// @Security Bearer
+func (ctrl *FollowController) Unfollow(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.FollowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	followService, err := do.Invoke[service.IFollowService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := followService.Unfollow(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetFollowing 获取关注列表
+// @Summary 获取关注列表
+// @Description 获取指定用户关注的用户或版块，不传user_id则查询当前登录用户
+// @Tags [用户]关注
+// @Accept json
+// @Produce json
+// @Param user_id query int false "用户ID，不传则查询当前登录用户"
+// @Param target_type query string false "关注对象类型" Enums(User, Category) default(User)
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(20)
+// @Success 200 {object} response.Data{data=schema.FollowingListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/follows/following [get]
+// @Security Bearer
+func (ctrl *FollowController) GetFollowing(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.FollowingListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 确定要查询的用户ID
+	targetUserID := userID
+	if req.UserID > 0 {
+		targetUserID = req.UserID
+	}
+
+	// 获取服务
+	followService, err := do.Invoke[service.IFollowService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := followService.GetFollowing(c.Request.Context(), targetUserID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetFollowers 获取粉丝列表
+// @Summary 获取粉丝列表
+// @Description 获取关注了指定用户的用户列表，不传user_id则查询当前登录用户
+// @Tags [用户]关注
+// @Accept json
+// @Produce json
+// @Param user_id query int false "用户ID，不传则查询当前登录用户"
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(20)
+// @Success 200 {object} response.Data{data=schema.FollowerListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/follows/followers [get]
+// @Security Bearer
+func (ctrl *FollowController) GetFollowers(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.FollowerListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 确定要查询的用户ID
+	targetUserID := userID
+	if req.UserID > 0 {
+		targetUserID = req.UserID
+	}
+
+	// 获取服务
+	followService, err := do.Invoke[service.IFollowService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := followService.GetFollowers(c.Request.Context(), targetUserID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
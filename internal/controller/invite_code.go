@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// InviteCodeController 邀请码控制器
+type InviteCodeController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewInviteCodeController 创建邀请码控制器实例
+func NewInviteCodeController(injector *do.Injector) *InviteCodeController {
+	return &InviteCodeController{
+		injector: injector,
+	}
+}
+
+// InviteCodeRouter 邀请码相关路由注册
+func (ctrl *InviteCodeController) InviteCodeRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取邀请码列表
+	router.GET("/list", ctrl.GetInviteCodes)
+	// 创建邀请码
+	router.POST("", ctrl.CreateInviteCode)
+	// 作废邀请码
+	router.DELETE("/:id", ctrl.RevokeInviteCode)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *InviteCodeController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetInviteCodes 获取邀请码列表
+// @Summary 获取邀请码列表
+// @Description 获取当前用户创建的邀请码及使用情况，包含可持有数量上限与已邀请人数
+// @Tags [用户]邀请码
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.InviteCodeListResponse} "获取成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/invite-codes/list [get]
+// @Security Bearer
+func (ctrl *InviteCodeController) GetInviteCodes(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 获取邀请码服务
+	inviteCodeService := do.MustInvoke[service.IInviteCodeService](ctrl.injector)
+
+	result, err := inviteCodeService.GetInviteCodes(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "获取邀请码列表失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// CreateInviteCode 创建邀请码
+// @Summary 创建邀请码
+// @Description 开启邀请码注册后，用户可在数量上限内创建邀请码，使用次数由安全设置决定
+// @Tags [用户]邀请码
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.InviteCodeItem} "创建成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/invite-codes [post]
+// @Security Bearer
+func (ctrl *InviteCodeController) CreateInviteCode(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 获取邀请码服务
+	inviteCodeService := do.MustInvoke[service.IInviteCodeService](ctrl.injector)
+
+	result, err := inviteCodeService.CreateInviteCode(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "创建邀请码失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// RevokeInviteCode 作废邀请码
+// @Summary 作废邀请码
+// @Description 作废当前用户的指定邀请码，作废后不可再用于注册
+// @Tags [用户]邀请码
+// @Accept json
+// @Produce json
+// @Param id path int true "邀请码记录ID"
+// @Success 200 {object} response.Data "作废成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/invite-codes/{id} [delete]
+// @Security Bearer
+func (ctrl *InviteCodeController) RevokeInviteCode(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.InviteCodeRevokeRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取邀请码服务
+	inviteCodeService := do.MustInvoke[service.IInviteCodeService](ctrl.injector)
+
+	if err = inviteCodeService.RevokeInviteCode(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "作废邀请码失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
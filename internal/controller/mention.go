@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// MentionController 提及控制器
+type MentionController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewMentionController 创建提及控制器实例
+func NewMentionController(injector *do.Injector) *MentionController {
+	return &MentionController{
+		injector: injector,
+	}
+}
+
+// MentionRouter 提及相关路由注册
+func (ctrl *MentionController) MentionRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取提及列表
+	router.GET("", ctrl.GetMyMentions)
+	// 标记提及已读
+	router.POST("/read", ctrl.MarkMentionsRead)
+	// 更新提及提醒设置
+	router.PUT("/settings", ctrl.UpdateMentionSettings)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *MentionController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetMyMentions 获取提及列表
+// @Summary 获取提及列表
+// @Description 分页获取当前用户在帖子或评论中被 @提及 的记录，同时返回未读数量
+// @Tags [用户]提及
+// @Accept json
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(20)
+// @Param unread_only query bool false "仅返回未读提及"
+// @Success 200 {object} response.Data{data=schema.MentionListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/mentions [get]
+// @Security Bearer
+func (ctrl *MentionController) GetMyMentions(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.MentionListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	mentionService, err := do.Invoke[service.IMentionService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := mentionService.GetMyMentions(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// MarkMentionsRead 标记提及已读
+// @Summary 标记提及已读
+// @Description 将指定的提及标记为已读，未传ID时标记全部已读
+// @Tags [用户]提及
+// @Accept json
+// @Produce json
+// @Param request body schema.MentionReadRequest true "提及记录ID列表"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/mentions/read [post]
+// @Security Bearer
+func (ctrl *MentionController) MarkMentionsRead(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.MentionReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	mentionService, err := do.Invoke[service.IMentionService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = mentionService.MarkMentionsRead(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// UpdateMentionSettings 更新提及提醒设置
+// @Summary 更新提及提醒设置
+// @Description 设置被 @提及 时是否发送邮件提醒，仅对已验证的邮箱生效；拉黑的用户提及你时不会记录也不会提醒
+// @Tags [用户]提及
+// @Accept json
+// @Produce json
+// @Param request body schema.MentionSettingsRequest true "提及提醒设置"
+// @Success 200 {object} response.Data "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/mentions/settings [put]
+// @Security Bearer
+func (ctrl *MentionController) UpdateMentionSettings(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.MentionSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	mentionService, err := do.Invoke[service.IMentionService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = mentionService.UpdateMentionSettings(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
@@ -0,0 +1,291 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/middleware"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// MessageController 私信控制器
+type MessageController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewMessageController 创建私信控制器实例
+func NewMessageController(injector *do.Injector) *MessageController {
+	return &MessageController{
+		injector: injector,
+	}
+}
+
+// MessageRouter 私信相关路由注册
+func (ctrl *MessageController) MessageRouter(router *gin.RouterGroup) {
+	userGroupService := do.MustInvoke[service.IUserGroupService](ctrl.injector)
+
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取会话列表
+	router.GET("", ctrl.GetConversations)
+	// 获取未读私信数
+	router.GET("/unread", ctrl.GetUnreadCount)
+	// 发送私信
+	router.POST("", middleware.CheckPermission(userGroupService, satoken.PermMessageSend), ctrl.SendMessage)
+	// 标记会话已读
+	router.POST("/read", ctrl.MarkRead)
+	// 获取与指定用户的消息记录
+	router.GET("/:user_id", ctrl.GetMessages)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *MessageController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetConversations 获取私信会话列表
+// @Summary 获取私信会话列表
+// @Description 按最后一条消息时间倒序分页获取当前用户的私信会话
+// @Tags [用户]私信
+// @Accept json
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(20)
+// @Success 200 {object} response.Data{data=schema.MessageConversationListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/messages [get]
+// @Security Bearer
+func (ctrl *MessageController) GetConversations(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.MessageConversationListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	messageService, err := do.Invoke[service.IMessageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := messageService.GetConversations(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetUnreadCount 获取未读私信数
+// @Summary 获取未读私信数
+// @Description 获取当前用户全部会话的未读私信总数
+// @Tags [用户]私信
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.MessageUnreadResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/messages/unread [get]
+// @Security Bearer
+func (ctrl *MessageController) GetUnreadCount(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	messageService, err := do.Invoke[service.IMessageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := messageService.GetUnreadCount(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// SendMessage 发送私信
+// @Summary 发送私信
+// @Description 向指定用户发送私信，双方存在拉黑关系或经验值不足时无法发起，对方在线时实时推送
+// @Tags [用户]私信
+// @Accept json
+// @Produce json
+// @Param request body schema.MessageSendRequest true "私信内容"
+// @Success 200 {object} response.Data{data=schema.MessageItem} "发送成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/messages [post]
+// @Security Bearer
+func (ctrl *MessageController) SendMessage(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.MessageSendRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	messageService, err := do.Invoke[service.IMessageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := messageService.SendMessage(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// MarkRead 标记会话已读
+// @Summary 标记会话已读
+// @Description 将与指定用户的会话中收到的消息全部标记为已读，并向对方推送已读回执
+// @Tags [用户]私信
+// @Accept json
+// @Produce json
+// @Param request body schema.MessageReadRequest true "对方用户ID"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/messages/read [post]
+// @Security Bearer
+func (ctrl *MessageController) MarkRead(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.MessageReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	messageService, err := do.Invoke[service.IMessageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err := messageService.MarkRead(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// GetMessages 获取消息记录
+// @Summary 获取消息记录
+// @Description 按消息ID倒序获取与指定用户的私信记录，使用before_id向前翻页
+// @Tags [用户]私信
+// @Accept json
+// @Produce json
+// @Param user_id path int true "对方用户ID"
+// @Param before_id query int false "游标，返回ID小于该值的消息"
+// @Param limit query int false "返回数量" default(20)
+// @Success 200 {object} response.Data{data=schema.MessageHistoryResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/messages/{user_id} [get]
+// @Security Bearer
+func (ctrl *MessageController) GetMessages(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.MessageHistoryRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	messageService, err := do.Invoke[service.IMessageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := messageService.GetMessages(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
@@ -62,6 +62,8 @@ func (ctrl *ModeratorController) ModeratorRouter(router *gin.RouterGroup) {
 		router.PUT("/posts", ctrl.EditPost)
 		// 移动帖子
 		router.PUT("/posts/move", ctrl.MovePost)
+		// 修改帖子标签
+		router.PUT("/posts/tags", ctrl.RetagPost)
 		// 设置帖子精华
 		router.PUT("/posts/essence", ctrl.SetPostEssence)
 		// 锁定帖子
@@ -79,6 +81,18 @@ func (ctrl *ModeratorController) ModeratorRouter(router *gin.RouterGroup) {
 		// 获取版块公告列表
 		router.GET("/categories/:category_id/announcements", ctrl.GetCategoryAnnouncements)
 	}
+
+	// 举报处理
+	{
+		// 获取举报处理队列
+		router.GET("/reports", ctrl.GetReportQueue)
+		// 认领举报
+		router.POST("/reports/claim", ctrl.ClaimReport)
+		// 处理举报
+		router.POST("/reports/resolve", ctrl.ResolveReport)
+		// 驳回举报
+		router.POST("/reports/dismiss", ctrl.DismissReport)
+	}
 }
 
 // GetModeratorCategories 获取版主管理的版块列表
@@ -250,6 +264,50 @@ func (ctrl *ModeratorController) MovePost(c *gin.Context) {
 	response.ResSuccess(c, nil)
 }
 
+// RetagPost 修改帖子标签
+// @Summary 修改帖子标签
+// @Description 版主修改指定版块内帖子的标签，同义词会解析为规范标签，不存在的标签自动创建
+// @Tags [版主]版块管理
+// @Accept json
+// @Produce json
+// @Param request body schema.PostRetagRequest true "标签信息"
+// @Success 200 {object} response.Data{data=[]string} "修改成功，返回规范标签列表"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 403 {object} response.Data "权限不足"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /moderator/posts/tags [put]
+func (ctrl *ModeratorController) RetagPost(c *gin.Context) {
+	var req schema.PostRetagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取用户ID（通过其他中间件验证版主身份）
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	moderatorService, err := do.Invoke[service.IModeratorService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	tags, err := moderatorService.RetagPost(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, tags)
+}
+
 // SetPostEssence 设置帖子精华
 // @Summary 设置帖子精华
 // @Description 版主设置或取消帖子的精华状态
@@ -515,3 +573,176 @@ func (ctrl *ModeratorController) GetCategoryAnnouncements(c *gin.Context) {
 
 	response.ResSuccess(c, result)
 }
+
+// GetReportQueue 获取举报处理队列
+// @Summary 获取举报处理队列
+// @Description 获取当前版主所管理版块内的举报，支持按状态、目标类型与版块筛选
+// @Tags [版主]举报处理
+// @Accept json
+// @Produce json
+// @Param page query int true "页码" example("1")
+// @Param page_size query int true "每页数量" example("20")
+// @Param status query string false "处理状态" Enums(Pending, Processing, Resolved, Dismissed)
+// @Param target_type query string false "目标类型" Enums(Post, Comment, User)
+// @Param category_id query int false "版块ID" example("1")
+// @Success 200 {object} response.Data{data=schema.ReportListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /moderator/reports [get]
+func (ctrl *ModeratorController) GetReportQueue(c *gin.Context) {
+	var req schema.ReportListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	reportService, err := do.Invoke[service.IReportService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := reportService.GetReportQueue(c.Request.Context(), operatorID, false, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// ClaimReport 认领举报
+// @Summary 认领举报
+// @Description 版主认领所管理版块内的待处理举报
+// @Tags [版主]举报处理
+// @Accept json
+// @Produce json
+// @Param request body schema.ReportClaimRequest true "认领举报信息"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /moderator/reports/claim [post]
+func (ctrl *ModeratorController) ClaimReport(c *gin.Context) {
+	var req schema.ReportClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	reportService, err := do.Invoke[service.IReportService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = reportService.ClaimReport(c.Request.Context(), operatorID, false, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// ResolveReport 处理举报
+// @Summary 处理举报
+// @Description 版主处理举报，可选择封禁帖子或删除评论，封禁用户需由管理员处理
+// @Tags [版主]举报处理
+// @Accept json
+// @Produce json
+// @Param request body schema.ReportResolveRequest true "处理举报信息"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /moderator/reports/resolve [post]
+func (ctrl *ModeratorController) ResolveReport(c *gin.Context) {
+	var req schema.ReportResolveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	reportService, err := do.Invoke[service.IReportService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = reportService.ResolveReport(c.Request.Context(), operatorID, false, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// DismissReport 驳回举报
+// @Summary 驳回举报
+// @Description 版主驳回所管理版块内的举报
+// @Tags [版主]举报处理
+// @Accept json
+// @Produce json
+// @Param request body schema.ReportDismissRequest true "驳回举报信息"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /moderator/reports/dismiss [post]
+func (ctrl *ModeratorController) DismissReport(c *gin.Context) {
+	var req schema.ReportDismissRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	reportService, err := do.Invoke[service.IReportService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = reportService.DismissReport(c.Request.Context(), operatorID, false, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
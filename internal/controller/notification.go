@@ -0,0 +1,320 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// NotificationController 站内通知控制器
+type NotificationController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewNotificationController 创建站内通知控制器实例
+func NewNotificationController(injector *do.Injector) *NotificationController {
+	return &NotificationController{
+		injector: injector,
+	}
+}
+
+// NotificationRouter 站内通知相关路由注册
+func (ctrl *NotificationController) NotificationRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取通知列表
+	router.GET("", ctrl.GetNotifications)
+	// 获取未读通知数
+	router.GET("/unread", ctrl.GetUnreadCount)
+	// 标记通知已读
+	router.POST("/read", ctrl.MarkRead)
+	// 全部标记已读
+	router.POST("/read-all", ctrl.MarkAllRead)
+	// 获取通知偏好
+	router.GET("/preferences", ctrl.GetPreferences)
+	// 更新通知偏好
+	router.PUT("/preferences", ctrl.UpdatePreferences)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *NotificationController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetNotifications 获取通知列表
+// @Summary 获取通知列表
+// @Description 分页获取当前用户的站内通知，包括帖子回复、评论回复、提及、点赞、管理操作、余额变动与系统公告
+// @Tags [用户]通知
+// @Accept json
+// @Produce json
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(20)
+// @Param type query string false "通知类型" Enums(Reply, CommentReply, Mention, Like, Moderation, Balance, System)
+// @Param unread_only query bool false "仅返回未读通知"
+// @Success 200 {object} response.Data{data=schema.NotificationListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/notifications [get]
+// @Security Bearer
+func (ctrl *NotificationController) GetNotifications(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.NotificationListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	notificationService, err := do.Invoke[service.INotificationService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := notificationService.GetNotifications(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetUnreadCount 获取未读通知数
+// @Summary 获取未读通知数
+// @Description 获取当前用户的未读通知总数及各类型未读数
+// @Tags [用户]通知
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.NotificationUnreadResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/notifications/unread [get]
+// @Security Bearer
+func (ctrl *NotificationController) GetUnreadCount(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	notificationService, err := do.Invoke[service.INotificationService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := notificationService.GetUnreadCount(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// MarkRead 标记通知已读
+// @Summary 标记通知已读
+// @Description 将指定的通知标记为已读
+// @Tags [用户]通知
+// @Accept json
+// @Produce json
+// @Param request body schema.NotificationReadRequest true "通知ID列表"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/notifications/read [post]
+// @Security Bearer
+func (ctrl *NotificationController) MarkRead(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.NotificationReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	notificationService, err := do.Invoke[service.INotificationService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = notificationService.MarkRead(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// MarkAllRead 全部标记已读
+// @Summary 全部标记已读
+// @Description 将全部通知或指定类型的通知标记为已读
+// @Tags [用户]通知
+// @Accept json
+// @Produce json
+// @Param request body schema.NotificationReadAllRequest true "通知类型"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/notifications/read-all [post]
+// @Security Bearer
+func (ctrl *NotificationController) MarkAllRead(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.NotificationReadAllRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	notificationService, err := do.Invoke[service.INotificationService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = notificationService.MarkAllRead(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// GetPreferences 获取通知偏好
+// @Summary 获取通知偏好
+// @Description 获取各类型通知的接收设置，未设置的类型默认接收
+// @Tags [用户]通知
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.NotificationPreferenceResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/notifications/preferences [get]
+// @Security Bearer
+func (ctrl *NotificationController) GetPreferences(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	notificationService, err := do.Invoke[service.INotificationService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := notificationService.GetPreferences(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// UpdatePreferences 更新通知偏好
+// @Summary 更新通知偏好
+// @Description 设置各类型通知是否接收，系统公告不可关闭
+// @Tags [用户]通知
+// @Accept json
+// @Produce json
+// @Param request body schema.NotificationPreferenceUpdateRequest true "通知偏好"
+// @Success 200 {object} response.Data "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /profile/notifications/preferences [put]
+// @Security Bearer
+func (ctrl *NotificationController) UpdatePreferences(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.NotificationPreferenceUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	notificationService, err := do.Invoke[service.INotificationService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = notificationService.UpdatePreferences(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
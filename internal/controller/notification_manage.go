@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// NotificationManageController 通知管理控制器
+type NotificationManageController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewNotificationManageController 创建通知管理控制器实例
+func NewNotificationManageController(injector *do.Injector) *NotificationManageController {
+	return &NotificationManageController{
+		injector: injector,
+	}
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *NotificationManageController) getUserID(c *gin.Context) (int, error) {
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// NotificationManageRouter 通知管理相关路由注册
+func (ctrl *NotificationManageController) NotificationManageRouter(router *gin.RouterGroup) {
+	// 发送系统公告
+	router.POST("/announcements", ctrl.SendAnnouncement)
+}
+
+// SendAnnouncement 发送系统公告
+// @Summary 发送系统公告
+// @Description 向全部未封禁用户发送系统公告通知，公告在后台异步分批写入且不受用户通知偏好限制
+// @Tags [管理员]通知管理
+// @Accept json
+// @Produce json
+// @Param request body schema.NotificationAnnouncementRequest true "公告内容"
+// @Success 200 {object} response.Data "发送成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/notifications/announcements [post]
+func (ctrl *NotificationManageController) SendAnnouncement(c *gin.Context) {
+	var req schema.NotificationAnnouncementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	notificationService, err := do.Invoke[service.INotificationService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = notificationService.SendAnnouncement(c.Request.Context(), operatorID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
@@ -0,0 +1,207 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// PasskeyController 通行密钥控制器
+type PasskeyController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewPasskeyController 创建通行密钥控制器实例
+func NewPasskeyController(injector *do.Injector) *PasskeyController {
+	return &PasskeyController{
+		injector: injector,
+	}
+}
+
+// PasskeyRouter 通行密钥相关路由注册
+func (ctrl *PasskeyController) PasskeyRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取通行密钥列表
+	router.GET("/list", ctrl.GetCredentials)
+	// 获取通行密钥注册选项
+	router.POST("/register/options", ctrl.RegisterOptions)
+	// 注册通行密钥
+	router.POST("/register", ctrl.Register)
+	// 删除通行密钥
+	router.DELETE("/:id", ctrl.DeleteCredential)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *PasskeyController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetCredentials 获取通行密钥列表
+// @Summary 获取通行密钥列表
+// @Description 获取当前用户已注册的通行密钥
+// @Tags [用户]通行密钥
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=[]schema.WebAuthnCredentialItem} "获取成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/passkeys/list [get]
+// @Security Bearer
+func (ctrl *PasskeyController) GetCredentials(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 获取通行密钥服务
+	webAuthnService := do.MustInvoke[service.IWebAuthnService](ctrl.injector)
+
+	result, err := webAuthnService.GetCredentials(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "获取通行密钥列表失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// RegisterOptions 获取通行密钥注册选项
+// @Summary 获取通行密钥注册选项
+// @Description 生成WebAuthn注册选项，供前端调用 navigator.credentials.create
+// @Tags [用户]通行密钥
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.WebAuthnRegisterOptionsResponse} "获取成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/passkeys/register/options [post]
+// @Security Bearer
+func (ctrl *PasskeyController) RegisterOptions(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 获取通行密钥服务
+	webAuthnService := do.MustInvoke[service.IWebAuthnService](ctrl.injector)
+
+	result, err := webAuthnService.BeginRegistration(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "获取注册选项失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// Register 注册通行密钥
+// @Summary 注册通行密钥
+// @Description 校验认证器返回的注册结果并保存凭证
+// @Tags [用户]通行密钥
+// @Accept json
+// @Produce json
+// @Param request body schema.WebAuthnRegisterRequest true "注册结果"
+// @Success 200 {object} response.Data{data=schema.WebAuthnCredentialItem} "注册成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/passkeys/register [post]
+// @Security Bearer
+func (ctrl *PasskeyController) Register(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.WebAuthnRegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取通行密钥服务
+	webAuthnService := do.MustInvoke[service.IWebAuthnService](ctrl.injector)
+
+	result, err := webAuthnService.FinishRegistration(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "注册通行密钥失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// DeleteCredential 删除通行密钥
+// @Summary 删除通行密钥
+// @Description 删除当前用户的指定通行密钥，不允许删除最后一种登录方式
+// @Tags [用户]通行密钥
+// @Accept json
+// @Produce json
+// @Param id path int true "凭证记录ID"
+// @Success 200 {object} response.Data "删除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/passkeys/{id} [delete]
+// @Security Bearer
+func (ctrl *PasskeyController) DeleteCredential(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.WebAuthnCredentialDeleteRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取通行密钥服务
+	webAuthnService := do.MustInvoke[service.IWebAuthnService](ctrl.injector)
+
+	if err = webAuthnService.DeleteCredential(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "删除通行密钥失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
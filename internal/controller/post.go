@@ -10,7 +10,9 @@ import (
 	"github.com/samber/do"
 
 	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/middleware"
 	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
 	"github.com/PokeForum/PokeForum/internal/schema"
 	"github.com/PokeForum/PokeForum/internal/service"
 )
@@ -53,10 +55,12 @@ func (ctrl *PostController) getUserID(c *gin.Context) (int, error) {
 
 // PostRouter 帖子相关路由注册
 func (ctrl *PostController) PostRouter(router *gin.RouterGroup) {
+	userGroupService := do.MustInvoke[service.IUserGroupService](ctrl.injector)
+
 	// 发布新帖
-	router.POST("", saGin.CheckRole(user.RoleUser.String()), ctrl.CreatePost)
+	router.POST("", saGin.CheckRole(user.RoleUser.String()), middleware.CheckPermission(userGroupService, satoken.PermPostCreate), ctrl.CreatePost)
 	// 保存草稿
-	router.POST("/draft", saGin.CheckRole(user.RoleUser.String()), ctrl.SaveDraft)
+	router.POST("/draft", saGin.CheckRole(user.RoleUser.String()), middleware.CheckPermission(userGroupService, satoken.PermPostCreate), ctrl.SaveDraft)
 	// 编辑帖子
 	router.PUT("", saGin.CheckRole(user.RoleUser.String()), ctrl.UpdatePost)
 	// 设置帖子私有
@@ -67,6 +71,8 @@ func (ctrl *PostController) PostRouter(router *gin.RouterGroup) {
 	router.POST("/dislike", saGin.CheckRole(user.RoleUser.String()), ctrl.DislikePost)
 	// 收藏帖子
 	router.POST("/favorite", saGin.CheckRole(user.RoleUser.String()), ctrl.FavoritePost)
+	// 购买付费阅读帖子
+	router.POST("/purchase", saGin.CheckRole(user.RoleUser.String()), ctrl.PurchasePost)
 	// 获取帖子列表
 	router.GET("", ctrl.GetPostList)
 	// 获取帖子详情
@@ -378,6 +384,49 @@ func (ctrl *PostController) FavoritePost(c *gin.Context) {
 	response.ResSuccess(c, result)
 }
 
+// PurchasePost 购买付费阅读帖子
+// @Summary 购买付费阅读帖子
+// @Description 使用货币购买付费阅读帖子，货币转入作者账户，购买后可永久查看正文
+// @Tags [用户]主题贴
+// @Accept json
+// @Produce json
+// @Param request body schema.UserPostActionRequest true "帖子信息"
+// @Success 200 {object} response.Data{data=schema.UserPostPurchaseResponse} "购买成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未登录"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /posts/purchase [post]
+func (ctrl *PostController) PurchasePost(c *gin.Context) {
+	var req schema.UserPostActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	postService, err := do.Invoke[service.IPostService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := postService.PurchasePost(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
 // GetPostList 获取帖子列表
 // @Summary 获取帖子列表
 // @Description 获取帖子列表，支持分页和排序
@@ -385,6 +434,8 @@ func (ctrl *PostController) FavoritePost(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param category_id query int false "版块ID"
+// @Param include_children query bool false "是否包含子孙版块的帖子"
+// @Param tag query string false "标签名称，同义词会解析为规范标签"
 // @Param page query int false "页码，默认1" default(1)
 // @Param page_size query int false "每页数量，默认20，最大100" default(20)
 // @Param sort query string false "排序方式：latest(最新)、hot(热门)、essence(精华)" default(latest)
@@ -1,6 +1,10 @@
 package controller
 
 import (
+	"fmt"
+	"strconv"
+
+	"github.com/click33/sa-token-go/stputil"
 	"github.com/gin-gonic/gin"
 	"github.com/samber/do"
 
@@ -23,6 +27,26 @@ func NewPostManageController(injector *do.Injector) *PostManageController {
 	}
 }
 
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *PostManageController) getUserID(c *gin.Context) (int, error) {
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
 // PostManageRouter 帖子管理相关路由注册
 func (ctrl *PostManageController) PostManageRouter(router *gin.RouterGroup) {
 	// 帖子列表
@@ -146,7 +170,7 @@ func (ctrl *PostManageController) CreatePost(c *gin.Context) {
 
 // UpdatePost 更新帖子信息
 // @Summary 更新帖子信息
-// @Description 更新帖子的基本信息
+// @Description 更新帖子的基本信息，修改标题或内容时记录修订历史
 // @Tags [管理员]主题贴管理
 // @Accept json
 // @Produce json
@@ -162,6 +186,13 @@ func (ctrl *PostManageController) UpdatePost(c *gin.Context) {
 		return
 	}
 
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
 	// 获取服务
 	postManageService, err := do.Invoke[service.IPostManageService](ctrl.injector)
 	if err != nil {
@@ -170,7 +201,7 @@ func (ctrl *PostManageController) UpdatePost(c *gin.Context) {
 	}
 
 	// 调用服务
-	post, err := postManageService.UpdatePost(c.Request.Context(), req)
+	post, err := postManageService.UpdatePost(c.Request.Context(), operatorID, req)
 	if err != nil {
 		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
 		return
@@ -0,0 +1,195 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// PostRevisionController 帖子修订历史控制器
+type PostRevisionController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewPostRevisionController 创建帖子修订历史控制器实例
+func NewPostRevisionController(injector *do.Injector) *PostRevisionController {
+	return &PostRevisionController{
+		injector: injector,
+	}
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *PostRevisionController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// PostRevisionRouter 帖子修订历史相关路由注册
+func (ctrl *PostRevisionController) PostRevisionRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取修订历史
+	router.GET("", ctrl.GetRevisions)
+	// 对比修订版本
+	router.GET("/diff", ctrl.GetRevisionDiff)
+	// 回滚到指定版本
+	router.POST("/rollback", ctrl.RollbackRevision)
+}
+
+// GetRevisions 获取帖子修订历史
+// @Summary 获取帖子修订历史
+// @Description 获取帖子的全部修订版本，仅帖子作者、所在版块版主与管理员可查看
+// @Tags [用户]主题贴
+// @Accept json
+// @Produce json
+// @Param post_id query int true "帖子ID"
+// @Success 200 {object} response.Data{data=schema.PostRevisionListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未登录"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /posts/revisions [get]
+func (ctrl *PostRevisionController) GetRevisions(c *gin.Context) {
+	var req schema.PostRevisionListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	revisionService, err := do.Invoke[service.IPostRevisionService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := revisionService.GetRevisions(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetRevisionDiff 对比帖子修订版本
+// @Summary 对比帖子修订版本
+// @Description 返回两个修订版本的标题与正文统一diff，仅帖子作者、所在版块版主与管理员可查看
+// @Tags [用户]主题贴
+// @Accept json
+// @Produce json
+// @Param post_id query int true "帖子ID"
+// @Param from query int true "起始版本号"
+// @Param to query int true "目标版本号"
+// @Success 200 {object} response.Data{data=schema.PostRevisionDiffResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未登录"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /posts/revisions/diff [get]
+func (ctrl *PostRevisionController) GetRevisionDiff(c *gin.Context) {
+	var req schema.PostRevisionDiffRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	revisionService, err := do.Invoke[service.IPostRevisionService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := revisionService.GetRevisionDiff(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// RollbackRevision 回滚帖子到指定版本
+// @Summary 回滚帖子到指定版本
+// @Description 帖子作者、所在版块版主或管理员将帖子标题与正文恢复为指定版本，回滚本身也会记录为新版本
+// @Tags [用户]主题贴
+// @Accept json
+// @Produce json
+// @Param request body schema.PostRevisionRollbackRequest true "回滚信息"
+// @Success 200 {object} response.Data{data=schema.PostRevisionItem} "回滚成功，返回新生成的版本"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未登录"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /posts/revisions/rollback [post]
+func (ctrl *PostRevisionController) RollbackRevision(c *gin.Context) {
+	var req schema.PostRevisionRollbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	revisionService, err := do.Invoke[service.IPostRevisionService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := revisionService.RollbackRevision(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
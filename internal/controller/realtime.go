@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+const (
+	// realtimeHeartbeatInterval SSE 心跳间隔，避免代理因空闲断开连接
+	realtimeHeartbeatInterval = 25 * time.Second
+	// realtimeRetryMillis 客户端断线后的重连等待时间（毫秒）
+	realtimeRetryMillis = 3000
+)
+
+// RealtimeController 实时推送控制器
+type RealtimeController struct {
+	injector *do.Injector
+	logger   *zap.Logger
+}
+
+// NewRealtimeController 创建实时推送控制器实例
+func NewRealtimeController(injector *do.Injector) *RealtimeController {
+	logger, _ := do.Invoke[*zap.Logger](injector) //nolint:errcheck // 依赖注入失败时返回nil
+	return &RealtimeController{
+		injector: injector,
+		logger:   logger,
+	}
+}
+
+// RealtimeRouter 实时推送相关路由注册
+func (ctrl *RealtimeController) RealtimeRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 订阅实时事件
+	router.GET("/stream", ctrl.HandleSSE)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *RealtimeController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// HandleSSE 处理实时推送 SSE 连接
+// @Summary 实时事件流
+// @Description 通过 SSE 推送新的站内通知，传入 post_id 时同时推送该帖子的新评论与点赞数变化。
+// @Description 事件类型：notification、comment、post_stats、comment_stats、message、message_read；每条事件带有 id，断线重连时通过 Last-Event-ID 请求头（或 last_event_id 参数）补发期间错过的事件。
+// @Description 连接空闲时定期发送心跳注释行。
+// @Tags [用户]实时推送
+// @Produce text/event-stream
+// @Param post_id query int false "正在浏览的帖子ID"
+// @Param last_event_id query string false "最后收到的事件ID"
+// @Param Last-Event-ID header string false "最后收到的事件ID"
+// @Success 200 {object} schema.RealtimeEvent "SSE 数据流"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /realtime/stream [get]
+// @Security Bearer
+func (ctrl *RealtimeController) HandleSSE(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	var req schema.RealtimeStreamRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 浏览器重连时会自动携带 Last-Event-ID 请求头
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = req.LastEventID
+	}
+
+	// 获取服务
+	realtimeService, err := do.Invoke[service.IRealtimeService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	ctx := c.Request.Context()
+	events, err := realtimeService.Subscribe(ctx, userID, req, lastEventID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	// 设置 SSE 响应头
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("X-Accel-Buffering", "no") // 禁用 Nginx 缓冲
+
+	if _, err = fmt.Fprintf(c.Writer, "retry: %d\n\n", realtimeRetryMillis); err != nil {
+		return
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(realtimeHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err = ctrl.writeEvent(c, event); err != nil {
+				ctrl.logger.Debug("SSE 写入失败", zap.Error(err))
+				return
+			}
+		case <-heartbeat.C:
+			if _, err = fmt.Fprintf(c.Writer, ": heartbeat %d\n\n", time.Now().Unix()); err != nil {
+				ctrl.logger.Debug("SSE 心跳写入失败", zap.Error(err))
+				return
+			}
+			c.Writer.Flush()
+		case <-ctx.Done():
+			ctrl.logger.Debug("SSE 客户端断开连接", zap.Int("user_id", userID))
+			return
+		}
+	}
+}
+
+// writeEvent 写入一条 SSE 事件
+func (ctrl *RealtimeController) writeEvent(c *gin.Context, event schema.RealtimeEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Event, data); err != nil {
+		return err
+	}
+	c.Writer.Flush()
+	return nil
+}
@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// ReportController 举报控制器
+type ReportController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewReportController 创建举报控制器实例
+func NewReportController(injector *do.Injector) *ReportController {
+	return &ReportController{
+		injector: injector,
+	}
+}
+
+// ReportRouter 举报相关路由注册
+func (ctrl *ReportController) ReportRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 提交举报
+	router.POST("", ctrl.CreateReport)
+	// 获取我的举报列表
+	router.GET("/list", ctrl.GetReports)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *ReportController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// CreateReport 提交举报
+// @Summary 提交举报
+// @Description 举报帖子、评论或用户，同一目标只能举报一次
+// @Tags [用户]举报
+// @Accept json
+// @Produce json
+// @Param request body schema.ReportCreateRequest true "举报信息"
+// @Success 200 {object} response.Data{data=schema.ReportItem} "举报成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/reports [post]
+// @Security Bearer
+func (ctrl *ReportController) CreateReport(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.ReportCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取举报服务
+	reportService := do.MustInvoke[service.IReportService](ctrl.injector)
+
+	result, err := reportService.CreateReport(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "举报失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetReports 获取我的举报列表
+// @Summary 获取我的举报列表
+// @Description 分页获取当前用户提交的举报及处理结果
+// @Tags [用户]举报
+// @Accept json
+// @Produce json
+// @Param page query int true "页码" example("1")
+// @Param page_size query int true "每页数量" example("20")
+// @Param status query string false "处理状态" Enums(Pending, Processing, Resolved, Dismissed)
+// @Param target_type query string false "目标类型" Enums(Post, Comment, User)
+// @Success 200 {object} response.Data{data=schema.ReportListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/reports/list [get]
+// @Security Bearer
+func (ctrl *ReportController) GetReports(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.ReportListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取举报服务
+	reportService := do.MustInvoke[service.IReportService](ctrl.injector)
+
+	result, err := reportService.GetUserReports(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "获取举报列表失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
@@ -0,0 +1,232 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// ReportManageController 举报管理控制器
+type ReportManageController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewReportManageController 创建举报管理控制器实例
+func NewReportManageController(injector *do.Injector) *ReportManageController {
+	return &ReportManageController{
+		injector: injector,
+	}
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *ReportManageController) getUserID(c *gin.Context) (int, error) {
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// ReportManageRouter 举报管理相关路由注册
+func (ctrl *ReportManageController) ReportManageRouter(router *gin.RouterGroup) {
+	// 获取举报处理队列
+	router.GET("", ctrl.GetReportQueue)
+	// 认领举报
+	router.POST("/claim", ctrl.ClaimReport)
+	// 处理举报
+	router.POST("/resolve", ctrl.ResolveReport)
+	// 驳回举报
+	router.POST("/dismiss", ctrl.DismissReport)
+}
+
+// GetReportQueue 获取举报处理队列
+// @Summary 获取举报处理队列
+// @Description 获取全站举报，支持按状态、目标类型与版块筛选
+// @Tags [管理员]举报管理
+// @Accept json
+// @Produce json
+// @Param page query int true "页码" example("1")
+// @Param page_size query int true "每页数量" example("20")
+// @Param status query string false "处理状态" Enums(Pending, Processing, Resolved, Dismissed)
+// @Param target_type query string false "目标类型" Enums(Post, Comment, User)
+// @Param category_id query int false "版块ID" example("1")
+// @Success 200 {object} response.Data{data=schema.ReportListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/reports [get]
+func (ctrl *ReportManageController) GetReportQueue(c *gin.Context) {
+	var req schema.ReportListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	reportService, err := do.Invoke[service.IReportService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := reportService.GetReportQueue(c.Request.Context(), operatorID, true, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// ClaimReport 认领举报
+// @Summary 认领举报
+// @Description 管理员认领待处理的举报
+// @Tags [管理员]举报管理
+// @Accept json
+// @Produce json
+// @Param request body schema.ReportClaimRequest true "认领举报信息"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/reports/claim [post]
+func (ctrl *ReportManageController) ClaimReport(c *gin.Context) {
+	var req schema.ReportClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	reportService, err := do.Invoke[service.IReportService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = reportService.ClaimReport(c.Request.Context(), operatorID, true, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// ResolveReport 处理举报
+// @Summary 处理举报
+// @Description 管理员处理举报，可选择封禁帖子、删除评论或封禁用户，同一目标的其他举报一并结案
+// @Tags [管理员]举报管理
+// @Accept json
+// @Produce json
+// @Param request body schema.ReportResolveRequest true "处理举报信息"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/reports/resolve [post]
+func (ctrl *ReportManageController) ResolveReport(c *gin.Context) {
+	var req schema.ReportResolveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	reportService, err := do.Invoke[service.IReportService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = reportService.ResolveReport(c.Request.Context(), operatorID, true, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// DismissReport 驳回举报
+// @Summary 驳回举报
+// @Description 管理员驳回举报
+// @Tags [管理员]举报管理
+// @Accept json
+// @Produce json
+// @Param request body schema.ReportDismissRequest true "驳回举报信息"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/reports/dismiss [post]
+func (ctrl *ReportManageController) DismissReport(c *gin.Context) {
+	var req schema.ReportDismissRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResError(c, response.CodeNeedLogin)
+		return
+	}
+
+	// 获取服务
+	reportService, err := do.Invoke[service.IReportService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = reportService.DismissReport(c.Request.Context(), operatorID, true, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// SearchController 搜索控制器
+type SearchController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewSearchController 创建搜索控制器实例
+func NewSearchController(injector *do.Injector) *SearchController {
+	return &SearchController{
+		injector: injector,
+	}
+}
+
+// SearchRouter 搜索相关路由注册
+func (ctrl *SearchController) SearchRouter(router *gin.RouterGroup) {
+	// 全文搜索
+	router.GET("", ctrl.Search)
+}
+
+// Search 全文搜索
+// @Summary 全文搜索
+// @Description 搜索主题帖标题与内容或评论内容，按相关度排序并返回高亮摘要，仅返回当前用户可见的内容
+// @Tags [用户]搜索
+// @Accept json
+// @Produce json
+// @Param keyword query string true "搜索关键词，支持 \"短语\"、OR、-排除 语法"
+// @Param type query string false "搜索类型：post(主题帖)、comment(评论)" default(post)
+// @Param category_id query int false "版块ID"
+// @Param author_id query int false "作者ID"
+// @Param start_date query string false "开始日期" example("2024-01-01")
+// @Param end_date query string false "结束日期（包含当天）" example("2024-12-31")
+// @Param page query int false "页码，默认1" default(1)
+// @Param page_size query int false "每页数量，默认20，最大50" default(20)
+// @Success 200 {object} response.Data{data=schema.SearchResponse} "搜索成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /search [get]
+func (ctrl *SearchController) Search(c *gin.Context) {
+	var req schema.SearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	searchService, err := do.Invoke[service.ISearchService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 携带登录用户ID，用于判断需登录版块的可见性
+	ctx := tracing.ContextWithUserID(c, c.Request.Context())
+
+	// 调用服务
+	result, err := searchService.Search(ctx, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
@@ -82,6 +82,20 @@ func (ctrl *SettingsController) SettingsRouter(router *gin.RouterGroup) {
 		signinGroup.GET("", ctrl.GetSigninSettings)
 		signinGroup.POST("", ctrl.UpdateSigninSettings)
 	}
+
+	// 上传设置
+	uploadGroup := router.Group("/upload")
+	{
+		uploadGroup.GET("", ctrl.GetUploadSettings)
+		uploadGroup.POST("", ctrl.UpdateUploadSettings)
+	}
+
+	// 等级设置
+	levelGroup := router.Group("/level")
+	{
+		levelGroup.GET("", ctrl.GetLevelSettings)
+		levelGroup.POST("", ctrl.UpdateLevelSettings)
+	}
 }
 
 // GetRoutineSettings 获取常规设置
@@ -594,3 +608,121 @@ func (ctrl *SettingsController) UpdateSigninSettings(c *gin.Context) {
 
 	response.ResSuccess(c, nil)
 }
+
+// GetUploadSettings 获取上传设置
+// @Summary 获取上传设置
+// @Description 获取附件上传相关配置，包括大小上限、允许的文件类型与用户容量
+// @Tags [超级管理员]系统设置
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.UploadSettingsResponse} "获取成功"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /super/manage/settings/upload [get]
+// @Security Bearer
+func (ctrl *SettingsController) GetUploadSettings(c *gin.Context) {
+	settingsService, err := do.Invoke[service.ISettingsService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	config, err := settingsService.GetUploadSettings(c.Request.Context())
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, config)
+}
+
+// UpdateUploadSettings 更新上传设置
+// @Summary 更新上传设置
+// @Description 更新附件上传相关配置
+// @Tags [超级管理员]系统设置
+// @Accept json
+// @Produce json
+// @Param request body schema.UploadSettingsRequest true "上传设置信息"
+// @Success 200 {object} response.Data "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /super/manage/settings/upload [post]
+// @Security Bearer
+func (ctrl *SettingsController) UpdateUploadSettings(c *gin.Context) {
+	var req schema.UploadSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	settingsService, err := do.Invoke[service.ISettingsService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	if err = settingsService.UpdateUploadSettings(c.Request.Context(), req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// GetLevelSettings 获取等级设置
+// @Summary 获取等级设置
+// @Description 获取用户等级表，包括等级名称、经验值阈值、图标与等级权益
+// @Tags [超级管理员]系统设置
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.LevelSettingsResponse} "获取成功"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /super/manage/settings/level [get]
+// @Security Bearer
+func (ctrl *SettingsController) GetLevelSettings(c *gin.Context) {
+	settingsService, err := do.Invoke[service.ISettingsService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	config, err := settingsService.GetLevelSettings(c.Request.Context())
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, config)
+}
+
+// UpdateLevelSettings 更新等级设置
+// @Summary 更新等级设置
+// @Description 更新用户等级表，等级按经验值阈值升序排列，第一个等级的阈值必须为0
+// @Tags [超级管理员]系统设置
+// @Accept json
+// @Produce json
+// @Param request body schema.LevelSettingsRequest true "等级设置信息"
+// @Success 200 {object} response.Data "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /super/manage/settings/level [post]
+// @Security Bearer
+func (ctrl *SettingsController) UpdateLevelSettings(c *gin.Context) {
+	var req schema.LevelSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	settingsService, err := do.Invoke[service.ISettingsService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	if err = settingsService.UpdateLevelSettings(c.Request.Context(), req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// TagController 标签控制器
+type TagController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewTagController 创建标签控制器实例
+func NewTagController(injector *do.Injector) *TagController {
+	return &TagController{
+		injector: injector,
+	}
+}
+
+// TagRouter 标签相关路由注册
+func (ctrl *TagController) TagRouter(router *gin.RouterGroup) {
+	// 获取热门标签
+	router.GET("/popular", ctrl.GetPopularTags)
+	// 获取标签详情
+	router.GET("/:name", ctrl.GetTagDetail)
+}
+
+// GetPopularTags 获取热门标签
+// @Summary 获取热门标签
+// @Description 按关联帖子数降序获取热门标签
+// @Tags [用户]标签
+// @Accept json
+// @Produce json
+// @Param limit query int false "返回数量，默认20，最大100" default(20)
+// @Success 200 {object} response.Data{data=[]schema.TagItem} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /tags/popular [get]
+func (ctrl *TagController) GetPopularTags(c *gin.Context) {
+	var req schema.TagPopularRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	tagService, err := do.Invoke[service.ITagService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := tagService.GetPopularTags(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetTagDetail 获取标签详情
+// @Summary 获取标签详情
+// @Description 获取标签页信息，同义词会解析为规范标签；标签下的帖子通过帖子列表的tag参数获取
+// @Tags [用户]标签
+// @Accept json
+// @Produce json
+// @Param name path string true "标签名称"
+// @Success 200 {object} response.Data{data=schema.TagItem} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /tags/{name} [get]
+func (ctrl *TagController) GetTagDetail(c *gin.Context) {
+	var req schema.TagDetailRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	tagService, err := do.Invoke[service.ITagService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := tagService.GetTagDetail(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
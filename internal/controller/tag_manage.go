@@ -0,0 +1,212 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// TagManageController 标签管理控制器
+type TagManageController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewTagManageController 创建标签管理控制器实例
+func NewTagManageController(injector *do.Injector) *TagManageController {
+	return &TagManageController{
+		injector: injector,
+	}
+}
+
+// TagManageRouter 标签管理相关路由注册
+func (ctrl *TagManageController) TagManageRouter(router *gin.RouterGroup) {
+	// 标签列表
+	router.GET("", ctrl.GetTagList)
+	// 重命名标签
+	router.PUT("/rename", ctrl.RenameTag)
+	// 合并标签
+	router.POST("/merge", ctrl.MergeTags)
+
+	// 同义词管理
+	router.POST("/synonyms", ctrl.CreateTagSynonym)
+	router.DELETE("/synonyms/:id", ctrl.DeleteTagSynonym)
+}
+
+// GetTagList 获取标签列表
+// @Summary 获取标签列表
+// @Description 分页获取规范标签或同义词列表，支持名称关键词搜索
+// @Tags [管理员]标签管理
+// @Accept json
+// @Produce json
+// @Param page query int true "页码" example("1")
+// @Param page_size query int true "每页数量" example("20")
+// @Param keyword query string false "名称关键词" example("go")
+// @Param synonym query bool false "是否只查询同义词" default(false)
+// @Success 200 {object} response.Data{data=schema.TagListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/tags [get]
+func (ctrl *TagManageController) GetTagList(c *gin.Context) {
+	var req schema.TagListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	tagService, err := do.Invoke[service.ITagService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := tagService.GetTagList(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// RenameTag 重命名标签
+// @Summary 重命名标签
+// @Description 修改标签名称，新名称已存在时需使用合并功能
+// @Tags [管理员]标签管理
+// @Accept json
+// @Produce json
+// @Param request body schema.TagRenameRequest true "重命名信息"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/tags/rename [put]
+func (ctrl *TagManageController) RenameTag(c *gin.Context) {
+	var req schema.TagRenameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	tagService, err := do.Invoke[service.ITagService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = tagService.RenameTag(c.Request.Context(), req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// MergeTags 合并标签
+// @Summary 合并标签
+// @Description 将源标签的帖子迁移到目标标签，源标签及其同义词成为目标标签的同义词
+// @Tags [管理员]标签管理
+// @Accept json
+// @Produce json
+// @Param request body schema.TagMergeRequest true "合并信息"
+// @Success 200 {object} response.Data "操作成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/tags/merge [post]
+func (ctrl *TagManageController) MergeTags(c *gin.Context) {
+	var req schema.TagMergeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	tagService, err := do.Invoke[service.ITagService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = tagService.MergeTags(c.Request.Context(), req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// CreateTagSynonym 添加同义词
+// @Summary 添加同义词
+// @Description 为规范标签添加同义词，发帖或筛选时同义词会解析为规范标签
+// @Tags [管理员]标签管理
+// @Accept json
+// @Produce json
+// @Param request body schema.TagSynonymCreateRequest true "同义词信息"
+// @Success 200 {object} response.Data{data=schema.TagItem} "添加成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/tags/synonyms [post]
+func (ctrl *TagManageController) CreateTagSynonym(c *gin.Context) {
+	var req schema.TagSynonymCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	tagService, err := do.Invoke[service.ITagService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := tagService.CreateTagSynonym(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// DeleteTagSynonym 删除同义词
+// @Summary 删除同义词
+// @Description 删除同义词，不影响规范标签及其帖子
+// @Tags [管理员]标签管理
+// @Accept json
+// @Produce json
+// @Param id path int true "同义词标签ID"
+// @Success 200 {object} response.Data "删除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/tags/synonyms/{id} [delete]
+func (ctrl *TagManageController) DeleteTagSynonym(c *gin.Context) {
+	var req schema.TagSynonymDeleteRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	tagService, err := do.Invoke[service.ITagService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = tagService.DeleteTagSynonym(c.Request.Context(), req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
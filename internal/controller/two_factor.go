@@ -0,0 +1,249 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// TwoFactorController 两步验证控制器
+type TwoFactorController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewTwoFactorController 创建两步验证控制器实例
+func NewTwoFactorController(injector *do.Injector) *TwoFactorController {
+	return &TwoFactorController{
+		injector: injector,
+	}
+}
+
+// TwoFactorRouter 两步验证相关路由注册
+func (ctrl *TwoFactorController) TwoFactorRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取两步验证状态
+	router.GET("/status", ctrl.GetStatus)
+	// 获取绑定信息
+	router.POST("/setup", ctrl.Setup)
+	// 启用两步验证
+	router.POST("/enable", ctrl.Enable)
+	// 关闭两步验证
+	router.POST("/disable", ctrl.Disable)
+	// 重新生成恢复码
+	router.POST("/recovery-codes", ctrl.RegenerateRecoveryCodes)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *TwoFactorController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetStatus 获取两步验证状态
+// @Summary 获取两步验证状态
+// @Description 获取当前用户两步验证的启用状态与剩余恢复码数量
+// @Tags [用户]两步验证
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.TwoFactorStatusResponse} "获取成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/2fa/status [get]
+// @Security Bearer
+func (ctrl *TwoFactorController) GetStatus(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 获取两步验证服务
+	twoFactorService := do.MustInvoke[service.ITwoFactorService](ctrl.injector)
+
+	result, err := twoFactorService.GetStatus(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "获取两步验证状态失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// Setup 获取两步验证绑定信息
+// @Summary 获取两步验证绑定信息
+// @Description 生成TOTP密钥与otpauth地址，前端据此生成二维码，需在有效期内调用启用接口确认
+// @Tags [用户]两步验证
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.TwoFactorSetupResponse} "获取成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/2fa/setup [post]
+// @Security Bearer
+func (ctrl *TwoFactorController) Setup(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 获取两步验证服务
+	twoFactorService := do.MustInvoke[service.ITwoFactorService](ctrl.injector)
+
+	result, err := twoFactorService.Setup(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "获取绑定信息失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// Enable 启用两步验证
+// @Summary 启用两步验证
+// @Description 校验动态验证码后启用两步验证，返回的恢复码仅展示一次
+// @Tags [用户]两步验证
+// @Accept json
+// @Produce json
+// @Param request body schema.TwoFactorEnableRequest true "动态验证码"
+// @Success 200 {object} response.Data{data=schema.TwoFactorRecoveryCodesResponse} "启用成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/2fa/enable [post]
+// @Security Bearer
+func (ctrl *TwoFactorController) Enable(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.TwoFactorEnableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取两步验证服务
+	twoFactorService := do.MustInvoke[service.ITwoFactorService](ctrl.injector)
+
+	result, err := twoFactorService.Enable(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "启用两步验证失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// Disable 关闭两步验证
+// @Summary 关闭两步验证
+// @Description 校验动态验证码或恢复码后关闭两步验证，被强制启用的身份无法关闭
+// @Tags [用户]两步验证
+// @Accept json
+// @Produce json
+// @Param request body schema.TwoFactorCodeRequest true "动态验证码或恢复码"
+// @Success 200 {object} response.Data "关闭成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/2fa/disable [post]
+// @Security Bearer
+func (ctrl *TwoFactorController) Disable(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取两步验证服务
+	twoFactorService := do.MustInvoke[service.ITwoFactorService](ctrl.injector)
+
+	if err = twoFactorService.Disable(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "关闭两步验证失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码
+// @Summary 重新生成恢复码
+// @Description 校验动态验证码或恢复码后重新生成恢复码，旧恢复码全部作废
+// @Tags [用户]两步验证
+// @Accept json
+// @Produce json
+// @Param request body schema.TwoFactorCodeRequest true "动态验证码或恢复码"
+// @Success 200 {object} response.Data{data=schema.TwoFactorRecoveryCodesResponse} "生成成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/2fa/recovery-codes [post]
+// @Security Bearer
+func (ctrl *TwoFactorController) RegenerateRecoveryCodes(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取两步验证服务
+	twoFactorService := do.MustInvoke[service.ITwoFactorService](ctrl.injector)
+
+	result, err := twoFactorService.RegenerateRecoveryCodes(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "生成恢复码失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
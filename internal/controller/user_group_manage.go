@@ -0,0 +1,314 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// UserGroupManageController 用户组管理控制器
+type UserGroupManageController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewUserGroupManageController 创建用户组管理控制器实例
+func NewUserGroupManageController(injector *do.Injector) *UserGroupManageController {
+	return &UserGroupManageController{
+		injector: injector,
+	}
+}
+
+// UserGroupManageRouter 用户组管理相关路由注册
+func (ctrl *UserGroupManageController) UserGroupManageRouter(router *gin.RouterGroup) {
+	// 用户组列表
+	router.GET("", ctrl.GetGroupList)
+	// 创建用户组
+	router.POST("", ctrl.CreateGroup)
+	// 更新用户组
+	router.PUT("", ctrl.UpdateGroup)
+	// 删除用户组
+	router.DELETE("/:id", ctrl.DeleteGroup)
+	// 可分配权限列表
+	router.GET("/permissions", ctrl.GetPermissionList)
+
+	// 用户组成员管理
+	router.GET("/:id/members", ctrl.GetGroupMembers)
+	router.POST("/members", ctrl.AddGroupMember)
+	router.DELETE("/members", ctrl.RemoveGroupMember)
+}
+
+// GetGroupList 获取用户组列表
+// @Summary 获取用户组列表
+// @Description 分页获取用户组列表，支持名称关键词搜索
+// @Tags [管理员]用户组管理
+// @Accept json
+// @Produce json
+// @Param page query int true "页码" example("1")
+// @Param page_size query int true "每页数量" example("20")
+// @Param keyword query string false "名称关键词" example("VIP")
+// @Success 200 {object} response.Data{data=schema.UserGroupListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/user-groups [get]
+func (ctrl *UserGroupManageController) GetGroupList(c *gin.Context) {
+	var req schema.UserGroupListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	userGroupService, err := do.Invoke[service.IUserGroupService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := userGroupService.GetGroupList(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// CreateGroup 创建用户组
+// @Summary 创建用户组
+// @Description 创建用户组，用户组可用于版块访问控制规则，并为成员授予额外的权限
+// @Tags [管理员]用户组管理
+// @Accept json
+// @Produce json
+// @Param request body schema.UserGroupCreateRequest true "用户组信息"
+// @Success 200 {object} response.Data{data=schema.UserGroupItem} "创建成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/user-groups [post]
+func (ctrl *UserGroupManageController) CreateGroup(c *gin.Context) {
+	var req schema.UserGroupCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	userGroupService, err := do.Invoke[service.IUserGroupService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := userGroupService.CreateGroup(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// UpdateGroup 更新用户组
+// @Summary 更新用户组
+// @Description 更新用户组名称、描述与权限，权限变更会同步到全部成员
+// @Tags [管理员]用户组管理
+// @Accept json
+// @Produce json
+// @Param request body schema.UserGroupUpdateRequest true "用户组信息"
+// @Success 200 {object} response.Data{data=schema.UserGroupItem} "更新成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/user-groups [put]
+func (ctrl *UserGroupManageController) UpdateGroup(c *gin.Context) {
+	var req schema.UserGroupUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	userGroupService, err := do.Invoke[service.IUserGroupService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := userGroupService.UpdateGroup(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// DeleteGroup 删除用户组
+// @Summary 删除用户组
+// @Description 删除用户组并移除全部成员，同时从版块访问控制规则中移除该用户组
+// @Tags [管理员]用户组管理
+// @Accept json
+// @Produce json
+// @Param id path int true "用户组ID" example("1")
+// @Success 200 {object} response.Data "删除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/user-groups/{id} [delete]
+func (ctrl *UserGroupManageController) DeleteGroup(c *gin.Context) {
+	var req struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	userGroupService, err := do.Invoke[service.IUserGroupService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = userGroupService.DeleteGroup(c.Request.Context(), req.ID); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// GetGroupMembers 获取用户组成员列表
+// @Summary 获取用户组成员列表
+// @Description 按加入时间倒序分页获取用户组成员
+// @Tags [管理员]用户组管理
+// @Accept json
+// @Produce json
+// @Param id path int true "用户组ID" example("1")
+// @Param page query int false "页码" default(1)
+// @Param page_size query int false "每页数量" default(20)
+// @Success 200 {object} response.Data{data=schema.UserGroupMemberListResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/user-groups/{id}/members [get]
+func (ctrl *UserGroupManageController) GetGroupMembers(c *gin.Context) {
+	var req schema.UserGroupMemberListRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	userGroupService, err := do.Invoke[service.IUserGroupService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	result, err := userGroupService.GetGroupMembers(c.Request.Context(), req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// AddGroupMember 添加用户组成员
+// @Summary 添加用户组成员
+// @Description 将用户加入用户组，可设置成员资格有效期，用户已在用户组中时重新设置有效期
+// @Tags [管理员]用户组管理
+// @Accept json
+// @Produce json
+// @Param request body schema.UserGroupMemberAddRequest true "成员信息"
+// @Success 200 {object} response.Data "添加成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/user-groups/members [post]
+func (ctrl *UserGroupManageController) AddGroupMember(c *gin.Context) {
+	var req schema.UserGroupMemberAddRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	userGroupService, err := do.Invoke[service.IUserGroupService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = userGroupService.AddGroupMember(c.Request.Context(), req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// RemoveGroupMember 移除用户组成员
+// @Summary 移除用户组成员
+// @Description 将用户移出用户组
+// @Tags [管理员]用户组管理
+// @Accept json
+// @Produce json
+// @Param request body schema.UserGroupMemberRequest true "成员信息"
+// @Success 200 {object} response.Data "移除成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/user-groups/members [delete]
+func (ctrl *UserGroupManageController) RemoveGroupMember(c *gin.Context) {
+	var req schema.UserGroupMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取服务
+	userGroupService, err := do.Invoke[service.IUserGroupService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 调用服务
+	if err = userGroupService.RemoveGroupMember(c.Request.Context(), req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// GetPermissionList 获取可分配权限列表
+// @Summary 获取可分配权限列表
+// @Description 获取可分配给用户组的全部权限标识，权限标识前加"-"表示在用户组中禁止该权限
+// @Tags [管理员]用户组管理
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.PermissionListResponse} "获取成功"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/user-groups/permissions [get]
+func (ctrl *UserGroupManageController) GetPermissionList(c *gin.Context) {
+	// 获取服务
+	userGroupService, err := do.Invoke[service.IUserGroupService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	response.ResSuccess(c, userGroupService.GetPermissionList(c.Request.Context()))
+}
@@ -82,6 +82,11 @@ func (ctrl *UserManageController) UserManageRouter(router *gin.RouterGroup) {
 	// 用户封禁/解封
 	router.POST("/ban", ctrl.BanUser)
 	router.POST("/unban", ctrl.UnbanUser)
+
+	// 用户登录会话
+	router.GET("/:id/sessions", ctrl.GetUserSessions)
+	router.DELETE("/:id/sessions", ctrl.RevokeUserSessions)
+	router.DELETE("/:id/sessions/:session_id", ctrl.RevokeUserSession)
 }
 
 // GetUserList 获取用户列表
@@ -637,3 +642,162 @@ func (ctrl *UserManageController) UnbanUser(c *gin.Context) {
 
 	response.ResSuccess(c, nil)
 }
+
+// GetUserSessions 获取用户登录会话
+// @Summary 获取用户登录会话
+// @Description 获取指定用户所有有效的登录会话，包含设备、IP、归属地与最后活跃时间
+// @Tags [管理员]用户管理
+// @Accept json
+// @Produce json
+// @Param id path int true "用户ID" example("1")
+// @Success 200 {object} response.Data{data=[]schema.UserSessionItem} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/users/{id}/sessions [get]
+func (ctrl *UserManageController) GetUserSessions(c *gin.Context) {
+	var req schema.UserSessionManageRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, err.Error())
+		return
+	}
+	req.OperatorID = operatorID
+
+	userManageService, err := do.Invoke[service.IUserManageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 校验操作权限
+	if err = userManageService.CheckUserOperable(c.Request.Context(), req.OperatorID, req.UserID); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	sessionService, err := do.Invoke[service.IUserSessionService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	result, err := sessionService.GetSessions(c.Request.Context(), req.UserID, "")
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// RevokeUserSession 注销用户指定会话
+// @Summary 注销用户指定会话
+// @Description 使指定用户的某个会话Token失效
+// @Tags [管理员]用户管理
+// @Accept json
+// @Produce json
+// @Param id path int true "用户ID" example("1")
+// @Param session_id path string true "会话ID"
+// @Success 200 {object} response.Data "注销成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/users/{id}/sessions/{session_id} [delete]
+func (ctrl *UserManageController) RevokeUserSession(c *gin.Context) {
+	var req schema.UserSessionManageRevokeRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, err.Error())
+		return
+	}
+	req.OperatorID = operatorID
+
+	userManageService, err := do.Invoke[service.IUserManageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 校验操作权限
+	if err = userManageService.CheckUserOperable(c.Request.Context(), req.OperatorID, req.UserID); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	sessionService, err := do.Invoke[service.IUserSessionService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	if err = sessionService.RevokeSession(c.Request.Context(), req.UserID, req.SessionID); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// RevokeUserSessions 注销用户全部会话
+// @Summary 注销用户全部会话
+// @Description 使指定用户的所有会话Token失效，不影响账户状态
+// @Tags [管理员]用户管理
+// @Accept json
+// @Produce json
+// @Param id path int true "用户ID" example("1")
+// @Success 200 {object} response.Data{data=schema.UserSessionRevokeResponse} "注销成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 500 {object} response.Data "服务器错误"
+// @Router /manage/users/{id}/sessions [delete]
+func (ctrl *UserManageController) RevokeUserSessions(c *gin.Context) {
+	var req schema.UserSessionManageRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, err.Error())
+		return
+	}
+
+	// 获取操作者ID
+	operatorID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, err.Error())
+		return
+	}
+	req.OperatorID = operatorID
+
+	userManageService, err := do.Invoke[service.IUserManageService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	// 校验操作权限
+	if err = userManageService.CheckUserOperable(c.Request.Context(), req.OperatorID, req.UserID); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	sessionService, err := do.Invoke[service.IUserSessionService](ctrl.injector)
+	if err != nil {
+		response.ResError(c, response.CodeServerBusy)
+		return
+	}
+
+	result, err := sessionService.RevokeAllSessions(c.Request.Context(), req.UserID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
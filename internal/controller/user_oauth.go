@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// UserOAuthController 第三方账号绑定控制器
+type UserOAuthController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewUserOAuthController 创建第三方账号绑定控制器实例
+func NewUserOAuthController(injector *do.Injector) *UserOAuthController {
+	return &UserOAuthController{
+		injector: injector,
+	}
+}
+
+// UserOAuthRouter 第三方账号绑定相关路由注册
+func (ctrl *UserOAuthController) UserOAuthRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取第三方账号绑定列表
+	router.GET("/list", ctrl.GetBindings)
+	// 获取绑定授权地址
+	router.GET("/:provider/authorize", ctrl.GetBindURL)
+	// 绑定第三方账号
+	router.POST("/:provider/bind", ctrl.BindAccount)
+	// 解绑第三方账号
+	router.DELETE("/:provider", ctrl.UnbindAccount)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *UserOAuthController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetBindings 获取第三方账号绑定列表
+// @Summary 获取第三方账号绑定列表
+// @Description 获取当前用户已绑定的第三方账号，以及是否可使用邮箱密码登录
+// @Tags [用户]第三方账号
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.UserOAuthBindingListResponse} "获取成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/oauth/list [get]
+// @Security Bearer
+func (ctrl *UserOAuthController) GetBindings(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 获取第三方登录服务
+	oauthLoginService := do.MustInvoke[service.IOAuthLoginService](ctrl.injector)
+
+	result, err := oauthLoginService.GetBindings(c.Request.Context(), userID)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "获取绑定列表失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// GetBindURL 获取绑定授权地址
+// @Summary 获取绑定授权地址
+// @Description 生成绑定第三方账号的授权地址，state与当前用户关联
+// @Tags [用户]第三方账号
+// @Accept json
+// @Produce json
+// @Param provider path string true "第三方提供商" Enums(QQ, GitHub, Apple, Google, Telegram)
+// @Success 200 {object} response.Data{data=schema.OAuthAuthorizeResponse} "获取成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/oauth/{provider}/authorize [get]
+// @Security Bearer
+func (ctrl *UserOAuthController) GetBindURL(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.OAuthAuthorizeRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取第三方登录服务
+	oauthLoginService := do.MustInvoke[service.IOAuthLoginService](ctrl.injector)
+
+	result, err := oauthLoginService.GetBindURL(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "获取授权地址失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// BindAccount 绑定第三方账号
+// @Summary 绑定第三方账号
+// @Description 使用授权回调中的code和state绑定第三方账号
+// @Tags [用户]第三方账号
+// @Accept json
+// @Produce json
+// @Param provider path string true "第三方提供商" Enums(QQ, GitHub, Apple, Google, Telegram)
+// @Param request body schema.UserOAuthBindRequest true "绑定请求"
+// @Success 200 {object} response.Data{data=schema.UserOAuthBindingItem} "绑定成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/oauth/{provider}/bind [post]
+// @Security Bearer
+func (ctrl *UserOAuthController) BindAccount(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.UserOAuthBindRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+	req.Provider = c.Param("provider")
+
+	// 获取第三方登录服务
+	oauthLoginService := do.MustInvoke[service.IOAuthLoginService](ctrl.injector)
+
+	result, err := oauthLoginService.BindAccount(c.Request.Context(), userID, req)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "绑定第三方账号失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// UnbindAccount 解绑第三方账号
+// @Summary 解绑第三方账号
+// @Description 解绑指定平台的第三方账号，不允许解绑最后一种登录方式
+// @Tags [用户]第三方账号
+// @Accept json
+// @Produce json
+// @Param provider path string true "第三方提供商" Enums(QQ, GitHub, Apple, Google, Telegram)
+// @Success 200 {object} response.Data "解绑成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/oauth/{provider} [delete]
+// @Security Bearer
+func (ctrl *UserOAuthController) UnbindAccount(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.UserOAuthUnbindRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取第三方登录服务
+	oauthLoginService := do.MustInvoke[service.IOAuthLoginService](ctrl.injector)
+
+	if err = oauthLoginService.UnbindAccount(c.Request.Context(), userID, req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "解绑第三方账号失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
@@ -324,7 +324,7 @@ func (ctrl *UserProfileController) UpdatePassword(c *gin.Context) {
 
 // UpdateAvatar 修改头像
 // @Summary 修改头像
-// @Description 修改当前登录用户的头像
+// @Description 修改当前登录用户的头像；传入图片附件ID时服务端异步裁剪为48/96/256像素，完成后头像地址更新为256像素版本，其余尺寸仅文件名后缀不同
 // @Tags [用户]个人中心
 // @Accept json
 // @Produce json
@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/do"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// UserSessionController 登录会话控制器
+type UserSessionController struct {
+	// 注入器实例，用于获取服务
+	injector *do.Injector
+}
+
+// NewUserSessionController 创建登录会话控制器实例
+func NewUserSessionController(injector *do.Injector) *UserSessionController {
+	return &UserSessionController{
+		injector: injector,
+	}
+}
+
+// UserSessionRouter 登录会话相关路由注册
+func (ctrl *UserSessionController) UserSessionRouter(router *gin.RouterGroup) {
+	router.Use(saGin.CheckRole(user.RoleUser.String()))
+
+	// 获取会话列表
+	router.GET("/list", ctrl.GetSessions)
+	// 注销其他会话
+	router.POST("/revoke-others", ctrl.RevokeOtherSessions)
+	// 注销指定会话
+	router.DELETE("/:session_id", ctrl.RevokeSession)
+}
+
+// getUserID 从Header中获取token并解析用户ID
+func (ctrl *UserSessionController) getUserID(c *gin.Context) (int, error) {
+	// 从Header中获取token
+	token := c.GetHeader("Authorization")
+	if token == "" {
+		return 0, fmt.Errorf("未找到Authorization header")
+	}
+
+	// 使用stputil获取登录用户ID
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		return 0, err
+	}
+
+	// String转Int
+	sID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return 0, err
+	}
+
+	return sID, nil
+}
+
+// GetSessions 获取登录会话列表
+// @Summary 获取登录会话列表
+// @Description 获取当前用户所有有效的登录会话，包含设备、IP、归属地与最后活跃时间
+// @Tags [用户]登录会话
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=[]schema.UserSessionItem} "获取成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/sessions/list [get]
+// @Security Bearer
+func (ctrl *UserSessionController) GetSessions(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 获取会话服务
+	sessionService := do.MustInvoke[service.IUserSessionService](ctrl.injector)
+
+	result, err := sessionService.GetSessions(c.Request.Context(), userID, c.GetHeader("Authorization"))
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "获取会话列表失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
+
+// RevokeSession 注销指定会话
+// @Summary 注销指定会话
+// @Description 使当前用户的指定会话Token失效
+// @Tags [用户]登录会话
+// @Accept json
+// @Produce json
+// @Param session_id path string true "会话ID"
+// @Success 200 {object} response.Data "注销成功"
+// @Failure 400 {object} response.Data "请求参数错误"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/sessions/{session_id} [delete]
+// @Security Bearer
+func (ctrl *UserSessionController) RevokeSession(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 解析请求参数
+	var req schema.UserSessionRevokeRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ResErrorWithMsg(c, response.CodeInvalidParam, "请求参数错误", err.Error())
+		return
+	}
+
+	// 获取会话服务
+	sessionService := do.MustInvoke[service.IUserSessionService](ctrl.injector)
+
+	if err = sessionService.RevokeSession(c.Request.Context(), userID, req.SessionID); err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "注销会话失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, nil)
+}
+
+// RevokeOtherSessions 注销其他会话
+// @Summary 注销其他会话
+// @Description 保留当前会话，注销当前用户的其他所有会话
+// @Tags [用户]登录会话
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Data{data=schema.UserSessionRevokeResponse} "注销成功"
+// @Failure 401 {object} response.Data "未授权"
+// @Failure 500 {object} response.Data "服务器内部错误"
+// @Router /profile/sessions/revoke-others [post]
+// @Security Bearer
+func (ctrl *UserSessionController) RevokeOtherSessions(c *gin.Context) {
+	// 获取当前用户ID
+	userID, err := ctrl.getUserID(c)
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeNeedLogin, "获取用户信息失败", err.Error())
+		return
+	}
+
+	// 获取会话服务
+	sessionService := do.MustInvoke[service.IUserSessionService](ctrl.injector)
+
+	result, err := sessionService.RevokeOtherSessions(c.Request.Context(), userID, c.GetHeader("Authorization"))
+	if err != nil {
+		response.ResErrorWithMsg(c, response.CodeGenericError, "注销其他会话失败", err.Error())
+		return
+	}
+
+	response.ResSuccess(c, result)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/PokeForum/PokeForum/ent"
 	"github.com/PokeForum/PokeForum/ent/migrate"
 	"github.com/PokeForum/PokeForum/internal/configs"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
 
 	_ "github.com/lib/pq"
 )
@@ -71,4 +72,36 @@ func AutoMigrate(client *ent.Client) {
 	); err != nil {
 		configs.Log.Fatal("failed creating schema resources: ", zap.Error(err))
 	}
+
+	// 全文检索列与索引
+	pgDB := PgDB()
+	defer func() {
+		if err := pgDB.Close(); err != nil {
+			configs.Log.Warn(err.Error())
+		}
+	}()
+	migrateSearchIndex(pgDB)
+}
+
+// searchIndexStatements 全文检索列与GIN索引
+// ent 不支持生成列，使用原生SQL维护，语句均可重复执行
+var searchIndexStatements = []string{
+	fmt.Sprintf(`ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (
+			setweight(to_tsvector('%[1]s', coalesce(title, '')), 'A') ||
+			setweight(to_tsvector('%[1]s', coalesce(content, '')), 'B')
+		) STORED`, _const.SearchTextConfig),
+	`CREATE INDEX IF NOT EXISTS posts_search_vector_idx ON posts USING GIN (search_vector)`,
+	fmt.Sprintf(`ALTER TABLE comments ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (to_tsvector('%s', coalesce(content, ''))) STORED`, _const.SearchTextConfig),
+	`CREATE INDEX IF NOT EXISTS comments_search_vector_idx ON comments USING GIN (search_vector)`,
+}
+
+// migrateSearchIndex 创建全文检索所需的tsvector列与GIN索引
+func migrateSearchIndex(db *sql.DB) {
+	for _, stmt := range searchIndexStatements {
+		if _, err := db.ExecContext(context.Background(), stmt); err != nil {
+			configs.Log.Fatal("failed creating search index: ", zap.Error(err))
+		}
+	}
 }
@@ -1,10 +1,13 @@
 package initializer
 
 import (
+	"database/sql"
+
 	"github.com/samber/do"
 
 	"github.com/PokeForum/PokeForum/internal/configs"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/storage"
 	"github.com/PokeForum/PokeForum/internal/service"
 )
 
@@ -40,7 +43,11 @@ func InjectorSrv(injector *do.Injector) {
 		if err != nil {
 			return nil, err
 		}
-		return service.NewUserManageService(configs.DB, cacheService, configs.Log), nil
+		notificationTask, err := do.Invoke[*service.NotificationTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewUserManageService(configs.DB, cacheService, notificationTask, configs.Log), nil
 	})
 	// 注册 CategoryManageService
 	do.Provide(injector, func(i *do.Injector) (service.ICategoryManageService, error) {
@@ -88,7 +95,15 @@ func InjectorSrv(injector *do.Injector) {
 		if err != nil {
 			return nil, err
 		}
-		return service.NewModeratorService(configs.DB, cacheService, configs.Log), nil
+		notificationTask, err := do.Invoke[*service.NotificationTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		badgeTask, err := do.Invoke[*service.BadgeTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewModeratorService(configs.DB, cacheService, notificationTask, badgeTask, configs.Log), nil
 	})
 	// 注册 PostService
 	do.Provide(injector, func(i *do.Injector) (service.IPostService, error) {
@@ -96,7 +111,23 @@ func InjectorSrv(injector *do.Injector) {
 		if err != nil {
 			return nil, err
 		}
-		return service.NewPostService(configs.DB, cacheService, configs.Log), nil
+		store, err := do.Invoke[storage.IStorage](injector)
+		if err != nil {
+			return nil, err
+		}
+		mentionTask, err := do.Invoke[*service.MentionTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		notificationTask, err := do.Invoke[*service.NotificationTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		badgeTask, err := do.Invoke[*service.BadgeTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewPostService(configs.DB, cacheService, store, mentionTask, notificationTask, badgeTask, configs.Log), nil
 	})
 	// 注册 CommentService
 	do.Provide(injector, func(i *do.Injector) (service.ICommentService, error) {
@@ -104,7 +135,19 @@ func InjectorSrv(injector *do.Injector) {
 		if err != nil {
 			return nil, err
 		}
-		return service.NewCommentService(configs.DB, cacheService, configs.Log), nil
+		store, err := do.Invoke[storage.IStorage](injector)
+		if err != nil {
+			return nil, err
+		}
+		mentionTask, err := do.Invoke[*service.MentionTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		notificationTask, err := do.Invoke[*service.NotificationTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewCommentService(configs.DB, cacheService, store, mentionTask, notificationTask, configs.Log), nil
 	})
 	// 注册 UserProfileService
 	do.Provide(injector, func(i *do.Injector) (service.IUserProfileService, error) {
@@ -120,7 +163,15 @@ func InjectorSrv(injector *do.Injector) {
 		if err != nil {
 			return nil, err
 		}
-		return service.NewUserProfileService(configs.DB, cacheService, configs.Log, settingsService, userManageService), nil
+		store, err := do.Invoke[storage.IStorage](injector)
+		if err != nil {
+			return nil, err
+		}
+		imageTask, err := do.Invoke[*service.ImageProcessTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewUserProfileService(configs.DB, cacheService, configs.Log, settingsService, userManageService, store, imageTask), nil
 	})
 	// 注册 RankingService
 	do.Provide(injector, func(i *do.Injector) (service.IRankingService, error) {
@@ -138,19 +189,131 @@ func InjectorSrv(injector *do.Injector) {
 		}
 		return service.NewOAuthProviderService(configs.DB, cacheService, configs.Log), nil
 	})
+	// 注册 OAuthLoginService
+	do.Provide(injector, func(i *do.Injector) (service.IOAuthLoginService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		settingsService, err := do.Invoke[service.ISettingsService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewOAuthLoginService(configs.DB, cacheService, configs.Log, settingsService), nil
+	})
+	// 注册 WebAuthnService
+	do.Provide(injector, func(i *do.Injector) (service.IWebAuthnService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewWebAuthnService(configs.DB, cacheService, configs.Log), nil
+	})
+	// 注册 UserSessionService
+	do.Provide(injector, func(i *do.Injector) (service.IUserSessionService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewUserSessionService(cacheService, configs.Log), nil
+	})
+	// 注册 TwoFactorService
+	do.Provide(injector, func(i *do.Injector) (service.ITwoFactorService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		settingsService, err := do.Invoke[service.ISettingsService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewTwoFactorService(configs.DB, cacheService, configs.Log, settingsService), nil
+	})
+	// 注册 ReportService
+	do.Provide(injector, func(i *do.Injector) (service.IReportService, error) {
+		moderatorService, err := do.Invoke[service.IModeratorService](injector)
+		if err != nil {
+			return nil, err
+		}
+		postManageService, err := do.Invoke[service.IPostManageService](injector)
+		if err != nil {
+			return nil, err
+		}
+		commentManageService, err := do.Invoke[service.ICommentManageService](injector)
+		if err != nil {
+			return nil, err
+		}
+		userManageService, err := do.Invoke[service.IUserManageService](injector)
+		if err != nil {
+			return nil, err
+		}
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewReportService(configs.DB, cacheService, configs.Log, moderatorService, postManageService, commentManageService, userManageService), nil
+	})
+	// 注册 InviteCodeService
+	do.Provide(injector, func(i *do.Injector) (service.IInviteCodeService, error) {
+		settingsService, err := do.Invoke[service.ISettingsService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewInviteCodeService(configs.DB, configs.Log, settingsService), nil
+	})
 
 	// 注册 RedisLock
 	do.Provide(injector, func(i *do.Injector) (*cache.RedisLock, error) {
 		return cache.NewRedisLock(configs.Cache, configs.Log), nil
 	})
 
-	// TaskManager 和 SigninAsyncTask 在 server.go 中通过 do.ProvideValue 注入
+	// TaskManager、SigninAsyncTask、ImageProcessTask、MentionTask、NotificationTask、BadgeTask 与 Storage 在 server.go 中通过 do.ProvideValue 注入
 
 	// 注册 BlacklistService
 	do.Provide(injector, func(i *do.Injector) (service.IBlacklistService, error) {
 		return service.NewBlacklistService(configs.DB, configs.Log), nil
 	})
 
+	// 注册 MentionService
+	do.Provide(injector, func(i *do.Injector) (service.IMentionService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewMentionService(configs.DB, cacheService, configs.Log), nil
+	})
+
+	// 注册 NotificationService
+	do.Provide(injector, func(i *do.Injector) (service.INotificationService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		notificationTask, err := do.Invoke[*service.NotificationTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewNotificationService(configs.DB, cacheService, notificationTask, configs.Log), nil
+	})
+
+	// 注册 FollowService
+	do.Provide(injector, func(i *do.Injector) (service.IFollowService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewFollowService(configs.DB, cacheService, configs.Log), nil
+	})
+
+	// 注册 MessageService
+	do.Provide(injector, func(i *do.Injector) (service.IMessageService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewMessageService(configs.DB, cacheService, configs.Log), nil
+	})
+
 	// 注册 SigninService
 	do.Provide(injector, func(i *do.Injector) (service.ISigninService, error) {
 		cacheService, err := do.Invoke[cache.ICacheService](injector)
@@ -169,12 +332,91 @@ func InjectorSrv(injector *do.Injector) {
 		if err != nil {
 			return nil, err
 		}
-		return service.NewSigninService(configs.DB, cacheService, redisLock, configs.Log, settingsService, asyncTask), nil
+		badgeTask, err := do.Invoke[*service.BadgeTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewSigninService(configs.DB, cacheService, redisLock, configs.Log, settingsService, asyncTask, badgeTask), nil
+	})
+
+	// 注册原生 PostgreSQL 连接，供性能监控与全文搜索共用
+	do.Provide(injector, func(i *do.Injector) (*sql.DB, error) {
+		return PgDB(), nil
 	})
 
 	// 注册 PerformanceService
 	do.Provide(injector, func(i *do.Injector) (service.IPerformanceService, error) {
-		pgDB := PgDB()
+		pgDB, err := do.Invoke[*sql.DB](injector)
+		if err != nil {
+			return nil, err
+		}
 		return service.NewPerformanceService(pgDB, configs.Cache, configs.Log), nil
 	})
+
+	// 注册 RealtimeService
+	do.Provide(injector, func(i *do.Injector) (service.IRealtimeService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewRealtimeService(configs.DB, cacheService, configs.Cache, configs.Log), nil
+	})
+
+	// 注册 SearchService
+	do.Provide(injector, func(i *do.Injector) (service.ISearchService, error) {
+		pgDB, err := do.Invoke[*sql.DB](injector)
+		if err != nil {
+			return nil, err
+		}
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewSearchService(pgDB, configs.DB, cacheService, configs.Log), nil
+	})
+
+	// 注册 TagService
+	do.Provide(injector, func(i *do.Injector) (service.ITagService, error) {
+		return service.NewTagService(configs.DB, configs.Log), nil
+	})
+
+	// 注册 UserGroupService
+	do.Provide(injector, func(i *do.Injector) (service.IUserGroupService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewUserGroupService(configs.DB, cacheService, configs.Log), nil
+	})
+
+	// 注册 BadgeService
+	do.Provide(injector, func(i *do.Injector) (service.IBadgeService, error) {
+		return service.NewBadgeService(configs.DB, configs.Log), nil
+	})
+
+	// 注册 PostRevisionService
+	do.Provide(injector, func(i *do.Injector) (service.IPostRevisionService, error) {
+		cacheService, err := do.Invoke[cache.ICacheService](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewPostRevisionService(configs.DB, cacheService, configs.Log), nil
+	})
+
+	// 注册 AttachmentService
+	do.Provide(injector, func(i *do.Injector) (service.IAttachmentService, error) {
+		store, err := do.Invoke[storage.IStorage](injector)
+		if err != nil {
+			return nil, err
+		}
+		settingsService, err := do.Invoke[service.ISettingsService](injector)
+		if err != nil {
+			return nil, err
+		}
+		imageTask, err := do.Invoke[*service.ImageProcessTask](injector)
+		if err != nil {
+			return nil, err
+		}
+		return service.NewAttachmentService(configs.DB, store, settingsService, imageTask, configs.Log), nil
+	})
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/PokeForum/PokeForum/internal/controller"
 	"github.com/PokeForum/PokeForum/internal/middleware"
 	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
+	"github.com/PokeForum/PokeForum/internal/service"
 )
 
 func Routers(injector *do.Injector) *gin.Engine {
@@ -62,6 +63,9 @@ func Routers(injector *do.Injector) *gin.Engine {
 
 	api := Router.Group("/api/v1")
 
+	// 刷新登录会话活跃时间
+	api.Use(middleware.SessionActivity(do.MustInvoke[service.IUserSessionService](injector)))
+
 	// 认证校验（添加更严格的速率限制，防止暴力破解）
 	AuthGroup := api.Group("/auth")
 	AuthGroup.Use(middleware.RateLimit(middleware.AuthRateLimitConfig))
@@ -93,19 +97,72 @@ func Routers(injector *do.Injector) *gin.Engine {
 				BlacklistCon := controller.NewBlacklistController(injector)
 				BlacklistCon.BlacklistRouter(BlacklistGroup)
 
-				// TODO 举报
-
-				/*
-					- 邀请码注册
-					- 开关配置是否开启邀请码机制
-					- 用户可创建邀请码数量（有限/无限）
-					- 邀请码使用次数（有限/无限）
-				*/
-				// TODO 邀请码
+				// 第三方账号绑定
+				UserOAuthGroup := ForumGroup.Group("/profile/oauth")
+				UserOAuthCon := controller.NewUserOAuthController(injector)
+				UserOAuthCon.UserOAuthRouter(UserOAuthGroup)
+
+				// 通行密钥
+				PasskeyGroup := ForumGroup.Group("/profile/passkeys")
+				PasskeyCon := controller.NewPasskeyController(injector)
+				PasskeyCon.PasskeyRouter(PasskeyGroup)
+
+				// 两步验证
+				TwoFactorGroup := ForumGroup.Group("/profile/2fa")
+				TwoFactorCon := controller.NewTwoFactorController(injector)
+				TwoFactorCon.TwoFactorRouter(TwoFactorGroup)
+
+				// 登录会话
+				SessionGroup := ForumGroup.Group("/profile/sessions")
+				SessionCon := controller.NewUserSessionController(injector)
+				SessionCon.UserSessionRouter(SessionGroup)
+
+				// 举报
+				ReportGroup := ForumGroup.Group("/profile/reports")
+				ReportCon := controller.NewReportController(injector)
+				ReportCon.ReportRouter(ReportGroup)
+
+				// 邀请码
+				InviteCodeGroup := ForumGroup.Group("/profile/invite-codes")
+				InviteCodeCon := controller.NewInviteCodeController(injector)
+				InviteCodeCon.InviteCodeRouter(InviteCodeGroup)
+
+				// 提及
+				MentionGroup := ForumGroup.Group("/profile/mentions")
+				MentionCon := controller.NewMentionController(injector)
+				MentionCon.MentionRouter(MentionGroup)
+
+				// 站内通知
+				NotificationGroup := ForumGroup.Group("/profile/notifications")
+				NotificationCon := controller.NewNotificationController(injector)
+				NotificationCon.NotificationRouter(NotificationGroup)
+
+				// 私信
+				MessageGroup := ForumGroup.Group("/profile/messages")
+				MessageCon := controller.NewMessageController(injector)
+				MessageCon.MessageRouter(MessageGroup)
+
+				// 关注
+				FollowGroup := ForumGroup.Group("/profile/follows")
+				FollowCon := controller.NewFollowController(injector)
+				FollowCon.FollowRouter(FollowGroup)
+
+				// 徽章
+				BadgeGroup := ForumGroup.Group("/profile/badges")
+				BadgeCon := controller.NewBadgeController(injector)
+				BadgeCon.BadgeRouter(BadgeGroup)
 			}
 
-			// TODO 发现
+			// 实时推送
+			RealtimeGroup := ForumGroup.Group("/realtime")
+			RealtimeCon := controller.NewRealtimeController(injector)
+			RealtimeCon.RealtimeRouter(RealtimeGroup)
+
+			// 发现
 			{
+				FeedGroup := ForumGroup.Group("/feed")
+				FeedCon := controller.NewFeedController(injector)
+				FeedCon.FeedRouter(FeedGroup)
 			}
 
 			// 排行榜
@@ -113,6 +170,17 @@ func Routers(injector *do.Injector) *gin.Engine {
 			RankingCon := controller.NewRankingController(injector)
 			RankingCon.RankingRouter(RankingGroup)
 
+			// 搜索
+			SearchGroup := ForumGroup.Group("/search")
+			SearchGroup.Use(middleware.RateLimit(middleware.SearchRateLimitConfig))
+			SearchCon := controller.NewSearchController(injector)
+			SearchCon.SearchRouter(SearchGroup)
+
+			// 标签
+			TagGroup := ForumGroup.Group("/tags")
+			TagCon := controller.NewTagController(injector)
+			TagCon.TagRouter(TagGroup)
+
 			// 版块
 			CategoryGroup := ForumGroup.Group("/categories")
 			CategoryCon := controller.NewCategoryController(injector)
@@ -123,11 +191,21 @@ func Routers(injector *do.Injector) *gin.Engine {
 			PostCon := controller.NewPostController(injector)
 			PostCon.PostRouter(PostGroup)
 
+			// 主题帖修订历史
+			PostRevisionGroup := ForumGroup.Group("/posts/revisions")
+			PostRevisionCon := controller.NewPostRevisionController(injector)
+			PostRevisionCon.PostRevisionRouter(PostRevisionGroup)
+
 			// 评论
 			CommentGroup := ForumGroup.Group("/comments")
 			CommentCon := controller.NewCommentController(injector)
 			CommentCon.CommentRouter(CommentGroup)
 
+			// 附件
+			AttachmentGroup := ForumGroup.Group("/attachments")
+			AttachmentCon := controller.NewAttachmentController(injector)
+			AttachmentCon.AttachmentRouter(AttachmentGroup)
+
 			// 签到系统
 			SigninGroup := ForumGroup.Group("/signin")
 			SigninCon := controller.NewSigninController(injector)
@@ -166,6 +244,20 @@ func Routers(injector *do.Injector) *gin.Engine {
 			CategoryManageCon.CategoryManageRouter(CategoryManageGroup)
 		}
 
+		// 用户组管理
+		{
+			UserGroupManageGroup := ManageGroup.Group("/user-groups")
+			UserGroupManageCon := controller.NewUserGroupManageController(injector)
+			UserGroupManageCon.UserGroupManageRouter(UserGroupManageGroup)
+		}
+
+		// 徽章管理
+		{
+			BadgeManageGroup := ManageGroup.Group("/badges")
+			BadgeManageCon := controller.NewBadgeManageController(injector)
+			BadgeManageCon.BadgeManageRouter(BadgeManageGroup)
+		}
+
 		// 帖子管理
 		{
 			PostManageGroup := ManageGroup.Group("/posts")
@@ -173,6 +265,13 @@ func Routers(injector *do.Injector) *gin.Engine {
 			PostManageCon.PostManageRouter(PostManageGroup)
 		}
 
+		// 标签管理
+		{
+			TagManageGroup := ManageGroup.Group("/tags")
+			TagManageCon := controller.NewTagManageController(injector)
+			TagManageCon.TagManageRouter(TagManageGroup)
+		}
+
 		// 评论管理
 		{
 			CommentManageGroup := ManageGroup.Group("/comments")
@@ -180,7 +279,19 @@ func Routers(injector *do.Injector) *gin.Engine {
 			CommentManageCon.CommentManageRouter(CommentManageGroup)
 		}
 
-		// TODO 举报管理
+		// 举报管理
+		{
+			ReportManageGroup := ManageGroup.Group("/reports")
+			ReportManageCon := controller.NewReportManageController(injector)
+			ReportManageCon.ReportManageRouter(ReportManageGroup)
+		}
+
+		// 通知管理
+		{
+			NotificationManageGroup := ManageGroup.Group("/notifications")
+			NotificationManageCon := controller.NewNotificationManageController(injector)
+			NotificationManageCon.NotificationManageRouter(NotificationManageGroup)
+		}
 	}
 
 	// 超级管理接口
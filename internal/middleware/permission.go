@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"strconv"
+
+	"github.com/click33/sa-token-go/stputil"
+	"github.com/gin-gonic/gin"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/response"
+	"github.com/PokeForum/PokeForum/internal/service"
+)
+
+// CheckPermission 权限校验中间件，拥有任一指定权限即可通过
+// 权限在每次请求时由身份与未过期的用户组按需计算并缓存，用户组或成员资格变更后无需重新登录即可生效
+func CheckPermission(userGroupService service.IUserGroupService, perms ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader("Authorization")
+		if token == "" || !stputil.IsLogin(token) {
+			response.ResError(c, response.CodeNeedLogin)
+			c.Abort()
+			return
+		}
+		loginID, err := stputil.GetLoginID(token)
+		if err != nil {
+			response.ResError(c, response.CodeNeedLogin)
+			c.Abort()
+			return
+		}
+		userID, err := strconv.Atoi(loginID)
+		if err != nil {
+			response.ResError(c, response.CodeNeedLogin)
+			c.Abort()
+			return
+		}
+
+		allowed, err := userGroupService.HasPermission(c.Request.Context(), userID, perms...)
+		if err != nil {
+			response.ResError(c, response.CodeServerBusy)
+			c.Abort()
+			return
+		}
+		if !allowed {
+			response.ResErrorWithMsg(c, response.CodeGenericError, "您没有执行该操作的权限")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
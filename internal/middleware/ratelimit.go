@@ -45,6 +45,13 @@ var AuthRateLimitConfig = RateLimitConfig{
 	KeyPrefix:   "ratelimit:auth",
 }
 
+// SearchRateLimitConfig 搜索接口限流配置：每分钟30次（全文检索开销较大）
+var SearchRateLimitConfig = RateLimitConfig{
+	WindowSize:  60,
+	MaxRequests: 30,
+	KeyPrefix:   "ratelimit:search",
+}
+
 // RateLimit 基于Redis的滑动窗口速率限制中间件
 func RateLimit(config RateLimitConfig) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/internal/configs"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/service"
+	"github.com/PokeForum/PokeForum/internal/utils"
+)
+
+// SessionActivity 登录会话活跃度中间件
+// 请求处理完成后刷新会话的最后活跃时间、IP与归属地，刷新失败不影响请求
+func SessionActivity(sessionService service.IUserSessionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		token := c.GetHeader("Authorization")
+		if token == "" {
+			return
+		}
+
+		country, city := utils.TrustedGeoHeaders(c.RemoteIP(), configs.Config.Proxy.TrustedProxies, c.Request.Header)
+		if err := sessionService.TouchSession(c.Request.Context(), token, c.ClientIP(), country, city); err != nil {
+			configs.Log.Debug("刷新会话活跃时间失败",
+				zap.String("trace_id", tracing.GetTraceID(c.Request.Context())),
+				zap.Error(err))
+		}
+	}
+}
@@ -7,6 +7,27 @@ const (
 
 	// TypeStatsSync 统计数据同步任务
 	TypeStatsSync = "stats:sync"
+
+	// TypeImageAttachment 附件图片处理任务
+	TypeImageAttachment = "image:attachment"
+
+	// TypeImageAvatar 头像裁剪任务
+	TypeImageAvatar = "image:avatar"
+
+	// TypeMentionEmail 提及邮件提醒任务
+	TypeMentionEmail = "mention:email"
+
+	// TypeNotificationCreate 站内通知创建任务
+	TypeNotificationCreate = "notification:create"
+
+	// TypePostHotRefresh 帖子热度榜刷新任务
+	TypePostHotRefresh = "post:hot_refresh"
+
+	// TypeUserGroupExpire 用户组成员过期清理任务
+	TypeUserGroupExpire = "user_group:expire"
+
+	// TypeBadgeEvaluate 徽章评估任务
+	TypeBadgeEvaluate = "badge:evaluate"
 )
 
 // 队列名称常量
@@ -186,6 +186,34 @@ type ICacheService interface {
 	// 返回: 排名（0开始）和错误信息，如果成员不存在返回-1
 	ZRevRank(ctx context.Context, key string, member string) (int64, error)
 
+	// ZCard 获取有序集合成员数量
+	// ctx: 上下文
+	// key: 有序集合键名
+	// 返回: 成员数量和错误信息
+	ZCard(ctx context.Context, key string) (int64, error)
+
+	// ZRem 从有序集合移除成员
+	// ctx: 上下文
+	// key: 有序集合键名
+	// members: 要移除的成员
+	// 返回: 错误信息
+	ZRem(ctx context.Context, key string, members ...string) error
+
+	// ZRemRangeByRank 按排名（从低到高，0开始）移除有序集合成员，支持负数索引
+	// ctx: 上下文
+	// key: 有序集合键名
+	// start: 起始排名
+	// stop: 结束排名
+	// 返回: 错误信息
+	ZRemRangeByRank(ctx context.Context, key string, start, stop int64) error
+
+	// Rename 重命名键，目标键已存在时会被覆盖
+	// ctx: 上下文
+	// key: 原键名
+	// newKey: 新键名
+	// 返回: 错误信息
+	Rename(ctx context.Context, key, newKey string) error
+
 	// XAdd 向Stream添加消息
 	// ctx: 上下文
 	// stream: Stream键名
@@ -238,4 +266,27 @@ type ICacheService interface {
 	// stream: Stream键名
 	// 返回: Stream长度和错误信息
 	XLen(ctx context.Context, stream string) (int64, error)
+
+	// XAddWithMaxLen 向Stream添加消息并近似裁剪到指定长度
+	// ctx: 上下文
+	// stream: Stream键名
+	// maxLen: 保留的最大消息数量
+	// values: 消息字段和值的映射
+	// 返回: 消息ID和错误信息
+	XAddWithMaxLen(ctx context.Context, stream string, maxLen int64, values map[string]interface{}) (string, error)
+
+	// XRange 按ID范围读取Stream消息
+	// ctx: 上下文
+	// stream: Stream键名
+	// start: 起始ID（"-"表示最小，"(id"表示不包含该ID）
+	// stop: 结束ID（"+"表示最大）
+	// 返回: 消息列表（包含message_id字段）和错误信息
+	XRange(ctx context.Context, stream, start, stop string) ([]map[string]interface{}, error)
+
+	// Publish 向频道发布消息
+	// ctx: 上下文
+	// channel: 频道名
+	// message: 消息内容
+	// 返回: 接收到消息的订阅者数量和错误信息
+	Publish(ctx context.Context, channel string, message interface{}) (int64, error)
 }
@@ -319,6 +319,50 @@ func (r *RedisCacheService) ZRevRank(ctx context.Context, key string, member str
 	return rank, nil
 }
 
+// ZCard 获取有序集合成员数量
+func (r *RedisCacheService) ZCard(ctx context.Context, key string) (int64, error) {
+	count, err := r.client.ZCard(ctx, key).Result()
+	if err != nil {
+		r.logger.Error("获取有序集合成员数量失败", zap.String("key", key), zap.Error(err))
+		return 0, fmt.Errorf("获取有序集合成员数量失败: %w", err)
+	}
+	return count, nil
+}
+
+// ZRem 从有序集合移除成员
+func (r *RedisCacheService) ZRem(ctx context.Context, key string, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	args := make([]interface{}, len(members))
+	for i, m := range members {
+		args[i] = m
+	}
+	if err := r.client.ZRem(ctx, key, args...).Err(); err != nil {
+		r.logger.Error("从有序集合移除成员失败", zap.String("key", key), zap.Error(err))
+		return fmt.Errorf("从有序集合移除成员失败: %w", err)
+	}
+	return nil
+}
+
+// ZRemRangeByRank 按排名移除有序集合成员
+func (r *RedisCacheService) ZRemRangeByRank(ctx context.Context, key string, start, stop int64) error {
+	if err := r.client.ZRemRangeByRank(ctx, key, start, stop).Err(); err != nil {
+		r.logger.Error("按排名移除有序集合成员失败", zap.String("key", key), zap.Error(err))
+		return fmt.Errorf("按排名移除有序集合成员失败: %w", err)
+	}
+	return nil
+}
+
+// Rename 重命名键，目标键已存在时会被覆盖
+func (r *RedisCacheService) Rename(ctx context.Context, key, newKey string) error {
+	if err := r.client.Rename(ctx, key, newKey).Err(); err != nil {
+		r.logger.Error("重命名键失败", zap.String("key", key), zap.String("new_key", newKey), zap.Error(err))
+		return fmt.Errorf("重命名键失败: %w", err)
+	}
+	return nil
+}
+
 // XAdd 向Stream添加消息
 func (r *RedisCacheService) XAdd(ctx context.Context, stream string, values map[string]interface{}) (string, error) {
 	messageID, err := r.client.XAdd(ctx, &redis.XAddArgs{
@@ -456,3 +500,51 @@ func (r *RedisCacheService) XLen(ctx context.Context, stream string) (int64, err
 
 	return length, nil
 }
+
+// XAddWithMaxLen 向Stream添加消息并近似裁剪到指定长度
+func (r *RedisCacheService) XAddWithMaxLen(ctx context.Context, stream string, maxLen int64, values map[string]interface{}) (string, error) {
+	messageID, err := r.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: stream,
+		MaxLen: maxLen,
+		Approx: true,
+		Values: values,
+	}).Result()
+	if err != nil {
+		r.logger.Error("向Stream添加消息失败", zap.String("stream", stream), zap.Error(err))
+		return "", fmt.Errorf("向Stream添加消息失败: %w", err)
+	}
+
+	return messageID, nil
+}
+
+// XRange 按ID范围读取Stream消息
+func (r *RedisCacheService) XRange(ctx context.Context, stream, start, stop string) ([]map[string]interface{}, error) {
+	result, err := r.client.XRange(ctx, stream, start, stop).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		r.logger.Error("读取Stream消息失败", zap.String("stream", stream), zap.Error(err))
+		return nil, fmt.Errorf("读取Stream消息失败: %w", err)
+	}
+
+	messages := make([]map[string]interface{}, 0, len(result))
+	for _, message := range result {
+		fieldsInterface := make(map[string]interface{}, len(message.Values)+1)
+		for k, v := range message.Values {
+			fieldsInterface[k] = v
+		}
+		fieldsInterface["message_id"] = message.ID
+		messages = append(messages, fieldsInterface)
+	}
+
+	return messages, nil
+}
+
+// Publish 向频道发布消息
+func (r *RedisCacheService) Publish(ctx context.Context, channel string, message interface{}) (int64, error) {
+	count, err := r.client.Publish(ctx, channel, message).Result()
+	if err != nil {
+		r.logger.Error("发布消息失败", zap.String("channel", channel), zap.Error(err))
+		return 0, fmt.Errorf("发布消息失败: %w", err)
+	}
+
+	return count, nil
+}
@@ -19,8 +19,16 @@ type SettingsService interface {
 
 // TemplateData 邮件模板数据
 type TemplateData struct {
-	VerifyCode    string        // 验证码
-	CommonContext CommonContext // 通用上下文
+	VerifyCode    string         // 验证码
+	Mention       MentionContext // 提及信息
+	CommonContext CommonContext  // 通用上下文
+}
+
+// MentionContext 提及提醒邮件上下文
+type MentionContext struct {
+	FromUsername string // 发起提及的用户名
+	PostTitle    string // 帖子标题
+	Excerpt      string // 提及所在正文摘要
 }
 
 // CommonContext 通用邮件上下文
@@ -112,6 +120,35 @@ func (et *Template) RenderPasswordResetTemplate(ctx context.Context, verifyCode
 	return et.renderTemplate(templateContent, data)
 }
 
+// RenderMentionTemplate 渲染提及提醒模板
+func (et *Template) RenderMentionTemplate(ctx context.Context, mention MentionContext, siteName string) (string, error) {
+	// 构建模板数据
+	data := TemplateData{
+		Mention: mention,
+		CommonContext: CommonContext{
+			SiteBasic: SiteBasic{
+				Name: siteName,
+			},
+		},
+	}
+
+	// 从数据库获取自定义模板
+	customTemplate, err := et.settingsService.GetSettingByKey(ctx, _const.EmailMentionTemplate, "")
+	if err != nil {
+		et.logger.Warn("获取自定义提及提醒邮件模板失败，使用默认模板", zap.Error(err))
+		customTemplate = ""
+	}
+
+	// 如果自定义模板为空，使用默认模板
+	templateContent := customTemplate
+	if strings.TrimSpace(templateContent) == "" {
+		templateContent = _const.DefaultEmailMentionTemplate
+	}
+
+	// 渲染模板
+	return et.renderTemplate(templateContent, data)
+}
+
 // renderTemplate 渲染模板内容
 func (et *Template) renderTemplate(templateContent string, data TemplateData) (string, error) {
 	// 创建模板实例
@@ -0,0 +1,97 @@
+package image_tools
+
+import "errors"
+
+const (
+	// gifExtensionIntroducer 扩展块起始标记
+	gifExtensionIntroducer = 0x21
+	// gifImageSeparator 图像描述符起始标记
+	gifImageSeparator = 0x2C
+	// gifTrailer 文件结束标记
+	gifTrailer = 0x3B
+)
+
+// errInvalidGIF GIF文件结构无效
+var errInvalidGIF = errors.New("GIF文件结构无效")
+
+// gifFrameLimit 根据画布尺寸计算允许解码的帧数
+// 解码时每帧都会分配画布大小的内存，帧数同时受MaxGIFFrames与MaxGIFPixels限制，至少保留一帧
+func gifFrameLimit(width, height int) int {
+	pixels := width * height
+	if pixels <= 0 {
+		return MaxGIFFrames
+	}
+	return max(1, min(MaxGIFFrames, MaxGIFPixels/pixels))
+}
+
+// capGIFFrames 在不解码像素数据的情况下扫描GIF块结构，只保留前maxFrames帧
+// 超出部分直接在字节层面截断并补上结束标记，避免解码全部帧后再截断占满内存
+// 帧数未超过上限时原样返回
+func capGIFFrames(data []byte, maxFrames int) ([]byte, error) {
+	// 文件头6字节，逻辑屏幕描述符7字节
+	if len(data) < 13 {
+		return nil, errInvalidGIF
+	}
+	pos := 13
+	if flags := data[10]; flags&0x80 != 0 {
+		pos += 3 << (flags&0x07 + 1)
+	}
+
+	frames := 0
+	for pos < len(data) {
+		block := data[pos]
+		if block == gifTrailer {
+			return data, nil
+		}
+		// 已保留足够帧数，从下一帧的扩展块或图像描述符处截断
+		if frames >= maxFrames {
+			out := make([]byte, pos+1)
+			copy(out, data[:pos])
+			out[pos] = gifTrailer
+			return out, nil
+		}
+
+		switch block {
+		case gifExtensionIntroducer:
+			pos += 2
+		case gifImageSeparator:
+			if len(data)-pos < 10 {
+				return nil, errInvalidGIF
+			}
+			flags := data[pos+9]
+			pos += 10
+			if flags&0x80 != 0 {
+				pos += 3 << (flags&0x07 + 1)
+			}
+			// LZW最小码长
+			pos++
+			frames++
+		default:
+			return nil, errInvalidGIF
+		}
+
+		next, err := skipGIFSubBlocks(data, pos)
+		if err != nil {
+			return nil, err
+		}
+		pos = next
+	}
+
+	// 缺少结束标记的文件交给解码器处理
+	return data, nil
+}
+
+// skipGIFSubBlocks 跳过以0长度块结尾的数据子块序列，返回其后的位置
+func skipGIFSubBlocks(data []byte, pos int) (int, error) {
+	for {
+		if pos >= len(data) {
+			return 0, errInvalidGIF
+		}
+		size := int(data[pos])
+		pos++
+		if size == 0 {
+			return pos, nil
+		}
+		pos += size
+	}
+}
@@ -0,0 +1,134 @@
+package image_tools
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/disintegration/imaging"
+	_ "golang.org/x/image/webp" // 注册WebP解码器
+)
+
+const (
+	// MaxPixels 允许处理的最大像素数，防止解压炸弹占满内存
+	MaxPixels = 40_000_000
+	// MaxGIFFrames 动图最多保留的帧数
+	MaxGIFFrames = 120
+	// MaxGIFPixels 动图解码时全部帧的像素总数上限，按画布尺寸乘以帧数计算
+	MaxGIFPixels = 100_000_000
+	// JPEGQuality JPEG编码质量
+	JPEGQuality = 85
+)
+
+// AvatarSizes 头像裁剪尺寸
+var AvatarSizes = []int{48, 96, 256}
+
+// ErrTooLarge 图片尺寸超过处理上限
+var ErrTooLarge = errors.New("图片尺寸过大")
+
+// Config 解析图片格式与尺寸，不解码像素数据
+func Config(data []byte) (format string, width, height int, err error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("解析图片失败: %w", err)
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return "", 0, 0, ErrTooLarge
+	}
+	return format, cfg.Width, cfg.Height, nil
+}
+
+// Decode 解码图片并按EXIF方向信息旋转，动图只取第一帧
+func Decode(data []byte) (image.Image, error) {
+	if _, _, _, err := Config(data); err != nil {
+		return nil, err
+	}
+	img, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, fmt.Errorf("解码图片失败: %w", err)
+	}
+	return img, nil
+}
+
+// Square 居中裁剪并缩放为正方形
+func Square(img image.Image, size int) image.Image {
+	return imaging.Fill(img, size, size, imaging.Center, imaging.Lanczos)
+}
+
+// Thumbnail 等比缩放到指定边长以内，图片小于该尺寸时保持原样
+func Thumbnail(img image.Image, maxSize int) image.Image {
+	b := img.Bounds()
+	if b.Dx() <= maxSize && b.Dy() <= maxSize {
+		return img
+	}
+	return imaging.Fit(img, maxSize, maxSize, imaging.Lanczos)
+}
+
+// EncodeJPEG 编码为JPEG，透明区域以白色填充
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	b := img.Bounds()
+	flat := imaging.New(b.Dx(), b.Dy(), color.White)
+	flat = imaging.Overlay(flat, img, image.Pt(0, 0), 1.0)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flat, &jpeg.Options{Quality: JPEGQuality}); err != nil {
+		return nil, fmt.Errorf("编码JPEG失败: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Sanitize 重新编码图片以去除EXIF、GPS等元数据，动图超过帧数或像素总数上限时截断
+// WebP暂无编码器，只在容器层面移除EXIF与XMP块；返回nil表示无需替换原文件
+func Sanitize(data []byte) ([]byte, error) {
+	format, width, height, err := Config(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	switch format {
+	case "jpeg":
+		img, err := Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		if err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 92}); err != nil {
+			return nil, fmt.Errorf("编码JPEG失败: %w", err)
+		}
+	case "png":
+		img, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("解码图片失败: %w", err)
+		}
+		if err = png.Encode(&buf, img); err != nil {
+			return nil, fmt.Errorf("编码PNG失败: %w", err)
+		}
+	case "gif":
+		// 解码前先按块结构截断帧数，避免超长动图在解码阶段占满内存
+		capped, err := capGIFFrames(data, gifFrameLimit(width, height))
+		if err != nil {
+			return nil, fmt.Errorf("解析图片失败: %w", err)
+		}
+		g, err := gif.DecodeAll(bytes.NewReader(capped))
+		if err != nil {
+			return nil, fmt.Errorf("解码图片失败: %w", err)
+		}
+		if err = gif.EncodeAll(&buf, g); err != nil {
+			return nil, fmt.Errorf("编码GIF失败: %w", err)
+		}
+	case "webp":
+		stripped, err := stripWebPMetadata(data)
+		if err != nil {
+			return nil, fmt.Errorf("解析图片失败: %w", err)
+		}
+		return stripped, nil
+	default:
+		return nil, nil
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,63 @@
+package image_tools
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	// webpFlagEXIF VP8X头中表示包含EXIF块的标志位
+	webpFlagEXIF = 0x08
+	// webpFlagXMP VP8X头中表示包含XMP块的标志位
+	webpFlagXMP = 0x04
+)
+
+// errInvalidWebP WebP容器结构无效
+var errInvalidWebP = errors.New("WebP文件结构无效")
+
+// stripWebPMetadata 移除WebP容器中的EXIF与XMP块，并同步清除VP8X头中的对应标志位
+// WebP暂无编码器，无法通过重新编码去除元数据，只能在RIFF容器层面删除
+// 返回nil表示不包含元数据，无需替换原文件
+func stripWebPMetadata(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, errInvalidWebP
+	}
+
+	out := make([]byte, 12, len(data))
+	copy(out, data[:12])
+	vp8x := -1
+	stripped := false
+
+	for pos := 12; pos < len(data); {
+		if len(data)-pos < 8 {
+			return nil, errInvalidWebP
+		}
+		fourCC := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4 : pos+8]))
+		// 块数据按偶数字节对齐，奇数长度时末尾有一个填充字节
+		end := pos + 8 + size + size&1
+		if size < 0 || end > len(data) {
+			return nil, errInvalidWebP
+		}
+
+		switch fourCC {
+		case "EXIF", "XMP ":
+			stripped = true
+		case "VP8X":
+			vp8x = len(out)
+			fallthrough
+		default:
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+
+	if !stripped {
+		return nil, nil
+	}
+	if vp8x >= 0 && len(out) > vp8x+8 {
+		out[vp8x+8] &^= webpFlagEXIF | webpFlagXMP
+	}
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+	return out, nil
+}
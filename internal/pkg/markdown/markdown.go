@@ -0,0 +1,189 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// LinkRel 用户内容中链接统一添加的rel属性
+const LinkRel = "nofollow ugc"
+
+// Version 渲染器与过滤策略版本，用于区分渲染结果缓存
+// 调整解析扩展、链接处理或白名单策略后需要递增，使旧版本的缓存失效
+const Version = "1"
+
+var (
+	// md Markdown解析器，原始HTML不会输出（goldmark默认行为）
+	md = goldmark.New(
+		goldmark.WithExtensions(
+			extension.Strikethrough,
+			extension.Linkify,
+			extension.TaskList,
+			extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		),
+		goldmark.WithParserOptions(
+			parser.WithAutoHeadingID(),
+			parser.WithASTTransformers(util.Prioritized(&linkTransformer{}, 100)),
+		),
+	)
+
+	// policy 白名单过滤策略，渲染结果仍需经过过滤以防解析器遗漏
+	policy = newPolicy()
+
+	// noLinkPolicy 不允许链接的过滤策略，链接文本会被保留
+	noLinkPolicy = newBasePolicy()
+)
+
+// Render 将Markdown渲染为经过白名单过滤的HTML
+// 链接添加 rel="nofollow ugc"，代码块保留 language-* 类名供前端高亮，标题带有锚点
+func Render(source string) (string, error) {
+	if strings.TrimSpace(source) == "" {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	ctx := parser.NewContext(parser.WithIDs(newHeadingIDs()))
+	if err := md.Convert([]byte(source), &buf, parser.WithContext(ctx)); err != nil {
+		return "", fmt.Errorf("渲染Markdown失败: %w", err)
+	}
+	return policy.Sanitize(buf.String()), nil
+}
+
+// StripLinks 移除已渲染HTML中的链接，仅保留链接文本
+func StripLinks(html string) string {
+	if html == "" {
+		return ""
+	}
+	return noLinkPolicy.Sanitize(html)
+}
+
+// newPolicy 创建HTML白名单过滤策略
+func newPolicy() *bluemonday.Policy {
+	p := newBasePolicy()
+
+	// 链接，AllowStandardURLs仅允许http、https、mailto与相对地址
+	p.AllowAttrs("href", "title").OnElements("a")
+	p.AllowAttrs("rel").Matching(regexp.MustCompile(`^` + LinkRel + `$`)).OnElements("a")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^anchor$`)).OnElements("a")
+
+	return p
+}
+
+// newBasePolicy 创建不包含链接的HTML白名单过滤策略
+func newBasePolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+
+	p.AllowElements(
+		"p", "br", "hr", "blockquote", "pre", "code",
+		"strong", "em", "del", "sup", "sub",
+		"ul", "ol", "li",
+		"table", "thead", "tbody", "tr", "th", "td",
+		"h1", "h2", "h3", "h4", "h5", "h6",
+	)
+
+	// 图片，AllowStandardURLs仅允许http、https、mailto与相对地址
+	p.AllowStandardURLs()
+	p.AllowAttrs("src", "alt", "title").OnElements("img")
+
+	// 标题锚点
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+
+	// 代码高亮类名
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#.-]+$`)).OnElements("code")
+
+	// 列表与表格
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(left|center|right)$`)).OnElements("th", "td")
+
+	// 任务列表复选框
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+
+	return p
+}
+
+// linkTransformer 为链接添加rel属性，并在标题末尾插入锚点链接
+type linkTransformer struct{}
+
+// Transform 实现 parser.ASTTransformer 接口
+func (t *linkTransformer) Transform(doc *ast.Document, _ text.Reader, _ parser.Context) {
+	var headings []*ast.Heading
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) { //nolint:errcheck // 遍历函数不返回错误
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *ast.Link, *ast.AutoLink:
+			node.SetAttributeString("rel", []byte(LinkRel))
+		case *ast.Heading:
+			headings = append(headings, node)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, h := range headings {
+		id, ok := h.AttributeString("id")
+		if !ok {
+			continue
+		}
+		idBytes, ok := id.([]byte)
+		if !ok {
+			continue
+		}
+		anchor := ast.NewLink()
+		anchor.Destination = append([]byte("#"), idBytes...)
+		anchor.SetAttributeString("class", []byte("anchor"))
+		anchor.SetAttributeString("rel", []byte(LinkRel))
+		h.AppendChild(h, anchor)
+	}
+}
+
+// headingIDs 标题锚点ID生成器，与默认实现相比保留中文等非ASCII字符
+type headingIDs struct {
+	values map[string]bool
+}
+
+// newHeadingIDs 创建标题锚点ID生成器
+func newHeadingIDs() parser.IDs {
+	return &headingIDs{values: map[string]bool{}}
+}
+
+// Generate 根据标题文本生成唯一ID
+func (s *headingIDs) Generate(value []byte, _ ast.NodeKind) []byte {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(string(value)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			b.WriteRune('-')
+		}
+	}
+	result := b.String()
+	if result == "" {
+		result = "heading"
+	}
+
+	id := result
+	for i := 1; s.values[id]; i++ {
+		id = fmt.Sprintf("%s-%d", result, i)
+	}
+	s.values[id] = true
+	return []byte(id)
+}
+
+// Put 记录已使用的ID
+func (s *headingIDs) Put(value []byte) {
+	s.values[string(value)] = true
+}
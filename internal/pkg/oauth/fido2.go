@@ -46,10 +46,7 @@ func (f *FIDO2Provider) ValidateToken(ctx context.Context, accessToken string) (
 	return false, fmt.Errorf("fido2 does not use access token")
 }
 
-// 注意：完整的FIDO2实现需要：
-// 1. 注册阶段：生成challenge，验证attestation
-// 2. 认证阶段：生成challenge，验证assertion
-// 3. 存储公钥凭证
-// 建议使用成熟的WebAuthn库，如：
-// - github.com/go-webauthn/webauthn
-// - github.com/duo-labs/webauthn
+// 注意：FIDO2的注册与认证仪式由 service.WebAuthnService 基于 github.com/go-webauthn/webauthn 实现，
+// 此处仅保留提供商占位，使FIDO2可以在OAuth提供商配置中启用并提供RP配置：
+// - redirect_url: 前端站点地址，作为默认的RP ID与RP Origin
+// - extra_config: 可选 rp_id、rp_display_name、rp_origins 覆盖默认值
@@ -0,0 +1,91 @@
+package sa_token
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+)
+
+// 权限标识，命名规则为 资源.操作
+const (
+	PermPostCreate       = "post.create"       // 发布帖子
+	PermCommentCreate    = "comment.create"    // 发表评论
+	PermCommentPin       = "comment.pin"       // 置顶评论
+	PermAttachmentUpload = "attachment.upload" // 上传附件
+	PermMessageSend      = "message.send"      // 发送私信
+)
+
+const (
+	// PermAll 通配权限，拥有全部权限
+	PermAll = "*"
+	// PermDenyPrefix 禁止权限前缀，用户组中以该前缀声明的权限会从最终权限中移除
+	PermDenyPrefix = "-"
+)
+
+// Permissions 可分配给用户组的全部权限标识
+var Permissions = []string{
+	PermPostCreate,
+	PermCommentCreate,
+	PermCommentPin,
+	PermAttachmentUpload,
+	PermMessageSend,
+}
+
+// IsValidPermission 检查用户组权限标识是否合法，支持禁止权限前缀
+func IsValidPermission(perm string) bool {
+	return slices.Contains(Permissions, strings.TrimPrefix(perm, PermDenyPrefix))
+}
+
+// GetRolePermission 获取用户身份默认拥有的权限
+func GetRolePermission(role string) []string {
+	switch role {
+	case user.RoleUser.String(), user.RoleModerator.String():
+		return []string{
+			PermPostCreate,
+			PermCommentCreate,
+			PermAttachmentUpload,
+			PermMessageSend,
+		}
+	case user.RoleAdmin.String(), user.RoleSuperAdmin.String():
+		return []string{PermAll}
+	default:
+		// 不存在身份用户
+		return []string{}
+	}
+}
+
+// GetUserPermission 合并身份默认权限与用户组权限
+// 用户组中以禁止前缀声明的权限会从结果中移除，拥有通配权限的管理员不受影响
+func GetUserPermission(role string, groupPermissions ...[]string) []string {
+	granted := GetRolePermission(role)
+	if slices.Contains(granted, PermAll) {
+		return granted
+	}
+
+	denied := make(map[string]bool)
+	for _, perms := range groupPermissions {
+		for _, perm := range perms {
+			if name, ok := strings.CutPrefix(perm, PermDenyPrefix); ok {
+				denied[name] = true
+				continue
+			}
+			if !slices.Contains(granted, perm) {
+				granted = append(granted, perm)
+			}
+		}
+	}
+
+	result := make([]string, 0, len(granted))
+	for _, perm := range granted {
+		if !denied[perm] {
+			result = append(result, perm)
+		}
+	}
+	return result
+}
+
+// HasPermission 检查权限列表中是否包含指定权限，通配权限视为拥有全部权限
+func HasPermission(granted []string, perm string) bool {
+	return slices.Contains(granted, PermAll) || slices.Contains(granted, perm)
+}
@@ -7,16 +7,19 @@ import (
 	"github.com/PokeForum/PokeForum/internal/configs"
 )
 
+// TokenTimeout Token有效期（秒），30天
+const TokenTimeout = 2592000
+
 // NewSaToken 创建 SaToken
 func NewSaToken() *saGin.Manager {
 	// 创建SaToken配置
 	saCfg := &saGin.Config{
 		TokenName:              "Authorization",
-		Timeout:                2592000, // 30天（秒）
-		ActiveTimeout:          259200,  // 3天（秒）活跃阈值
-		IsConcurrent:           true,    // 允许多设备登录
-		IsShare:                false,   // 不共享Token
-		MaxLoginCount:          5,       // 限制最多5台设备登录
+		Timeout:                TokenTimeout, // 30天（秒）
+		ActiveTimeout:          259200,       // 3天（秒）活跃阈值
+		IsConcurrent:           true,         // 允许多设备登录
+		IsShare:                false,        // 不共享Token
+		MaxLoginCount:          5,            // 限制最多5台设备登录
 		IsReadHeader:           true,
 		TokenStyle:             saGin.TokenStyleHash, // SHA256哈希风格
 		DataRefreshPeriod:      604800,               // 自动续签7天（单位：秒）
@@ -19,6 +19,14 @@ const (
 	// PostDirtySetKey 帖子脏数据集合键
 	// 存储需要同步到数据库的帖子ID
 	PostDirtySetKey = "post:dirty:set"
+
+	// PostHotGlobalKey 全站帖子热度榜有序集合键
+	// 成员为帖子ID，分数为热度分
+	PostHotGlobalKey = "post:hot:global"
+
+	// PostHotCategoryKeyPrefix 版块帖子热度榜有序集合键前缀
+	// 格式: post:hot:category:{category_id}
+	PostHotCategoryKeyPrefix = "post:hot:category:"
 )
 
 // 评论相关Redis键
@@ -48,6 +56,11 @@ func GetPostUserActionKey(userID, postID int) string {
 	return fmt.Sprintf("%s%d:%d", PostUserActionKeyPrefix, userID, postID)
 }
 
+// GetPostHotCategoryKey 获取版块帖子热度榜键
+func GetPostHotCategoryKey(categoryID int) string {
+	return fmt.Sprintf("%s%d", PostHotCategoryKeyPrefix, categoryID)
+}
+
 // GetCommentStatsKey 获取评论统计数据键
 func GetCommentStatsKey(commentID int) string {
 	return fmt.Sprintf("%s%d", CommentStatsKeyPrefix, commentID)
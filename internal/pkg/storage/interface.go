@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+const (
+	// DriverLocal 本地文件系统存储
+	DriverLocal = "local"
+	// DriverS3 S3兼容对象存储
+	DriverS3 = "s3"
+)
+
+// ErrNotFound 文件不存在
+var ErrNotFound = errors.New("文件不存在")
+
+// IStorage 附件存储驱动接口
+// 文件以key标识，key由调用方生成，使用"/"分隔目录层级
+type IStorage interface {
+	// Name 获取驱动名称
+	Name() string
+
+	// Put 写入文件，key已存在时覆盖
+	// ctx: 上下文
+	// key: 文件键名
+	// reader: 文件内容
+	// size: 文件大小（字节）
+	// contentType: 文件MIME类型
+	// 返回: 错误信息
+	Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error
+
+	// Get 读取文件，调用方负责关闭返回的读取器
+	// ctx: 上下文
+	// key: 文件键名
+	// 返回: 文件读取器和错误信息，文件不存在时返回 ErrNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete 删除文件，文件不存在时不返回错误
+	// ctx: 上下文
+	// key: 文件键名
+	// 返回: 错误信息
+	Delete(ctx context.Context, key string) error
+
+	// Exists 检查文件是否存在
+	// ctx: 上下文
+	// key: 文件键名
+	// 返回: 是否存在和错误信息
+	Exists(ctx context.Context, key string) (bool, error)
+
+	// URL 获取文件的公开访问地址
+	// key: 文件键名
+	// 返回: 访问地址，未配置公开地址时返回空字符串，需通过服务端下载接口访问
+	URL(key string) string
+}
+
+// ValidateKey 校验文件键名，防止路径穿越
+func ValidateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return errors.New("文件键名无效")
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return errors.New("文件键名无效")
+		}
+	}
+	return nil
+}
+
+// joinURL 拼接公开访问地址
+func joinURL(prefix, key string) string {
+	if prefix == "" {
+		return ""
+	}
+	return strings.TrimRight(prefix, "/") + "/" + key
+}
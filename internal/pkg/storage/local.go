@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/PokeForum/PokeForum/internal/utils"
+)
+
+// LocalStorage 本地文件系统存储驱动
+type LocalStorage struct {
+	root      string
+	publicURL string
+}
+
+// NewLocalStorage 创建本地文件系统存储驱动，root不存在时自动创建
+func NewLocalStorage(root, publicURL string) (*LocalStorage, error) {
+	if err := utils.CreatNestedFolder(root); err != nil {
+		return nil, fmt.Errorf("创建存储目录失败: %w", err)
+	}
+	return &LocalStorage{
+		root:      root,
+		publicURL: publicURL,
+	}, nil
+}
+
+// Name 获取驱动名称
+func (l *LocalStorage) Name() string {
+	return DriverLocal
+}
+
+// path 将key转换为本地文件路径
+func (l *LocalStorage) path(key string) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
+
+// Put 写入文件，先写入临时文件再重命名，避免读取到不完整的文件
+func (l *LocalStorage) Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err = utils.CreatNestedFolder(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("创建存储目录失败: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("创建临时文件失败: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name()) //nolint:errcheck // 重命名成功后临时文件已不存在
+	}()
+
+	if _, err = io.Copy(tmp, reader); err != nil {
+		_ = tmp.Close() //nolint:errcheck // 写入失败时关闭失败无需处理
+		return fmt.Errorf("写入文件失败: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("写入文件失败: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("保存文件失败: %w", err)
+	}
+	return nil
+}
+
+// Get 读取文件
+func (l *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path) //nolint:gosec // 路径已通过ValidateKey校验
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("读取文件失败: %w", err)
+	}
+	return f, nil
+}
+
+// Delete 删除文件
+func (l *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("删除文件失败: %w", err)
+	}
+	return nil
+}
+
+// Exists 检查文件是否存在
+func (l *LocalStorage) Exists(ctx context.Context, key string) (bool, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return false, err
+	}
+	if _, err = os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("检查文件失败: %w", err)
+	}
+	return true, nil
+}
+
+// URL 获取文件的公开访问地址
+func (l *LocalStorage) URL(key string) string {
+	return joinURL(l.publicURL, key)
+}
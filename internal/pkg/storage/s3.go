@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/PokeForum/PokeForum/internal/configs/autoload"
+)
+
+// S3Storage S3兼容对象存储驱动，支持 AWS S3、MinIO、Cloudflare R2 等
+type S3Storage struct {
+	client    *minio.Client
+	bucket    string
+	publicURL string
+}
+
+// NewS3Storage 创建S3兼容对象存储驱动
+func NewS3Storage(cfg autoload.S3) (*S3Storage, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("S3存储未配置服务地址或存储桶")
+	}
+
+	lookup := minio.BucketLookupAuto
+	if cfg.PathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure:       cfg.UseSSL,
+		Region:       cfg.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建S3客户端失败: %w", err)
+	}
+
+	return &S3Storage{
+		client:    client,
+		bucket:    cfg.Bucket,
+		publicURL: cfg.PublicURL,
+	}, nil
+}
+
+// Name 获取驱动名称
+func (s *S3Storage) Name() string {
+	return DriverS3
+}
+
+// Put 上传对象
+func (s *S3Storage) Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	if _, err := s.client.PutObject(ctx, s.bucket, key, reader, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	}); err != nil {
+		return fmt.Errorf("上传对象失败: %w", err)
+	}
+	return nil
+}
+
+// Get 下载对象
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("下载对象失败: %w", err)
+	}
+	// GetObject为延迟请求，通过Stat确认对象存在
+	if _, err = obj.Stat(); err != nil {
+		_ = obj.Close() //nolint:errcheck // 对象不可用时关闭失败无需处理
+		if isS3NotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("下载对象失败: %w", err)
+	}
+	return obj, nil
+}
+
+// Delete 删除对象，对象不存在时S3同样返回成功
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("删除对象失败: %w", err)
+	}
+	return nil
+}
+
+// Exists 检查对象是否存在
+func (s *S3Storage) Exists(ctx context.Context, key string) (bool, error) {
+	if err := ValidateKey(key); err != nil {
+		return false, err
+	}
+	if _, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{}); err != nil {
+		if isS3NotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("检查对象失败: %w", err)
+	}
+	return true, nil
+}
+
+// URL 获取对象的公开访问地址
+func (s *S3Storage) URL(key string) string {
+	return joinURL(s.publicURL, key)
+}
+
+// isS3NotFound 判断是否为对象不存在错误
+func isS3NotFound(err error) bool {
+	resp := minio.ToErrorResponse(err)
+	return resp.Code == "NoSuchKey" || resp.StatusCode == http.StatusNotFound
+}
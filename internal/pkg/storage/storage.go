@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/PokeForum/PokeForum/internal/configs/autoload"
+	"github.com/PokeForum/PokeForum/internal/utils"
+)
+
+// New 根据配置创建存储驱动，未配置驱动时默认使用本地存储
+func New(cfg autoload.Storage) (IStorage, error) {
+	switch cfg.Driver {
+	case "", DriverLocal:
+		root := cfg.Local.Path
+		if root == "" {
+			root = "uploads"
+		}
+		if !filepath.IsAbs(root) {
+			root = filepath.Join(utils.DataFolder, root)
+		}
+		return NewLocalStorage(root, cfg.Local.PublicURL)
+	case DriverS3:
+		return NewS3Storage(cfg.S3)
+	default:
+		return nil, fmt.Errorf("不支持的存储驱动: %s", cfg.Driver)
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+// AttachmentItem 附件信息
+type AttachmentItem struct {
+	// 附件ID
+	ID int `json:"id" example:"1"`
+	// 原始文件名
+	Filename string `json:"filename" example:"screenshot.png"`
+	// 文件MIME类型
+	MimeType string `json:"mime_type" example:"image/png"`
+	// 文件大小（字节）
+	Size int64 `json:"size" example:"102400"`
+	// 是否为图片
+	IsImage bool `json:"is_image" example:"true"`
+	// 访问地址
+	URL string `json:"url" example:"/api/v1/attachments/file/attachments/ab/cd/abcd.png"`
+	// 缩略图地址，图片处理完成后返回
+	ThumbnailURL string `json:"thumbnail_url,omitempty" example:"/api/v1/attachments/file/thumbnails/ab/cd/abcd.jpg"`
+	// 图片宽度（像素），处理完成前为0
+	Width int `json:"width" example:"1920"`
+	// 图片高度（像素），处理完成前为0
+	Height int `json:"height" example:"1080"`
+	// 所属帖子ID，0表示未关联
+	PostID int `json:"post_id" example:"0"`
+	// 所属评论ID，0表示未关联
+	CommentID int `json:"comment_id" example:"0"`
+	// 上传时间
+	CreatedAt string `json:"created_at" example:"2024-01-01 00:00:00"`
+}
+
+// AttachmentListRequest 我的附件列表请求
+type AttachmentListRequest struct {
+	// 页码，默认1
+	Page int `form:"page" binding:"omitempty,min=1" example:"1"`
+	// 每页数量，默认20
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100" example:"20"`
+	// 仅显示未关联帖子或评论的附件
+	Unbound bool `form:"unbound" example:"false"`
+}
+
+// AttachmentListResponse 我的附件列表响应
+type AttachmentListResponse struct {
+	// 附件列表
+	List []AttachmentItem `json:"list"`
+	// 总数量
+	Total int `json:"total"`
+	// 当前页码
+	Page int `json:"page"`
+	// 每页数量
+	PageSize int `json:"page_size"`
+	// 已使用容量（字节）
+	UsedBytes int64 `json:"used_bytes" example:"1048576"`
+	// 容量上限（字节），0表示不限制
+	QuotaBytes int64 `json:"quota_bytes" example:"1073741824"`
+}
+
+// AttachmentDeleteRequest 删除附件请求
+type AttachmentDeleteRequest struct {
+	// 附件ID
+	ID int `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// AttachmentFile 附件文件下载信息
+type AttachmentFile struct {
+	// 原始文件名
+	Filename string
+	// 文件MIME类型
+	MimeType string
+	// 文件大小（字节），-1表示未知
+	Size int64
+}
@@ -2,9 +2,10 @@ package schema
 
 // RegisterRequest 用户注册请求体
 type RegisterRequest struct {
-	Username string `json:"username" binding:"required,min=3,max=100" example:"testuser"` // 用户名
-	Email    string `json:"email" binding:"required,email" example:"test@example.com"`    // 邮箱
-	Password string `json:"password" binding:"required,min=8" example:"password123"`      // 密码
+	Username   string `json:"username" binding:"required,min=3,max=100" example:"testuser"`  // 用户名
+	Email      string `json:"email" binding:"required,email" example:"test@example.com"`     // 邮箱
+	Password   string `json:"password" binding:"required,min=8" example:"password123"`       // 密码
+	InviteCode string `json:"invite_code" binding:"omitempty,max=32" example:"aB3dE5fG7hJ9"` // 邀请码，开启邀请码注册时必填
 }
 
 // LoginRequest 用户登录请求体
@@ -15,9 +16,13 @@ type LoginRequest struct {
 
 // LoginResponse 用户登录响应体
 type LoginResponse struct {
-	Token    string `json:"token"`    // Token
-	ID       int    `json:"id"`       // 用户ID
-	Username string `json:"username"` // 用户名
+	Token                  string   `json:"token"`                     // Token，需要两步验证时为空
+	ID                     int      `json:"id"`                        // 用户ID
+	Username               string   `json:"username"`                  // 用户名
+	TwoFactorRequired      bool     `json:"two_factor_required"`       // 是否需要两步验证
+	TwoFactorSetupRequired bool     `json:"two_factor_setup_required"` // 是否需要先绑定两步验证
+	PendingToken           string   `json:"pending_token,omitempty"`   // 待验证登录凭证，用于提交两步验证码
+	RecoveryCodes          []string `json:"recovery_codes,omitempty"`  // 登录流程中完成绑定时返回的恢复码
 }
 
 // UserResponse 用户响应体
@@ -51,3 +56,21 @@ type ResetPasswordResponse struct {
 	Success bool   `json:"success" example:"true"`   // 是否成功
 	Message string `json:"message" example:"密码重置成功"` // 提示信息
 }
+
+// OAuthAuthorizeRequest 第三方授权请求
+type OAuthAuthorizeRequest struct {
+	Provider string `uri:"provider" binding:"required,oneof=QQ GitHub Apple Google Telegram" example:"GitHub"` // 第三方提供商
+}
+
+// OAuthAuthorizeResponse 第三方授权响应
+type OAuthAuthorizeResponse struct {
+	AuthURL string `json:"auth_url" example:"https://github.com/login/oauth/authorize?client_id=xxx"` // 授权地址
+	State   string `json:"state" example:"c3RhdGU="`                                                  // 防CSRF随机串
+}
+
+// OAuthCallbackRequest 第三方授权回调请求
+type OAuthCallbackRequest struct {
+	Provider string `json:"-" form:"-"`                                  // 第三方提供商，取自路径参数
+	Code     string `form:"code" binding:"required" example:"abc123"`    // 授权码
+	State    string `form:"state" binding:"required" example:"c3RhdGU="` // 防CSRF随机串
+}
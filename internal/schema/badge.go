@@ -0,0 +1,94 @@
+package schema
+
+// BadgeListRequest 徽章列表请求体
+type BadgeListRequest struct {
+	Page     int    `form:"page" binding:"required,min=1" example:"1"`                                                                               // 页码
+	PageSize int    `form:"page_size" binding:"required,min=1,max=100" example:"20"`                                                                 // 每页数量
+	Keyword  string `form:"keyword" binding:"omitempty,max=50" example:"签到"`                                                                         // 名称关键词
+	Criteria string `form:"criteria" binding:"omitempty,oneof=manual post_count likes_received signin_streak essence_count" example:"signin_streak"` // 获得条件类型
+}
+
+// BadgeCreateRequest 创建徽章请求体
+type BadgeCreateRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=50" example:"坚持不懈"`                                                                    // 徽章名称
+	Description string `json:"description" binding:"max=200" example:"连续签到30天"`                                                                        // 徽章描述
+	Icon        string `json:"icon" binding:"omitempty,max=500" example:"https://example.com/badge.png"`                                               // 徽章图标地址
+	Criteria    string `json:"criteria" binding:"required,oneof=manual post_count likes_received signin_streak essence_count" example:"signin_streak"` // 获得条件类型
+	Threshold   int    `json:"threshold" binding:"min=0" example:"30"`                                                                                 // 获得条件阈值，手动授予的徽章忽略该值
+	Enabled     *bool  `json:"enabled" example:"true"`                                                                                                 // 是否启用，不传默认启用
+}
+
+// BadgeUpdateRequest 更新徽章请求体
+type BadgeUpdateRequest struct {
+	ID          int     `json:"id" binding:"required" example:"1"`                                                                                       // 徽章ID
+	Name        string  `json:"name" binding:"omitempty,min=1,max=50" example:"坚持不懈"`                                                                    // 徽章名称
+	Description *string `json:"description" binding:"omitempty,max=200" example:"连续签到30天"`                                                               // 徽章描述，不传表示不修改
+	Icon        *string `json:"icon" binding:"omitempty,max=500" example:"https://example.com/badge.png"`                                                // 徽章图标地址，不传表示不修改
+	Criteria    string  `json:"criteria" binding:"omitempty,oneof=manual post_count likes_received signin_streak essence_count" example:"signin_streak"` // 获得条件类型
+	Threshold   *int    `json:"threshold" binding:"omitempty,min=0" example:"30"`                                                                        // 获得条件阈值，不传表示不修改
+	Enabled     *bool   `json:"enabled" example:"true"`                                                                                                  // 是否启用，不传表示不修改
+}
+
+// BadgeItem 徽章列表项
+type BadgeItem struct {
+	ID          int    `json:"id" example:"1"`                               // 徽章ID
+	Name        string `json:"name" example:"坚持不懈"`                          // 徽章名称
+	Description string `json:"description" example:"连续签到30天"`                // 徽章描述
+	Icon        string `json:"icon" example:"https://example.com/badge.png"` // 徽章图标地址
+	Criteria    string `json:"criteria" example:"signin_streak"`             // 获得条件类型
+	Threshold   int    `json:"threshold" example:"30"`                       // 获得条件阈值
+	Enabled     bool   `json:"enabled" example:"true"`                       // 是否启用
+	HolderCount int    `json:"holder_count" example:"10"`                    // 获得人数
+	CreatedAt   string `json:"created_at" example:"2024-01-01 00:00:00"`     // 创建时间
+	UpdatedAt   string `json:"updated_at" example:"2024-01-01 00:00:00"`     // 更新时间
+}
+
+// BadgeListResponse 徽章列表响应体
+type BadgeListResponse struct {
+	List     []BadgeItem `json:"list"`      // 徽章列表
+	Total    int         `json:"total"`     // 总数量
+	Page     int         `json:"page"`      // 当前页码
+	PageSize int         `json:"page_size"` // 每页数量
+}
+
+// BadgeAwardRequest 手动授予徽章请求体
+type BadgeAwardRequest struct {
+	BadgeID int    `json:"badge_id" binding:"required" example:"1"`   // 徽章ID
+	UserID  int    `json:"user_id" binding:"required" example:"10"`   // 用户ID
+	Reason  string `json:"reason" binding:"max=200" example:"社区活动奖励"` // 授予原因
+}
+
+// BadgeRevokeRequest 收回徽章请求体
+type BadgeRevokeRequest struct {
+	BadgeID int `json:"badge_id" binding:"required" example:"1"` // 徽章ID
+	UserID  int `json:"user_id" binding:"required" example:"10"` // 用户ID
+}
+
+// BadgeBrief 展示在用户名旁的徽章摘要
+type BadgeBrief struct {
+	ID   int    `json:"id" example:"1"`                               // 徽章ID
+	Name string `json:"name" example:"坚持不懈"`                          // 徽章名称
+	Icon string `json:"icon" example:"https://example.com/badge.png"` // 徽章图标地址
+}
+
+// UserBadgeItem 用户获得的徽章
+type UserBadgeItem struct {
+	ID           int    `json:"id" example:"1"`                               // 徽章ID
+	Name         string `json:"name" example:"坚持不懈"`                          // 徽章名称
+	Description  string `json:"description" example:"连续签到30天"`                // 徽章描述
+	Icon         string `json:"icon" example:"https://example.com/badge.png"` // 徽章图标地址
+	Source       string `json:"source" example:"auto"`                        // 获得方式：auto-自动授予，manual-手动授予
+	Reason       string `json:"reason" example:"社区活动奖励"`                      // 授予原因
+	DisplayOrder int    `json:"display_order" example:"1"`                    // 展示顺序，0表示不展示
+	AwardedAt    string `json:"awarded_at" example:"2024-01-01 00:00:00"`     // 获得时间
+}
+
+// UserBadgeListResponse 用户徽章列表响应体
+type UserBadgeListResponse struct {
+	List []UserBadgeItem `json:"list"` // 获得的徽章列表
+}
+
+// UserBadgeDisplayRequest 设置展示徽章请求体
+type UserBadgeDisplayRequest struct {
+	BadgeIDs []int `json:"badge_ids" binding:"max=3,dive,min=1" example:"1,2,3"` // 展示的徽章ID，按展示顺序排列，最多3个，为空表示不展示
+}
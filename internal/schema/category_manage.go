@@ -6,6 +6,7 @@ type CategoryListRequest struct {
 	PageSize int    `form:"page_size" binding:"required,min=1,max=100" example:"20"` // 每页数量
 	Keyword  string `form:"keyword" example:"技术"`                                    // 搜索关键词（版块名称或描述）
 	Status   string `form:"status" example:"Normal"`                                 // 版块状态筛选
+	ParentID *int   `form:"parent_id" binding:"omitempty,min=0" example:"0"`         // 父版块ID筛选，0表示顶级版块
 }
 
 // CategoryCreateRequest 创建版块请求体
@@ -16,6 +17,7 @@ type CategoryCreateRequest struct {
 	Icon        string `json:"icon" example:"https://example.com/icon.png"`                                         // 版块图标
 	Weight      int    `json:"weight" binding:"min=0" example:"0"`                                                  // 权重排序
 	Status      string `json:"status" binding:"required,oneof=Normal LoginRequired Hidden Locked" example:"Normal"` // 版块状态
+	ParentID    int    `json:"parent_id" binding:"min=0" example:"0"`                                               // 父版块ID，0表示顶级版块
 }
 
 // CategoryUpdateRequest 更新版块请求体
@@ -27,6 +29,7 @@ type CategoryUpdateRequest struct {
 	Icon        string `json:"icon" example:"https://example.com/icon.png"`                                          // 版块图标
 	Weight      int    `json:"weight" binding:"omitempty,min=0" example:"0"`                                         // 权重排序
 	Status      string `json:"status" binding:"omitempty,oneof=Normal LoginRequired Hidden Locked" example:"Normal"` // 版块状态
+	ParentID    *int   `json:"parent_id" binding:"omitempty,min=0" example:"0"`                                      // 父版块ID，0表示移动为顶级版块，不传则不修改
 }
 
 // CategoryStatusUpdateRequest 更新版块状态请求体
@@ -39,6 +42,7 @@ type CategoryStatusUpdateRequest struct {
 // CategoryListItem 版块列表项响应体
 type CategoryListItem struct {
 	ID          int    `json:"id" example:"1"`                              // 版块ID
+	ParentID    int    `json:"parent_id" example:"0"`                       // 父版块ID，0表示顶级版块
 	Name        string `json:"name" example:"技术讨论"`                         // 版块名称
 	Slug        string `json:"slug" example:"tech"`                         // 版块英文标识
 	Description string `json:"description" example:"技术相关话题讨论区"`             // 版块描述
@@ -60,6 +64,7 @@ type CategoryListResponse struct {
 // CategoryDetailResponse 版块详情响应体
 type CategoryDetailResponse struct {
 	ID          int    `json:"id" example:"1"`                              // 版块ID
+	ParentID    int    `json:"parent_id" example:"0"`                       // 父版块ID，0表示顶级版块
 	Name        string `json:"name" example:"技术讨论"`                         // 版块名称
 	Slug        string `json:"slug" example:"tech"`                         // 版块英文标识
 	Description string `json:"description" example:"技术相关话题讨论区"`             // 版块描述
@@ -76,3 +81,24 @@ type CategoryModeratorRequest struct {
 	UserID     int    `json:"user_id" binding:"required" example:"10"`    // 版主用户ID
 	Reason     string `json:"reason" example:"版主任命"`                      // 操作原因
 }
+
+// CategoryPermissionRule 版块访问控制规则
+// 需同时满足最低身份与最低经验值，设置了用户组时还需属于其中任一用户组
+type CategoryPermissionRule struct {
+	Action          string `json:"action" binding:"required,oneof=View Post Reply" example:"Post"`                    // 规则作用的操作：View(查看)、Post(发帖)、Reply(回复)
+	MinRole         string `json:"min_role" binding:"omitempty,oneof=User Moderator Admin SuperAdmin" example:"User"` // 最低身份要求，默认User
+	MinExperience   int    `json:"min_experience" binding:"min=0" example:"100"`                                      // 最低经验值要求
+	AllowedGroupIDs []int  `json:"allowed_group_ids"`                                                                 // 允许的用户组ID列表，为空表示不限制用户组
+}
+
+// CategoryPermissionUpdateRequest 设置版块访问控制规则请求体
+type CategoryPermissionUpdateRequest struct {
+	CategoryID int                      `json:"category_id" binding:"required" example:"1"` // 版块ID
+	Rules      []CategoryPermissionRule `json:"rules" binding:"dive"`                       // 访问控制规则，未提交的操作将移除规则
+}
+
+// CategoryPermissionResponse 版块访问控制规则响应体
+type CategoryPermissionResponse struct {
+	CategoryID int                      `json:"category_id" example:"1"` // 版块ID
+	Rules      []CategoryPermissionRule `json:"rules"`                   // 访问控制规则，子版块同时受父版块规则约束
+}
@@ -6,19 +6,22 @@ type UserCommentCreateRequest struct {
 	ParentID      *int   `json:"parent_id" example:"1"`                              // 父评论ID（回复评论时使用）
 	ReplyToUserID *int   `json:"reply_to_user_id" example:"2"`                       // 回复目标用户ID（回复用户时使用）
 	Content       string `json:"content" binding:"required,min=1" example:"很有见地的评论"` // 评论内容
+	AttachmentIDs []int  `json:"attachment_ids" binding:"omitempty,max=20"`          // 附件ID列表
 }
 
 // UserCommentCreateResponse 用户创建评论响应体
 type UserCommentCreateResponse struct {
-	ID              int    `json:"id" example:"1"`                           // 评论ID
-	PostID          int    `json:"post_id" example:"1"`                      // 帖子ID
-	ParentID        *int   `json:"parent_id" example:"1"`                    // 父评论ID
-	ReplyToUserID   *int   `json:"reply_to_user_id" example:"2"`             // 回复目标用户ID
-	ReplyToUsername string `json:"reply_to_username" example:"targetuser"`   // 回复目标用户名
-	Content         string `json:"content" example:"很有见地的评论"`                // 评论内容
-	LikeCount       int    `json:"like_count" example:"0"`                   // 点赞数
-	DislikeCount    int    `json:"dislike_count" example:"0"`                // 点踩数
-	CreatedAt       string `json:"created_at" example:"2024-01-01 00:00:00"` // 创建时间
+	ID              int              `json:"id" example:"1"`                           // 评论ID
+	PostID          int              `json:"post_id" example:"1"`                      // 帖子ID
+	ParentID        *int             `json:"parent_id" example:"1"`                    // 父评论ID
+	ReplyToUserID   *int             `json:"reply_to_user_id" example:"2"`             // 回复目标用户ID
+	ReplyToUsername string           `json:"reply_to_username" example:"targetuser"`   // 回复目标用户名
+	Content         string           `json:"content" example:"很有见地的评论"`                // 评论内容
+	ContentHTML     string           `json:"content_html" example:"<p>很有见地的评论</p>"`    // 评论内容渲染后的HTML
+	LikeCount       int              `json:"like_count" example:"0"`                   // 点赞数
+	DislikeCount    int              `json:"dislike_count" example:"0"`                // 点踩数
+	CreatedAt       string           `json:"created_at" example:"2024-01-01 00:00:00"` // 创建时间
+	Attachments     []AttachmentItem `json:"attachments,omitempty"`                    // 附件列表
 }
 
 // UserCommentUpdateRequest 用户更新评论请求体
@@ -57,22 +60,26 @@ type UserCommentListRequest struct {
 
 // UserCommentListItem 用户评论列表项响应体
 type UserCommentListItem struct {
-	ID              int    `json:"id" example:"1"`                           // 评论ID
-	FloorNumber     int    `json:"floor_number" example:"1"`                 // 楼号
-	UserID          int    `json:"user_id" example:"1"`                      // 用户ID
-	Username        string `json:"username" example:"testuser"`              // 用户名
-	ParentID        *int   `json:"parent_id" example:"1"`                    // 父评论ID
-	ReplyToUserID   *int   `json:"reply_to_user_id" example:"2"`             // 回复目标用户ID
-	ReplyToUsername string `json:"reply_to_username" example:"targetuser"`   // 回复目标用户名
-	Content         string `json:"content" example:"很有见地的评论"`                // 评论内容
-	LikeCount       int    `json:"like_count" example:"10"`                  // 点赞数
-	DislikeCount    int    `json:"dislike_count" example:"1"`                // 点踩数
-	UserLiked       bool   `json:"user_liked" example:"false"`               // 当前用户是否已点赞
-	UserDisliked    bool   `json:"user_disliked" example:"false"`            // 当前用户是否已点踩
-	IsSelected      bool   `json:"is_selected" example:"true"`               // 是否精选
-	IsPinned        bool   `json:"is_pinned" example:"false"`                // 是否置顶
-	CreatedAt       string `json:"created_at" example:"2024-01-01 00:00:00"` // 创建时间
-	UpdatedAt       string `json:"updated_at" example:"2024-01-01 00:00:00"` // 更新时间
+	ID              int              `json:"id" example:"1"`                           // 评论ID
+	FloorNumber     int              `json:"floor_number" example:"1"`                 // 楼号
+	UserID          int              `json:"user_id" example:"1"`                      // 用户ID
+	Username        string           `json:"username" example:"testuser"`              // 用户名
+	AuthorLevel     *UserLevelBrief  `json:"author_level,omitempty"`                   // 作者等级
+	AuthorBadges    []BadgeBrief     `json:"author_badges,omitempty"`                  // 作者选择展示的徽章
+	ParentID        *int             `json:"parent_id" example:"1"`                    // 父评论ID
+	ReplyToUserID   *int             `json:"reply_to_user_id" example:"2"`             // 回复目标用户ID
+	ReplyToUsername string           `json:"reply_to_username" example:"targetuser"`   // 回复目标用户名
+	Content         string           `json:"content" example:"很有见地的评论"`                // 评论内容
+	ContentHTML     string           `json:"content_html" example:"<p>很有见地的评论</p>"`    // 评论内容渲染后的HTML
+	LikeCount       int              `json:"like_count" example:"10"`                  // 点赞数
+	DislikeCount    int              `json:"dislike_count" example:"1"`                // 点踩数
+	UserLiked       bool             `json:"user_liked" example:"false"`               // 当前用户是否已点赞
+	UserDisliked    bool             `json:"user_disliked" example:"false"`            // 当前用户是否已点踩
+	IsSelected      bool             `json:"is_selected" example:"true"`               // 是否精选
+	IsPinned        bool             `json:"is_pinned" example:"false"`                // 是否置顶
+	CreatedAt       string           `json:"created_at" example:"2024-01-01 00:00:00"` // 创建时间
+	UpdatedAt       string           `json:"updated_at" example:"2024-01-01 00:00:00"` // 更新时间
+	Attachments     []AttachmentItem `json:"attachments,omitempty"`                    // 附件列表
 }
 
 // UserCommentListResponse 用户评论列表响应体
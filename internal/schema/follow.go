@@ -0,0 +1,76 @@
+package schema
+
+// FollowRequest 关注/取消关注请求体
+type FollowRequest struct {
+	TargetType string `json:"target_type" binding:"required,oneof=User Category" example:"User"` // 关注对象类型：User、Category
+	TargetID   int    `json:"target_id" binding:"required,min=1" example:"2"`                    // 关注对象ID
+}
+
+// FollowResponse 关注/取消关注响应体
+type FollowResponse struct {
+	TargetType    string `json:"target_type" example:"User"`   // 关注对象类型
+	TargetID      int    `json:"target_id" example:"2"`        // 关注对象ID
+	Following     bool   `json:"following" example:"true"`     // 当前是否已关注
+	FollowerCount int    `json:"follower_count" example:"100"` // 关注对象的粉丝数
+}
+
+// FollowingListRequest 关注列表请求体
+type FollowingListRequest struct {
+	UserID     int    `form:"user_id" example:"1"`                                                // 用户ID，不传则查询当前登录用户
+	TargetType string `form:"target_type" binding:"omitempty,oneof=User Category" example:"User"` // 关注对象类型，默认User
+	Page       int    `form:"page" binding:"omitempty,min=1" example:"1"`                         // 页码
+	PageSize   int    `form:"page_size" binding:"omitempty,min=1,max=50" example:"20"`            // 每页数量
+}
+
+// FollowingItem 关注列表项
+type FollowingItem struct {
+	TargetType string `json:"target_type" example:"User"`                      // 关注对象类型
+	TargetID   int    `json:"target_id" example:"2"`                           // 关注对象ID
+	Name       string `json:"name" example:"testuser"`                         // 用户名或版块名称
+	Avatar     string `json:"avatar" example:"https://example.com/avatar.jpg"` // 用户头像或版块图标
+	CreatedAt  string `json:"created_at" example:"2024-01-01 00:00:00"`        // 关注时间
+}
+
+// FollowingListResponse 关注列表响应体
+type FollowingListResponse struct {
+	List     []FollowingItem `json:"list"`                   // 关注列表
+	Total    int             `json:"total" example:"10"`     // 总数量
+	Page     int             `json:"page" example:"1"`       // 当前页码
+	PageSize int             `json:"page_size" example:"20"` // 每页数量
+}
+
+// FollowerListRequest 粉丝列表请求体
+type FollowerListRequest struct {
+	UserID   int `form:"user_id" example:"1"`                                     // 用户ID，不传则查询当前登录用户
+	Page     int `form:"page" binding:"omitempty,min=1" example:"1"`              // 页码
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=50" example:"20"` // 每页数量
+}
+
+// FollowerItem 粉丝列表项
+type FollowerItem struct {
+	UserID    int    `json:"user_id" example:"2"`                             // 粉丝用户ID
+	Username  string `json:"username" example:"testuser"`                     // 粉丝用户名
+	Avatar    string `json:"avatar" example:"https://example.com/avatar.jpg"` // 粉丝头像
+	CreatedAt string `json:"created_at" example:"2024-01-01 00:00:00"`        // 关注时间
+}
+
+// FollowerListResponse 粉丝列表响应体
+type FollowerListResponse struct {
+	List     []FollowerItem `json:"list"`                   // 粉丝列表
+	Total    int            `json:"total" example:"10"`     // 总数量
+	Page     int            `json:"page" example:"1"`       // 当前页码
+	PageSize int            `json:"page_size" example:"20"` // 每页数量
+}
+
+// FeedRequest 关注动态请求体
+type FeedRequest struct {
+	Cursor int `form:"cursor" binding:"omitempty,min=1" example:"100"`      // 游标，返回ID小于该值的帖子，为空时从最新帖子开始
+	Limit  int `form:"limit" binding:"omitempty,min=1,max=50" example:"20"` // 返回数量
+}
+
+// FeedResponse 关注动态响应体
+type FeedResponse struct {
+	Posts      []UserPostCreateResponse `json:"posts"`                    // 帖子列表，按发布时间倒序
+	NextCursor int                      `json:"next_cursor" example:"80"` // 下一页游标，没有更多时为0
+	HasMore    bool                     `json:"has_more" example:"true"`  // 是否还有更多
+}
@@ -0,0 +1,25 @@
+package schema
+
+// InviteCodeItem 邀请码项
+type InviteCodeItem struct {
+	ID        int    `json:"id" example:"1"`                           // 邀请码记录ID
+	Code      string `json:"code" example:"aB3dE5fG7hJ9"`              // 邀请码
+	MaxUses   int    `json:"max_uses" example:"1"`                     // 最大使用次数，0表示不限制
+	UsedCount int    `json:"used_count" example:"0"`                   // 已使用次数
+	Status    string `json:"status" example:"Active"`                  // 状态：Active、Revoked
+	CreatedAt string `json:"created_at" example:"2024-01-01 00:00:00"` // 创建时间
+}
+
+// InviteCodeListResponse 邀请码列表响应体
+type InviteCodeListResponse struct {
+	List         []InviteCodeItem `json:"list"`                      // 邀请码列表
+	UserLimit    int              `json:"user_limit" example:"5"`    // 可创建的邀请码总数，0表示不限制
+	CreatedCount int              `json:"created_count" example:"2"` // 已创建的邀请码数量，含已作废与已用尽的邀请码
+	ActiveCount  int              `json:"active_count" example:"1"`  // 当前有效邀请码数量，不含已用尽次数的邀请码
+	InvitedCount int              `json:"invited_count" example:"3"` // 已邀请的用户数量
+}
+
+// InviteCodeRevokeRequest 作废邀请码请求体
+type InviteCodeRevokeRequest struct {
+	ID int `uri:"id" binding:"required" example:"1"` // 邀请码记录ID
+}
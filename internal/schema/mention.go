@@ -0,0 +1,42 @@
+package schema
+
+// MentionListRequest 提及列表请求体
+type MentionListRequest struct {
+	Page       int  `form:"page" binding:"omitempty,min=1" example:"1"`              // 页码
+	PageSize   int  `form:"page_size" binding:"omitempty,min=1,max=50" example:"20"` // 每页数量
+	UnreadOnly bool `form:"unread_only" example:"false"`                             // 仅返回未读提及
+}
+
+// MentionItem 提及列表项
+type MentionItem struct {
+	ID           int    `json:"id" example:"1"`                                  // 提及记录ID
+	FromUserID   int    `json:"from_user_id" example:"2"`                        // 发起提及的用户ID
+	FromUsername string `json:"from_username" example:"testuser"`                // 发起提及的用户名
+	FromAvatar   string `json:"from_avatar" example:"https://example.com/a.jpg"` // 发起提及的用户头像
+	PostID       int    `json:"post_id" example:"1"`                             // 帖子ID
+	PostTitle    string `json:"post_title" example:"帖子标题"`                       // 帖子标题
+	CommentID    int    `json:"comment_id" example:"0"`                          // 评论ID，0表示在帖子正文中提及
+	Excerpt      string `json:"excerpt" example:"@testuser 你怎么看"`                // 正文摘要
+	IsRead       bool   `json:"is_read" example:"false"`                         // 是否已读
+	CreatedAt    string `json:"created_at" example:"2024-01-01 00:00:00"`        // 提及时间
+}
+
+// MentionListResponse 提及列表响应体
+type MentionListResponse struct {
+	List        []MentionItem `json:"list"`                         // 提及列表
+	Total       int           `json:"total" example:"10"`           // 总数量
+	UnreadCount int           `json:"unread_count" example:"3"`     // 未读数量
+	Page        int           `json:"page" example:"1"`             // 当前页码
+	PageSize    int           `json:"page_size" example:"20"`       // 每页数量
+	EmailNotify bool          `json:"email_notify" example:"false"` // 是否开启提及邮件提醒
+}
+
+// MentionReadRequest 标记提及已读请求体
+type MentionReadRequest struct {
+	IDs []int `json:"ids" binding:"omitempty,max=100,dive,min=1" example:"1,2"` // 提及记录ID列表，为空时标记全部已读
+}
+
+// MentionSettingsRequest 提及提醒设置请求体
+type MentionSettingsRequest struct {
+	EmailNotify bool `json:"email_notify" example:"true"` // 是否开启提及邮件提醒
+}
@@ -0,0 +1,78 @@
+package schema
+
+// MessageConversationListRequest 私信会话列表请求体
+type MessageConversationListRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1" example:"1"`              // 页码
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=50" example:"20"` // 每页数量
+}
+
+// MessageConversationItem 私信会话列表项
+type MessageConversationItem struct {
+	ID            int    `json:"id" example:"1"`                                // 会话ID
+	UserID        int    `json:"user_id" example:"2"`                           // 对方用户ID
+	Username      string `json:"username" example:"testuser"`                   // 对方用户名
+	Avatar        string `json:"avatar" example:"https://example.com/a.jpg"`    // 对方头像
+	LastMessage   string `json:"last_message" example:"你好"`                     // 最后一条消息摘要
+	LastMessageAt string `json:"last_message_at" example:"2024-01-01 00:00:00"` // 最后一条消息时间
+	UnreadCount   int    `json:"unread_count" example:"2"`                      // 未读消息数
+}
+
+// MessageConversationListResponse 私信会话列表响应体
+type MessageConversationListResponse struct {
+	List        []MessageConversationItem `json:"list"`                     // 会话列表
+	Total       int                       `json:"total" example:"10"`       // 总数量
+	UnreadCount int                       `json:"unread_count" example:"3"` // 全部未读消息数
+	Page        int                       `json:"page" example:"1"`         // 当前页码
+	PageSize    int                       `json:"page_size" example:"20"`   // 每页数量
+}
+
+// MessageSendRequest 发送私信请求体
+type MessageSendRequest struct {
+	UserID  int    `json:"user_id" binding:"required,min=1" example:"2"`           // 接收者用户ID
+	Content string `json:"content" binding:"required,min=1,max=2000" example:"你好"` // 消息内容
+}
+
+// MessageItem 私信消息
+type MessageItem struct {
+	ID         int    `json:"id" example:"1"`                           // 消息ID
+	SenderID   int    `json:"sender_id" example:"1"`                    // 发送者ID
+	ReceiverID int    `json:"receiver_id" example:"2"`                  // 接收者ID
+	Content    string `json:"content" example:"你好"`                     // 消息内容
+	IsRead     bool   `json:"is_read" example:"false"`                  // 接收者是否已读
+	ReadAt     string `json:"read_at" example:"2024-01-01 00:00:00"`    // 接收者阅读时间，未读时为空
+	CreatedAt  string `json:"created_at" example:"2024-01-01 00:00:00"` // 发送时间
+}
+
+// MessageHistoryRequest 私信消息记录请求体
+type MessageHistoryRequest struct {
+	UserID   int `uri:"user_id" binding:"required,min=1" example:"2"`         // 对方用户ID
+	BeforeID int `form:"before_id" binding:"omitempty,min=1" example:"100"`   // 游标，返回ID小于该值的消息，为空时从最新消息开始
+	Limit    int `form:"limit" binding:"omitempty,min=1,max=50" example:"20"` // 返回数量
+}
+
+// MessageHistoryResponse 私信消息记录响应体
+type MessageHistoryResponse struct {
+	UserID   int           `json:"user_id" example:"2"`                        // 对方用户ID
+	Username string        `json:"username" example:"testuser"`                // 对方用户名
+	Avatar   string        `json:"avatar" example:"https://example.com/a.jpg"` // 对方头像
+	List     []MessageItem `json:"list"`                                       // 消息列表，按ID倒序
+	HasMore  bool          `json:"has_more" example:"true"`                    // 是否还有更早的消息
+}
+
+// MessageReadRequest 标记私信已读请求体
+type MessageReadRequest struct {
+	UserID int `json:"user_id" binding:"required,min=1" example:"2"` // 对方用户ID
+}
+
+// MessageUnreadResponse 私信未读数响应体
+type MessageUnreadResponse struct {
+	Total int `json:"total" example:"3"` // 全部未读消息数
+}
+
+// MessageReadReceipt 私信已读回执
+type MessageReadReceipt struct {
+	ConversationID int    `json:"conversation_id" example:"1"`           // 会话ID
+	ReaderID       int    `json:"reader_id" example:"2"`                 // 阅读者用户ID
+	LastReadID     int    `json:"last_read_id" example:"100"`            // 已读的最后一条消息ID
+	ReadAt         string `json:"read_at" example:"2024-01-01 00:00:00"` // 阅读时间
+}
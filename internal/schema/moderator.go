@@ -11,6 +11,7 @@ type PostEditRequest struct {
 	ID      int    `json:"id" binding:"required" example:"1"`                           // 帖子ID
 	Title   string `json:"title" binding:"required,min=1,max=100" example:"修改后的标题"`     // 帖子标题
 	Content string `json:"content" binding:"required,min=1,max=10000" example:"修改后的内容"` // 帖子内容
+	Reason  string `json:"reason" binding:"omitempty,max=200" example:"删除违规链接"`         // 编辑原因，记录到修订历史
 }
 
 // PostMoveRequest 移动帖子请求体
@@ -40,6 +41,12 @@ type PostPinRequest struct {
 	Reason string `json:"reason" example:"重要公告"`             // 操作原因
 }
 
+// PostRetagRequest 修改帖子标签请求体
+type PostRetagRequest struct {
+	ID   int      `json:"id" binding:"required" example:"1"`                                 // 帖子ID
+	Tags []string `json:"tags" binding:"omitempty,max=5,dive,min=1,max=32" example:"go,gin"` // 标签列表，传空数组则清空标签
+}
+
 // CategoryEditRequest 编辑版块请求体
 type CategoryEditRequest struct {
 	ID          int    `json:"id" binding:"required" example:"1"`                   // 版块ID
@@ -0,0 +1,70 @@
+package schema
+
+// NotificationListRequest 通知列表请求体
+type NotificationListRequest struct {
+	Page       int    `form:"page" binding:"omitempty,min=1" example:"1"`                                                               // 页码
+	PageSize   int    `form:"page_size" binding:"omitempty,min=1,max=50" example:"20"`                                                  // 每页数量
+	Type       string `form:"type" binding:"omitempty,oneof=Reply CommentReply Mention Like Moderation Balance System" example:"Reply"` // 通知类型
+	UnreadOnly bool   `form:"unread_only" example:"false"`                                                                              // 仅返回未读通知
+}
+
+// NotificationItem 通知列表项
+type NotificationItem struct {
+	ID            int    `json:"id" example:"1"`                                   // 通知ID
+	Type          string `json:"type" example:"Reply"`                             // 通知类型
+	ActorID       int    `json:"actor_id" example:"2"`                             // 触发通知的用户ID，0表示系统
+	ActorUsername string `json:"actor_username" example:"testuser"`                // 触发通知的用户名
+	ActorAvatar   string `json:"actor_avatar" example:"https://example.com/a.jpg"` // 触发通知的用户头像
+	PostID        int    `json:"post_id" example:"1"`                              // 关联帖子ID，0表示无关联
+	PostTitle     string `json:"post_title" example:"帖子标题"`                        // 关联帖子标题
+	CommentID     int    `json:"comment_id" example:"0"`                           // 关联评论ID，0表示无关联
+	Content       string `json:"content" example:"写得很好"`                           // 通知内容
+	IsRead        bool   `json:"is_read" example:"false"`                          // 是否已读
+	CreatedAt     string `json:"created_at" example:"2024-01-01 00:00:00"`         // 通知时间
+}
+
+// NotificationListResponse 通知列表响应体
+type NotificationListResponse struct {
+	List        []NotificationItem `json:"list"`                     // 通知列表
+	Total       int                `json:"total" example:"10"`       // 总数量
+	UnreadCount int                `json:"unread_count" example:"3"` // 未读数量
+	Page        int                `json:"page" example:"1"`         // 当前页码
+	PageSize    int                `json:"page_size" example:"20"`   // 每页数量
+}
+
+// NotificationUnreadResponse 未读通知数响应体
+type NotificationUnreadResponse struct {
+	Total  int            `json:"total" example:"3"` // 未读总数
+	ByType map[string]int `json:"by_type"`           // 按类型统计的未读数
+}
+
+// NotificationReadRequest 标记通知已读请求体
+type NotificationReadRequest struct {
+	IDs []int `json:"ids" binding:"required,min=1,max=100,dive,min=1" example:"1,2"` // 通知ID列表
+}
+
+// NotificationReadAllRequest 全部标记已读请求体
+type NotificationReadAllRequest struct {
+	Type string `json:"type" binding:"omitempty,oneof=Reply CommentReply Mention Like Moderation Balance System" example:"Reply"` // 通知类型，为空时标记全部类型
+}
+
+// NotificationPreferenceItem 通知偏好项
+type NotificationPreferenceItem struct {
+	Type    string `json:"type" binding:"required,oneof=Reply CommentReply Mention Like Moderation Balance" example:"Like"` // 通知类型，系统公告不可关闭
+	Enabled bool   `json:"enabled" example:"true"`                                                                          // 是否接收
+}
+
+// NotificationPreferenceResponse 通知偏好响应体
+type NotificationPreferenceResponse struct {
+	List []NotificationPreferenceItem `json:"list"` // 各类型通知偏好
+}
+
+// NotificationPreferenceUpdateRequest 更新通知偏好请求体
+type NotificationPreferenceUpdateRequest struct {
+	Preferences []NotificationPreferenceItem `json:"preferences" binding:"required,min=1,dive"` // 需要更新的通知偏好
+}
+
+// NotificationAnnouncementRequest 发送系统公告请求体
+type NotificationAnnouncementRequest struct {
+	Content string `json:"content" binding:"required,max=500" example:"系统将于今晚维护"` // 公告内容
+}
@@ -8,8 +8,12 @@ type UserPostCreateRequest struct {
 	Title string `json:"title" binding:"required,min=1,max=200"`
 	// 帖子内容
 	Content string `json:"content" binding:"required,min=1"`
-	// 阅读限制
-	ReadPermission string `json:"read_permission,omitempty"`
+	// 阅读限制：public、login、min_experience:最低经验值、min_level:最低等级、min_role:最低身份、reply_to_view、pay_to_view:价格，默认public
+	ReadPermission string `json:"read_permission,omitempty" binding:"omitempty,max=32"`
+	// 标签列表，最多5个
+	Tags []string `json:"tags" binding:"omitempty,max=5,dive,min=1,max=32"`
+	// 附件ID列表，最多20个，须为当前用户上传且未被使用的附件
+	AttachmentIDs []int `json:"attachment_ids" binding:"omitempty,max=20"`
 }
 
 // UserPostCreateResponse 创建帖子响应
@@ -24,10 +28,20 @@ type UserPostCreateResponse struct {
 	Title string `json:"title"`
 	// 帖子内容
 	Content string `json:"content"`
+	// 帖子内容渲染后的HTML，已经过白名单过滤
+	ContentHTML string `json:"content_html"`
+	// 正文是否因阅读权限被隐藏，隐藏时content为预览内容
+	ContentHidden bool `json:"content_hidden"`
 	// 作者用户名
 	Username string `json:"username"`
+	// 作者等级
+	AuthorLevel *UserLevelBrief `json:"author_level,omitempty"`
+	// 作者选择展示的徽章
+	AuthorBadges []BadgeBrief `json:"author_badges,omitempty"`
 	// 阅读限制
 	ReadPermission string `json:"read_permission,omitempty"`
+	// 标签列表
+	Tags []string `json:"tags"`
 	// 浏览数
 	ViewCount int `json:"view_count"`
 	// 点赞数
@@ -60,8 +74,14 @@ type UserPostUpdateRequest struct {
 	Title string `json:"title" binding:"required,min=1,max=200"`
 	// 帖子内容
 	Content string `json:"content" binding:"required,min=1"`
-	// 阅读限制
-	ReadPermission string `json:"read_permission,omitempty"`
+	// 阅读限制：public、login、min_experience:最低经验值、min_level:最低等级、min_role:最低身份、reply_to_view、pay_to_view:价格，默认public
+	ReadPermission string `json:"read_permission,omitempty" binding:"omitempty,max=32"`
+	// 标签列表，最多5个；不传则保持原标签，传空数组则清空标签
+	Tags []string `json:"tags" binding:"omitempty,max=5,dive,min=1,max=32"`
+	// 附件ID列表，最多20个；不传则保持原附件，传空数组则移除全部附件
+	AttachmentIDs []int `json:"attachment_ids" binding:"omitempty,max=20"`
+	// 编辑原因，记录到修订历史
+	Reason string `json:"reason" binding:"omitempty,max=200"`
 }
 
 // UserPostUpdateResponse 更新帖子响应
@@ -80,6 +100,8 @@ type UserPostUpdateResponse struct {
 	Username string `json:"username"`
 	// 阅读限制
 	ReadPermission string `json:"read_permission,omitempty"`
+	// 标签列表
+	Tags []string `json:"tags"`
 	// 浏览数
 	ViewCount int `json:"view_count"`
 	// 点赞数
@@ -124,10 +146,24 @@ type UserPostActionResponse struct {
 	ActionType string `json:"action_type"`
 }
 
+// UserPostPurchaseResponse 付费阅读购买响应
+type UserPostPurchaseResponse struct {
+	// 帖子ID
+	PostID int `json:"post_id"`
+	// 支付的货币数量
+	Price int `json:"price"`
+	// 购买后剩余货币
+	Currency int `json:"currency"`
+}
+
 // UserPostListRequest 帖子列表请求
 type UserPostListRequest struct {
 	// 版块ID，可选
 	CategoryID int `form:"category_id,omitempty"`
+	// 是否包含子孙版块的帖子，仅指定版块ID时生效
+	IncludeChildren bool `form:"include_children"`
+	// 标签名称，可选，同义词会解析为规范标签
+	Tag string `form:"tag" binding:"omitempty,max=32"`
 	// 页码，默认1
 	Page int `form:"page" binding:"min=1"`
 	// 每页数量，默认20，最大100
@@ -136,6 +172,16 @@ type UserPostListRequest struct {
 	Sort string `form:"sort" binding:"omitempty,oneof=latest hot essence"`
 }
 
+// UserPostHotListRequest 热门帖子列表请求
+type UserPostHotListRequest struct {
+	// 版块ID，可选，为空时返回全站热门
+	CategoryID int `form:"category_id" binding:"omitempty,min=1"`
+	// 页码，默认1
+	Page int `form:"page" binding:"omitempty,min=1"`
+	// 每页数量，默认20，最大100
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
 // UserPostListResponse 帖子列表响应
 type UserPostListResponse struct {
 	// 帖子列表
@@ -168,12 +214,24 @@ type UserPostDetailResponse struct {
 	Title string `json:"title"`
 	// 帖子内容
 	Content string `json:"content"`
+	// 帖子内容渲染后的HTML，已经过白名单过滤
+	ContentHTML string `json:"content_html"`
+	// 正文是否因阅读权限被隐藏，隐藏时content为预览内容
+	ContentHidden bool `json:"content_hidden"`
 	// 作者 ID
 	UserID int `json:"user_id"`
 	// 作者用户名
 	Username string `json:"username"`
+	// 作者等级
+	AuthorLevel *UserLevelBrief `json:"author_level,omitempty"`
+	// 作者选择展示的徽章
+	AuthorBadges []BadgeBrief `json:"author_badges,omitempty"`
 	// 阅读限制
 	ReadPermission string `json:"read_permission,omitempty"`
+	// 标签列表
+	Tags []string `json:"tags"`
+	// 附件列表，正文被隐藏时不返回
+	Attachments []AttachmentItem `json:"attachments,omitempty"`
 	// 浏览数
 	ViewCount int `json:"view_count"`
 	// 点赞数
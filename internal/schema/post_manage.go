@@ -30,6 +30,7 @@ type PostUpdateRequest struct {
 	Content        string `json:"content" binding:"omitempty,min=10" example:"## 技术分享\n这是内容"`                        // 帖子内容
 	ReadPermission string `json:"read_permission" example:"login"`                                                   // 阅读限制
 	Status         string `json:"status" binding:"omitempty,oneof=Normal Locked Draft Private Ban" example:"Normal"` // 帖子状态
+	Reason         string `json:"reason" binding:"omitempty,max=200" example:"删除违规内容"`                               // 编辑原因，修改标题或内容时记录到修订历史
 }
 
 // PostStatusUpdateRequest 更新帖子状态请求体
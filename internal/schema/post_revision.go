@@ -0,0 +1,50 @@
+package schema
+
+// PostRevisionListRequest 帖子修订历史列表请求体
+type PostRevisionListRequest struct {
+	PostID int `form:"post_id" binding:"required" example:"1"` // 帖子ID
+}
+
+// PostRevisionItem 帖子修订版本项
+type PostRevisionItem struct {
+	ID             int    `json:"id" example:"1"`                           // 修订记录ID
+	PostID         int    `json:"post_id" example:"1"`                      // 帖子ID
+	Revision       int    `json:"revision" example:"2"`                     // 修订版本号
+	EditorID       int    `json:"editor_id" example:"1"`                    // 编辑者ID
+	EditorUsername string `json:"editor_username" example:"testuser"`       // 编辑者用户名
+	Title          string `json:"title" example:"帖子标题"`                     // 该版本的标题
+	Content        string `json:"content" example:"帖子内容"`                   // 该版本的正文内容
+	Reason         string `json:"reason" example:"修正错别字"`                   // 编辑原因
+	RollbackFrom   int    `json:"rollback_from" example:"0"`                // 回滚来源版本号，0表示普通编辑
+	CreatedAt      string `json:"created_at" example:"2024-01-01 00:00:00"` // 修订时间
+}
+
+// PostRevisionListResponse 帖子修订历史列表响应体
+type PostRevisionListResponse struct {
+	PostID int                `json:"post_id" example:"1"` // 帖子ID
+	List   []PostRevisionItem `json:"list"`                // 修订版本列表，按版本号降序
+}
+
+// PostRevisionDiffRequest 帖子修订版本对比请求体
+type PostRevisionDiffRequest struct {
+	PostID int `form:"post_id" binding:"required" example:"1"`    // 帖子ID
+	From   int `form:"from" binding:"required,min=1" example:"1"` // 起始版本号
+	To     int `form:"to" binding:"required,min=1" example:"2"`   // 目标版本号
+}
+
+// PostRevisionDiffResponse 帖子修订版本对比响应体
+type PostRevisionDiffResponse struct {
+	PostID      int    `json:"post_id" example:"1"`                                                  // 帖子ID
+	From        int    `json:"from" example:"1"`                                                     // 起始版本号
+	To          int    `json:"to" example:"2"`                                                       // 目标版本号
+	FromTitle   string `json:"from_title" example:"旧标题"`                                             // 起始版本标题
+	ToTitle     string `json:"to_title" example:"新标题"`                                               // 目标版本标题
+	ContentDiff string `json:"content_diff" example:"--- 版本 1\n+++ 版本 2\n@@ -1 +1 @@\n-旧内容\n+新内容\n"` // 正文统一diff，内容相同时为空
+}
+
+// PostRevisionRollbackRequest 帖子回滚请求体
+type PostRevisionRollbackRequest struct {
+	PostID   int    `json:"post_id" binding:"required" example:"1"`                // 帖子ID
+	Revision int    `json:"revision" binding:"required,min=1" example:"1"`         // 回滚到的版本号
+	Reason   string `json:"reason" binding:"omitempty,max=200" example:"恢复被误改的内容"` // 回滚原因
+}
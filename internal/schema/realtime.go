@@ -0,0 +1,24 @@
+package schema
+
+import "encoding/json"
+
+// RealtimeStreamRequest 实时推送订阅请求体
+type RealtimeStreamRequest struct {
+	PostID      int    `form:"post_id" binding:"omitempty,min=1" example:"1"` // 正在浏览的帖子ID，订阅其新评论与点赞数变化
+	LastEventID string `form:"last_event_id" example:"1700000000000-0"`       // 最后收到的事件ID，无法设置Last-Event-ID请求头时使用
+}
+
+// RealtimeEvent 实时推送事件
+type RealtimeEvent struct {
+	ID      string          `json:"id" example:"1700000000000-0"` // 事件ID，断线重连时作为Last-Event-ID
+	Channel string          `json:"channel" example:"post:1"`     // 事件所属频道
+	Event   string          `json:"event" example:"comment"`      // 事件类型：notification、comment、post_stats、comment_stats、message、message_read
+	Data    json.RawMessage `json:"data" swaggertype:"object"`    // 事件数据
+}
+
+// RealtimeCommentEvent 新评论事件数据
+type RealtimeCommentEvent struct {
+	UserCommentCreateResponse
+	UserID   int    `json:"user_id" example:"1"`         // 评论者ID
+	Username string `json:"username" example:"testuser"` // 评论者用户名
+}
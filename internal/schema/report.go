@@ -0,0 +1,65 @@
+package schema
+
+// ReportCreateRequest 提交举报请求体
+type ReportCreateRequest struct {
+	TargetType  string `json:"target_type" binding:"required,oneof=Post Comment User" example:"Post"`                     // 举报目标类型：Post、Comment、User
+	TargetID    int    `json:"target_id" binding:"required" example:"1"`                                                  // 举报目标ID
+	Reason      string `json:"reason" binding:"required,oneof=Spam Abuse Porn Illegal Infringement Other" example:"Spam"` // 举报原因分类
+	Description string `json:"description" binding:"omitempty,max=500" example:"帖子内容为广告推广"`                               // 补充说明
+}
+
+// ReportListRequest 举报列表请求体
+type ReportListRequest struct {
+	Page       int    `form:"page" binding:"required,min=1" example:"1"`                                                // 页码
+	PageSize   int    `form:"page_size" binding:"required,min=1,max=100" example:"20"`                                  // 每页数量
+	Status     string `form:"status" binding:"omitempty,oneof=Pending Processing Resolved Dismissed" example:"Pending"` // 处理状态筛选
+	TargetType string `form:"target_type" binding:"omitempty,oneof=Post Comment User" example:"Post"`                   // 目标类型筛选
+	CategoryID int    `form:"category_id" example:"1"`                                                                  // 版块ID筛选
+}
+
+// ReportItem 举报项
+type ReportItem struct {
+	ID               int    `json:"id" example:"1"`                           // 举报记录ID
+	ReporterID       int    `json:"reporter_id" example:"1"`                  // 举报人ID
+	ReporterUsername string `json:"reporter_username" example:"testuser"`     // 举报人用户名
+	TargetType       string `json:"target_type" example:"Post"`               // 举报目标类型
+	TargetID         int    `json:"target_id" example:"1"`                    // 举报目标ID
+	TargetUserID     int    `json:"target_user_id" example:"2"`               // 被举报内容作者ID
+	TargetUsername   string `json:"target_username" example:"targetuser"`     // 被举报内容作者用户名
+	CategoryID       int    `json:"category_id" example:"1"`                  // 所属版块ID
+	Reason           string `json:"reason" example:"Spam"`                    // 举报原因分类
+	Description      string `json:"description" example:"帖子内容为广告推广"`          // 补充说明
+	Status           string `json:"status" example:"Pending"`                 // 处理状态
+	HandlerID        int    `json:"handler_id" example:"3"`                   // 处理人ID
+	Action           string `json:"action" example:"None"`                    // 处理动作
+	HandleNote       string `json:"handle_note" example:"已核实"`                // 处理备注
+	HandledAt        string `json:"handled_at" example:"2024-01-01 00:00:00"` // 处理时间
+	CreatedAt        string `json:"created_at" example:"2024-01-01 00:00:00"` // 举报时间
+}
+
+// ReportListResponse 举报列表响应体
+type ReportListResponse struct {
+	List     []ReportItem `json:"list"`      // 举报列表
+	Total    int64        `json:"total"`     // 总数量
+	Page     int          `json:"page"`      // 当前页码
+	PageSize int          `json:"page_size"` // 每页数量
+}
+
+// ReportClaimRequest 认领举报请求体
+type ReportClaimRequest struct {
+	ID int `json:"id" binding:"required" example:"1"` // 举报记录ID
+}
+
+// ReportResolveRequest 处理举报请求体
+type ReportResolveRequest struct {
+	ID          int    `json:"id" binding:"required" example:"1"`                                                     // 举报记录ID
+	Action      string `json:"action" binding:"omitempty,oneof=None BanPost DeleteComment BanUser" example:"BanPost"` // 处理动作，默认None
+	BanDuration int64  `json:"ban_duration" binding:"gte=0" example:"86400"`                                          // 封禁用户时长（秒），0表示永久封禁，仅BanUser时有效
+	Note        string `json:"note" binding:"omitempty,max=500" example:"已核实为广告"`                                     // 处理备注
+}
+
+// ReportDismissRequest 驳回举报请求体
+type ReportDismissRequest struct {
+	ID   int    `json:"id" binding:"required" example:"1"`              // 举报记录ID
+	Note string `json:"note" binding:"omitempty,max=500" example:"未违规"` // 驳回原因
+}
@@ -0,0 +1,45 @@
+package schema
+
+// SearchRequest 全文搜索请求
+type SearchRequest struct {
+	// 搜索关键词，支持 "短语"、OR、-排除 等语法
+	Keyword string `form:"keyword" binding:"required,min=1,max=100"`
+	// 搜索类型：post(主题帖)、comment(评论)，默认post
+	Type string `form:"type" binding:"omitempty,oneof=post comment"`
+	// 版块ID，可选
+	CategoryID int `form:"category_id,omitempty"`
+	// 作者ID，可选
+	AuthorID int `form:"author_id,omitempty"`
+	// 开始日期，可选，格式 2006-01-02
+	StartDate string `form:"start_date" binding:"omitempty,datetime=2006-01-02"`
+	// 结束日期，可选，格式 2006-01-02，包含当天
+	EndDate string `form:"end_date" binding:"omitempty,datetime=2006-01-02"`
+	// 页码，默认1
+	Page int `form:"page" binding:"omitempty,min=1"`
+	// 每页数量，默认20，最大50
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=50"`
+}
+
+// SearchResultItem 搜索结果项
+type SearchResultItem struct {
+	Type           string  `json:"type" example:"post"`                         // 结果类型：post、comment
+	PostID         int     `json:"post_id" example:"1"`                         // 帖子ID
+	CommentID      int     `json:"comment_id,omitempty" example:"1"`            // 评论ID，仅评论结果
+	Title          string  `json:"title" example:"技术分享帖"`                       // 帖子标题，已转义并高亮
+	Snippet        string  `json:"snippet" example:"关于<mark>Go</mark>的并发模型..."` // 内容摘要，已转义并高亮
+	ContentHidden  bool    `json:"content_hidden" example:"false"`              // 帖子正文是否因阅读权限被隐藏，隐藏时摘要为正文预览
+	CategoryID     int     `json:"category_id" example:"1"`                     // 版块ID
+	CategoryName   string  `json:"category_name" example:"技术交流"`                // 版块名称
+	AuthorID       int     `json:"author_id" example:"1"`                       // 作者ID
+	AuthorUsername string  `json:"author_username" example:"testuser"`          // 作者用户名
+	Rank           float64 `json:"rank" example:"0.6"`                          // 相关度得分
+	CreatedAt      string  `json:"created_at" example:"2024-01-01 00:00:00"`    // 发布时间
+}
+
+// SearchResponse 全文搜索响应
+type SearchResponse struct {
+	List     []SearchResultItem `json:"list"`      // 搜索结果
+	Total    int                `json:"total"`     // 总数量
+	Page     int                `json:"page"`      // 当前页码
+	PageSize int                `json:"page_size"` // 每页数量
+}
@@ -136,6 +136,16 @@ type SafeSettingsRequest struct {
 	EmailWhitelist string `json:"email_whitelist" binding:"omitempty,max=5000" example:"gmail.com,qq.com,163.com"`
 	// 是否需要验证邮箱
 	VerifyEmail bool `json:"verify_email" example:"true"`
+	// 是否强制版主及以上身份启用两步验证
+	ForceTwoFactor bool `json:"force_two_factor" example:"false"`
+	// 是否开启邀请码注册
+	IsEnableInviteCode bool `json:"is_enable_invite_code" example:"false"`
+	// 每个用户可创建的邀请码总数，已作废或已用尽的邀请码同样计入，0表示不限制
+	InviteCodeUserLimit int `json:"invite_code_user_limit" binding:"min=0" example:"5"`
+	// 每个邀请码可使用次数，0表示不限制
+	InviteCodeMaxUses int `json:"invite_code_max_uses" binding:"min=0" example:"1"`
+	// 发起私信所需的最低经验值，0表示不限制
+	MessageMinExperience int `json:"message_min_experience" binding:"min=0" example:"10"`
 }
 
 // SafeSettingsResponse 安全设置响应体
@@ -148,6 +158,16 @@ type SafeSettingsResponse struct {
 	EmailWhitelist string `json:"email_whitelist" example:"gmail.com,qq.com,163.com"`
 	// 是否需要验证邮箱
 	VerifyEmail bool `json:"verify_email" example:"true"`
+	// 是否强制版主及以上身份启用两步验证
+	ForceTwoFactor bool `json:"force_two_factor" example:"false"`
+	// 是否开启邀请码注册
+	IsEnableInviteCode bool `json:"is_enable_invite_code" example:"false"`
+	// 每个用户可创建的邀请码总数，已作废或已用尽的邀请码同样计入，0表示不限制
+	InviteCodeUserLimit int `json:"invite_code_user_limit" example:"5"`
+	// 每个邀请码可使用次数，0表示不限制
+	InviteCodeMaxUses int `json:"invite_code_max_uses" example:"1"`
+	// 发起私信所需的最低经验值，0表示不限制
+	MessageMinExperience int `json:"message_min_experience" example:"10"`
 }
 
 // SigninSettingsRequest 签到设置请求体
@@ -194,6 +214,54 @@ type SigninSettingsResponse struct {
 	ExperienceReward float64 `json:"experience_reward" example:"1.0"`
 }
 
+// UploadSettingsRequest 上传设置请求体
+type UploadSettingsRequest struct {
+	// 单个附件大小上限（KB）
+	MaxFileSize int `json:"max_file_size" binding:"required,min=1,max=1048576" example:"10240"`
+	// 允许上传的MIME类型，逗号分隔，支持 image/* 形式的通配
+	AllowedMimeTypes string `json:"allowed_mime_types" binding:"required,max=2000" example:"image/*,application/pdf"`
+	// 每个用户的附件总容量（MB），0表示不限制
+	UserQuota int `json:"user_quota" binding:"min=0" example:"1024"`
+}
+
+// UploadSettingsResponse 上传设置响应体
+type UploadSettingsResponse struct {
+	// 单个附件大小上限（KB）
+	MaxFileSize int `json:"max_file_size" example:"10240"`
+	// 允许上传的MIME类型，逗号分隔
+	AllowedMimeTypes string `json:"allowed_mime_types" example:"image/*,application/pdf"`
+	// 每个用户的附件总容量（MB），0表示不限制
+	UserQuota int `json:"user_quota" example:"1024"`
+}
+
+// LevelItem 等级配置项
+type LevelItem struct {
+	// 等级名称
+	Name string `json:"name" binding:"required,min=1,max=20" example:"新手"`
+	// 达到该等级所需的经验值
+	Threshold int `json:"threshold" binding:"min=0" example:"0"`
+	// 等级图标URL
+	Icon string `json:"icon" binding:"omitempty,max=500" example:"https://example.com/level1.png"`
+	// 每日发帖上限，0表示不限制
+	DailyPostLimit int `json:"daily_post_limit" binding:"min=0" example:"10"`
+	// 个性签名是否允许展示链接
+	SignatureLinks bool `json:"signature_links" example:"true"`
+	// 附件总容量（MB），0表示使用上传设置中的用户容量
+	UploadQuota int `json:"upload_quota" binding:"min=0" example:"1024"`
+}
+
+// LevelSettingsRequest 等级设置请求体
+type LevelSettingsRequest struct {
+	// 等级表，按经验值阈值升序排列，第一个等级的阈值必须为0
+	Levels []LevelItem `json:"levels" binding:"required,min=1,max=50,dive"`
+}
+
+// LevelSettingsResponse 等级设置响应体
+type LevelSettingsResponse struct {
+	// 等级表，按经验值阈值升序排列，等级从1开始依次编号
+	Levels []LevelItem `json:"levels"`
+}
+
 // PublicConfigResponse 公开配置响应体（客户端可获取的配置）
 type PublicConfigResponse struct {
 	Routine *RoutineSettingsResponse `json:"routine"`
@@ -202,4 +270,6 @@ type PublicConfigResponse struct {
 	Safe    *SafeSettingsResponse    `json:"safe"`
 	Code    *CodeSettingsResponse    `json:"code"`
 	Comment *CommentSettingsResponse `json:"comment"`
+	Upload  *UploadSettingsResponse  `json:"upload"`
+	Level   *LevelSettingsResponse   `json:"level"`
 }
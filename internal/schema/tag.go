@@ -0,0 +1,61 @@
+package schema
+
+// TagPopularRequest 热门标签请求体
+type TagPopularRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100" example:"20"` // 返回数量，默认20
+}
+
+// TagDetailRequest 标签详情请求体
+type TagDetailRequest struct {
+	Name string `uri:"name" binding:"required,max=32" example:"golang"` // 标签名称，同义词会解析为规范标签
+}
+
+// TagItem 标签项
+type TagItem struct {
+	ID            int      `json:"id" example:"1"`                           // 标签ID
+	Name          string   `json:"name" example:"golang"`                    // 标签名称
+	PostCount     int      `json:"post_count" example:"10"`                  // 关联帖子数
+	CanonicalID   int      `json:"canonical_id" example:"0"`                 // 规范标签ID，0表示本身为规范标签
+	CanonicalName string   `json:"canonical_name,omitempty" example:"go"`    // 规范标签名称，仅同义词返回
+	Synonyms      []string `json:"synonyms,omitempty" example:"go"`          // 同义词列表，仅规范标签返回
+	CreatedAt     string   `json:"created_at" example:"2024-01-01 00:00:00"` // 创建时间
+}
+
+// TagListRequest 标签管理列表请求体
+type TagListRequest struct {
+	Page     int    `form:"page" binding:"required,min=1" example:"1"`               // 页码
+	PageSize int    `form:"page_size" binding:"required,min=1,max=100" example:"20"` // 每页数量
+	Keyword  string `form:"keyword" binding:"omitempty,max=32" example:"go"`         // 名称关键词
+	Synonym  bool   `form:"synonym" example:"false"`                                 // 是否只查询同义词，默认只查询规范标签
+}
+
+// TagListResponse 标签管理列表响应体
+type TagListResponse struct {
+	List     []TagItem `json:"list"`      // 标签列表
+	Total    int64     `json:"total"`     // 总数量
+	Page     int       `json:"page"`      // 当前页码
+	PageSize int       `json:"page_size"` // 每页数量
+}
+
+// TagRenameRequest 重命名标签请求体
+type TagRenameRequest struct {
+	ID   int    `json:"id" binding:"required" example:"1"`                 // 标签ID
+	Name string `json:"name" binding:"required,min=1,max=32" example:"go"` // 新名称
+}
+
+// TagMergeRequest 合并标签请求体
+type TagMergeRequest struct {
+	SourceID int `json:"source_id" binding:"required" example:"2"` // 被合并的标签ID，合并后成为目标标签的同义词
+	TargetID int `json:"target_id" binding:"required" example:"1"` // 目标标签ID
+}
+
+// TagSynonymCreateRequest 添加同义词请求体
+type TagSynonymCreateRequest struct {
+	TagID int    `json:"tag_id" binding:"required" example:"1"`                 // 规范标签ID
+	Name  string `json:"name" binding:"required,min=1,max=32" example:"golang"` // 同义词名称
+}
+
+// TagSynonymDeleteRequest 删除同义词请求体
+type TagSynonymDeleteRequest struct {
+	ID int `uri:"id" binding:"required" example:"2"` // 同义词标签ID
+}
@@ -0,0 +1,48 @@
+package schema
+
+// TwoFactorStatusResponse 两步验证状态响应体
+type TwoFactorStatusResponse struct {
+	Enabled                bool   `json:"enabled" example:"true"`                   // 是否已启用
+	Required               bool   `json:"required" example:"false"`                 // 当前身份是否被强制要求启用
+	RecoveryCodesRemaining int    `json:"recovery_codes_remaining" example:"10"`    // 剩余可用恢复码数量
+	EnabledAt              string `json:"enabled_at" example:"2024-01-01 00:00:00"` // 启用时间
+}
+
+// TwoFactorSetupResponse 两步验证绑定信息响应体
+type TwoFactorSetupResponse struct {
+	Secret          string `json:"secret" example:"JBSWY3DPEHPK3PXP"`                                                            // TOTP密钥，供无法扫码时手动输入
+	ProvisioningURI string `json:"provisioning_uri" example:"otpauth://totp/PokeForum:test@example.com?secret=JBSWY3DPEHPK3PXP"` // 用于生成二维码的otpauth地址
+	ExpiresIn       int    `json:"expires_in" example:"600"`                                                                     // 绑定信息有效期（秒）
+}
+
+// TwoFactorCodeRequest 两步验证码请求体
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required,min=6,max=11" example:"123456"` // 6位动态验证码或恢复码
+}
+
+// TwoFactorEnableRequest 启用两步验证请求体
+type TwoFactorEnableRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric" example:"123456"` // 6位动态验证码
+}
+
+// TwoFactorRecoveryCodesResponse 恢复码响应体
+type TwoFactorRecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes" example:"a1b2c-d3e4f,g5h6i-j7k8l"` // 恢复码，仅展示一次，每个仅可使用一次
+}
+
+// TwoFactorPendingSetupRequest 登录流程中绑定两步验证请求体
+type TwoFactorPendingSetupRequest struct {
+	PendingToken string `json:"pending_token" binding:"required" example:"a1b2c3"` // 待验证登录凭证
+}
+
+// TwoFactorLoginRequest 两步验证登录请求体
+type TwoFactorLoginRequest struct {
+	PendingToken string `json:"pending_token" binding:"required" example:"a1b2c3"`     // 待验证登录凭证
+	Code         string `json:"code" binding:"required,min=6,max=11" example:"123456"` // 6位动态验证码或恢复码
+}
+
+// TwoFactorPendingLogin 待两步验证的登录信息
+type TwoFactorPendingLogin struct {
+	PendingToken  string // 待验证登录凭证
+	SetupRequired bool   // 是否需要先绑定两步验证
+}
@@ -0,0 +1,84 @@
+package schema
+
+// UserGroupListRequest 用户组列表请求体
+type UserGroupListRequest struct {
+	Page     int    `form:"page" binding:"required,min=1" example:"1"`               // 页码
+	PageSize int    `form:"page_size" binding:"required,min=1,max=100" example:"20"` // 每页数量
+	Keyword  string `form:"keyword" binding:"omitempty,max=50" example:"VIP"`        // 名称关键词
+}
+
+// UserGroupCreateRequest 创建用户组请求体
+type UserGroupCreateRequest struct {
+	Name        string   `json:"name" binding:"required,min=1,max=50" example:"VIP"`                            // 用户组名称
+	Description string   `json:"description" binding:"max=200" example:"付费会员用户组"`                               // 用户组描述
+	Permissions []string `json:"permissions" binding:"omitempty,max=50,dive,min=1" example:"attachment.upload"` // 权限标识，以"-"开头表示禁止该权限
+}
+
+// UserGroupUpdateRequest 更新用户组请求体
+type UserGroupUpdateRequest struct {
+	ID          int       `json:"id" binding:"required" example:"1"`                                             // 用户组ID
+	Name        string    `json:"name" binding:"omitempty,min=1,max=50" example:"VIP"`                           // 用户组名称
+	Description string    `json:"description" binding:"omitempty,max=200" example:"付费会员用户组"`                     // 用户组描述
+	Permissions *[]string `json:"permissions" binding:"omitempty,max=50,dive,min=1" example:"attachment.upload"` // 权限标识，不传表示不修改
+}
+
+// UserGroupItem 用户组列表项
+type UserGroupItem struct {
+	ID          int      `json:"id" example:"1"`                           // 用户组ID
+	Name        string   `json:"name" example:"VIP"`                       // 用户组名称
+	Description string   `json:"description" example:"付费会员用户组"`            // 用户组描述
+	Permissions []string `json:"permissions" example:"attachment.upload"`  // 权限标识
+	MemberCount int      `json:"member_count" example:"10"`                // 成员数量
+	CreatedAt   string   `json:"created_at" example:"2024-01-01 00:00:00"` // 创建时间
+	UpdatedAt   string   `json:"updated_at" example:"2024-01-01 00:00:00"` // 更新时间
+}
+
+// UserGroupListResponse 用户组列表响应体
+type UserGroupListResponse struct {
+	List     []UserGroupItem `json:"list"`      // 用户组列表
+	Total    int64           `json:"total"`     // 总数量
+	Page     int             `json:"page"`      // 当前页码
+	PageSize int             `json:"page_size"` // 每页数量
+}
+
+// UserGroupMemberAddRequest 添加用户组成员请求体
+type UserGroupMemberAddRequest struct {
+	GroupID  int   `json:"group_id" binding:"required" example:"1"`    // 用户组ID
+	UserID   int   `json:"user_id" binding:"required" example:"10"`    // 用户ID
+	Duration int64 `json:"duration" binding:"gte=0" example:"2592000"` // 成员资格有效期(秒)，0表示永久
+}
+
+// UserGroupMemberRequest 移除用户组成员请求体
+type UserGroupMemberRequest struct {
+	GroupID int `json:"group_id" binding:"required" example:"1"` // 用户组ID
+	UserID  int `json:"user_id" binding:"required" example:"10"` // 用户ID
+}
+
+// UserGroupMemberListRequest 用户组成员列表请求体
+type UserGroupMemberListRequest struct {
+	GroupID  int `uri:"id" binding:"required" example:"1"`                         // 用户组ID
+	Page     int `form:"page" binding:"omitempty,min=1" example:"1"`               // 页码
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100" example:"20"` // 每页数量
+}
+
+// UserGroupMemberItem 用户组成员列表项
+type UserGroupMemberItem struct {
+	UserID    int    `json:"user_id" example:"10"`                            // 用户ID
+	Username  string `json:"username" example:"testuser"`                     // 用户名
+	Avatar    string `json:"avatar" example:"https://example.com/avatar.jpg"` // 头像
+	ExpiresAt string `json:"expires_at" example:"2024-02-01 00:00:00"`        // 过期时间，为空表示永久
+	CreatedAt string `json:"created_at" example:"2024-01-01 00:00:00"`        // 加入时间
+}
+
+// UserGroupMemberListResponse 用户组成员列表响应体
+type UserGroupMemberListResponse struct {
+	List     []UserGroupMemberItem `json:"list"`      // 成员列表
+	Total    int                   `json:"total"`     // 总数量
+	Page     int                   `json:"page"`      // 当前页码
+	PageSize int                   `json:"page_size"` // 每页数量
+}
+
+// PermissionListResponse 可分配权限列表响应体
+type PermissionListResponse struct {
+	List []string `json:"list" example:"post.create"` // 权限标识列表
+}
@@ -0,0 +1,20 @@
+package schema
+
+// UserLevelInfo 用户等级详情
+type UserLevelInfo struct {
+	Level            int    `json:"level" example:"2"`                             // 当前等级，从1开始
+	Name             string `json:"name" example:"见习"`                             // 等级名称
+	Icon             string `json:"icon" example:"https://example.com/level2.png"` // 等级图标URL
+	Experience       int    `json:"experience" example:"150"`                      // 当前经验值
+	CurrentThreshold int    `json:"current_threshold" example:"100"`               // 当前等级所需经验值
+	NextThreshold    int    `json:"next_threshold" example:"500"`                  // 下一等级所需经验值，已达最高等级时为0
+	Progress         int    `json:"progress" example:"12"`                         // 升级进度百分比，已达最高等级时为100
+	IsMaxLevel       bool   `json:"is_max_level" example:"false"`                  // 是否已达最高等级
+}
+
+// UserLevelBrief 用户等级摘要，用于帖子与评论的作者信息
+type UserLevelBrief struct {
+	Level int    `json:"level" example:"2"`                             // 当前等级，从1开始
+	Name  string `json:"name" example:"见习"`                             // 等级名称
+	Icon  string `json:"icon" example:"https://example.com/level2.png"` // 等级图标URL
+}
@@ -0,0 +1,28 @@
+package schema
+
+// UserOAuthBindingItem 第三方账号绑定项
+type UserOAuthBindingItem struct {
+	ID               int    `json:"id" example:"1"`                                           // 绑定记录ID
+	Provider         string `json:"provider" example:"GitHub"`                                // 第三方提供商
+	ProviderUsername string `json:"provider_username" example:"octocat"`                      // 第三方用户名
+	ProviderAvatar   string `json:"provider_avatar" example:"https://example.com/avatar.jpg"` // 第三方头像
+	CreatedAt        string `json:"created_at" example:"2024-01-01 00:00:00"`                 // 绑定时间
+}
+
+// UserOAuthBindingListResponse 第三方账号绑定列表响应体
+type UserOAuthBindingListResponse struct {
+	List          []UserOAuthBindingItem `json:"list"`                          // 绑定列表
+	PasswordLogin bool                   `json:"password_login" example:"true"` // 是否可使用邮箱密码登录
+}
+
+// UserOAuthBindRequest 绑定第三方账号请求体
+type UserOAuthBindRequest struct {
+	Provider string `json:"-"`                                           // 第三方提供商，取自路径参数
+	Code     string `json:"code" binding:"required" example:"abc123"`    // 授权码
+	State    string `json:"state" binding:"required" example:"c3RhdGU="` // 防CSRF随机串
+}
+
+// UserOAuthUnbindRequest 解绑第三方账号请求体
+type UserOAuthUnbindRequest struct {
+	Provider string `uri:"provider" binding:"required,oneof=QQ GitHub Apple Google Telegram" example:"GitHub"` // 第三方提供商
+}
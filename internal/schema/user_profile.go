@@ -2,20 +2,27 @@ package schema
 
 // UserProfileOverviewResponse 用户个人中心概览响应体
 type UserProfileOverviewResponse struct {
-	ID            int    `json:"id" example:"1"`                                  // 用户ID
-	Username      string `json:"username" example:"testuser"`                     // 用户名
-	Email         string `json:"email" example:"test@example.com"`                // 邮箱
-	Avatar        string `json:"avatar" example:"https://example.com/avatar.jpg"` // 头像URL
-	Signature     string `json:"signature" example:"这是我的个性签名"`                    // 签名
-	Readme        string `json:"readme" example:"# 关于我\n这是我的自我介绍"`                // README
-	EmailVerified bool   `json:"email_verified" example:"true"`                   // 邮箱是否已验证
-	Points        int    `json:"points" example:"100"`                            // 积分
-	Currency      int    `json:"currency" example:"50"`                           // 货币
-	PostCount     int    `json:"post_count" example:"10"`                         // 帖子数
-	CommentCount  int    `json:"comment_count" example:"20"`                      // 评论数
-	Status        string `json:"status" example:"Normal"`                         // 用户状态
-	Role          string `json:"role" example:"User"`                             // 用户身份
-	CreatedAt     string `json:"created_at" example:"2024-01-01 00:00:00"`        // 创建时间
+	ID             int             `json:"id" example:"1"`                                  // 用户ID
+	Username       string          `json:"username" example:"testuser"`                     // 用户名
+	Email          string          `json:"email" example:"test@example.com"`                // 邮箱
+	Avatar         string          `json:"avatar" example:"https://example.com/avatar.jpg"` // 头像URL
+	Signature      string          `json:"signature" example:"这是我的个性签名"`                    // 签名
+	Readme         string          `json:"readme" example:"# 关于我\n这是我的自我介绍"`                // README
+	SignatureHTML  string          `json:"signature_html" example:"<p>这是我的个性签名</p>"`        // 签名渲染后的HTML
+	ReadmeHTML     string          `json:"readme_html" example:"<h1>关于我</h1>"`              // README渲染后的HTML
+	EmailVerified  bool            `json:"email_verified" example:"true"`                   // 邮箱是否已验证
+	Points         int             `json:"points" example:"100"`                            // 积分
+	Currency       int             `json:"currency" example:"50"`                           // 货币
+	Experience     int             `json:"experience" example:"150"`                        // 经验值
+	Level          *UserLevelInfo  `json:"level"`                                           // 等级信息
+	Badges         []UserBadgeItem `json:"badges"`                                          // 获得的徽章
+	PostCount      int             `json:"post_count" example:"10"`                         // 帖子数
+	CommentCount   int             `json:"comment_count" example:"20"`                      // 评论数
+	FollowerCount  int             `json:"follower_count" example:"30"`                     // 粉丝数
+	FollowingCount int             `json:"following_count" example:"15"`                    // 关注用户数
+	Status         string          `json:"status" example:"Normal"`                         // 用户状态
+	Role           string          `json:"role" example:"User"`                             // 用户身份
+	CreatedAt      string          `json:"created_at" example:"2024-01-01 00:00:00"`        // 创建时间
 }
 
 // UserProfileOverviewRequest 用户个人中心概览请求体
@@ -124,13 +131,15 @@ type UserUpdatePasswordResponse struct {
 
 // UserUpdateAvatarRequest 修改头像请求体
 type UserUpdateAvatarRequest struct {
-	AvatarURL string `json:"avatar_url" binding:"required,url" example:"https://example.com/avatar.jpg"` // 头像URL
+	AvatarURL    string `json:"avatar_url" binding:"required_without=AttachmentID,omitempty,url" example:"https://example.com/avatar.jpg"` // 头像URL
+	AttachmentID int    `json:"attachment_id" binding:"omitempty,min=1" example:"1"`                                                       // 已上传的图片附件ID，优先于avatar_url，服务端将裁剪为48/96/256像素
 }
 
 // UserUpdateAvatarResponse 修改头像响应体
 type UserUpdateAvatarResponse struct {
-	Success   bool   `json:"success" example:"true"`                              // 是否成功
-	AvatarURL string `json:"avatar_url" example:"https://example.com/avatar.jpg"` // 新的头像URL
+	Success    bool   `json:"success" example:"true"`                              // 是否成功
+	AvatarURL  string `json:"avatar_url" example:"https://example.com/avatar.jpg"` // 新的头像URL
+	Processing bool   `json:"processing" example:"false"`                          // 头像是否正在裁剪，完成后头像URL将更新为256像素版本
 }
 
 // UserUpdateUsernameRequest 修改用户名请求体
@@ -0,0 +1,37 @@
+package schema
+
+// UserSessionItem 登录会话项
+type UserSessionItem struct {
+	SessionID  string `json:"session_id" example:"9f86d081884c7d65"`      // 会话ID
+	Device     string `json:"device" example:"Mozilla/5.0"`               // 设备信息，取自登录时的UA
+	IPAddress  string `json:"ip_address" example:"127.0.0.1"`             // 最近访问IP
+	Country    string `json:"country" example:"CN"`                       // IP国家
+	City       string `json:"city" example:"Shanghai"`                    // IP城市
+	LoginAt    string `json:"login_at" example:"2024-01-01 00:00:00"`     // 登录时间
+	LastSeenAt string `json:"last_seen_at" example:"2024-01-01 00:00:00"` // 最后活跃时间
+	Current    bool   `json:"current" example:"true"`                     // 是否为当前请求所用会话
+}
+
+// UserSessionRevokeRequest 注销会话请求体
+type UserSessionRevokeRequest struct {
+	SessionID  string `uri:"session_id" binding:"required" example:"9f86d081884c7d65"` // 会话ID
+	OperatorID int    `uri:"-" json:"-"`                                               // 操作者ID（内部使用）
+}
+
+// UserSessionRevokeResponse 批量注销会话响应体
+type UserSessionRevokeResponse struct {
+	Revoked int `json:"revoked" example:"2"` // 注销的会话数量
+}
+
+// UserSessionManageRequest 管理员查看用户会话请求体
+type UserSessionManageRequest struct {
+	UserID     int `uri:"id" binding:"required" example:"1"` // 用户ID
+	OperatorID int `uri:"-" json:"-"`                        // 操作者ID（内部使用）
+}
+
+// UserSessionManageRevokeRequest 管理员注销用户会话请求体
+type UserSessionManageRevokeRequest struct {
+	UserID     int    `uri:"id" binding:"required" example:"1"`                        // 用户ID
+	SessionID  string `uri:"session_id" binding:"required" example:"9f86d081884c7d65"` // 会话ID
+	OperatorID int    `uri:"-" json:"-"`                                               // 操作者ID（内部使用）
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"github.com/go-webauthn/webauthn/protocol"
+)
+
+// WebAuthnRegisterOptionsResponse 通行密钥注册选项响应体
+type WebAuthnRegisterOptionsResponse struct {
+	Options *protocol.CredentialCreation `json:"options"` // 传给 navigator.credentials.create 的选项
+}
+
+// WebAuthnRegisterRequest 通行密钥注册请求体
+type WebAuthnRegisterRequest struct {
+	Name       string          `json:"name" binding:"omitempty,max=64" example:"我的笔记本"`    // 凭证名称
+	Credential json.RawMessage `json:"credential" binding:"required" swaggertype:"object"` // navigator.credentials.create 返回的凭证
+}
+
+// WebAuthnLoginOptionsResponse 通行密钥登录选项响应体
+type WebAuthnLoginOptionsResponse struct {
+	SessionID string                        `json:"session_id" example:"a1b2c3"` // 登录会话ID，完成登录时回传
+	Options   *protocol.CredentialAssertion `json:"options"`                     // 传给 navigator.credentials.get 的选项
+}
+
+// WebAuthnLoginRequest 通行密钥登录请求体
+type WebAuthnLoginRequest struct {
+	SessionID  string          `json:"session_id" binding:"required" example:"a1b2c3"`     // 登录会话ID
+	Credential json.RawMessage `json:"credential" binding:"required" swaggertype:"object"` // navigator.credentials.get 返回的断言
+}
+
+// WebAuthnCredentialItem 通行密钥凭证项
+type WebAuthnCredentialItem struct {
+	ID           int      `json:"id" example:"1"`                             // 凭证记录ID
+	Name         string   `json:"name" example:"我的笔记本"`                       // 凭证名称
+	Transports   []string `json:"transports" example:"internal,hybrid"`       // 传输方式
+	CloneWarning bool     `json:"clone_warning" example:"false"`              // 是否存在克隆警告
+	LastUsedAt   string   `json:"last_used_at" example:"2024-01-01 00:00:00"` // 最后使用时间
+	CreatedAt    string   `json:"created_at" example:"2024-01-01 00:00:00"`   // 注册时间
+}
+
+// WebAuthnCredentialDeleteRequest 删除通行密钥请求体
+type WebAuthnCredentialDeleteRequest struct {
+	ID int `uri:"id" binding:"required" example:"1"` // 凭证记录ID
+}
@@ -0,0 +1,558 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gabriel-vasile/mimetype"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/attachment"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/storage"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/utils"
+)
+
+const (
+	// attachmentFileURLPrefix 服务端附件下载接口地址前缀，存储驱动未配置公开地址时使用
+	attachmentFileURLPrefix = "/api/v1/attachments/file/"
+	// attachmentKeyPrefix 附件在存储驱动中的键名前缀
+	attachmentKeyPrefix = "attachments"
+	// maxAttachmentFilenameLength 附件文件名最大字符数
+	maxAttachmentFilenameLength = 255
+	// maxAttachmentsPerTarget 单个帖子或评论可关联的附件数量上限
+	maxAttachmentsPerTarget = 20
+)
+
+// IAttachmentService 附件服务接口
+type IAttachmentService interface {
+	// Upload 上传附件，校验大小、类型与用户容量，相同内容的文件只存储一份
+	Upload(ctx context.Context, userID int, filename string, reader io.Reader, size int64) (*schema.AttachmentItem, error)
+	// GetMyAttachments 获取我的附件列表
+	GetMyAttachments(ctx context.Context, userID int, req schema.AttachmentListRequest) (*schema.AttachmentListResponse, error)
+	// DeleteAttachment 删除附件，存储对象不再被引用时一并删除
+	DeleteAttachment(ctx context.Context, userID int, req schema.AttachmentDeleteRequest) error
+	// OpenFile 根据存储键名打开附件文件，调用方负责关闭返回的读取器
+	OpenFile(ctx context.Context, key string) (io.ReadCloser, *schema.AttachmentFile, error)
+}
+
+// AttachmentService 附件服务实现
+type AttachmentService struct {
+	db              *ent.Client
+	storage         storage.IStorage
+	settingsService ISettingsService
+	imageTask       *ImageProcessTask
+	logger          *zap.Logger
+}
+
+// NewAttachmentService 创建附件服务实例
+func NewAttachmentService(db *ent.Client, store storage.IStorage, settingsService ISettingsService, imageTask *ImageProcessTask, logger *zap.Logger) IAttachmentService {
+	return &AttachmentService{
+		db:              db,
+		storage:         store,
+		settingsService: settingsService,
+		imageTask:       imageTask,
+		logger:          logger,
+	}
+}
+
+// Upload 上传附件
+func (s *AttachmentService) Upload(ctx context.Context, userID int, filename string, reader io.Reader, size int64) (*schema.AttachmentItem, error) {
+	s.logger.Info("上传附件", zap.Int("user_id", userID), zap.String("filename", filename), zap.Int64("size", size), tracing.WithTraceIDField(ctx))
+
+	// 检查用户状态
+	userData, err := s.db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldStatus).
+		Only(ctx)
+	if err != nil {
+		s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	if userData.Status == user.StatusMute || userData.Status == user.StatusBlocked {
+		return nil, errors.New("您的账号状态异常，无法上传附件")
+	}
+
+	uploadSettings, err := s.settingsService.GetUploadSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maxSize := int64(uploadSettings.MaxFileSize) * 1024
+	quota := s.userQuota(ctx, userID, uploadSettings)
+
+	// 请求声明的大小可提前拦截，实际大小在读取时再次校验
+	if size > maxSize {
+		return nil, fmt.Errorf("附件大小不能超过%dKB", uploadSettings.MaxFileSize)
+	}
+	if err = s.checkQuota(ctx, userID, size, quota); err != nil {
+		return nil, err
+	}
+
+	// 写入临时文件并计算哈希，超过大小上限时立即停止读取
+	tmp, err := os.CreateTemp("", "pokeforum-upload-*")
+	if err != nil {
+		s.logger.Error("创建临时文件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建临时文件失败: %w", err)
+	}
+	defer func() {
+		_ = tmp.Close()           //nolint:errcheck // 临时文件关闭失败无需处理
+		_ = os.Remove(tmp.Name()) //nolint:errcheck // 临时文件删除失败无需处理
+	}()
+
+	var written int64
+	hasher := sha256.New()
+	counter := utils.NewCallbackReader(io.LimitReader(reader, maxSize+1), func(n int64) {
+		written += n
+	})
+	if _, err = io.Copy(io.MultiWriter(tmp, hasher), counter); err != nil {
+		s.logger.Error("读取上传文件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("读取上传文件失败: %w", err)
+	}
+	if written == 0 {
+		return nil, errors.New("附件内容为空")
+	}
+	if written > maxSize {
+		return nil, fmt.Errorf("附件大小不能超过%dKB", uploadSettings.MaxFileSize)
+	}
+	if err = s.checkQuota(ctx, userID, written, quota); err != nil {
+		return nil, err
+	}
+
+	// 根据文件内容识别类型，不信任客户端声明的类型与扩展名
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("读取上传文件失败: %w", err)
+	}
+	mtype, err := mimetype.DetectReader(tmp)
+	if err != nil {
+		s.logger.Error("识别文件类型失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("识别文件类型失败: %w", err)
+	}
+	if !isMimeTypeAllowed(mtype, uploadSettings.AllowedMimeTypes) {
+		return nil, fmt.Errorf("不支持上传该类型的文件: %s", baseMimeType(mtype.String()))
+	}
+	mimeType := baseMimeType(mtype.String())
+	hash := hex.EncodeToString(hasher.Sum(nil))
+
+	// 相同内容的文件复用已有存储对象
+	storageKey := fmt.Sprintf("%s/%s/%s/%s%s", attachmentKeyPrefix, hash[:2], hash[2:4], hash, mtype.Extension())
+	needUpload := true
+	existing, err := s.db.Attachment.Query().
+		Where(attachment.HashEQ(hash)).
+		First(ctx)
+	switch {
+	case err == nil:
+		storageKey = existing.StorageKey
+		exists, err := s.storage.Exists(ctx, storageKey)
+		if err != nil {
+			s.logger.Warn("检查存储对象失败，将重新上传", zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+		needUpload = !exists
+	case !ent.IsNotFound(err):
+		s.logger.Error("查询附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询附件失败: %w", err)
+	}
+
+	if needUpload {
+		if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("读取上传文件失败: %w", err)
+		}
+		if err = s.storage.Put(ctx, storageKey, tmp, written, mimeType); err != nil {
+			s.logger.Error("保存附件失败", zap.String("key", storageKey), zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("保存附件失败: %w", err)
+		}
+	}
+
+	create := s.db.Attachment.Create().
+		SetUserID(userID).
+		SetFilename(sanitizeAttachmentFilename(filename, mtype.Extension())).
+		SetHash(hash).
+		SetStorageKey(storageKey).
+		SetMimeType(mimeType).
+		SetSize(written)
+	// 复用已处理的图片结果
+	needProcess := strings.HasPrefix(mimeType, "image/")
+	if !needUpload && existing.ThumbnailKey != "" {
+		create = create.
+			SetSize(existing.Size).
+			SetThumbnailKey(existing.ThumbnailKey).
+			SetWidth(existing.Width).
+			SetHeight(existing.Height)
+		needProcess = false
+	}
+	newAttachment, err := create.Save(ctx)
+	if err != nil {
+		s.logger.Error("创建附件记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建附件记录失败: %w", err)
+	}
+
+	// 图片异步清理元数据并生成缩略图，提交失败不影响上传结果
+	if needProcess {
+		if err = s.imageTask.SubmitAttachmentTask(ctx, storageKey); err != nil {
+			s.logger.Warn("提交图片处理任务失败", zap.Int("attachment_id", newAttachment.ID), zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+	}
+
+	item := toAttachmentItem(newAttachment, s.storage)
+	s.logger.Info("上传附件成功", zap.Int("attachment_id", item.ID), zap.Bool("deduplicated", !needUpload), tracing.WithTraceIDField(ctx))
+	return &item, nil
+}
+
+// GetMyAttachments 获取我的附件列表
+func (s *AttachmentService) GetMyAttachments(ctx context.Context, userID int, req schema.AttachmentListRequest) (*schema.AttachmentListResponse, error) {
+	s.logger.Info("获取我的附件列表", zap.Int("user_id", userID), zap.Int("page", req.Page), tracing.WithTraceIDField(ctx))
+
+	// 设置默认值
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+
+	query := s.db.Attachment.Query().
+		Where(attachment.UserIDEQ(userID))
+	if req.Unbound {
+		query = query.Where(attachment.PostIDEQ(0), attachment.CommentIDEQ(0))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取附件总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取附件总数失败: %w", err)
+	}
+
+	attachments, err := query.
+		Order(ent.Desc(attachment.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取附件列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取附件列表失败: %w", err)
+	}
+
+	used, err := s.usedBytes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	uploadSettings, err := s.settingsService.GetUploadSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]schema.AttachmentItem, 0, len(attachments))
+	for _, a := range attachments {
+		list = append(list, toAttachmentItem(a, s.storage))
+	}
+
+	return &schema.AttachmentListResponse{
+		List:       list,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		UsedBytes:  used,
+		QuotaBytes: s.userQuota(ctx, userID, uploadSettings),
+	}, nil
+}
+
+// userQuota 获取用户附件配额（字节），用户等级配置了上传配额时优先使用等级配额
+func (s *AttachmentService) userQuota(ctx context.Context, userID int, uploadSettings *schema.UploadSettingsResponse) int64 {
+	quota := int64(uploadSettings.UserQuota) * 1024 * 1024
+	perks, err := getUserLevelPerks(ctx, s.db, s.settingsService, s.logger, userID)
+	if err != nil {
+		s.logger.Warn("获取用户等级权益失败，使用默认配额", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return quota
+	}
+	if perks.UploadQuota > 0 {
+		quota = int64(perks.UploadQuota) * 1024 * 1024
+	}
+	return quota
+}
+
+// DeleteAttachment 删除附件
+func (s *AttachmentService) DeleteAttachment(ctx context.Context, userID int, req schema.AttachmentDeleteRequest) error {
+	s.logger.Info("删除附件", zap.Int("user_id", userID), zap.Int("attachment_id", req.ID), tracing.WithTraceIDField(ctx))
+
+	attachmentData, err := s.db.Attachment.Query().
+		Where(attachment.IDEQ(req.ID), attachment.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("附件不存在")
+		}
+		s.logger.Error("获取附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("获取附件失败: %w", err)
+	}
+
+	if err = s.db.Attachment.DeleteOneID(attachmentData.ID).Exec(ctx); err != nil {
+		s.logger.Error("删除附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("删除附件失败: %w", err)
+	}
+
+	// 存储对象不再被其他附件引用时删除
+	referenced, err := s.db.Attachment.Query().
+		Where(attachment.StorageKeyEQ(attachmentData.StorageKey)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Warn("检查存储对象引用失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil
+	}
+	if !referenced {
+		if err = s.storage.Delete(ctx, attachmentData.StorageKey); err != nil {
+			// 附件记录已删除，存储对象删除失败不影响结果
+			s.logger.Warn("删除存储对象失败", zap.String("key", attachmentData.StorageKey), zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+		if attachmentData.ThumbnailKey != "" {
+			if err = s.storage.Delete(ctx, attachmentData.ThumbnailKey); err != nil {
+				s.logger.Warn("删除缩略图失败", zap.String("key", attachmentData.ThumbnailKey), zap.Error(err), tracing.WithTraceIDField(ctx))
+			}
+		}
+	}
+
+	s.logger.Info("删除附件成功", zap.Int("attachment_id", req.ID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// OpenFile 根据存储键名打开附件文件
+func (s *AttachmentService) OpenFile(ctx context.Context, key string) (io.ReadCloser, *schema.AttachmentFile, error) {
+	if err := storage.ValidateKey(key); err != nil {
+		return nil, nil, errors.New("附件不存在")
+	}
+
+	// 缩略图与头像为图片处理任务生成的派生文件，没有对应的附件记录
+	if strings.HasPrefix(key, thumbnailKeyPrefix+"/") || strings.HasPrefix(key, avatarKeyPrefix+"/") {
+		reader, err := s.storage.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, nil, errors.New("附件不存在")
+			}
+			s.logger.Error("读取附件失败", zap.String("key", key), zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, nil, fmt.Errorf("读取附件失败: %w", err)
+		}
+		return reader, &schema.AttachmentFile{
+			Filename: path.Base(key),
+			MimeType: "image/jpeg",
+			Size:     -1,
+		}, nil
+	}
+
+	// 仅允许访问已登记的附件
+	attachmentData, err := s.db.Attachment.Query().
+		Where(attachment.StorageKeyEQ(key)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, errors.New("附件不存在")
+		}
+		s.logger.Error("获取附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, nil, fmt.Errorf("获取附件失败: %w", err)
+	}
+
+	reader, err := s.storage.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, errors.New("附件不存在")
+		}
+		s.logger.Error("读取附件失败", zap.String("key", key), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, nil, fmt.Errorf("读取附件失败: %w", err)
+	}
+
+	return reader, &schema.AttachmentFile{
+		Filename: attachmentData.Filename,
+		MimeType: attachmentData.MimeType,
+		Size:     attachmentData.Size,
+	}, nil
+}
+
+// checkQuota 检查用户附件容量，quota为0表示不限制
+func (s *AttachmentService) checkQuota(ctx context.Context, userID int, size, quota int64) error {
+	if quota <= 0 {
+		return nil
+	}
+	used, err := s.usedBytes(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if used+size > quota {
+		return fmt.Errorf("附件容量不足，已使用%dMB，上限%dMB", used/1024/1024, quota/1024/1024)
+	}
+	return nil
+}
+
+// usedBytes 统计用户已使用的附件容量（字节）
+func (s *AttachmentService) usedBytes(ctx context.Context, userID int) (int64, error) {
+	used, err := s.db.Attachment.Query().
+		Where(attachment.UserIDEQ(userID)).
+		Aggregate(func(sel *entsql.Selector) string {
+			return fmt.Sprintf("COALESCE(%s, 0)", entsql.Sum(sel.C(attachment.FieldSize)))
+		}).
+		Int(ctx)
+	if err != nil {
+		s.logger.Error("统计附件容量失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return 0, fmt.Errorf("统计附件容量失败: %w", err)
+	}
+	return int64(used), nil
+}
+
+// isMimeTypeAllowed 判断文件类型是否在允许列表中，支持 image/* 形式的通配
+func isMimeTypeAllowed(mtype *mimetype.MIME, allowed string) bool {
+	detected := baseMimeType(mtype.String())
+	for _, item := range strings.Split(allowed, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item == "" {
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(item, "/*"); ok {
+			if strings.HasPrefix(detected, prefix+"/") {
+				return true
+			}
+			continue
+		}
+		if mtype.Is(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// baseMimeType 去除MIME类型中的参数部分，如 text/plain; charset=utf-8
+func baseMimeType(mimeType string) string {
+	base, _, _ := strings.Cut(mimeType, ";")
+	return strings.ToLower(strings.TrimSpace(base))
+}
+
+// sanitizeAttachmentFilename 清理客户端提交的文件名，仅保留基础名称并限制长度
+func sanitizeAttachmentFilename(filename, ext string) string {
+	filename = strings.TrimSpace(filepath.Base(strings.ReplaceAll(filename, "\\", "/")))
+	if filename == "" || filename == "." || filename == "/" {
+		filename = "file" + ext
+	}
+	if runes := []rune(filename); len(runes) > maxAttachmentFilenameLength {
+		filename = string(runes[:maxAttachmentFilenameLength])
+	}
+	return filename
+}
+
+// isInlineImage 判断附件是否为可在浏览器内直接展示的图片
+func isInlineImage(mimeType string) bool {
+	switch mimeType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return true
+	default:
+		return false
+	}
+}
+
+// toAttachmentItem 转换附件为响应项
+func toAttachmentItem(a *ent.Attachment, store storage.IStorage) schema.AttachmentItem {
+	item := schema.AttachmentItem{
+		ID:        a.ID,
+		Filename:  a.Filename,
+		MimeType:  a.MimeType,
+		Size:      a.Size,
+		IsImage:   isInlineImage(a.MimeType),
+		URL:       storageURL(store, a.StorageKey),
+		Width:     a.Width,
+		Height:    a.Height,
+		PostID:    a.PostID,
+		CommentID: a.CommentID,
+		CreatedAt: a.CreatedAt.Format(time_tools.DateTimeFormat),
+	}
+	if a.ThumbnailKey != "" {
+		item.ThumbnailURL = storageURL(store, a.ThumbnailKey)
+	}
+	return item
+}
+
+// storageURL 获取存储对象的访问地址，存储驱动未配置公开地址时通过附件下载接口访问
+func storageURL(store storage.IStorage, key string) string {
+	if url := store.URL(key); url != "" {
+		return url
+	}
+	return attachmentFileURLPrefix + key
+}
+
+// bindAttachments 将用户未关联的附件关联到帖子或评论，附件不属于该用户或已被关联时返回错误
+func bindAttachments(ctx context.Context, tx *ent.Tx, userID int, ids []int, postID, commentID int) error {
+	ids = uniqueInts(ids)
+	if len(ids) == 0 {
+		return nil
+	}
+	if len(ids) > maxAttachmentsPerTarget {
+		return fmt.Errorf("附件数量不能超过%d个", maxAttachmentsPerTarget)
+	}
+
+	n, err := tx.Attachment.Update().
+		Where(
+			attachment.IDIn(ids...),
+			attachment.UserIDEQ(userID),
+			attachment.PostIDEQ(0),
+			attachment.CommentIDEQ(0),
+		).
+		SetPostID(postID).
+		SetCommentID(commentID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("关联附件失败: %w", err)
+	}
+	if n != len(ids) {
+		return errors.New("附件不存在或已被使用")
+	}
+	return nil
+}
+
+// setPostAttachments 重新设置帖子正文的附件，未保留的附件解除关联后可再次使用
+func setPostAttachments(ctx context.Context, tx *ent.Tx, userID, postID int, ids []int) error {
+	if _, err := tx.Attachment.Update().
+		Where(attachment.PostIDEQ(postID), attachment.CommentIDEQ(0)).
+		SetPostID(0).
+		Save(ctx); err != nil {
+		return fmt.Errorf("解除附件关联失败: %w", err)
+	}
+	return bindAttachments(ctx, tx, userID, ids, postID, 0)
+}
+
+// getAttachmentItems 查询附件并按所属帖子或评论分组，keyFn返回分组键
+func getAttachmentItems(ctx context.Context, client *ent.Client, store storage.IStorage, keyFn func(*ent.Attachment) int, ps ...predicate.Attachment) (map[int][]schema.AttachmentItem, error) {
+	attachments, err := client.Attachment.Query().
+		Where(ps...).
+		Order(ent.Asc(attachment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询附件失败: %w", err)
+	}
+
+	result := make(map[int][]schema.AttachmentItem)
+	for _, a := range attachments {
+		key := keyFn(a)
+		result[key] = append(result[key], toAttachmentItem(a, store))
+	}
+	return result, nil
+}
+
+// uniqueInts 去除重复的整数，保持原有顺序
+func uniqueInts(values []int) []int {
+	seen := make(map[int]bool, len(values))
+	result := make([]int, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -91,34 +91,11 @@ func (s *AuthService) Register(ctx context.Context, req schema.RegisterRequest)
 		return nil, fmt.Errorf("查询邮箱失败: %w", err)
 	}
 
-	// 检查邮箱白名单设置
-	isEnableEmailWhitelist, err := s.settings.GetSettingByKey(ctx, _const.SafeIsEnableEmailWhitelist, _const.SettingBoolFalse.String())
-	if err != nil {
-		s.logger.Error("查询邮箱白名单设置失败", tracing.WithTraceIDField(ctx), zap.Error(err))
-		return nil, fmt.Errorf("查询邮箱白名单设置失败: %w", err)
+	// 检查邮箱白名单
+	if err = checkEmailWhitelist(ctx, s.settings, s.logger, req.Email); err != nil {
+		return nil, err
 	}
 
-	// 如果开启了邮箱白名单，则进行白名单验证
-	if isEnableEmailWhitelist == _const.SettingBoolTrue.String() {
-		// 获取邮箱白名单列表
-		emailWhitelist, err := s.settings.GetSettingByKey(ctx, _const.SafeEmailWhitelist, "")
-		if err != nil {
-			s.logger.Error("查询邮箱白名单失败", tracing.WithTraceIDField(ctx), zap.Error(err))
-			return nil, fmt.Errorf("查询邮箱白名单失败: %w", err)
-		}
-
-		// 提取邮箱域名
-		emailDomain := utils.ExtractEmailDomain(req.Email)
-		if emailDomain == "" {
-			return nil, errors.New("邮箱格式无效")
-		}
-
-		// 验证邮箱域名是否在白名单中
-		if !isEmailDomainInWhitelist(emailDomain, emailWhitelist) {
-			s.logger.Warn("邮箱域名不在白名单中", tracing.WithTraceIDField(ctx), zap.String("email", req.Email), zap.String("domain", emailDomain))
-			return nil, errors.New("邮箱域名不在允许注册的白名单中")
-		}
-	}
 	// 生成密码盐
 	pwdSalt := utils.GeneratePasswordSalt()
 
@@ -132,18 +109,68 @@ func (s *AuthService) Register(ctx context.Context, req schema.RegisterRequest)
 		return nil, fmt.Errorf("密码加密失败: %w", err)
 	}
 
+	// 检查是否开启邀请码注册
+	inviteRequired, err := isInviteCodeRequired(ctx, s.settings, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// 开启数据库事务，保证邀请码消耗、用户创建与邀请关系记录同时生效
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", tracing.WithTraceIDField(ctx), zap.Error(err))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	// 校验并消耗邀请码
+	var inviteCode *ent.InviteCode
+	if inviteRequired {
+		inviteCode, err = consumeInviteCode(ctx, tx, req.InviteCode)
+		if err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Warn("邀请码校验失败", tracing.WithTraceIDField(ctx), zap.Error(err))
+			return nil, err
+		}
+	}
+
 	// 创建用户
-	newUser, err := s.db.User.Create().
+	newUser, err := tx.User.Create().
 		SetUsername(req.Username).
 		SetEmail(req.Email).
 		SetPassword(hashedPassword).
 		SetPasswordSalt(pwdSalt).
 		Save(ctx)
 	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
 		s.logger.Error("创建用户失败", tracing.WithTraceIDField(ctx), zap.Error(err))
 		return nil, fmt.Errorf("创建用户失败: %w", err)
 	}
 
+	// 记录邀请关系
+	if inviteCode != nil {
+		_, err = tx.UserInvitation.Create().
+			SetInviterID(inviteCode.UserID).
+			SetInviteeID(newUser.ID).
+			SetInviteCodeID(inviteCode.ID).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Error("记录邀请关系失败", tracing.WithTraceIDField(ctx), zap.Error(err))
+			return nil, fmt.Errorf("记录邀请关系失败: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", tracing.WithTraceIDField(ctx), zap.Error(err))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
 	return newUser, nil
 }
 
@@ -162,18 +189,9 @@ func (s *AuthService) Login(ctx context.Context, req schema.LoginRequest) (*ent.
 		return nil, fmt.Errorf("查询用户失败: %w", err)
 	}
 
-	// 检查封禁-长期
-	if u.Status == user.StatusBlocked {
-		return nil, errors.New("账户已被锁定使用")
-	}
-
-	// 检查封禁-短期
-	if isDisabled := stputil.IsDisable(u.ID); isDisabled {
-		remainingTime, err := stputil.GetDisableTime(u.ID) // 查询剩余时间, 单位（秒）
-		if err != nil {
-			return nil, errors.New("账户已被限制使用")
-		}
-		return nil, fmt.Errorf("账户已被限制使用, 解除时间: %s", time_tools.CalculateRemainingTime(remainingTime))
+	// 检查封禁状态
+	if err = checkUserLoginStatus(u); err != nil {
+		return nil, err
 	}
 
 	// 拼接密码和盐
@@ -187,6 +205,25 @@ func (s *AuthService) Login(ctx context.Context, req schema.LoginRequest) (*ent.
 	return u, nil
 }
 
+// checkUserLoginStatus 检查用户是否允许登录
+func checkUserLoginStatus(u *ent.User) error {
+	// 检查封禁-长期
+	if u.Status == user.StatusBlocked {
+		return errors.New("账户已被锁定使用")
+	}
+
+	// 检查封禁-短期
+	if isDisabled := stputil.IsDisable(u.ID); isDisabled {
+		remainingTime, err := stputil.GetDisableTime(u.ID) // 查询剩余时间, 单位（秒）
+		if err != nil {
+			return errors.New("账户已被限制使用")
+		}
+		return fmt.Errorf("账户已被限制使用, 解除时间: %s", time_tools.CalculateRemainingTime(remainingTime))
+	}
+
+	return nil
+}
+
 // GetUserByID 根据ID获取用户
 func (s *AuthService) GetUserByID(ctx context.Context, id int) (*ent.User, error) {
 	u, err := s.db.User.Get(ctx, id)
@@ -200,6 +237,49 @@ func (s *AuthService) GetUserByID(ctx context.Context, id int) (*ent.User, error
 	return u, nil
 }
 
+// checkEmailWhitelist 开启邮箱白名单时校验邮箱域名，供账号注册与第三方注册共用
+// 第三方未提供邮箱时生成的占位邮箱无法证明归属，开启白名单时一律拒绝
+func checkEmailWhitelist(ctx context.Context, settings ISettingsService, logger *zap.Logger, email string) error {
+	// 检查邮箱白名单设置
+	isEnableEmailWhitelist, err := settings.GetSettingByKey(ctx, _const.SafeIsEnableEmailWhitelist, _const.SettingBoolFalse.String())
+	if err != nil {
+		logger.Error("查询邮箱白名单设置失败", tracing.WithTraceIDField(ctx), zap.Error(err))
+		return fmt.Errorf("查询邮箱白名单设置失败: %w", err)
+	}
+
+	// 未开启邮箱白名单，直接通过
+	if isEnableEmailWhitelist != _const.SettingBoolTrue.String() {
+		return nil
+	}
+
+	// 获取邮箱白名单列表
+	emailWhitelist, err := settings.GetSettingByKey(ctx, _const.SafeEmailWhitelist, "")
+	if err != nil {
+		logger.Error("查询邮箱白名单失败", tracing.WithTraceIDField(ctx), zap.Error(err))
+		return fmt.Errorf("查询邮箱白名单失败: %w", err)
+	}
+
+	// 提取邮箱域名
+	emailDomain := utils.ExtractEmailDomain(email)
+	if emailDomain == "" {
+		return errors.New("邮箱格式无效")
+	}
+
+	// 占位邮箱不参与白名单匹配
+	if strings.EqualFold(emailDomain, oauthPlaceholderEmailDomain) {
+		logger.Warn("第三方账号未提供邮箱，无法通过邮箱白名单校验", tracing.WithTraceIDField(ctx))
+		return errors.New("第三方账号未提供邮箱，当前仅允许白名单邮箱注册")
+	}
+
+	// 验证邮箱域名是否在白名单中
+	if !isEmailDomainInWhitelist(emailDomain, emailWhitelist) {
+		logger.Warn("邮箱域名不在白名单中", tracing.WithTraceIDField(ctx), zap.String("email", email), zap.String("domain", emailDomain))
+		return errors.New("邮箱域名不在允许注册的白名单中")
+	}
+
+	return nil
+}
+
 // isEmailDomainInWhitelist 检查邮箱域名是否在白名单中
 // emailDomain: 要检查的域名，如 "gmail.com"
 // whitelist: 白名单字符串，用英文逗号分隔域名，如 "gmail.com,qq.com"
@@ -0,0 +1,662 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/badge"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/ent/postaction"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/userbadge"
+	"github.com/PokeForum/PokeForum/ent/usersigninstatus"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// maxDisplayBadges 用户名旁最多展示的徽章数量
+const maxDisplayBadges = 3
+
+// IBadgeService 徽章服务接口
+type IBadgeService interface {
+	// GetBadgeList 获取徽章列表
+	GetBadgeList(ctx context.Context, req schema.BadgeListRequest) (*schema.BadgeListResponse, error)
+	// CreateBadge 创建徽章
+	CreateBadge(ctx context.Context, req schema.BadgeCreateRequest) (*schema.BadgeItem, error)
+	// UpdateBadge 更新徽章
+	UpdateBadge(ctx context.Context, req schema.BadgeUpdateRequest) (*schema.BadgeItem, error)
+	// DeleteBadge 删除徽章，同时收回全部用户已获得的该徽章
+	DeleteBadge(ctx context.Context, id int) error
+	// AwardBadge 手动授予用户徽章
+	AwardBadge(ctx context.Context, operatorID int, req schema.BadgeAwardRequest) error
+	// RevokeBadge 收回用户徽章
+	RevokeBadge(ctx context.Context, req schema.BadgeRevokeRequest) error
+	// GetUserBadges 获取用户获得的全部徽章
+	GetUserBadges(ctx context.Context, userID int) (*schema.UserBadgeListResponse, error)
+	// SetDisplayBadges 设置展示在用户名旁的徽章
+	SetDisplayBadges(ctx context.Context, userID int, req schema.UserBadgeDisplayRequest) error
+	// EvaluateUserBadges 按条件类型评估用户是否满足徽章获得条件，返回新授予的徽章数量
+	// value: 事件携带的指标值，目前仅签到事件携带连续签到天数
+	EvaluateUserBadges(ctx context.Context, userID int, criteria badge.Criteria, value int) (int, error)
+}
+
+// BadgeService 徽章服务实现
+type BadgeService struct {
+	db     *ent.Client
+	logger *zap.Logger
+}
+
+// NewBadgeService 创建徽章服务实例
+func NewBadgeService(db *ent.Client, logger *zap.Logger) IBadgeService {
+	return &BadgeService{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// toBadgeItem 转换为徽章列表项
+func (s *BadgeService) toBadgeItem(b *ent.Badge, holderCount int) *schema.BadgeItem {
+	return &schema.BadgeItem{
+		ID:          b.ID,
+		Name:        b.Name,
+		Description: b.Description,
+		Icon:        b.Icon,
+		Criteria:    string(b.Criteria),
+		Threshold:   b.Threshold,
+		Enabled:     b.Enabled,
+		HolderCount: holderCount,
+		CreatedAt:   b.CreatedAt.Format(time_tools.DateTimeFormat),
+		UpdatedAt:   b.UpdatedAt.Format(time_tools.DateTimeFormat),
+	}
+}
+
+// validateBadgeThreshold 校验徽章获得条件，自动授予的徽章阈值必须大于0
+func validateBadgeThreshold(criteria badge.Criteria, threshold int) error {
+	if criteria != badge.CriteriaManual && threshold <= 0 {
+		return errors.New("自动授予的徽章阈值必须大于0")
+	}
+	return nil
+}
+
+// loadDisplayBadges 批量获取用户展示在用户名旁的徽章
+func loadDisplayBadges(ctx context.Context, db *ent.Client, userIDs []int) (map[int][]schema.BadgeBrief, error) {
+	result := make(map[int][]schema.BadgeBrief)
+	userIDs = uniqueInts(userIDs)
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	userBadges, err := db.UserBadge.Query().
+		Where(userbadge.UserIDIn(userIDs...), userbadge.DisplayOrderGT(0)).
+		Order(ent.Asc(userbadge.FieldUserID), ent.Asc(userbadge.FieldDisplayOrder)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询展示徽章失败: %w", err)
+	}
+	if len(userBadges) == 0 {
+		return result, nil
+	}
+
+	badgeIDs := make([]int, len(userBadges))
+	for i, ub := range userBadges {
+		badgeIDs[i] = ub.BadgeID
+	}
+	badges, err := db.Badge.Query().
+		Where(badge.IDIn(uniqueInts(badgeIDs)...)).
+		Select(badge.FieldID, badge.FieldName, badge.FieldIcon).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询徽章信息失败: %w", err)
+	}
+	badgeMap := make(map[int]*ent.Badge, len(badges))
+	for _, b := range badges {
+		badgeMap[b.ID] = b
+	}
+
+	for _, ub := range userBadges {
+		b, ok := badgeMap[ub.BadgeID]
+		if !ok {
+			continue
+		}
+		result[ub.UserID] = append(result[ub.UserID], schema.BadgeBrief{
+			ID:   b.ID,
+			Name: b.Name,
+			Icon: b.Icon,
+		})
+	}
+	return result, nil
+}
+
+// GetBadgeList 获取徽章列表
+func (s *BadgeService) GetBadgeList(ctx context.Context, req schema.BadgeListRequest) (*schema.BadgeListResponse, error) {
+	s.logger.Info("获取徽章列表", zap.Int("page", req.Page), zap.Int("page_size", req.PageSize), zap.String("keyword", req.Keyword), tracing.WithTraceIDField(ctx))
+
+	query := s.db.Badge.Query()
+	if req.Keyword != "" {
+		query = query.Where(badge.NameContains(req.Keyword))
+	}
+	if req.Criteria != "" {
+		query = query.Where(badge.CriteriaEQ(badge.Criteria(req.Criteria)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取徽章总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取徽章总数失败: %w", err)
+	}
+
+	badges, err := query.
+		Order(ent.Asc(badge.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取徽章列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取徽章列表失败: %w", err)
+	}
+
+	// 批量统计获得人数
+	badgeIDs := make([]int, len(badges))
+	for i, b := range badges {
+		badgeIDs[i] = b.ID
+	}
+	var rows []struct {
+		BadgeID int `json:"badge_id"`
+		Count   int `json:"count"`
+	}
+	if len(badgeIDs) > 0 {
+		if err = s.db.UserBadge.Query().
+			Where(userbadge.BadgeIDIn(badgeIDs...)).
+			GroupBy(userbadge.FieldBadgeID).
+			Aggregate(ent.Count()).
+			Scan(ctx, &rows); err != nil {
+			s.logger.Error("统计徽章获得人数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("统计徽章获得人数失败: %w", err)
+		}
+	}
+	countMap := make(map[int]int, len(rows))
+	for _, row := range rows {
+		countMap[row.BadgeID] = row.Count
+	}
+
+	list := make([]schema.BadgeItem, 0, len(badges))
+	for _, b := range badges {
+		list = append(list, *s.toBadgeItem(b, countMap[b.ID]))
+	}
+
+	return &schema.BadgeListResponse{
+		List:     list,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// CreateBadge 创建徽章
+func (s *BadgeService) CreateBadge(ctx context.Context, req schema.BadgeCreateRequest) (*schema.BadgeItem, error) {
+	s.logger.Info("创建徽章", zap.String("name", req.Name), zap.String("criteria", req.Criteria), tracing.WithTraceIDField(ctx))
+
+	criteria := badge.Criteria(req.Criteria)
+	if err := validateBadgeThreshold(criteria, req.Threshold); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.db.Badge.Query().
+		Where(badge.NameEQ(req.Name)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查徽章名称失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("检查徽章名称失败: %w", err)
+	}
+	if exists {
+		return nil, errors.New("徽章名称已存在")
+	}
+
+	create := s.db.Badge.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetIcon(req.Icon).
+		SetCriteria(criteria).
+		SetThreshold(req.Threshold)
+	if req.Enabled != nil {
+		create = create.SetEnabled(*req.Enabled)
+	}
+	b, err := create.Save(ctx)
+	if err != nil {
+		s.logger.Error("创建徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建徽章失败: %w", err)
+	}
+
+	s.logger.Info("徽章创建成功", zap.Int("id", b.ID), tracing.WithTraceIDField(ctx))
+	return s.toBadgeItem(b, 0), nil
+}
+
+// UpdateBadge 更新徽章
+// 修改获得条件不会收回已授予的徽章
+func (s *BadgeService) UpdateBadge(ctx context.Context, req schema.BadgeUpdateRequest) (*schema.BadgeItem, error) {
+	s.logger.Info("更新徽章", zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
+
+	existing, err := s.db.Badge.Get(ctx, req.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("徽章不存在")
+		}
+		s.logger.Error("获取徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取徽章失败: %w", err)
+	}
+
+	// 如果要更新名称，检查是否与其他徽章冲突
+	if req.Name != "" && req.Name != existing.Name {
+		exists, err := s.db.Badge.Query().
+			Where(badge.NameEQ(req.Name), badge.IDNEQ(req.ID)).
+			Exist(ctx)
+		if err != nil {
+			s.logger.Error("检查徽章名称失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("检查徽章名称失败: %w", err)
+		}
+		if exists {
+			return nil, errors.New("徽章名称已存在")
+		}
+	}
+
+	criteria := existing.Criteria
+	if req.Criteria != "" {
+		criteria = badge.Criteria(req.Criteria)
+	}
+	threshold := existing.Threshold
+	if req.Threshold != nil {
+		threshold = *req.Threshold
+	}
+	if err = validateBadgeThreshold(criteria, threshold); err != nil {
+		return nil, err
+	}
+
+	update := s.db.Badge.UpdateOneID(req.ID).
+		SetCriteria(criteria).
+		SetThreshold(threshold)
+	if req.Name != "" {
+		update = update.SetName(req.Name)
+	}
+	if req.Description != nil {
+		update = update.SetDescription(*req.Description)
+	}
+	if req.Icon != nil {
+		update = update.SetIcon(*req.Icon)
+	}
+	if req.Enabled != nil {
+		update = update.SetEnabled(*req.Enabled)
+	}
+	b, err := update.Save(ctx)
+	if err != nil {
+		s.logger.Error("更新徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("更新徽章失败: %w", err)
+	}
+
+	holderCount, err := s.db.UserBadge.Query().
+		Where(userbadge.BadgeIDEQ(b.ID)).
+		Count(ctx)
+	if err != nil {
+		s.logger.Error("统计徽章获得人数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("统计徽章获得人数失败: %w", err)
+	}
+
+	s.logger.Info("徽章更新成功", zap.Int("id", b.ID), tracing.WithTraceIDField(ctx))
+	return s.toBadgeItem(b, holderCount), nil
+}
+
+// DeleteBadge 删除徽章
+func (s *BadgeService) DeleteBadge(ctx context.Context, id int) error {
+	s.logger.Info("删除徽章", zap.Int("id", id), tracing.WithTraceIDField(ctx))
+
+	exists, err := s.db.Badge.Query().
+		Where(badge.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("检查徽章失败: %w", err)
+	}
+	if !exists {
+		return errors.New("徽章不存在")
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	if _, err = tx.UserBadge.Delete().
+		Where(userbadge.BadgeIDEQ(id)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("收回用户徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("收回用户徽章失败: %w", err)
+	}
+
+	if err = tx.Badge.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("删除徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("删除徽章失败: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	s.logger.Info("徽章删除成功", zap.Int("id", id), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// AwardBadge 手动授予用户徽章
+// 手动授予不受徽章启用状态与获得条件限制
+func (s *BadgeService) AwardBadge(ctx context.Context, operatorID int, req schema.BadgeAwardRequest) error {
+	s.logger.Info("手动授予徽章", zap.Int("badge_id", req.BadgeID), zap.Int("user_id", req.UserID), zap.Int("operator_id", operatorID), tracing.WithTraceIDField(ctx))
+
+	exists, err := s.db.Badge.Query().
+		Where(badge.IDEQ(req.BadgeID)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("检查徽章失败: %w", err)
+	}
+	if !exists {
+		return errors.New("徽章不存在")
+	}
+
+	exists, err = s.db.User.Query().
+		Where(user.IDEQ(req.UserID)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("检查用户失败: %w", err)
+	}
+	if !exists {
+		return errors.New("用户不存在")
+	}
+
+	if err = s.db.UserBadge.Create().
+		SetUserID(req.UserID).
+		SetBadgeID(req.BadgeID).
+		SetSource(userbadge.SourceManual).
+		SetGrantedBy(operatorID).
+		SetReason(req.Reason).
+		Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return errors.New("该用户已拥有该徽章")
+		}
+		s.logger.Error("授予徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("授予徽章失败: %w", err)
+	}
+
+	s.logger.Info("徽章授予成功", zap.Int("badge_id", req.BadgeID), zap.Int("user_id", req.UserID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// RevokeBadge 收回用户徽章
+func (s *BadgeService) RevokeBadge(ctx context.Context, req schema.BadgeRevokeRequest) error {
+	s.logger.Info("收回用户徽章", zap.Int("badge_id", req.BadgeID), zap.Int("user_id", req.UserID), tracing.WithTraceIDField(ctx))
+
+	deleted, err := s.db.UserBadge.Delete().
+		Where(userbadge.UserIDEQ(req.UserID), userbadge.BadgeIDEQ(req.BadgeID)).
+		Exec(ctx)
+	if err != nil {
+		s.logger.Error("收回用户徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("收回用户徽章失败: %w", err)
+	}
+	if deleted == 0 {
+		return errors.New("该用户未拥有该徽章")
+	}
+
+	s.logger.Info("用户徽章收回成功", zap.Int("badge_id", req.BadgeID), zap.Int("user_id", req.UserID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// GetUserBadges 获取用户获得的全部徽章
+func (s *BadgeService) GetUserBadges(ctx context.Context, userID int) (*schema.UserBadgeListResponse, error) {
+	s.logger.Info("获取用户徽章", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	userBadges, err := s.db.UserBadge.Query().
+		Where(userbadge.UserIDEQ(userID)).
+		Order(ent.Desc(userbadge.FieldID)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取用户徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户徽章失败: %w", err)
+	}
+
+	list := make([]schema.UserBadgeItem, 0, len(userBadges))
+	if len(userBadges) == 0 {
+		return &schema.UserBadgeListResponse{List: list}, nil
+	}
+
+	badgeIDs := make([]int, len(userBadges))
+	for i, ub := range userBadges {
+		badgeIDs[i] = ub.BadgeID
+	}
+	badges, err := s.db.Badge.Query().
+		Where(badge.IDIn(badgeIDs...)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取徽章信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取徽章信息失败: %w", err)
+	}
+	badgeMap := make(map[int]*ent.Badge, len(badges))
+	for _, b := range badges {
+		badgeMap[b.ID] = b
+	}
+
+	for _, ub := range userBadges {
+		b, ok := badgeMap[ub.BadgeID]
+		if !ok {
+			continue
+		}
+		list = append(list, schema.UserBadgeItem{
+			ID:           b.ID,
+			Name:         b.Name,
+			Description:  b.Description,
+			Icon:         b.Icon,
+			Source:       string(ub.Source),
+			Reason:       ub.Reason,
+			DisplayOrder: ub.DisplayOrder,
+			AwardedAt:    ub.CreatedAt.Format(time_tools.DateTimeFormat),
+		})
+	}
+
+	return &schema.UserBadgeListResponse{List: list}, nil
+}
+
+// SetDisplayBadges 设置展示在用户名旁的徽章
+// 按请求中的顺序展示，未包含的徽章取消展示
+func (s *BadgeService) SetDisplayBadges(ctx context.Context, userID int, req schema.UserBadgeDisplayRequest) error {
+	s.logger.Info("设置展示徽章", zap.Int("user_id", userID), zap.Ints("badge_ids", req.BadgeIDs), tracing.WithTraceIDField(ctx))
+
+	if len(req.BadgeIDs) > maxDisplayBadges {
+		return fmt.Errorf("最多展示%d个徽章", maxDisplayBadges)
+	}
+	if len(uniqueInts(req.BadgeIDs)) != len(req.BadgeIDs) {
+		return errors.New("展示的徽章不能重复")
+	}
+
+	if len(req.BadgeIDs) > 0 {
+		owned, err := s.db.UserBadge.Query().
+			Where(userbadge.UserIDEQ(userID), userbadge.BadgeIDIn(req.BadgeIDs...)).
+			Count(ctx)
+		if err != nil {
+			s.logger.Error("检查用户徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("检查用户徽章失败: %w", err)
+		}
+		if owned != len(req.BadgeIDs) {
+			return errors.New("只能展示已获得的徽章")
+		}
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	if err = tx.UserBadge.Update().
+		Where(userbadge.UserIDEQ(userID), userbadge.DisplayOrderGT(0)).
+		SetDisplayOrder(0).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("重置展示徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("重置展示徽章失败: %w", err)
+	}
+
+	for i, badgeID := range req.BadgeIDs {
+		if err = tx.UserBadge.Update().
+			Where(userbadge.UserIDEQ(userID), userbadge.BadgeIDEQ(badgeID)).
+			SetDisplayOrder(i + 1).
+			Exec(ctx); err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Error("设置展示徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("设置展示徽章失败: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	s.logger.Info("展示徽章设置成功", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// EvaluateUserBadges 按条件类型评估用户是否满足徽章获得条件
+// 只评估已启用且用户尚未获得的徽章，新建徽章会在用户下次触发对应事件时评估
+func (s *BadgeService) EvaluateUserBadges(ctx context.Context, userID int, criteria badge.Criteria, value int) (int, error) {
+	s.logger.Info("评估用户徽章", zap.Int("user_id", userID), zap.String("criteria", string(criteria)), tracing.WithTraceIDField(ctx))
+
+	if criteria == badge.CriteriaManual {
+		return 0, nil
+	}
+
+	badges, err := s.db.Badge.Query().
+		Where(badge.CriteriaEQ(criteria), badge.EnabledEQ(true)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("查询徽章失败: %w", err)
+	}
+	if len(badges) == 0 {
+		return 0, nil
+	}
+
+	badgeIDs := make([]int, len(badges))
+	for i, b := range badges {
+		badgeIDs[i] = b.ID
+	}
+	owned, err := s.db.UserBadge.Query().
+		Where(userbadge.UserIDEQ(userID), userbadge.BadgeIDIn(badgeIDs...)).
+		Select(userbadge.FieldBadgeID).
+		Ints(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("查询用户徽章失败: %w", err)
+	}
+	badges = slices.DeleteFunc(badges, func(b *ent.Badge) bool {
+		return slices.Contains(owned, b.ID)
+	})
+	if len(badges) == 0 {
+		return 0, nil
+	}
+
+	metric, err := s.badgeMetric(ctx, userID, criteria, value)
+	if err != nil {
+		return 0, err
+	}
+
+	awarded := 0
+	for _, b := range badges {
+		if metric < b.Threshold {
+			continue
+		}
+		if err = s.db.UserBadge.Create().
+			SetUserID(userID).
+			SetBadgeID(b.ID).
+			SetSource(userbadge.SourceAuto).
+			Exec(ctx); err != nil {
+			// 并发事件可能已授予该徽章
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			return awarded, fmt.Errorf("授予徽章失败: %w", err)
+		}
+		awarded++
+		s.logger.Info("用户获得徽章", zap.Int("user_id", userID), zap.Int("badge_id", b.ID), zap.String("name", b.Name), tracing.WithTraceIDField(ctx))
+	}
+	return awarded, nil
+}
+
+// badgeMetric 统计用户在指定条件类型下的指标值
+func (s *BadgeService) badgeMetric(ctx context.Context, userID int, criteria badge.Criteria, value int) (int, error) {
+	switch criteria {
+	case badge.CriteriaPostCount:
+		count, err := s.db.Post.Query().
+			Where(post.UserIDEQ(userID), post.StatusNotIn(post.StatusDraft, post.StatusBan)).
+			Count(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("统计发帖数失败: %w", err)
+		}
+		return count, nil
+	case badge.CriteriaLikesReceived:
+		postIDs, err := s.db.Post.Query().
+			Where(post.UserIDEQ(userID)).
+			IDs(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("查询用户帖子失败: %w", err)
+		}
+		if len(postIDs) == 0 {
+			return 0, nil
+		}
+		count, err := s.db.PostAction.Query().
+			Where(postaction.PostIDIn(postIDs...), postaction.ActionTypeEQ(postaction.ActionTypeLike)).
+			Count(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("统计获赞数失败: %w", err)
+		}
+		return count, nil
+	case badge.CriteriaSigninStreak:
+		// 签到状态异步落库，优先使用事件携带的连续签到天数
+		status, err := s.db.UserSigninStatus.Query().
+			Where(usersigninstatus.UserIDEQ(int64(userID))).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return 0, fmt.Errorf("查询签到状态失败: %w", err)
+		}
+		if status != nil {
+			value = max(value, status.ContinuousDays)
+		}
+		return value, nil
+	case badge.CriteriaEssenceCount:
+		count, err := s.db.Post.Query().
+			Where(post.UserIDEQ(userID), post.IsEssenceEQ(true)).
+			Count(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("统计精华帖数失败: %w", err)
+		}
+		return count, nil
+	default:
+		return 0, nil
+	}
+}
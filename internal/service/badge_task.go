@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/badge"
+	"github.com/PokeForum/PokeForum/ent/post"
+	pkgasynq "github.com/PokeForum/PokeForum/internal/pkg/asynq"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+)
+
+// BadgeTask 徽章评估异步任务
+// 发帖、获赞、签到与设置精华等事件只提交任务，徽章条件的统计与授予在任务中完成
+type BadgeTask struct {
+	db           *ent.Client
+	logger       *zap.Logger
+	badgeService IBadgeService
+	taskManager  *pkgasynq.TaskManager
+}
+
+// BadgeTaskPayload 徽章评估任务载荷
+type BadgeTaskPayload struct {
+	UserID   int            `json:"user_id"`  // 被评估的用户ID，为0时由PostID解析帖子作者
+	PostID   int            `json:"post_id"`  // 触发事件的帖子ID
+	Criteria badge.Criteria `json:"criteria"` // 需要评估的条件类型
+	Value    int            `json:"value"`    // 事件携带的指标值，目前仅签到事件携带连续签到天数
+	TraceID  string         `json:"trace_id"` // 用于链路追踪
+}
+
+// NewBadgeTask 创建徽章评估异步任务
+func NewBadgeTask(db *ent.Client, taskManager *pkgasynq.TaskManager, logger *zap.Logger) *BadgeTask {
+	return &BadgeTask{
+		db:           db,
+		logger:       logger,
+		badgeService: NewBadgeService(db, logger),
+		taskManager:  taskManager,
+	}
+}
+
+// RegisterHandler 注册任务处理器到TaskManager
+func (t *BadgeTask) RegisterHandler() {
+	t.taskManager.RegisterHandlerFunc(pkgasynq.TypeBadgeEvaluate, t.HandleTask)
+	t.logger.Info("徽章评估任务处理器已注册")
+}
+
+// Submit 提交徽章评估任务
+// 徽章属于附属功能，提交失败只记录日志；未注入任务时（如统计同步任务中）直接忽略
+func (t *BadgeTask) Submit(ctx context.Context, payload BadgeTaskPayload) {
+	if t == nil || (payload.UserID == 0 && payload.PostID == 0) {
+		return
+	}
+	payload.TraceID = tracing.GetTraceID(ctx)
+
+	data, err := json.Marshal(&payload)
+	if err != nil {
+		t.logger.Error("序列化徽章评估任务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return
+	}
+
+	task := asynq.NewTask(pkgasynq.TypeBadgeEvaluate, data, asynq.MaxRetry(3), asynq.Queue(pkgasynq.QueueLow))
+	info, err := t.taskManager.EnqueueContext(ctx, task)
+	if err != nil {
+		t.logger.Error("提交徽章评估任务失败", zap.String("criteria", string(payload.Criteria)), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return
+	}
+
+	t.logger.Debug("提交徽章评估任务成功", zap.String("criteria", string(payload.Criteria)), zap.String("task_id", info.ID), tracing.WithTraceIDField(ctx))
+}
+
+// HandleTask 处理徽章评估任务
+func (t *BadgeTask) HandleTask(ctx context.Context, task *asynq.Task) error {
+	var payload BadgeTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		t.logger.Error("反序列化徽章评估任务失败", zap.Error(err))
+		return fmt.Errorf("反序列化失败: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.TraceID != "" {
+		ctx = tracing.WithTraceID(ctx, payload.TraceID)
+	}
+
+	return t.Evaluate(ctx, payload)
+}
+
+// Evaluate 解析被评估的用户并执行徽章评估
+func (t *BadgeTask) Evaluate(ctx context.Context, payload BadgeTaskPayload) error {
+	userID := payload.UserID
+	if userID == 0 {
+		postData, err := t.db.Post.Query().
+			Where(post.IDEQ(payload.PostID)).
+			Select(post.FieldUserID).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("获取帖子失败: %w", err)
+		}
+		userID = postData.UserID
+	}
+
+	awarded, err := t.badgeService.EvaluateUserBadges(ctx, userID, payload.Criteria, payload.Value)
+	if err != nil {
+		t.logger.Error("评估用户徽章失败", zap.Int("user_id", userID), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return err
+	}
+
+	t.logger.Debug("用户徽章评估完成", zap.Int("user_id", userID), zap.Int("awarded", awarded), tracing.WithTraceIDField(ctx))
+	return nil
+}
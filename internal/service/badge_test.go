@@ -0,0 +1,229 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/badge"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/ent/postaction"
+	"github.com/PokeForum/PokeForum/ent/userbadge"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// newTestPost 创建测试帖子
+func newTestPost(t *testing.T, db *ent.Client, userID int, status post.Status, essence bool) *ent.Post {
+	t.Helper()
+
+	return db.Post.Create().
+		SetUserID(userID).
+		SetCategoryID(1).
+		SetTitle("帖子").
+		SetContent("内容").
+		SetStatus(status).
+		SetIsEssence(essence).
+		SaveX(t.Context())
+}
+
+func TestEvaluateUserBadges(t *testing.T) {
+	tests := []struct {
+		name      string
+		criteria  badge.Criteria
+		threshold int
+		value     int
+		setup     func(t *testing.T, db *ent.Client, userID int)
+		want      int
+	}{
+		{
+			name:      "首次发帖",
+			criteria:  badge.CriteriaPostCount,
+			threshold: 1,
+			setup: func(t *testing.T, db *ent.Client, userID int) {
+				newTestPost(t, db, userID, post.StatusNormal, false)
+			},
+			want: 1,
+		},
+		{
+			name:      "草稿与封禁帖子不计入发帖数",
+			criteria:  badge.CriteriaPostCount,
+			threshold: 1,
+			setup: func(t *testing.T, db *ent.Client, userID int) {
+				newTestPost(t, db, userID, post.StatusDraft, false)
+				newTestPost(t, db, userID, post.StatusBan, false)
+			},
+		},
+		{
+			name:      "获赞数达到阈值",
+			criteria:  badge.CriteriaLikesReceived,
+			threshold: 2,
+			setup: func(t *testing.T, db *ent.Client, userID int) {
+				p := newTestPost(t, db, userID, post.StatusNormal, false)
+				for _, name := range []string{"fan1", "fan2"} {
+					fan := newTestUser(t, db, name, name+"@example.com")
+					db.PostAction.Create().SetUserID(fan.ID).SetPostID(p.ID).SetActionType(postaction.ActionTypeLike).ExecX(t.Context())
+				}
+			},
+			want: 1,
+		},
+		{
+			name:      "点踩不计入获赞数",
+			criteria:  badge.CriteriaLikesReceived,
+			threshold: 2,
+			setup: func(t *testing.T, db *ent.Client, userID int) {
+				p := newTestPost(t, db, userID, post.StatusNormal, false)
+				fan := newTestUser(t, db, "fan", "fan@example.com")
+				db.PostAction.Create().SetUserID(fan.ID).SetPostID(p.ID).SetActionType(postaction.ActionTypeLike).ExecX(t.Context())
+				db.PostAction.Create().SetUserID(fan.ID).SetPostID(p.ID).SetActionType(postaction.ActionTypeDislike).ExecX(t.Context())
+			},
+		},
+		{
+			name:      "事件携带的连续签到天数达到阈值",
+			criteria:  badge.CriteriaSigninStreak,
+			threshold: 30,
+			value:     30,
+			want:      1,
+		},
+		{
+			name:      "取事件与签到状态中较大的连续签到天数",
+			criteria:  badge.CriteriaSigninStreak,
+			threshold: 30,
+			value:     29,
+			setup: func(t *testing.T, db *ent.Client, userID int) {
+				db.UserSigninStatus.Create().SetUserID(int64(userID)).SetLastSigninDate(time.Now()).SetContinuousDays(30).SetTotalDays(30).ExecX(t.Context())
+			},
+			want: 1,
+		},
+		{
+			name:      "连续签到天数不足",
+			criteria:  badge.CriteriaSigninStreak,
+			threshold: 30,
+			value:     29,
+		},
+		{
+			name:      "帖子被设为精华",
+			criteria:  badge.CriteriaEssenceCount,
+			threshold: 1,
+			setup: func(t *testing.T, db *ent.Client, userID int) {
+				newTestPost(t, db, userID, post.StatusNormal, true)
+			},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			svc := NewBadgeService(db, zap.NewNop())
+			u := newTestUser(t, db, "member", "member@example.com")
+			b := db.Badge.Create().SetName("徽章").SetCriteria(tt.criteria).SetThreshold(tt.threshold).SaveX(t.Context())
+			if tt.setup != nil {
+				tt.setup(t, db, u.ID)
+			}
+
+			awarded, err := svc.EvaluateUserBadges(t.Context(), u.ID, tt.criteria, tt.value)
+			if err != nil {
+				t.Fatalf("评估徽章失败: %v", err)
+			}
+			if awarded != tt.want {
+				t.Fatalf("应授予 %d 个徽章，实际 %d 个", tt.want, awarded)
+			}
+			if tt.want == 0 {
+				return
+			}
+
+			ub := db.UserBadge.Query().Where(userbadge.UserIDEQ(u.ID), userbadge.BadgeIDEQ(b.ID)).OnlyX(t.Context())
+			if ub.Source != userbadge.SourceAuto {
+				t.Fatalf("自动授予的徽章来源应为 auto，实际 %s", ub.Source)
+			}
+			// 已获得的徽章不会重复授予
+			if awarded, err = svc.EvaluateUserBadges(t.Context(), u.ID, tt.criteria, tt.value); err != nil || awarded != 0 {
+				t.Fatalf("重复评估不应再次授予徽章，实际 %d 个: %v", awarded, err)
+			}
+		})
+	}
+}
+
+func TestEvaluateUserBadgesSkipsDisabledAndManual(t *testing.T) {
+	db := newTestDB(t)
+	svc := NewBadgeService(db, zap.NewNop())
+	u := newTestUser(t, db, "member", "member@example.com")
+	newTestPost(t, db, u.ID, post.StatusNormal, false)
+	db.Badge.Create().SetName("已停用").SetCriteria(badge.CriteriaPostCount).SetThreshold(1).SetEnabled(false).ExecX(t.Context())
+	db.Badge.Create().SetName("手动授予").SetCriteria(badge.CriteriaManual).ExecX(t.Context())
+
+	for _, criteria := range []badge.Criteria{badge.CriteriaPostCount, badge.CriteriaManual} {
+		awarded, err := svc.EvaluateUserBadges(t.Context(), u.ID, criteria, 0)
+		if err != nil {
+			t.Fatalf("评估徽章失败: %v", err)
+		}
+		if awarded != 0 {
+			t.Fatalf("条件 %s 不应自动授予徽章，实际 %d 个", criteria, awarded)
+		}
+	}
+}
+
+func TestBadgeTaskEvaluateResolvesPostAuthor(t *testing.T) {
+	db := newTestDB(t)
+	task := &BadgeTask{db: db, logger: zap.NewNop(), badgeService: NewBadgeService(db, zap.NewNop())}
+	author := newTestUser(t, db, "author", "author@example.com")
+	p := newTestPost(t, db, author.ID, post.StatusNormal, true)
+	b := db.Badge.Create().SetName("精华作者").SetCriteria(badge.CriteriaEssenceCount).SetThreshold(1).SaveX(t.Context())
+
+	if err := task.Evaluate(t.Context(), BadgeTaskPayload{PostID: p.ID, Criteria: badge.CriteriaEssenceCount}); err != nil {
+		t.Fatalf("执行徽章评估任务失败: %v", err)
+	}
+	if !db.UserBadge.Query().Where(userbadge.UserIDEQ(author.ID), userbadge.BadgeIDEQ(b.ID)).ExistX(t.Context()) {
+		t.Fatal("应为帖子作者授予徽章")
+	}
+
+	// 帖子已删除时跳过评估
+	if err := task.Evaluate(t.Context(), BadgeTaskPayload{PostID: 999, Criteria: badge.CriteriaEssenceCount}); err != nil {
+		t.Fatalf("帖子不存在时不应返回错误: %v", err)
+	}
+}
+
+func TestSetDisplayBadges(t *testing.T) {
+	db := newTestDB(t)
+	svc := NewBadgeService(db, zap.NewNop())
+	u := newTestUser(t, db, "member", "member@example.com")
+	admin := newTestUser(t, db, "admin", "admin@example.com")
+
+	var ids []int
+	for _, name := range []string{"一", "二", "三", "四"} {
+		b := db.Badge.Create().SetName(name).SaveX(t.Context())
+		if err := svc.AwardBadge(t.Context(), admin.ID, schema.BadgeAwardRequest{BadgeID: b.ID, UserID: u.ID}); err != nil {
+			t.Fatalf("授予徽章失败: %v", err)
+		}
+		ids = append(ids, b.ID)
+	}
+	unowned := db.Badge.Create().SetName("未获得").SaveX(t.Context())
+
+	if err := svc.AwardBadge(t.Context(), admin.ID, schema.BadgeAwardRequest{BadgeID: ids[0], UserID: u.ID}); err == nil {
+		t.Fatal("重复授予徽章应返回错误")
+	}
+
+	invalid := map[string][]int{
+		"超过展示上限": ids,
+		"重复徽章":   {ids[0], ids[0]},
+		"未获得的徽章": {ids[0], unowned.ID},
+	}
+	for name, badgeIDs := range invalid {
+		if err := svc.SetDisplayBadges(t.Context(), u.ID, schema.UserBadgeDisplayRequest{BadgeIDs: badgeIDs}); err == nil {
+			t.Fatalf("%s应返回错误", name)
+		}
+	}
+
+	if err := svc.SetDisplayBadges(t.Context(), u.ID, schema.UserBadgeDisplayRequest{BadgeIDs: []int{ids[2], ids[0]}}); err != nil {
+		t.Fatalf("设置展示徽章失败: %v", err)
+	}
+	display, err := loadDisplayBadges(t.Context(), db, []int{u.ID})
+	if err != nil {
+		t.Fatalf("获取展示徽章失败: %v", err)
+	}
+	if got := display[u.ID]; len(got) != 2 || got[0].ID != ids[2] || got[1].ID != ids[0] {
+		t.Fatalf("展示徽章应按设置顺序返回，实际 %+v", got)
+	}
+}
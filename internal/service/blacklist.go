@@ -9,6 +9,7 @@ import (
 
 	"github.com/PokeForum/PokeForum/ent"
 	"github.com/PokeForum/PokeForum/ent/blacklist"
+	"github.com/PokeForum/PokeForum/ent/follow"
 	"github.com/PokeForum/PokeForum/ent/user"
 	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
 	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
@@ -196,6 +197,24 @@ func (s *BlacklistService) AddToBlacklist(ctx context.Context, userID int, block
 		return nil, fmt.Errorf("创建黑名单记录失败: %w", err)
 	}
 
+	// 拉黑后解除双方的关注关系
+	if _, err = s.db.Follow.Delete().
+		Where(
+			follow.TargetTypeEQ(follow.TargetTypeUser),
+			follow.Or(
+				follow.And(follow.UserIDEQ(userID), follow.TargetIDEQ(blockedUserID)),
+				follow.And(follow.UserIDEQ(blockedUserID), follow.TargetIDEQ(userID)),
+			),
+		).
+		Exec(ctx); err != nil {
+		s.logger.Warn("解除关注关系失败",
+			tracing.WithTraceIDField(ctx),
+			zap.Int("user_id", userID),
+			zap.Int("blocked_user_id", blockedUserID),
+			zap.Error(err),
+		)
+	}
+
 	result := &schema.UserBlacklistAddResponse{
 		ID:            blacklistItem.ID,
 		UserID:        blacklistItem.UserID,
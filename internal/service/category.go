@@ -7,7 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/PokeForum/PokeForum/ent"
-	"github.com/PokeForum/PokeForum/ent/category"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
 	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
 	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
@@ -16,7 +16,7 @@ import (
 
 // ICategoryService 用户侧版块服务接口
 type ICategoryService interface {
-	// GetUserCategories 获取用户可见的版块列表
+	// GetUserCategories 获取用户可见的版块树
 	GetUserCategories(ctx context.Context) (*schema.UserCategoryResponse, error)
 }
 
@@ -36,44 +36,48 @@ func NewCategoryService(db *ent.Client, cacheService cache.ICacheService, logger
 	}
 }
 
-// GetUserCategories 获取用户可见的版块列表
+// GetUserCategories 获取用户可见的版块树
 // 用户侧可以看到状态为 Normal、LoginRequired、Locked 的版块
 // 其中 Locked 状态的版块只能查看，不能发帖和评论
-// 隐藏(Hidden)的版块对用户不可见
+// 隐藏(Hidden)的版块与不满足查看规则的版块对用户不可见
+// 版块状态与访问控制规则沿版块树向下继承，不可见版块的子版块同样不可见
 func (s *CategoryService) GetUserCategories(ctx context.Context) (*schema.UserCategoryResponse, error) {
 	s.logger.Info("获取用户版块列表", tracing.WithTraceIDField(ctx))
 
-	// 构建查询条件，只查询用户可见的版块状态
-	categories, err := s.db.Category.Query().Where(
-		category.Or(
-			category.StatusEQ(category.StatusNormal),
-			category.StatusEQ(category.StatusLoginRequired),
-			category.StatusEQ(category.StatusLocked),
-		),
-	).
-		// 按权重升序、创建时间降序排列
-		Order(ent.Asc(category.FieldWeight), ent.Desc(category.FieldCreatedAt)).
-		All(ctx)
+	tree, viewer, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, tracing.GetUserID(ctx))
 	if err != nil {
 		s.logger.Error("获取用户版块列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 		return nil, fmt.Errorf("获取版块列表失败: %w", err)
 	}
 
-	// 转换为响应格式
-	list := make([]schema.UserCategoryListItem, len(categories))
-	for i, cat := range categories {
-		list[i] = schema.UserCategoryListItem{
-			ID:          cat.ID,
-			Name:        cat.Name,
-			Slug:        cat.Slug,
-			Description: cat.Description,
-			Icon:        cat.Icon,
-			Weight:      cat.Weight,
-			CreatedAt:   cat.CreatedAt.Format(time_tools.DateTimeFormat),
-		}
-	}
-
 	return &schema.UserCategoryResponse{
-		List: list,
+		List: s.buildCategoryNodes(tree, viewer, 0),
 	}, nil
 }
+
+// buildCategoryNodes 递归构建指定父版块下可见的子版块
+func (s *CategoryService) buildCategoryNodes(tree *categoryTree, viewer *categoryViewer, parentID int) []schema.UserCategoryListItem {
+	list := make([]schema.UserCategoryListItem, 0, len(tree.children[parentID]))
+	for _, id := range tree.children[parentID] {
+		access := tree.access(id)
+		// 需登录版块仍展示给未登录用户，不满足查看规则的版块不展示
+		if access.Hidden || !tree.allows(id, categorypermission.ActionView, viewer) {
+			continue
+		}
+		cat := tree.nodes[id]
+		list = append(list, schema.UserCategoryListItem{
+			ID:            cat.ID,
+			ParentID:      parentID,
+			Name:          cat.Name,
+			Slug:          cat.Slug,
+			Description:   cat.Description,
+			Icon:          cat.Icon,
+			Weight:        cat.Weight,
+			LoginRequired: access.LoginRequired,
+			Locked:        access.Locked,
+			Children:      s.buildCategoryNodes(tree, viewer, id),
+			CreatedAt:     cat.CreatedAt.Format(time_tools.DateTimeFormat),
+		})
+	}
+	return list
+}
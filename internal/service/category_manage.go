@@ -10,7 +10,9 @@ import (
 	"github.com/PokeForum/PokeForum/ent"
 	"github.com/PokeForum/PokeForum/ent/category"
 	"github.com/PokeForum/PokeForum/ent/categorymoderator"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
 	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/usergroup"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
 	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
 	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
@@ -33,6 +35,10 @@ type ICategoryManageService interface {
 	SetCategoryModerators(ctx context.Context, req schema.CategoryModeratorRequest) error
 	// DeleteCategory 隐藏版块（软删除，状态设为Hidden）
 	DeleteCategory(ctx context.Context, id int) error
+	// GetCategoryPermissions 获取版块访问控制规则
+	GetCategoryPermissions(ctx context.Context, categoryID int) (*schema.CategoryPermissionResponse, error)
+	// UpdateCategoryPermissions 设置版块访问控制规则，整体替换版块已有的规则
+	UpdateCategoryPermissions(ctx context.Context, req schema.CategoryPermissionUpdateRequest) error
 }
 
 // CategoryManageService 版块管理服务实现
@@ -73,6 +79,11 @@ func (s *CategoryManageService) GetCategoryList(ctx context.Context, req schema.
 		query = query.Where(category.StatusEQ(category.Status(req.Status)))
 	}
 
+	// 父版块筛选
+	if req.ParentID != nil {
+		query = query.Where(category.ParentIDEQ(*req.ParentID))
+	}
+
 	// 获取总数
 	total, err := query.Count(ctx)
 	if err != nil {
@@ -96,6 +107,7 @@ func (s *CategoryManageService) GetCategoryList(ctx context.Context, req schema.
 	for i, cat := range categories {
 		list[i] = schema.CategoryListItem{
 			ID:          cat.ID,
+			ParentID:    cat.ParentID,
 			Name:        cat.Name,
 			Slug:        cat.Slug,
 			Description: cat.Description,
@@ -131,8 +143,14 @@ func (s *CategoryManageService) CreateCategory(ctx context.Context, req schema.C
 		return nil, errors.New("版块标识已存在")
 	}
 
+	// 检查父版块
+	if err = s.checkParentCategory(ctx, 0, req.ParentID); err != nil {
+		return nil, err
+	}
+
 	// 创建版块
 	categories, err := s.db.Category.Create().
+		SetParentID(req.ParentID).
 		SetName(req.Name).
 		SetSlug(req.Slug).
 		SetDescription(req.Description).
@@ -145,6 +163,7 @@ func (s *CategoryManageService) CreateCategory(ctx context.Context, req schema.C
 		return nil, fmt.Errorf("创建版块失败: %w", err)
 	}
 
+	clearCategoryTreeCache(ctx, s.cache, s.logger)
 	s.logger.Info("版块创建成功", zap.Int("id", categories.ID), tracing.WithTraceIDField(ctx))
 	return categories, nil
 }
@@ -182,9 +201,19 @@ func (s *CategoryManageService) UpdateCategory(ctx context.Context, req schema.C
 		}
 	}
 
+	// 如果要移动版块，检查父版块是否合法
+	if req.ParentID != nil && *req.ParentID != existingCategory.ParentID {
+		if err = s.checkParentCategory(ctx, req.ID, *req.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
 	// 构建更新操作
 	update := s.db.Category.UpdateOneID(req.ID)
 
+	if req.ParentID != nil {
+		update = update.SetParentID(*req.ParentID)
+	}
 	if req.Name != "" {
 		update = update.SetName(req.Name)
 	}
@@ -211,6 +240,7 @@ func (s *CategoryManageService) UpdateCategory(ctx context.Context, req schema.C
 		return nil, fmt.Errorf("更新版块失败: %w", err)
 	}
 
+	clearCategoryTreeCache(ctx, s.cache, s.logger)
 	s.logger.Info("版块更新成功", zap.Int("id", updatedCategory.ID), tracing.WithTraceIDField(ctx))
 	return updatedCategory, nil
 }
@@ -240,6 +270,7 @@ func (s *CategoryManageService) UpdateCategoryStatus(ctx context.Context, req sc
 		return fmt.Errorf("更新版块状态失败: %w", err)
 	}
 
+	clearCategoryTreeCache(ctx, s.cache, s.logger)
 	s.logger.Info("版块状态更新成功", zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
 	return nil
 }
@@ -261,6 +292,7 @@ func (s *CategoryManageService) GetCategoryDetail(ctx context.Context, id int) (
 	// 转换为响应格式
 	result := &schema.CategoryDetailResponse{
 		ID:          categories.ID,
+		ParentID:    categories.ParentID,
 		Name:        categories.Name,
 		Slug:        categories.Slug,
 		Description: categories.Description,
@@ -441,6 +473,164 @@ func (s *CategoryManageService) DeleteCategory(ctx context.Context, id int) erro
 		return fmt.Errorf("删除版块失败: %w", err)
 	}
 
+	clearCategoryTreeCache(ctx, s.cache, s.logger)
 	s.logger.Info("版块删除成功", zap.Int("id", id), tracing.WithTraceIDField(ctx))
 	return nil
 }
+
+// checkParentCategory 检查父版块是否存在，且不会使版块树形成环
+// id为0表示新建版块
+func (s *CategoryManageService) checkParentCategory(ctx context.Context, id, parentID int) error {
+	if parentID == 0 {
+		return nil
+	}
+
+	tree, err := loadCategoryTree(ctx, s.db, s.cache)
+	if err != nil {
+		s.logger.Error("获取版块失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return err
+	}
+	if _, ok := tree.nodes[parentID]; !ok {
+		return errors.New("父版块不存在")
+	}
+	if id == 0 {
+		return nil
+	}
+	for _, cid := range tree.descendants(id) {
+		if cid == parentID {
+			return errors.New("不能将版块移动到自身或其子版块下")
+		}
+	}
+	return nil
+}
+
+// GetCategoryPermissions 获取版块访问控制规则
+func (s *CategoryManageService) GetCategoryPermissions(ctx context.Context, categoryID int) (*schema.CategoryPermissionResponse, error) {
+	s.logger.Info("获取版块访问控制规则", zap.Int("category_id", categoryID), tracing.WithTraceIDField(ctx))
+
+	exists, err := s.db.Category.Query().
+		Where(category.IDEQ(categoryID)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查版块失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("检查版块失败: %w", err)
+	}
+	if !exists {
+		return nil, errors.New("版块不存在")
+	}
+
+	rules, err := s.db.CategoryPermission.Query().
+		Where(categorypermission.CategoryIDEQ(categoryID)).
+		Order(ent.Asc(categorypermission.FieldAction)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取版块访问控制规则失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取版块访问控制规则失败: %w", err)
+	}
+
+	result := &schema.CategoryPermissionResponse{
+		CategoryID: categoryID,
+		Rules:      make([]schema.CategoryPermissionRule, len(rules)),
+	}
+	for i, rule := range rules {
+		groupIDs := rule.AllowedGroupIds
+		if groupIDs == nil {
+			groupIDs = []int{}
+		}
+		result.Rules[i] = schema.CategoryPermissionRule{
+			Action:          rule.Action.String(),
+			MinRole:         rule.MinRole.String(),
+			MinExperience:   rule.MinExperience,
+			AllowedGroupIDs: groupIDs,
+		}
+	}
+	return result, nil
+}
+
+// UpdateCategoryPermissions 设置版块访问控制规则
+func (s *CategoryManageService) UpdateCategoryPermissions(ctx context.Context, req schema.CategoryPermissionUpdateRequest) error {
+	s.logger.Info("设置版块访问控制规则", zap.Int("category_id", req.CategoryID), zap.Int("rule_count", len(req.Rules)), tracing.WithTraceIDField(ctx))
+
+	exists, err := s.db.Category.Query().
+		Where(category.IDEQ(req.CategoryID)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查版块失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("检查版块失败: %w", err)
+	}
+	if !exists {
+		return errors.New("版块不存在")
+	}
+
+	// 校验操作不重复，并收集用户组ID
+	actions := make(map[string]bool, len(req.Rules))
+	var groupIDs []int
+	for i, rule := range req.Rules {
+		if actions[rule.Action] {
+			return fmt.Errorf("操作 %s 的规则重复", rule.Action)
+		}
+		actions[rule.Action] = true
+		req.Rules[i].AllowedGroupIDs = uniqueInts(rule.AllowedGroupIDs)
+		groupIDs = append(groupIDs, req.Rules[i].AllowedGroupIDs...)
+	}
+
+	// 检查用户组是否存在
+	groupIDs = uniqueInts(groupIDs)
+	if len(groupIDs) > 0 {
+		count, err := s.db.UserGroup.Query().
+			Where(usergroup.IDIn(groupIDs...)).
+			Count(ctx)
+		if err != nil {
+			s.logger.Error("检查用户组失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("检查用户组失败: %w", err)
+		}
+		if count != len(groupIDs) {
+			return errors.New("用户组不存在")
+		}
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	if _, err = tx.CategoryPermission.Delete().
+		Where(categorypermission.CategoryIDEQ(req.CategoryID)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("清除版块访问控制规则失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("清除版块访问控制规则失败: %w", err)
+	}
+
+	for _, rule := range req.Rules {
+		create := tx.CategoryPermission.Create().
+			SetCategoryID(req.CategoryID).
+			SetAction(categorypermission.Action(rule.Action)).
+			SetMinExperience(rule.MinExperience).
+			SetAllowedGroupIds(rule.AllowedGroupIDs)
+		if rule.MinRole != "" {
+			create = create.SetMinRole(categorypermission.MinRole(rule.MinRole))
+		}
+		if err = create.Exec(ctx); err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Error("保存版块访问控制规则失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("保存版块访问控制规则失败: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	clearCategoryTreeCache(ctx, s.cache, s.logger)
+	s.logger.Info("版块访问控制规则设置成功", zap.Int("category_id", req.CategoryID), tracing.WithTraceIDField(ctx))
+	return nil
+}
@@ -0,0 +1,428 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/category"
+	"github.com/PokeForum/PokeForum/ent/categorymoderator"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/usergroupmember"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+)
+
+// categoryAccess 版块沿祖先链继承后的访问状态
+// 祖先版块的 Hidden、LoginRequired、Locked 状态会同时作用于全部子版块
+type categoryAccess struct {
+	Hidden        bool
+	LoginRequired bool
+	Locked        bool
+}
+
+// categoryViewer 版块访问者，用于计算版块状态与访问控制规则
+// userID为0表示未登录用户
+type categoryViewer struct {
+	userID     int
+	role       user.Role
+	experience int
+	// groups 所属且未过期的用户组ID
+	groups map[int]bool
+	// moderated 直接担任版主的版块ID，子孙版块的权限通过版块树计算
+	moderated map[int]bool
+}
+
+// loadCategoryViewer 加载版块访问者信息，userID为0或用户不存在时视为未登录用户
+func loadCategoryViewer(ctx context.Context, db *ent.Client, userID int) (*categoryViewer, error) {
+	v := &categoryViewer{
+		groups:    make(map[int]bool),
+		moderated: make(map[int]bool),
+	}
+	if userID == 0 {
+		return v, nil
+	}
+
+	u, err := db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldID, user.FieldRole, user.FieldExperience).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return v, nil
+		}
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	v.userID = u.ID
+	v.role = u.Role
+	v.experience = u.Experience
+
+	groupIDs, err := db.UserGroupMember.Query().
+		Where(usergroupmember.UserIDEQ(userID), activeGroupMember()).
+		Select(usergroupmember.FieldGroupID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户组失败: %w", err)
+	}
+	for _, id := range groupIDs {
+		v.groups[id] = true
+	}
+
+	moderated, err := moderatedCategoryIDs(ctx, db, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range moderated {
+		v.moderated[id] = true
+	}
+	return v, nil
+}
+
+// loggedIn 是否为已登录用户
+func (v *categoryViewer) loggedIn() bool {
+	return v.userID != 0
+}
+
+// isAdmin 是否为管理员，管理员不受版块访问控制规则限制
+func (v *categoryViewer) isAdmin() bool {
+	return v.role == user.RoleAdmin || v.role == user.RoleSuperAdmin
+}
+
+// satisfies 是否满足单条访问控制规则
+// 需同时满足最低身份与最低经验值，设置了用户组时还需属于其中任一用户组
+// 未登录用户只能通过不设任何限制的规则
+func (v *categoryViewer) satisfies(rule *ent.CategoryPermission) bool {
+	if !v.loggedIn() {
+		return rule.MinRole == categorypermission.MinRoleUser && rule.MinExperience == 0 && len(rule.AllowedGroupIds) == 0
+	}
+	// 身份的继承关系与sa-token的身份列表保持一致
+	if !slices.Contains(satoken.GetUserRole(v.role.String()), rule.MinRole.String()) {
+		return false
+	}
+	if v.experience < rule.MinExperience {
+		return false
+	}
+	if len(rule.AllowedGroupIds) == 0 {
+		return true
+	}
+	for _, id := range rule.AllowedGroupIds {
+		if v.groups[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// categoryTree 内存中的版块树
+// 版块数量有限，按需整体加载后在应用层计算继承关系
+type categoryTree struct {
+	nodes map[int]*ent.Category
+	// children 父版块ID到子版块ID的映射，顶级版块挂在0下，按权重升序、创建时间降序排列
+	children map[int][]int
+	// rules 版块ID到各操作访问控制规则的映射
+	rules map[int]map[categorypermission.Action]*ent.CategoryPermission
+}
+
+const (
+	// categoryTreeCacheKey 版块树缓存键，版块或访问控制规则变更时删除
+	categoryTreeCacheKey = "category:tree"
+	// categoryTreeCacheTTL 版块树缓存时间，变更时主动删除，过期时间仅作兜底
+	categoryTreeCacheTTL = 10 * time.Minute
+)
+
+// categoryTreeSnapshot 版块树缓存内容，按数据库查询顺序保存全部版块与访问控制规则
+type categoryTreeSnapshot struct {
+	Categories []*ent.Category           `json:"categories"`
+	Rules      []*ent.CategoryPermission `json:"rules"`
+}
+
+// loadCategoryTree 加载全部版块及访问控制规则并构建版块树
+// 几乎每个请求都需要计算版块权限，版块与规则整体缓存到Redis，缓存不可用时直接查询数据库
+func loadCategoryTree(ctx context.Context, db *ent.Client, cacheService cache.ICacheService) (*categoryTree, error) {
+	var snapshot categoryTreeSnapshot
+	if cached, err := cacheService.Get(ctx, categoryTreeCacheKey); err == nil && cached != "" &&
+		json.Unmarshal([]byte(cached), &snapshot) == nil {
+		return buildCategoryTree(snapshot.Categories, snapshot.Rules), nil
+	}
+
+	categories, err := db.Category.Query().
+		Order(ent.Asc(category.FieldWeight), ent.Desc(category.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取版块失败: %w", err)
+	}
+
+	rules, err := db.CategoryPermission.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取版块访问控制规则失败: %w", err)
+	}
+
+	// 写入缓存失败不影响本次请求
+	if data, err := json.Marshal(categoryTreeSnapshot{Categories: categories, Rules: rules}); err == nil {
+		_ = cacheService.SetExDuration(ctx, categoryTreeCacheKey, string(data), categoryTreeCacheTTL) //nolint:errcheck // 缓存写入失败时下次请求重新加载
+	}
+	return buildCategoryTree(categories, rules), nil
+}
+
+// clearCategoryTreeCache 删除版块树缓存，版块或访问控制规则变更后调用
+func clearCategoryTreeCache(ctx context.Context, cacheService cache.ICacheService, logger *zap.Logger) {
+	if _, err := cacheService.Del(ctx, categoryTreeCacheKey); err != nil {
+		logger.Warn("清理版块树缓存失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+}
+
+// buildCategoryTree 根据版块与访问控制规则构建版块树，categories需按权重升序、创建时间降序排列
+func buildCategoryTree(categories []*ent.Category, rules []*ent.CategoryPermission) *categoryTree {
+	tree := &categoryTree{
+		nodes:    make(map[int]*ent.Category, len(categories)),
+		children: make(map[int][]int),
+		rules:    make(map[int]map[categorypermission.Action]*ent.CategoryPermission),
+	}
+	for _, rule := range rules {
+		if tree.rules[rule.CategoryID] == nil {
+			tree.rules[rule.CategoryID] = make(map[categorypermission.Action]*ent.CategoryPermission)
+		}
+		tree.rules[rule.CategoryID][rule.Action] = rule
+	}
+	for _, c := range categories {
+		tree.nodes[c.ID] = c
+	}
+	for _, c := range categories {
+		parentID := c.ParentID
+		// 父版块不存在时视为顶级版块
+		if _, ok := tree.nodes[parentID]; !ok {
+			parentID = 0
+		}
+		tree.children[parentID] = append(tree.children[parentID], c.ID)
+	}
+	return tree
+}
+
+// loadCategoryTreeForViewer 同时加载版块树与版块访问者信息
+func loadCategoryTreeForViewer(ctx context.Context, db *ent.Client, cacheService cache.ICacheService, userID int) (*categoryTree, *categoryViewer, error) {
+	tree, err := loadCategoryTree(ctx, db, cacheService)
+	if err != nil {
+		return nil, nil, err
+	}
+	v, err := loadCategoryViewer(ctx, db, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tree, v, nil
+}
+
+// ancestors 返回版块自身及其全部祖先版块ID，自身在前
+func (t *categoryTree) ancestors(id int) []int {
+	var ids []int
+	visited := make(map[int]bool)
+	for id != 0 && !visited[id] {
+		node, ok := t.nodes[id]
+		if !ok {
+			break
+		}
+		visited[id] = true
+		ids = append(ids, id)
+		id = node.ParentID
+	}
+	return ids
+}
+
+// descendants 返回版块自身及其全部子孙版块ID
+func (t *categoryTree) descendants(id int) []int {
+	if _, ok := t.nodes[id]; !ok {
+		return nil
+	}
+	ids := []int{id}
+	visited := map[int]bool{id: true}
+	for i := 0; i < len(ids); i++ {
+		for _, childID := range t.children[ids[i]] {
+			if !visited[childID] {
+				visited[childID] = true
+				ids = append(ids, childID)
+			}
+		}
+	}
+	return ids
+}
+
+// access 计算版块继承后的访问状态，版块不存在时视为隐藏
+func (t *categoryTree) access(id int) categoryAccess {
+	chain := t.ancestors(id)
+	if len(chain) == 0 {
+		return categoryAccess{Hidden: true}
+	}
+	var a categoryAccess
+	for _, cid := range chain {
+		switch t.nodes[cid].Status {
+		case category.StatusHidden:
+			a.Hidden = true
+		case category.StatusLoginRequired:
+			a.LoginRequired = true
+		case category.StatusLocked:
+			a.Locked = true
+		}
+	}
+	return a
+}
+
+// moderates 访问者是否为版块或其任一祖先版块的版主
+func (t *categoryTree) moderates(id int, v *categoryViewer) bool {
+	for _, cid := range t.ancestors(id) {
+		if v.moderated[cid] {
+			return true
+		}
+	}
+	return false
+}
+
+// allows 访问者是否满足版块及其全部祖先版块针对指定操作的访问控制规则
+// 管理员与版主不受访问控制规则限制
+func (t *categoryTree) allows(id int, action categorypermission.Action, v *categoryViewer) bool {
+	if v.isAdmin() || t.moderates(id, v) {
+		return true
+	}
+	for _, cid := range t.ancestors(id) {
+		if rule := t.rules[cid][action]; rule != nil && !v.satisfies(rule) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkCategoryReadable 检查访问者是否可以查看版块
+func (t *categoryTree) checkCategoryReadable(id int, v *categoryViewer) error {
+	a := t.access(id)
+	if a.Hidden {
+		return errors.New("版块不存在")
+	}
+	if a.LoginRequired && !v.loggedIn() {
+		return errors.New("请登录后查看该版块")
+	}
+	if !t.allows(id, categorypermission.ActionView, v) {
+		if !v.loggedIn() {
+			return errors.New("请登录后查看该版块")
+		}
+		return errors.New("您没有查看该版块的权限")
+	}
+	return nil
+}
+
+// visible 版块对访问者是否可见
+func (t *categoryTree) visible(id int, v *categoryViewer) bool {
+	return t.checkCategoryReadable(id, v) == nil
+}
+
+// invisibleIDs 对访问者不可见的版块ID
+func (t *categoryTree) invisibleIDs(v *categoryViewer) []int {
+	var ids []int
+	for id := range t.nodes {
+		if !t.visible(id, v) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// visibleDescendants 返回版块自身及对访问者可见的子孙版块ID
+func (t *categoryTree) visibleDescendants(id int, v *categoryViewer) []int {
+	var ids []int
+	for _, cid := range t.descendants(id) {
+		if t.visible(cid, v) {
+			ids = append(ids, cid)
+		}
+	}
+	return ids
+}
+
+// moderatedCategoryIDs 获取用户直接担任版主的版块ID
+func moderatedCategoryIDs(ctx context.Context, db *ent.Client, userID int) ([]int, error) {
+	ids, err := db.CategoryModerator.Query().
+		Where(categorymoderator.UserIDEQ(userID)).
+		Select(categorymoderator.FieldCategoryID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询版主关联记录失败: %w", err)
+	}
+	return ids, nil
+}
+
+// moderatedCategorySet 获取用户拥有版主权限的全部版块
+// 父版块的版主同时拥有全部子孙版块的管理权限
+func moderatedCategorySet(ctx context.Context, db *ent.Client, cacheService cache.ICacheService, userID int) (map[int]bool, error) {
+	direct, err := moderatedCategoryIDs(ctx, db, userID)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[int]bool)
+	if len(direct) == 0 {
+		return set, nil
+	}
+
+	tree, err := loadCategoryTree(ctx, db, cacheService)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range direct {
+		for _, cid := range tree.descendants(id) {
+			set[cid] = true
+		}
+	}
+	return set, nil
+}
+
+// isCategoryModerator 检查用户是否为版块或其任一祖先版块的版主
+func isCategoryModerator(ctx context.Context, db *ent.Client, cacheService cache.ICacheService, userID, categoryID int) (bool, error) {
+	direct, err := moderatedCategoryIDs(ctx, db, userID)
+	if err != nil {
+		return false, err
+	}
+	if len(direct) == 0 {
+		return false, nil
+	}
+
+	tree, err := loadCategoryTree(ctx, db, cacheService)
+	if err != nil {
+		return false, err
+	}
+	moderated := make(map[int]bool, len(direct))
+	for _, id := range direct {
+		moderated[id] = true
+	}
+	for _, id := range tree.ancestors(categoryID) {
+		if moderated[id] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkCategoryWritable 检查用户是否可以在版块中发帖或回复
+// 继承了隐藏状态的版块视为不存在；锁定的版块仅管理员与版主可以继续发言；
+// 发帖与回复还需满足版块的查看规则
+func checkCategoryWritable(ctx context.Context, db *ent.Client, cacheService cache.ICacheService, userID, categoryID int, action categorypermission.Action) error {
+	tree, v, err := loadCategoryTreeForViewer(ctx, db, cacheService, userID)
+	if err != nil {
+		return err
+	}
+	if err = tree.checkCategoryReadable(categoryID, v); err != nil {
+		return err
+	}
+	if tree.access(categoryID).Locked && !v.isAdmin() && !tree.moderates(categoryID, v) {
+		return errors.New("版块已锁定，无法发帖或评论")
+	}
+	if !tree.allows(categoryID, action, v) {
+		if action == categorypermission.ActionReply {
+			return errors.New("您没有在该版块回复的权限")
+		}
+		return errors.New("您没有在该版块发帖的权限")
+	}
+	return nil
+}
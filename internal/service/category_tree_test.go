@@ -0,0 +1,421 @@
+package service
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/category"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// newTestCategory 创建测试版块，parentID为0表示顶级版块
+func newTestCategory(t *testing.T, db *ent.Client, slug string, parentID int) *ent.Category {
+	t.Helper()
+
+	return db.Category.Create().SetName(slug).SetSlug(slug).SetParentID(parentID).SaveX(t.Context())
+}
+
+// setTestCategoryRules 通过管理服务设置版块访问控制规则，同时清理版块树缓存
+func setTestCategoryRules(t *testing.T, db *ent.Client, cacheService cache.ICacheService, categoryID int, rules ...schema.CategoryPermissionRule) {
+	t.Helper()
+
+	svc := NewCategoryManageService(db, cacheService, zap.NewNop())
+	if err := svc.UpdateCategoryPermissions(t.Context(), schema.CategoryPermissionUpdateRequest{CategoryID: categoryID, Rules: rules}); err != nil {
+		t.Fatalf("设置版块访问控制规则失败: %v", err)
+	}
+}
+
+func TestCategoryPermissionRules(t *testing.T) {
+	type fixture struct {
+		db     *ent.Client
+		group  *ent.UserGroup
+		parent *ent.Category
+	}
+
+	tests := []struct {
+		name     string
+		onParent bool // 规则设置在父版块上，由子版块继承
+		rule     schema.CategoryPermissionRule
+		viewer   func(t *testing.T, f fixture) int
+		action   categorypermission.Action
+		wantErr  bool
+	}{
+		{
+			name:   "未设置规则时登录用户可发帖",
+			viewer: func(t *testing.T, f fixture) int { return newTestUser(t, f.db, "member", "member@example.com").ID },
+			action: categorypermission.ActionPost,
+		},
+		{
+			name:    "未登录用户不满足有限制的查看规则",
+			rule:    schema.CategoryPermissionRule{Action: "View", MinExperience: 1},
+			viewer:  func(*testing.T, fixture) int { return 0 },
+			action:  categorypermission.ActionView,
+			wantErr: true,
+		},
+		{
+			name:   "未登录用户可以通过不设限制的查看规则",
+			rule:   schema.CategoryPermissionRule{Action: "View"},
+			viewer: func(*testing.T, fixture) int { return 0 },
+			action: categorypermission.ActionView,
+		},
+		{
+			name:    "经验值不足",
+			rule:    schema.CategoryPermissionRule{Action: "Post", MinExperience: 100},
+			viewer:  func(t *testing.T, f fixture) int { return newTestUserWith(t, f.db, user.RoleUser, 99) },
+			action:  categorypermission.ActionPost,
+			wantErr: true,
+		},
+		{
+			name:   "经验值达到要求",
+			rule:   schema.CategoryPermissionRule{Action: "Post", MinExperience: 100},
+			viewer: func(t *testing.T, f fixture) int { return newTestUserWith(t, f.db, user.RoleUser, 100) },
+			action: categorypermission.ActionPost,
+		},
+		{
+			name:    "身份不足",
+			rule:    schema.CategoryPermissionRule{Action: "Reply", MinRole: "Moderator"},
+			viewer:  func(t *testing.T, f fixture) int { return newTestUserWith(t, f.db, user.RoleUser, 0) },
+			action:  categorypermission.ActionReply,
+			wantErr: true,
+		},
+		{
+			name:   "其他版块的版主满足最低身份",
+			rule:   schema.CategoryPermissionRule{Action: "Reply", MinRole: "Moderator"},
+			viewer: func(t *testing.T, f fixture) int { return newTestUserWith(t, f.db, user.RoleModerator, 0) },
+			action: categorypermission.ActionReply,
+		},
+		{
+			name:    "发帖规则不影响回复",
+			rule:    schema.CategoryPermissionRule{Action: "Post", MinRole: "Admin"},
+			viewer:  func(t *testing.T, f fixture) int { return newTestUserWith(t, f.db, user.RoleUser, 0) },
+			action:  categorypermission.ActionReply,
+			wantErr: false,
+		},
+		{
+			name:    "查看规则同时限制发帖",
+			rule:    schema.CategoryPermissionRule{Action: "View", MinExperience: 100},
+			viewer:  func(t *testing.T, f fixture) int { return newTestUserWith(t, f.db, user.RoleUser, 0) },
+			action:  categorypermission.ActionPost,
+			wantErr: true,
+		},
+		{
+			name: "属于允许的用户组",
+			rule: schema.CategoryPermissionRule{Action: "Post"},
+			viewer: func(t *testing.T, f fixture) int {
+				id := newTestUserWith(t, f.db, user.RoleUser, 0)
+				f.db.UserGroupMember.Create().SetGroupID(f.group.ID).SetUserID(id).ExecX(t.Context())
+				return id
+			},
+			action: categorypermission.ActionPost,
+		},
+		{
+			name: "用户组成员资格已过期",
+			rule: schema.CategoryPermissionRule{Action: "Post"},
+			viewer: func(t *testing.T, f fixture) int {
+				id := newTestUserWith(t, f.db, user.RoleUser, 0)
+				f.db.UserGroupMember.Create().SetGroupID(f.group.ID).SetUserID(id).SetExpiresAt(time.Now().Add(-time.Minute)).ExecX(t.Context())
+				return id
+			},
+			action:  categorypermission.ActionPost,
+			wantErr: true,
+		},
+		{
+			name:     "子版块继承父版块的规则",
+			onParent: true,
+			rule:     schema.CategoryPermissionRule{Action: "Post", MinExperience: 100},
+			viewer:   func(t *testing.T, f fixture) int { return newTestUserWith(t, f.db, user.RoleUser, 0) },
+			action:   categorypermission.ActionPost,
+			wantErr:  true,
+		},
+		{
+			name:     "父版块的版主不受规则限制",
+			onParent: true,
+			rule:     schema.CategoryPermissionRule{Action: "Post", MinRole: "Admin"},
+			viewer: func(t *testing.T, f fixture) int {
+				id := newTestUserWith(t, f.db, user.RoleModerator, 0)
+				f.db.CategoryModerator.Create().SetCategoryID(f.parent.ID).SetUserID(id).ExecX(t.Context())
+				return id
+			},
+			action: categorypermission.ActionPost,
+		},
+		{
+			name:   "管理员不受规则限制",
+			rule:   schema.CategoryPermissionRule{Action: "View", MinRole: "SuperAdmin"},
+			viewer: func(t *testing.T, f fixture) int { return newTestUserWith(t, f.db, user.RoleAdmin, 0) },
+			action: categorypermission.ActionView,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			cacheService := newTestCache(t)
+			f := fixture{
+				db:     db,
+				group:  db.UserGroup.Create().SetName("VIP").SaveX(t.Context()),
+				parent: newTestCategory(t, db, "parent", 0),
+			}
+			child := newTestCategory(t, db, "child", f.parent.ID)
+
+			if tt.rule.Action != "" {
+				target := child.ID
+				if tt.onParent {
+					target = f.parent.ID
+				}
+				rule := tt.rule
+				if rule.Action == string(categorypermission.ActionPost) && rule.MinRole == "" && rule.MinExperience == 0 {
+					rule.AllowedGroupIDs = []int{f.group.ID}
+				}
+				setTestCategoryRules(t, db, cacheService, target, rule)
+			}
+			userID := tt.viewer(t, f)
+
+			var err error
+			if tt.action == categorypermission.ActionView {
+				tree, viewer, loadErr := loadCategoryTreeForViewer(t.Context(), db, cacheService, userID)
+				if loadErr != nil {
+					t.Fatalf("加载版块树失败: %v", loadErr)
+				}
+				err = tree.checkCategoryReadable(child.ID, viewer)
+			} else {
+				err = checkCategoryWritable(t.Context(), db, cacheService, userID, child.ID, tt.action)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCategoryTreeCacheInvalidation(t *testing.T) {
+	db := newTestDB(t)
+	cacheService := newTestCache(t)
+	manage := NewCategoryManageService(db, cacheService, zap.NewNop())
+	c := newTestCategory(t, db, "board", 0)
+	userID := newTestUser(t, db, "member", "member@example.com").ID
+
+	readable := func() bool {
+		t.Helper()
+		tree, viewer, err := loadCategoryTreeForViewer(t.Context(), db, cacheService, userID)
+		if err != nil {
+			t.Fatalf("加载版块树失败: %v", err)
+		}
+		return tree.checkCategoryReadable(c.ID, viewer) == nil
+	}
+
+	if !readable() {
+		t.Fatal("正常版块应可见")
+	}
+	if cached, err := cacheService.Get(t.Context(), categoryTreeCacheKey); err != nil || cached == "" {
+		t.Fatalf("加载后应缓存版块树: %v", err)
+	}
+
+	// 未经过管理服务的修改在缓存过期前不生效
+	db.Category.UpdateOne(c).SetName("renamed").ExecX(t.Context())
+	tree, err := loadCategoryTree(t.Context(), db, cacheService)
+	if err != nil || tree.nodes[c.ID].Name != "board" {
+		t.Fatalf("应读取缓存中的版块树: %v", err)
+	}
+
+	steps := []struct {
+		name  string
+		apply func() error
+		want  bool
+	}{
+		{name: "隐藏版块", apply: func() error { return manage.DeleteCategory(t.Context(), c.ID) }, want: false},
+		{name: "恢复版块", apply: func() error {
+			return manage.UpdateCategoryStatus(t.Context(), schema.CategoryStatusUpdateRequest{ID: c.ID, Status: "Normal"})
+		}, want: true},
+		{name: "设置查看规则", apply: func() error {
+			return manage.UpdateCategoryPermissions(t.Context(), schema.CategoryPermissionUpdateRequest{
+				CategoryID: c.ID,
+				Rules:      []schema.CategoryPermissionRule{{Action: "View", MinRole: "Moderator"}},
+			})
+		}, want: false},
+		{name: "移除查看规则", apply: func() error {
+			return manage.UpdateCategoryPermissions(t.Context(), schema.CategoryPermissionUpdateRequest{CategoryID: c.ID})
+		}, want: true},
+	}
+	for _, step := range steps {
+		if err := step.apply(); err != nil {
+			t.Fatalf("%s失败: %v", step.name, err)
+		}
+		if got := readable(); got != step.want {
+			t.Fatalf("%s后版块可见应为 %v，实际 %v", step.name, step.want, got)
+		}
+	}
+}
+
+func TestCommentListCategoryACL(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    *schema.CategoryPermissionRule
+		viewer  user.Role
+		login   bool
+		wantErr bool
+	}{
+		{name: "公开版块", login: false},
+		{name: "无权查看版块", rule: &schema.CategoryPermissionRule{Action: "View", MinRole: "Moderator"}, login: true, viewer: user.RoleUser, wantErr: true},
+		{name: "满足查看规则", rule: &schema.CategoryPermissionRule{Action: "View", MinRole: "Moderator"}, login: true, viewer: user.RoleModerator},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			cacheService := newTestCache(t)
+			svc := NewCommentService(db, cacheService, nil, nil, nil, zap.NewNop())
+			author := newTestUser(t, db, "author", "author@example.com")
+			c := newTestCategory(t, db, "board", 0)
+			if tt.rule != nil {
+				setTestCategoryRules(t, db, cacheService, c.ID, *tt.rule)
+			}
+			p := db.Post.Create().SetUserID(author.ID).SetCategoryID(c.ID).SetTitle("帖子").SetContent("内容").SaveX(t.Context())
+			db.Comment.Create().SetPostID(p.ID).SetUserID(author.ID).SetContent("评论").ExecX(t.Context())
+
+			ctx := t.Context()
+			if tt.login {
+				ctx = tracing.WithUserID(ctx, newTestUserWith(t, db, tt.viewer, 0))
+			}
+			result, err := svc.GetCommentList(ctx, schema.UserCommentListRequest{PostID: p.ID, Page: 1, PageSize: 20})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+			if err == nil && result.Total != 1 {
+				t.Fatalf("应返回 1 条评论，实际 %d 条", result.Total)
+			}
+		})
+	}
+}
+
+// newTestUserWith 创建指定身份与经验值的测试用户，返回用户ID
+func newTestUserWith(t *testing.T, db *ent.Client, role user.Role, experience int) int {
+	t.Helper()
+
+	n := db.User.Query().CountX(t.Context()) + 1
+	u := newTestUser(t, db, "user"+strconv.Itoa(n), "user"+strconv.Itoa(n)+"@example.com")
+	return db.User.UpdateOne(u).SetRole(role).SetExperience(experience).SaveX(t.Context()).ID
+}
+
+// newTestCategoryTree 构建内存版块树：1 → 2 → 3，以及独立的顶级版块 4
+func newTestCategoryTree(status map[int]category.Status) *categoryTree {
+	parents := map[int]int{1: 0, 2: 1, 3: 2, 4: 0}
+	categories := make([]*ent.Category, 0, len(parents))
+	for id := 1; id <= len(parents); id++ {
+		s := category.StatusNormal
+		if st, ok := status[id]; ok {
+			s = st
+		}
+		categories = append(categories, &ent.Category{ID: id, ParentID: parents[id], Status: s})
+	}
+	return buildCategoryTree(categories, nil)
+}
+
+func TestCategoryTreeAccessInheritance(t *testing.T) {
+	tests := []struct {
+		name   string
+		status map[int]category.Status
+		id     int
+		want   categoryAccess
+	}{
+		{name: "正常版块", id: 3},
+		{name: "继承祖先版块的隐藏状态", status: map[int]category.Status{1: category.StatusHidden}, id: 3, want: categoryAccess{Hidden: true}},
+		{name: "继承父版块的登录可见状态", status: map[int]category.Status{2: category.StatusLoginRequired}, id: 3, want: categoryAccess{LoginRequired: true}},
+		{name: "继承祖先版块的锁定状态", status: map[int]category.Status{1: category.StatusLocked}, id: 3, want: categoryAccess{Locked: true}},
+		{name: "祖先状态叠加", status: map[int]category.Status{1: category.StatusLocked, 2: category.StatusLoginRequired}, id: 3, want: categoryAccess{LoginRequired: true, Locked: true}},
+		{name: "子版块状态不影响父版块", status: map[int]category.Status{3: category.StatusHidden}, id: 2},
+		{name: "不影响其他顶级版块", status: map[int]category.Status{1: category.StatusHidden}, id: 4},
+		{name: "版块不存在视为隐藏", id: 99, want: categoryAccess{Hidden: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newTestCategoryTree(tt.status).access(tt.id); got != tt.want {
+				t.Fatalf("访问状态应为 %+v，实际 %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCategoryTreeModeratorInheritance(t *testing.T) {
+	tree := newTestCategoryTree(nil)
+	v := &categoryViewer{userID: 1, role: user.RoleModerator, moderated: map[int]bool{2: true}}
+
+	for id, want := range map[int]bool{1: false, 2: true, 3: true, 4: false} {
+		if got := tree.moderates(id, v); got != want {
+			t.Fatalf("版块 %d 的版主权限应为 %v，实际 %v", id, want, got)
+		}
+	}
+}
+
+func TestCategoryTreeVisibleDescendants(t *testing.T) {
+	tree := newTestCategoryTree(map[int]category.Status{2: category.StatusLoginRequired})
+
+	guest := &categoryViewer{}
+	if got := tree.visibleDescendants(1, guest); !slices.Equal(got, []int{1}) {
+		t.Fatalf("未登录用户可见的子孙版块应为 [1]，实际 %v", got)
+	}
+	member := &categoryViewer{userID: 1, role: user.RoleUser}
+	if got := tree.visibleDescendants(1, member); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("登录用户可见的子孙版块应为 [1 2 3]，实际 %v", got)
+	}
+	if got := tree.visibleDescendants(99, member); got != nil {
+		t.Fatalf("不存在的版块应返回空，实际 %v", got)
+	}
+}
+
+func TestCategoryTreeToleratesCycle(t *testing.T) {
+	// 数据异常形成环时，祖先与子孙遍历均应终止
+	tree := buildCategoryTree([]*ent.Category{
+		{ID: 1, ParentID: 2, Status: category.StatusNormal},
+		{ID: 2, ParentID: 1, Status: category.StatusHidden},
+	}, nil)
+
+	if got := tree.ancestors(1); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("祖先版块应为 [1 2]，实际 %v", got)
+	}
+	if !tree.access(1).Hidden {
+		t.Fatal("应继承环上版块的隐藏状态")
+	}
+}
+
+func TestUpdateCategoryRejectsCycle(t *testing.T) {
+	db := newTestDB(t)
+	cacheService := newTestCache(t)
+	svc := NewCategoryManageService(db, cacheService, zap.NewNop())
+	parent := newTestCategory(t, db, "parent", 0)
+	child := newTestCategory(t, db, "child", parent.ID)
+	grandchild := newTestCategory(t, db, "grandchild", child.ID)
+	other := newTestCategory(t, db, "other", 0)
+
+	tests := []struct {
+		name     string
+		id       int
+		parentID int
+		wantErr  bool
+	}{
+		{name: "移动到自身下", id: parent.ID, parentID: parent.ID, wantErr: true},
+		{name: "移动到子孙版块下", id: parent.ID, parentID: grandchild.ID, wantErr: true},
+		{name: "父版块不存在", id: child.ID, parentID: 999, wantErr: true},
+		{name: "移动到其他版块下", id: child.ID, parentID: other.ID},
+		{name: "移动为顶级版块", id: grandchild.ID, parentID: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parentID := tt.parentID
+			_, err := svc.UpdateCategory(t.Context(), schema.CategoryUpdateRequest{ID: tt.id, ParentID: &parentID})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -7,13 +7,17 @@ import (
 	"strings"
 
 	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/attachment"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
 	"github.com/PokeForum/PokeForum/ent/comment"
 	"github.com/PokeForum/PokeForum/ent/commentaction"
+	"github.com/PokeForum/PokeForum/ent/notification"
 	"github.com/PokeForum/PokeForum/ent/post"
 	"github.com/PokeForum/PokeForum/ent/user"
 	_const "github.com/PokeForum/PokeForum/internal/consts"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
 	"github.com/PokeForum/PokeForum/internal/pkg/stats"
+	"github.com/PokeForum/PokeForum/internal/pkg/storage"
 	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
 	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
 	"github.com/PokeForum/PokeForum/internal/schema"
@@ -32,25 +36,33 @@ type ICommentService interface {
 	DislikeComment(ctx context.Context, userID int, req schema.UserCommentActionRequest) (*schema.UserCommentActionResponse, error)
 	// GetCommentList 获取评论列表
 	GetCommentList(ctx context.Context, req schema.UserCommentListRequest) (*schema.UserCommentListResponse, error)
+	// PinComment 置顶评论，仅楼主与所在版块的版主可以操作
+	PinComment(ctx context.Context, userID int, req schema.CommentPinUpdateRequest) error
 }
 
 // CommentService 评论服务实现
 type CommentService struct {
 	db                  *ent.Client
 	cache               cache.ICacheService
+	storage             storage.IStorage
 	logger              *zap.Logger
 	commentStatsService ICommentStatsService
 	settingsService     ISettingsService
+	mentionTask         *MentionTask
+	notificationTask    *NotificationTask
 }
 
 // NewCommentService 创建评论服务实例
-func NewCommentService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) ICommentService {
+func NewCommentService(db *ent.Client, cacheService cache.ICacheService, store storage.IStorage, mentionTask *MentionTask, notificationTask *NotificationTask, logger *zap.Logger) ICommentService {
 	return &CommentService{
 		db:                  db,
 		cache:               cacheService,
+		storage:             store,
 		logger:              logger,
 		commentStatsService: NewCommentStatsService(db, cacheService, logger),
 		settingsService:     NewSettingsService(db, cacheService, logger),
+		mentionTask:         mentionTask,
+		notificationTask:    notificationTask,
 	}
 }
 
@@ -80,6 +92,11 @@ func (s *CommentService) CreateComment(ctx context.Context, userID int, clientIP
 		return nil, fmt.Errorf("获取帖子失败: %w", err)
 	}
 
+	// 检查版块状态与回复权限，锁定版块不能评论
+	if err = checkCategoryWritable(ctx, s.db, s.cache, userID, postData.CategoryID, categorypermission.ActionReply); err != nil {
+		return nil, err
+	}
+
 	// 检查是否被楼主拉黑
 	blacklistService := NewBlacklistService(s.db, s.logger)
 	isBlockedByAuthor, err := blacklistService.IsUserBlocked(ctx, postData.UserID, userID)
@@ -130,8 +147,20 @@ func (s *CommentService) CreateComment(ctx context.Context, userID int, clientIP
 		replyToUsername = replyToUser.Username
 	}
 
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
 	// 创建评论
-	newComment, err := s.db.Comment.Create().
+	newComment, err := tx.Comment.Create().
 		SetUserID(userID).
 		SetPostID(req.PostID).
 		SetContent(req.Content).
@@ -141,19 +170,58 @@ func (s *CommentService) CreateComment(ctx context.Context, userID int, clientIP
 		SetDeviceInfo(deviceInfo). // 从请求中获取设备信息
 		Save(ctx)
 	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
 		s.logger.Error("创建评论失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 		return nil, fmt.Errorf("创建评论失败: %w", err)
 	}
 
+	// 关联评论附件
+	if err = bindAttachments(ctx, tx, userID, req.AttachmentIDs, req.PostID, newComment.ID); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Warn("关联评论附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	// 记录正文中的提及
+	mentionIDs, err := syncMentions(ctx, tx, userID, req.PostID, newComment.ID, req.Content)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("记录提及失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	// 提醒发送失败不影响评论
+	if err = s.mentionTask.SubmitEmailTask(ctx, mentionIDs); err != nil {
+		s.logger.Warn("提交提及提醒任务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+	s.notifyCommentCreated(ctx, userID, postData, newComment)
+
+	var attachments []schema.AttachmentItem
+	if len(req.AttachmentIDs) > 0 {
+		attachmentMap, err := getAttachmentItems(ctx, s.db, s.storage, func(a *ent.Attachment) int { return a.CommentID },
+			attachment.CommentIDEQ(newComment.ID))
+		if err != nil {
+			s.logger.Warn("查询评论附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+		attachments = attachmentMap[newComment.ID]
+	}
+
 	// 构建响应数据
 	result := &schema.UserCommentCreateResponse{
 		ID:              newComment.ID,
 		PostID:          newComment.PostID,
 		ReplyToUsername: replyToUsername,
 		Content:         newComment.Content,
+		ContentHTML:     renderMarkdown(ctx, s.cache, s.logger, newComment.Content),
 		LikeCount:       newComment.LikeCount,
 		DislikeCount:    newComment.DislikeCount,
 		CreatedAt:       newComment.CreatedAt.Format(time_tools.DateTimeFormat),
+		Attachments:     attachments,
 	}
 
 	// 只有当ParentID不为0时才设置
@@ -166,10 +234,33 @@ func (s *CommentService) CreateComment(ctx context.Context, userID int, clientIP
 		result.ReplyToUserID = &newComment.ReplyToUserID
 	}
 
+	s.publishCommentCreated(ctx, userID, result)
+
+	// 评论数计入帖子热度
+	if err = NewPostHotService(s.db, s.cache, s.logger).UpdateScore(ctx, req.PostID); err != nil {
+		s.logger.Warn("更新帖子热度分失败", zap.Int("post_id", req.PostID), zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
 	s.logger.Info("创建评论成功", zap.Int("comment_id", result.ID), tracing.WithTraceIDField(ctx))
 	return result, nil
 }
 
+// publishCommentCreated 向正在浏览该帖子的用户推送新评论
+func (s *CommentService) publishCommentCreated(ctx context.Context, userID int, result *schema.UserCommentCreateResponse) {
+	event := schema.RealtimeCommentEvent{
+		UserCommentCreateResponse: *result,
+		UserID:                    userID,
+	}
+	author, err := s.db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldUsername).
+		Only(ctx)
+	if err == nil {
+		event.Username = author.Username
+	}
+	publishRealtimeEvent(ctx, s.cache, s.logger, realtimePostChannel(result.PostID), RealtimeEventComment, event)
+}
+
 // UpdateComment 更新评论
 func (s *CommentService) UpdateComment(ctx context.Context, userID int, req schema.UserCommentUpdateRequest) (*schema.UserCommentUpdateResponse, error) {
 	s.logger.Info("更新评论", zap.Int("user_id", userID), zap.Int("comment_id", req.ID), tracing.WithTraceIDField(ctx))
@@ -216,15 +307,45 @@ func (s *CommentService) UpdateComment(ctx context.Context, userID int, req sche
 		return nil, errors.New("您已被楼主拉黑，无法编辑该评论")
 	}
 
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
 	// 更新评论
-	updatedComment, err := s.db.Comment.UpdateOne(commentData).
+	updatedComment, err := tx.Comment.UpdateOne(commentData).
 		SetContent(req.Content).
 		Save(ctx)
 	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
 		s.logger.Error("更新评论失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 		return nil, fmt.Errorf("更新评论失败: %w", err)
 	}
 
+	// 同步正文中的提及，只提醒新增的用户
+	mentionIDs, err := syncMentions(ctx, tx, userID, commentData.PostID, commentData.ID, req.Content)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("同步提及失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	if err = s.mentionTask.SubmitEmailTask(ctx, mentionIDs); err != nil {
+		s.logger.Warn("提交提及提醒任务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
 	// 构建响应数据
 	result := &schema.UserCommentUpdateResponse{
 		ID:        updatedComment.ID,
@@ -254,10 +375,55 @@ func (s *CommentService) LikeComment(ctx context.Context, userID int, req schema
 		DislikeCount: action.DislikeCount,
 	}
 
+	// 通知评论作者，失败不影响点赞
+	commentData, err := s.db.Comment.Query().
+		Where(comment.IDEQ(req.ID)).
+		Select(comment.FieldUserID, comment.FieldPostID, comment.FieldContent).
+		Only(ctx)
+	if err != nil {
+		s.logger.Warn("获取评论作者失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	} else if err = s.notificationTask.Submit(ctx, NotificationTaskPayload{
+		Type:      notification.TypeLike,
+		UserIDs:   []int{commentData.UserID},
+		ActorID:   userID,
+		PostID:    commentData.PostID,
+		CommentID: req.ID,
+		Content:   commentData.Content,
+	}); err != nil {
+		s.logger.Warn("提交点赞通知失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
 	s.logger.Info("点赞评论成功", zap.Int("comment_id", result.ID), tracing.WithTraceIDField(ctx))
 	return result, nil
 }
 
+// notifyCommentCreated 通知被回复的评论作者与楼主
+// 同时是楼主与被回复者时只发送评论回复通知，失败不影响评论
+func (s *CommentService) notifyCommentCreated(ctx context.Context, userID int, postData *ent.Post, newComment *ent.Comment) {
+	payload := NotificationTaskPayload{
+		ActorID:   userID,
+		PostID:    postData.ID,
+		CommentID: newComment.ID,
+		Content:   newComment.Content,
+	}
+
+	if newComment.ReplyToUserID != 0 {
+		payload.Type = notification.TypeCommentReply
+		payload.UserIDs = []int{newComment.ReplyToUserID}
+		if err := s.notificationTask.Submit(ctx, payload); err != nil {
+			s.logger.Warn("提交评论回复通知失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+	}
+
+	if postData.UserID != newComment.ReplyToUserID {
+		payload.Type = notification.TypeReply
+		payload.UserIDs = []int{postData.UserID}
+		if err := s.notificationTask.Submit(ctx, payload); err != nil {
+			s.logger.Warn("提交帖子回复通知失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+	}
+}
+
 // DislikeComment 点踩评论
 func (s *CommentService) DislikeComment(ctx context.Context, userID int, req schema.UserCommentActionRequest) (*schema.UserCommentActionResponse, error) {
 	s.logger.Info("点踩评论", zap.Int("user_id", userID), zap.Int("comment_id", req.ID), tracing.WithTraceIDField(ctx))
@@ -280,10 +446,88 @@ func (s *CommentService) DislikeComment(ctx context.Context, userID int, req sch
 	return result, nil
 }
 
+// PinComment 置顶评论
+// 拥有评论置顶权限的用户只能置顶自己帖子下的评论，或自己担任版主的版块中的评论
+func (s *CommentService) PinComment(ctx context.Context, userID int, req schema.CommentPinUpdateRequest) error {
+	s.logger.Info("置顶评论", zap.Int("user_id", userID), zap.Int("comment_id", req.ID), zap.Bool("is_pinned", req.IsPinned), tracing.WithTraceIDField(ctx))
+
+	commentData, err := s.db.Comment.Query().
+		Where(comment.IDEQ(req.ID)).
+		Select(comment.FieldPostID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("评论不存在")
+		}
+		s.logger.Error("获取评论失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("获取评论失败: %w", err)
+	}
+
+	postData, err := s.db.Post.Query().
+		Where(post.IDEQ(commentData.PostID)).
+		Select(post.FieldUserID, post.FieldCategoryID).
+		Only(ctx)
+	if err != nil {
+		s.logger.Error("获取评论所属帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("获取帖子信息失败: %w", err)
+	}
+
+	// 检查帖子所在版块是否可见
+	tree, categoryVisitor, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, userID)
+	if err != nil {
+		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return err
+	}
+	if err = tree.checkCategoryReadable(postData.CategoryID, categoryVisitor); err != nil {
+		return err
+	}
+
+	// 检查是否为楼主或版主
+	if postData.UserID != userID {
+		isModerator, err := isCategoryModerator(ctx, s.db, s.cache, userID, postData.CategoryID)
+		if err != nil {
+			s.logger.Error("检查版主权限失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("检查版主权限失败: %w", err)
+		}
+		if !isModerator {
+			return errors.New("只有楼主或版主可以置顶该评论")
+		}
+	}
+
+	if err = s.db.Comment.UpdateOneID(req.ID).SetIsPinned(req.IsPinned).Exec(ctx); err != nil {
+		s.logger.Error("置顶评论失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("置顶评论失败: %w", err)
+	}
+
+	s.logger.Info("置顶评论成功", zap.Int("comment_id", req.ID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
 // GetCommentList 获取评论列表
 func (s *CommentService) GetCommentList(ctx context.Context, req schema.UserCommentListRequest) (*schema.UserCommentListResponse, error) {
 	s.logger.Info("获取评论列表", zap.Int("post_id", req.PostID), zap.Int("page", req.Page), tracing.WithTraceIDField(ctx))
 
+	// 检查帖子所在版块是否可见，与帖子详情保持一致
+	postData, err := s.db.Post.Query().
+		Where(post.IDEQ(req.PostID)).
+		Select(post.FieldCategoryID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("帖子不存在")
+		}
+		s.logger.Error("获取帖子信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取帖子信息失败: %w", err)
+	}
+	tree, categoryVisitor, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, tracing.GetUserID(ctx))
+	if err != nil {
+		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	if err = tree.checkCategoryReadable(postData.CategoryID, categoryVisitor); err != nil {
+		return nil, err
+	}
+
 	// 构建查询条件，固定按创建时间升序排序（盖楼形式：最早的在最前面）
 	query := s.db.Comment.Query().
 		Where(comment.PostIDEQ(req.PostID)).
@@ -322,7 +566,7 @@ func (s *CommentService) GetCommentList(ctx context.Context, req schema.UserComm
 	}
 	users, err := s.db.User.Query().
 		Where(user.IDIn(userIDList...)).
-		Select(user.FieldID, user.FieldUsername).
+		Select(user.FieldID, user.FieldUsername, user.FieldExperience).
 		All(ctx)
 	if err != nil {
 		s.logger.Error("查询用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
@@ -386,10 +630,24 @@ func (s *CommentService) GetCommentList(ctx context.Context, req schema.UserComm
 		statsMap = make(map[int]*stats.Stats)
 	}
 
-	// 创建用户ID到用户名的映射
+	// 批量查询评论附件
+	attachmentMap, err := getAttachmentItems(ctx, s.db, s.storage, func(a *ent.Attachment) int { return a.CommentID },
+		attachment.CommentIDIn(commentIDs...))
+	if err != nil {
+		s.logger.Warn("查询评论附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
+	// 创建用户ID到用户名与等级的映射
+	levelTable := loadLevelTable(ctx, s.settingsService, s.logger)
 	userMap := make(map[int]string)
+	userLevelMap := make(map[int]*schema.UserLevelBrief)
 	for _, u := range users {
 		userMap[u.ID] = u.Username
+		userLevelMap[u.ID] = buildUserLevelBrief(levelTable, u.Experience)
+	}
+	userBadgeMap, err := loadDisplayBadges(ctx, s.db, userIDList)
+	if err != nil {
+		s.logger.Warn("查询展示徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 	}
 
 	// 计算起始楼号
@@ -431,7 +689,10 @@ func (s *CommentService) GetCommentList(ctx context.Context, req schema.UserComm
 			FloorNumber:  startFloorNumber + i, // 计算楼号
 			UserID:       commentData.UserID,
 			Username:     username,
+			AuthorLevel:  userLevelMap[commentData.UserID],
+			AuthorBadges: userBadgeMap[commentData.UserID],
 			Content:      commentData.Content,
+			ContentHTML:  renderMarkdown(ctx, s.cache, s.logger, commentData.Content),
 			LikeCount:    likeCount,
 			DislikeCount: dislikeCount,
 			UserLiked:    userLiked,
@@ -440,6 +701,7 @@ func (s *CommentService) GetCommentList(ctx context.Context, req schema.UserComm
 			IsPinned:     commentData.IsPinned,
 			CreatedAt:    commentData.CreatedAt.Format(time_tools.DateTimeFormat),
 			UpdatedAt:    commentData.UpdatedAt.Format(time_tools.DateTimeFormat),
+			Attachments:  attachmentMap[commentData.ID],
 		}
 
 		// 只有当ParentID不为0时才设置
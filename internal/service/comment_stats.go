@@ -183,7 +183,7 @@ func (s *CommentStatsService) PerformAction(ctx context.Context, userID, comment
 	_ = s.statsHelper.MarkDirty(ctx, stats.CommentDirtySetKey, commentID)
 
 	s.logger.Info("执行评论操作成功", zap.Int("comment_id", commentID), tracing.WithTraceIDField(ctx))
-	return s.GetStats(ctx, commentID)
+	return s.getStatsAndPublish(ctx, commentID)
 }
 
 // CancelAction 取消评论操作
@@ -225,7 +225,7 @@ func (s *CommentStatsService) CancelAction(ctx context.Context, userID, commentI
 	_ = s.statsHelper.MarkDirty(ctx, stats.CommentDirtySetKey, commentID)
 
 	s.logger.Info("取消评论操作成功", zap.Int("comment_id", commentID), tracing.WithTraceIDField(ctx))
-	return s.GetStats(ctx, commentID)
+	return s.getStatsAndPublish(ctx, commentID)
 }
 
 // GetStats 获取评论统计数据
@@ -457,3 +457,22 @@ func (s *CommentStatsService) getStatsField(actionType stats.ActionType) string
 		return ""
 	}
 }
+
+// getStatsAndPublish 获取最新统计数据并推送给正在浏览评论所在帖子的用户
+func (s *CommentStatsService) getStatsAndPublish(ctx context.Context, commentID int) (*stats.Stats, error) {
+	commentStats, err := s.GetStats(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	commentData, err := s.db.Comment.Query().
+		Where(comment.IDEQ(commentID)).
+		Select(comment.FieldPostID).
+		Only(ctx)
+	if err != nil {
+		s.logger.Warn("获取评论所在帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return commentStats, nil
+	}
+	publishRealtimeEvent(ctx, s.cache, s.logger, realtimePostChannel(commentData.PostID), RealtimeEventCommentStats, commentStats)
+	return commentStats, nil
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/blacklist"
+	"github.com/PokeForum/PokeForum/ent/category"
+	"github.com/PokeForum/PokeForum/ent/follow"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// IFollowService 关注服务接口
+type IFollowService interface {
+	// Follow 关注用户或版块
+	Follow(ctx context.Context, userID int, req schema.FollowRequest) (*schema.FollowResponse, error)
+	// Unfollow 取消关注用户或版块
+	Unfollow(ctx context.Context, userID int, req schema.FollowRequest) (*schema.FollowResponse, error)
+	// GetFollowing 获取关注列表
+	GetFollowing(ctx context.Context, userID int, req schema.FollowingListRequest) (*schema.FollowingListResponse, error)
+	// GetFollowers 获取粉丝列表
+	GetFollowers(ctx context.Context, userID int, req schema.FollowerListRequest) (*schema.FollowerListResponse, error)
+	// GetFollowCounts 获取用户的粉丝数与关注用户数
+	GetFollowCounts(ctx context.Context, userID int) (followerCount int, followingCount int, err error)
+}
+
+// FollowService 关注服务实现
+type FollowService struct {
+	db     *ent.Client
+	cache  cache.ICacheService
+	logger *zap.Logger
+}
+
+// NewFollowService 创建关注服务实例
+func NewFollowService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IFollowService {
+	return &FollowService{
+		db:     db,
+		cache:  cacheService,
+		logger: logger,
+	}
+}
+
+// Follow 关注用户或版块，重复关注直接返回成功
+func (s *FollowService) Follow(ctx context.Context, userID int, req schema.FollowRequest) (*schema.FollowResponse, error) {
+	s.logger.Info("关注", zap.Int("user_id", userID), zap.String("target_type", req.TargetType), zap.Int("target_id", req.TargetID), tracing.WithTraceIDField(ctx))
+
+	targetType := follow.TargetType(req.TargetType)
+	if err := s.checkTarget(ctx, userID, targetType, req.TargetID); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.db.Follow.Query().
+		Where(follow.UserIDEQ(userID), follow.TargetTypeEQ(targetType), follow.TargetIDEQ(req.TargetID)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("查询关注记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询关注记录失败: %w", err)
+	}
+	if !exists {
+		err = s.db.Follow.Create().
+			SetUserID(userID).
+			SetTargetType(targetType).
+			SetTargetID(req.TargetID).
+			Exec(ctx)
+		// 并发关注时唯一索引冲突，视为已关注
+		if err != nil && !ent.IsConstraintError(err) {
+			s.logger.Error("创建关注记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("关注失败: %w", err)
+		}
+	}
+
+	return s.buildFollowResponse(ctx, targetType, req.TargetID, true)
+}
+
+// Unfollow 取消关注用户或版块
+func (s *FollowService) Unfollow(ctx context.Context, userID int, req schema.FollowRequest) (*schema.FollowResponse, error) {
+	s.logger.Info("取消关注", zap.Int("user_id", userID), zap.String("target_type", req.TargetType), zap.Int("target_id", req.TargetID), tracing.WithTraceIDField(ctx))
+
+	targetType := follow.TargetType(req.TargetType)
+	if _, err := s.db.Follow.Delete().
+		Where(follow.UserIDEQ(userID), follow.TargetTypeEQ(targetType), follow.TargetIDEQ(req.TargetID)).
+		Exec(ctx); err != nil {
+		s.logger.Error("删除关注记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("取消关注失败: %w", err)
+	}
+
+	return s.buildFollowResponse(ctx, targetType, req.TargetID, false)
+}
+
+// checkTarget 检查关注对象是否存在且允许关注
+func (s *FollowService) checkTarget(ctx context.Context, userID int, targetType follow.TargetType, targetID int) error {
+	switch targetType {
+	case follow.TargetTypeUser:
+		if targetID == userID {
+			return errors.New("不能关注自己")
+		}
+		exists, err := s.db.User.Query().
+			Where(user.IDEQ(targetID), user.StatusNEQ(user.StatusBlocked)).
+			Exist(ctx)
+		if err != nil {
+			s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("获取用户信息失败: %w", err)
+		}
+		if !exists {
+			return errors.New("用户不存在")
+		}
+		blocked, err := NewBlacklistService(s.db, s.logger).IsUserBlocked(ctx, targetID, userID)
+		if err != nil {
+			return fmt.Errorf("检查拉黑状态失败: %w", err)
+		}
+		if blocked {
+			return errors.New("对方已将您拉黑，无法关注")
+		}
+	case follow.TargetTypeCategory:
+		// 版块状态与访问控制规则从父版块继承
+		tree, viewer, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, userID)
+		if err != nil {
+			s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return err
+		}
+		if err = tree.checkCategoryReadable(targetID, viewer); err != nil {
+			return err
+		}
+	default:
+		return errors.New("不支持的关注类型")
+	}
+	return nil
+}
+
+// buildFollowResponse 构建关注操作响应
+func (s *FollowService) buildFollowResponse(ctx context.Context, targetType follow.TargetType, targetID int, following bool) (*schema.FollowResponse, error) {
+	count, err := s.db.Follow.Query().
+		Where(follow.TargetTypeEQ(targetType), follow.TargetIDEQ(targetID)).
+		Count(ctx)
+	if err != nil {
+		s.logger.Error("统计粉丝数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("统计粉丝数失败: %w", err)
+	}
+
+	return &schema.FollowResponse{
+		TargetType:    string(targetType),
+		TargetID:      targetID,
+		Following:     following,
+		FollowerCount: count,
+	}, nil
+}
+
+// GetFollowing 获取关注列表
+func (s *FollowService) GetFollowing(ctx context.Context, userID int, req schema.FollowingListRequest) (*schema.FollowingListResponse, error) {
+	s.logger.Info("获取关注列表", zap.Int("user_id", userID), zap.String("target_type", req.TargetType), tracing.WithTraceIDField(ctx))
+
+	if req.TargetType == "" {
+		req.TargetType = string(follow.TargetTypeUser)
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+
+	targetType := follow.TargetType(req.TargetType)
+	query := s.db.Follow.Query().
+		Where(follow.UserIDEQ(userID), follow.TargetTypeEQ(targetType))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取关注总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取关注总数失败: %w", err)
+	}
+
+	follows, err := query.
+		Order(ent.Desc(follow.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取关注列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取关注列表失败: %w", err)
+	}
+
+	targetIDs := make([]int, len(follows))
+	for i, f := range follows {
+		targetIDs[i] = f.TargetID
+	}
+
+	// 关注对象ID -> 名称与头像
+	names := make(map[int][2]string)
+	if targetType == follow.TargetTypeUser {
+		users, err := s.db.User.Query().
+			Where(user.IDIn(targetIDs...)).
+			Select(user.FieldID, user.FieldUsername, user.FieldAvatar).
+			All(ctx)
+		if err != nil {
+			s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("获取用户信息失败: %w", err)
+		}
+		for _, u := range users {
+			names[u.ID] = [2]string{u.Username, u.Avatar}
+		}
+	} else {
+		categories, err := s.db.Category.Query().
+			Where(category.IDIn(targetIDs...)).
+			Select(category.FieldID, category.FieldName, category.FieldIcon).
+			All(ctx)
+		if err != nil {
+			s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("获取版块信息失败: %w", err)
+		}
+		for _, c := range categories {
+			names[c.ID] = [2]string{c.Name, c.Icon}
+		}
+	}
+
+	list := make([]schema.FollowingItem, 0, len(follows))
+	for _, f := range follows {
+		info, ok := names[f.TargetID]
+		if !ok {
+			continue // 关注对象已删除，跳过
+		}
+		list = append(list, schema.FollowingItem{
+			TargetType: string(f.TargetType),
+			TargetID:   f.TargetID,
+			Name:       info[0],
+			Avatar:     info[1],
+			CreatedAt:  f.CreatedAt.Format(time_tools.DateTimeFormat),
+		})
+	}
+
+	return &schema.FollowingListResponse{
+		List:     list,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// GetFollowers 获取粉丝列表
+func (s *FollowService) GetFollowers(ctx context.Context, userID int, req schema.FollowerListRequest) (*schema.FollowerListResponse, error) {
+	s.logger.Info("获取粉丝列表", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+
+	query := s.db.Follow.Query().
+		Where(follow.TargetTypeEQ(follow.TargetTypeUser), follow.TargetIDEQ(userID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取粉丝总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取粉丝总数失败: %w", err)
+	}
+
+	follows, err := query.
+		Order(ent.Desc(follow.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取粉丝列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取粉丝列表失败: %w", err)
+	}
+
+	followerIDs := make([]int, len(follows))
+	for i, f := range follows {
+		followerIDs[i] = f.UserID
+	}
+	users, err := s.db.User.Query().
+		Where(user.IDIn(followerIDs...)).
+		Select(user.FieldID, user.FieldUsername, user.FieldAvatar).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	userMap := make(map[int]*ent.User, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u
+	}
+
+	list := make([]schema.FollowerItem, 0, len(follows))
+	for _, f := range follows {
+		u, ok := userMap[f.UserID]
+		if !ok {
+			continue // 用户不存在，跳过
+		}
+		list = append(list, schema.FollowerItem{
+			UserID:    u.ID,
+			Username:  u.Username,
+			Avatar:    u.Avatar,
+			CreatedAt: f.CreatedAt.Format(time_tools.DateTimeFormat),
+		})
+	}
+
+	return &schema.FollowerListResponse{
+		List:     list,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// GetFollowCounts 获取用户的粉丝数与关注用户数
+func (s *FollowService) GetFollowCounts(ctx context.Context, userID int) (int, int, error) {
+	followerCount, err := s.db.Follow.Query().
+		Where(follow.TargetTypeEQ(follow.TargetTypeUser), follow.TargetIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("统计粉丝数失败: %w", err)
+	}
+
+	followingCount, err := s.db.Follow.Query().
+		Where(follow.UserIDEQ(userID), follow.TargetTypeEQ(follow.TargetTypeUser)).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("统计关注数失败: %w", err)
+	}
+
+	return followerCount, followingCount, nil
+}
+
+// postInFollowFeed 帖子作者或所在版块被指定用户关注
+// 关注列表通过子查询关联，关注数量较多时也无需把ID列表加载到内存
+func postInFollowFeed(userID int) predicate.Post {
+	return func(s *sql.Selector) {
+		t := sql.Table(follow.Table)
+		followed := func(targetType follow.TargetType) *sql.Selector {
+			return sql.Select(t.C(follow.FieldTargetID)).
+				From(t).
+				Where(sql.And(
+					sql.EQ(t.C(follow.FieldUserID), userID),
+					sql.EQ(t.C(follow.FieldTargetType), string(targetType)),
+				))
+		}
+		s.Where(sql.Or(
+			sql.In(s.C(post.FieldUserID), followed(follow.TargetTypeUser)),
+			sql.In(s.C(post.FieldCategoryID), followed(follow.TargetTypeCategory)),
+		))
+	}
+}
+
+// postAuthorNotBlocked 帖子作者不在指定用户的黑名单中
+func postAuthorNotBlocked(userID int) predicate.Post {
+	return func(s *sql.Selector) {
+		t := sql.Table(blacklist.Table)
+		s.Where(sql.NotIn(
+			s.C(post.FieldUserID),
+			sql.Select(t.C(blacklist.FieldBlockedUserID)).
+				From(t).
+				Where(sql.EQ(t.C(blacklist.FieldUserID), userID)),
+		))
+	}
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/attachment"
+	"github.com/PokeForum/PokeForum/ent/user"
+	pkgasynq "github.com/PokeForum/PokeForum/internal/pkg/asynq"
+	"github.com/PokeForum/PokeForum/internal/pkg/image_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/storage"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+)
+
+const (
+	// thumbnailKeyPrefix 缩略图在存储驱动中的键名前缀
+	thumbnailKeyPrefix = "thumbnails"
+	// avatarKeyPrefix 头像在存储驱动中的键名前缀
+	avatarKeyPrefix = "avatars"
+	// thumbnailMaxSize 缩略图最大边长（像素）
+	thumbnailMaxSize = 400
+)
+
+// ImageProcessTask 图片处理异步任务
+// 上传接口只保存原图并立即返回，缩略图、头像裁剪与元数据清理在任务中完成
+type ImageProcessTask struct {
+	db          *ent.Client
+	storage     storage.IStorage
+	logger      *zap.Logger
+	taskManager *pkgasynq.TaskManager
+}
+
+// AttachmentImageTaskPayload 附件图片处理任务载荷
+type AttachmentImageTaskPayload struct {
+	StorageKey string `json:"storage_key"`
+	TraceID    string `json:"trace_id"` // 用于链路追踪
+}
+
+// AvatarImageTaskPayload 头像裁剪任务载荷
+type AvatarImageTaskPayload struct {
+	UserID       int    `json:"user_id"`
+	AttachmentID int    `json:"attachment_id"`
+	SourceURL    string `json:"source_url"` // 任务提交时设置的头像地址，用户期间更换过头像则不再覆盖
+	TraceID      string `json:"trace_id"`   // 用于链路追踪
+}
+
+// NewImageProcessTask 创建图片处理异步任务
+func NewImageProcessTask(db *ent.Client, store storage.IStorage, taskManager *pkgasynq.TaskManager, logger *zap.Logger) *ImageProcessTask {
+	return &ImageProcessTask{
+		db:          db,
+		storage:     store,
+		logger:      logger,
+		taskManager: taskManager,
+	}
+}
+
+// RegisterHandler 注册任务处理器到TaskManager
+func (t *ImageProcessTask) RegisterHandler() {
+	t.taskManager.RegisterHandlerFunc(pkgasynq.TypeImageAttachment, t.HandleAttachmentTask)
+	t.taskManager.RegisterHandlerFunc(pkgasynq.TypeImageAvatar, t.HandleAvatarTask)
+	t.logger.Info("图片处理任务处理器已注册")
+}
+
+// SubmitAttachmentTask 提交附件图片处理任务
+func (t *ImageProcessTask) SubmitAttachmentTask(ctx context.Context, storageKey string) error {
+	return t.submit(ctx, pkgasynq.TypeImageAttachment, &AttachmentImageTaskPayload{
+		StorageKey: storageKey,
+		TraceID:    tracing.GetTraceID(ctx),
+	})
+}
+
+// SubmitAvatarTask 提交头像裁剪任务
+func (t *ImageProcessTask) SubmitAvatarTask(ctx context.Context, userID, attachmentID int, sourceURL string) error {
+	return t.submit(ctx, pkgasynq.TypeImageAvatar, &AvatarImageTaskPayload{
+		UserID:       userID,
+		AttachmentID: attachmentID,
+		SourceURL:    sourceURL,
+		TraceID:      tracing.GetTraceID(ctx),
+	})
+}
+
+// submit 序列化载荷并提交任务
+func (t *ImageProcessTask) submit(ctx context.Context, taskType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("序列化图片处理任务失败: %w", err)
+	}
+
+	task := asynq.NewTask(taskType, data, asynq.MaxRetry(3), asynq.Queue(pkgasynq.QueueLow), asynq.Timeout(2*time.Minute))
+	info, err := t.taskManager.EnqueueContext(ctx, task)
+	if err != nil {
+		t.logger.Error("提交图片处理任务失败", zap.String("task_type", taskType), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交任务失败: %w", err)
+	}
+
+	t.logger.Debug("提交图片处理任务成功", zap.String("task_type", taskType), zap.String("task_id", info.ID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// HandleAttachmentTask 处理附件图片：清理元数据、截断动图并生成缩略图
+func (t *ImageProcessTask) HandleAttachmentTask(ctx context.Context, task *asynq.Task) error {
+	var payload AttachmentImageTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		t.logger.Error("反序列化图片处理任务失败", zap.Error(err))
+		return fmt.Errorf("反序列化失败: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.TraceID != "" {
+		ctx = tracing.WithTraceID(ctx, payload.TraceID)
+	}
+
+	t.logger.Info("开始处理附件图片", zap.String("key", payload.StorageKey), tracing.WithTraceIDField(ctx))
+
+	attachmentData, err := t.db.Attachment.Query().
+		Where(attachment.StorageKeyEQ(payload.StorageKey)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// 附件已被删除
+			return nil
+		}
+		return fmt.Errorf("查询附件失败: %w", err)
+	}
+
+	data, err := t.read(ctx, payload.StorageKey)
+	if err != nil {
+		return err
+	}
+
+	// 重新编码原图，去除EXIF与GPS信息
+	sanitized, err := image_tools.Sanitize(data)
+	if err != nil {
+		t.logger.Warn("图片无法处理，跳过", zap.String("key", payload.StorageKey), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil
+	}
+	if sanitized != nil {
+		if err = t.storage.Put(ctx, payload.StorageKey, bytes.NewReader(sanitized), int64(len(sanitized)), attachmentData.MimeType); err != nil {
+			return fmt.Errorf("保存处理后的图片失败: %w", err)
+		}
+		data = sanitized
+	}
+
+	img, err := image_tools.Decode(data)
+	if err != nil {
+		t.logger.Warn("图片无法解码，跳过缩略图", zap.String("key", payload.StorageKey), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil
+	}
+	thumb, err := image_tools.EncodeJPEG(image_tools.Thumbnail(img, thumbnailMaxSize))
+	if err != nil {
+		return err
+	}
+
+	hash := attachmentData.Hash
+	thumbKey := fmt.Sprintf("%s/%s/%s/%s.jpg", thumbnailKeyPrefix, hash[:2], hash[2:4], hash)
+	if err = t.storage.Put(ctx, thumbKey, bytes.NewReader(thumb), int64(len(thumb)), "image/jpeg"); err != nil {
+		return fmt.Errorf("保存缩略图失败: %w", err)
+	}
+
+	// 同一存储对象的所有附件共用处理结果
+	bounds := img.Bounds()
+	update := t.db.Attachment.Update().
+		Where(attachment.StorageKeyEQ(payload.StorageKey)).
+		SetThumbnailKey(thumbKey).
+		SetWidth(bounds.Dx()).
+		SetHeight(bounds.Dy())
+	if sanitized != nil {
+		update = update.SetSize(int64(len(sanitized)))
+	}
+	if err = update.Exec(ctx); err != nil {
+		return fmt.Errorf("更新附件信息失败: %w", err)
+	}
+
+	t.logger.Info("附件图片处理完成", zap.String("key", payload.StorageKey), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// HandleAvatarTask 处理头像：按固定尺寸居中裁剪并更新用户头像地址
+func (t *ImageProcessTask) HandleAvatarTask(ctx context.Context, task *asynq.Task) error {
+	var payload AvatarImageTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		t.logger.Error("反序列化头像裁剪任务失败", zap.Error(err))
+		return fmt.Errorf("反序列化失败: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.TraceID != "" {
+		ctx = tracing.WithTraceID(ctx, payload.TraceID)
+	}
+
+	t.logger.Info("开始处理头像", zap.Int("user_id", payload.UserID), zap.Int("attachment_id", payload.AttachmentID), tracing.WithTraceIDField(ctx))
+
+	attachmentData, err := t.db.Attachment.Get(ctx, payload.AttachmentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("查询附件失败: %w", err)
+	}
+
+	data, err := t.read(ctx, attachmentData.StorageKey)
+	if err != nil {
+		return err
+	}
+	img, err := image_tools.Decode(data)
+	if err != nil {
+		t.logger.Warn("头像无法解码，跳过", zap.Int("attachment_id", payload.AttachmentID), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil
+	}
+
+	var largestKey string
+	for _, size := range image_tools.AvatarSizes {
+		encoded, err := image_tools.EncodeJPEG(image_tools.Square(img, size))
+		if err != nil {
+			return err
+		}
+		key := avatarKey(payload.UserID, attachmentData.Hash, size)
+		if err = t.storage.Put(ctx, key, bytes.NewReader(encoded), int64(len(encoded)), "image/jpeg"); err != nil {
+			return fmt.Errorf("保存头像失败: %w", err)
+		}
+		largestKey = key
+	}
+
+	// 仅当头像仍为提交任务时的地址才替换为裁剪后的版本
+	err = t.db.User.UpdateOneID(payload.UserID).
+		Where(user.AvatarEQ(payload.SourceURL)).
+		SetAvatar(storageURL(t.storage, largestKey)).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("更新头像失败: %w", err)
+	}
+
+	t.logger.Info("头像处理完成", zap.Int("user_id", payload.UserID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// read 读取存储对象内容，对象不存在时不再重试
+func (t *ImageProcessTask) read(ctx context.Context, key string) ([]byte, error) {
+	reader, err := t.storage.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("存储对象不存在: %s: %w", key, asynq.SkipRetry)
+		}
+		return nil, fmt.Errorf("读取存储对象失败: %w", err)
+	}
+	defer func() {
+		_ = reader.Close() //nolint:errcheck // 读取器关闭失败无需处理
+	}()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("读取存储对象失败: %w", err)
+	}
+	return data, nil
+}
+
+// avatarKey 生成头像裁剪结果的存储键名，不同尺寸仅后缀不同
+func avatarKey(userID int, hash string, size int) string {
+	return fmt.Sprintf("%s/%d/%s_%d.jpg", avatarKeyPrefix, userID, hash[:16], size)
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/invitecode"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/userinvitation"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/utils"
+)
+
+// inviteCodeLength 邀请码长度
+const inviteCodeLength = 12
+
+// IInviteCodeService 邀请码服务接口
+type IInviteCodeService interface {
+	// CreateInviteCode 创建邀请码
+	CreateInviteCode(ctx context.Context, userID int) (*schema.InviteCodeItem, error)
+	// GetInviteCodes 获取用户的邀请码列表
+	GetInviteCodes(ctx context.Context, userID int) (*schema.InviteCodeListResponse, error)
+	// RevokeInviteCode 作废邀请码
+	RevokeInviteCode(ctx context.Context, userID int, req schema.InviteCodeRevokeRequest) error
+}
+
+// InviteCodeService 邀请码服务实现
+type InviteCodeService struct {
+	db       *ent.Client
+	logger   *zap.Logger
+	settings ISettingsService
+}
+
+// NewInviteCodeService 创建邀请码服务实例
+func NewInviteCodeService(db *ent.Client, logger *zap.Logger, settingsService ISettingsService) IInviteCodeService {
+	return &InviteCodeService{
+		db:       db,
+		logger:   logger,
+		settings: settingsService,
+	}
+}
+
+// CreateInviteCode 创建邀请码
+func (s *InviteCodeService) CreateInviteCode(ctx context.Context, userID int) (*schema.InviteCodeItem, error) {
+	s.logger.Info("创建邀请码", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	enabled, err := isInviteCodeRequired(ctx, s.settings, s.logger)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, errors.New("系统未开启邀请码注册")
+	}
+
+	// 检查用户可创建的邀请码总数，作废或用尽后不会释放名额，避免反复作废重建绕过限制
+	userLimit, err := getIntSetting(ctx, s.settings, s.logger, _const.SafeInviteCodeUserLimit)
+	if err != nil {
+		return nil, err
+	}
+	if userLimit > 0 {
+		createdCount, err := s.countCreatedCodes(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if createdCount >= userLimit {
+			return nil, fmt.Errorf("最多只能创建%d个邀请码", userLimit)
+		}
+	}
+
+	// 使用次数在创建时确定，后续调整设置不影响已创建的邀请码
+	maxUses, err := getIntSetting(ctx, s.settings, s.logger, _const.SafeInviteCodeMaxUses)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := s.db.InviteCode.Create().
+		SetUserID(userID).
+		SetCode(utils.RandomString(inviteCodeLength)).
+		SetMaxUses(maxUses).
+		Save(ctx)
+	if err != nil {
+		s.logger.Error("创建邀请码失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建邀请码失败: %w", err)
+	}
+
+	item := toInviteCodeItem(code)
+	return &item, nil
+}
+
+// GetInviteCodes 获取用户的邀请码列表
+func (s *InviteCodeService) GetInviteCodes(ctx context.Context, userID int) (*schema.InviteCodeListResponse, error) {
+	s.logger.Info("获取邀请码列表", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	codes, err := s.db.InviteCode.Query().
+		Where(invitecode.UserIDEQ(userID)).
+		Order(ent.Desc(invitecode.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("查询邀请码失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询邀请码失败: %w", err)
+	}
+
+	userLimit, err := getIntSetting(ctx, s.settings, s.logger, _const.SafeInviteCodeUserLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	invitedCount, err := s.db.UserInvitation.Query().
+		Where(userinvitation.InviterIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		s.logger.Error("查询邀请记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询邀请记录失败: %w", err)
+	}
+
+	resp := &schema.InviteCodeListResponse{
+		List:         make([]schema.InviteCodeItem, 0, len(codes)),
+		UserLimit:    userLimit,
+		CreatedCount: len(codes),
+		InvitedCount: invitedCount,
+	}
+	for _, code := range codes {
+		// 已用尽次数的邀请码不计入有效数量
+		if code.Status == invitecode.StatusActive && (code.MaxUses == 0 || code.UsedCount < code.MaxUses) {
+			resp.ActiveCount++
+		}
+		resp.List = append(resp.List, toInviteCodeItem(code))
+	}
+
+	return resp, nil
+}
+
+// RevokeInviteCode 作废邀请码
+func (s *InviteCodeService) RevokeInviteCode(ctx context.Context, userID int, req schema.InviteCodeRevokeRequest) error {
+	s.logger.Info("作废邀请码", zap.Int("user_id", userID), zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
+
+	affected, err := s.db.InviteCode.Update().
+		Where(
+			invitecode.IDEQ(req.ID),
+			invitecode.UserIDEQ(userID),
+			invitecode.StatusEQ(invitecode.StatusActive),
+		).
+		SetStatus(invitecode.StatusRevoked).
+		Save(ctx)
+	if err != nil {
+		s.logger.Error("作废邀请码失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("作废邀请码失败: %w", err)
+	}
+	if affected == 0 {
+		return errors.New("邀请码不存在或已作废")
+	}
+
+	return nil
+}
+
+// countCreatedCodes 统计用户已创建的邀请码数量，包含已作废与已用尽的邀请码
+func (s *InviteCodeService) countCreatedCodes(ctx context.Context, userID int) (int, error) {
+	count, err := s.db.InviteCode.Query().
+		Where(invitecode.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		s.logger.Error("统计邀请码数量失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return 0, fmt.Errorf("统计邀请码数量失败: %w", err)
+	}
+	return count, nil
+}
+
+// isInviteCodeRequired 查询是否开启邀请码注册
+func isInviteCodeRequired(ctx context.Context, settings ISettingsService, logger *zap.Logger) (bool, error) {
+	enabled, err := settings.GetSettingByKey(ctx, _const.SafeIsEnableInviteCode, _const.SettingBoolFalse.String())
+	if err != nil {
+		logger.Error("查询邀请码设置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return false, fmt.Errorf("查询邀请码设置失败: %w", err)
+	}
+	return enabled == _const.SettingBoolTrue.String(), nil
+}
+
+// getIntSetting 查询整数类型的设置项，未配置或解析失败时返回0（不限制）
+func getIntSetting(ctx context.Context, settings ISettingsService, logger *zap.Logger, key string) (int, error) {
+	value, err := settings.GetSettingByKey(ctx, key, "0")
+	if err != nil {
+		logger.Error("查询设置失败", zap.String("key", key), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return 0, fmt.Errorf("查询设置失败: %w", err)
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, nil
+	}
+	return n, nil
+}
+
+// inviteCodeHasRemainingUses 邀请码仍有剩余使用次数：不限次数或已使用次数小于最大次数
+func inviteCodeHasRemainingUses() predicate.InviteCode {
+	return invitecode.Or(
+		invitecode.MaxUsesEQ(0),
+		func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(invitecode.FieldUsedCount), s.C(invitecode.FieldMaxUses)))
+		},
+	)
+}
+
+// consumeInviteCode 在事务中校验并消耗邀请码，返回邀请码记录
+// 通过带条件的UPDATE原子递增使用次数，并发注册时不会超出使用上限
+func consumeInviteCode(ctx context.Context, tx *ent.Tx, code string) (*ent.InviteCode, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, errors.New("请填写邀请码")
+	}
+
+	record, err := tx.InviteCode.Query().
+		Where(invitecode.CodeEQ(code)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("邀请码无效")
+		}
+		return nil, fmt.Errorf("查询邀请码失败: %w", err)
+	}
+
+	affected, err := tx.InviteCode.Update().
+		Where(
+			invitecode.IDEQ(record.ID),
+			invitecode.StatusEQ(invitecode.StatusActive),
+			inviteCodeHasRemainingUses(),
+		).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("使用邀请码失败: %w", err)
+	}
+	if affected == 0 {
+		return nil, errors.New("邀请码已失效或使用次数已达上限")
+	}
+
+	return record, nil
+}
+
+// toInviteCodeItem 转换邀请码记录为响应项
+func toInviteCodeItem(code *ent.InviteCode) schema.InviteCodeItem {
+	return schema.InviteCodeItem{
+		ID:        code.ID,
+		Code:      code.Code,
+		MaxUses:   code.MaxUses,
+		UsedCount: code.UsedCount,
+		Status:    code.Status.String(),
+		CreatedAt: code.CreatedAt.Format(time_tools.DateTimeFormat),
+	}
+}
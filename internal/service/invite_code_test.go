@@ -0,0 +1,81 @@
+package service
+
+import (
+	"strconv"
+	"testing"
+
+	"go.uber.org/zap"
+
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+func TestInviteCodeUserLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		enabled    bool
+		userLimit  int
+		creates    int
+		revokes    int // 创建后作废的邀请码数量
+		exhausts   int // 创建后用尽次数的邀请码数量
+		wantErr    bool
+		wantActive int // 最后一次创建前的有效邀请码数量
+	}{
+		{name: "未开启邀请码注册", enabled: false, creates: 0, wantErr: true},
+		{name: "不限制数量", enabled: true, userLimit: 0, creates: 5, wantActive: 5},
+		{name: "达到上限", enabled: true, userLimit: 2, creates: 2, wantErr: true, wantActive: 2},
+		{name: "作废后不释放名额", enabled: true, userLimit: 2, creates: 2, revokes: 1, wantErr: true, wantActive: 1},
+		{name: "用尽后不释放名额", enabled: true, userLimit: 2, creates: 2, exhausts: 2, wantErr: true, wantActive: 0},
+		{name: "未达到上限", enabled: true, userLimit: 3, creates: 1, revokes: 1, wantActive: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			if tt.enabled {
+				setTestSetting(t, db, _const.SafeIsEnableInviteCode, _const.SettingBoolTrue.String())
+			}
+			setTestSetting(t, db, _const.SafeInviteCodeUserLimit, strconv.Itoa(tt.userLimit))
+			setTestSetting(t, db, _const.SafeInviteCodeMaxUses, "1")
+			svc := NewInviteCodeService(db, zap.NewNop(), NewSettingsService(db, newTestCache(t), zap.NewNop()))
+			u := newTestUser(t, db, "inviter", "inviter@example.com")
+
+			for i := 0; i < tt.creates; i++ {
+				item, err := svc.CreateInviteCode(t.Context(), u.ID)
+				if err != nil {
+					t.Fatalf("创建第 %d 个邀请码失败: %v", i+1, err)
+				}
+				switch {
+				case i < tt.revokes:
+					if err = svc.RevokeInviteCode(t.Context(), u.ID, schema.InviteCodeRevokeRequest{ID: item.ID}); err != nil {
+						t.Fatalf("作废邀请码失败: %v", err)
+					}
+				case i < tt.revokes+tt.exhausts:
+					db.InviteCode.UpdateOneID(item.ID).SetUsedCount(1).ExecX(t.Context())
+				}
+			}
+
+			_, err := svc.CreateInviteCode(t.Context(), u.ID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+			if !tt.enabled {
+				return
+			}
+
+			list, err := svc.GetInviteCodes(t.Context(), u.ID)
+			if err != nil {
+				t.Fatalf("获取邀请码列表失败: %v", err)
+			}
+			wantCreated := tt.creates
+			wantActive := tt.wantActive
+			if !tt.wantErr {
+				wantCreated++
+				wantActive++
+			}
+			if list.CreatedCount != wantCreated || list.ActiveCount != wantActive {
+				t.Fatalf("已创建与有效数量应为 %d/%d，实际 %d/%d", wantCreated, wantActive, list.CreatedCount, list.ActiveCount)
+			}
+		})
+	}
+}
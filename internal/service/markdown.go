@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/markdown"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+)
+
+// markdownCacheTTL Markdown渲染结果缓存时间
+// 缓存键由渲染器版本与内容哈希生成，内容或渲染规则修改后自然使用新键，旧缓存到期后淘汰
+const markdownCacheTTL = 7 * 24 * time.Hour
+
+// renderMarkdown 将Markdown渲染为过滤后的HTML，结果按内容哈希缓存到Redis
+// 渲染失败时返回转义后的原文，保证接口正常返回
+func renderMarkdown(ctx context.Context, cacheService cache.ICacheService, logger *zap.Logger, source string) string {
+	if source == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(source))
+	cacheKey := fmt.Sprintf("markdown:html:v%s:%s", markdown.Version, hex.EncodeToString(sum[:]))
+
+	if cached, err := cacheService.Get(ctx, cacheKey); err == nil && cached != "" {
+		return cached
+	}
+
+	rendered, err := markdown.Render(source)
+	if err != nil {
+		logger.Warn("渲染Markdown失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return html.EscapeString(source)
+	}
+
+	if err = cacheService.SetExDuration(ctx, cacheKey, rendered, markdownCacheTTL); err != nil {
+		logger.Warn("缓存Markdown渲染结果失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+	return rendered
+}
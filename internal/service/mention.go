@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/blacklist"
+	"github.com/PokeForum/PokeForum/ent/comment"
+	"github.com/PokeForum/PokeForum/ent/mention"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+const (
+	// maxMentionsPerContent 单篇正文最多生效的提及人数，超出部分忽略
+	maxMentionsPerContent = 20
+	// contentExcerptLength 正文摘要最大字符数
+	contentExcerptLength = 120
+)
+
+var (
+	// mentionPattern @用户名匹配规则，@前不能紧跟字母数字，避免匹配邮箱地址
+	mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@])@([\p{L}\p{N}_-]{3,100})`)
+	// mentionCodePattern 代码块与行内代码，其中的 @ 不视为提及
+	mentionCodePattern = regexp.MustCompile("(?s)```.*?```|`[^`\n]*`")
+)
+
+// IMentionService 提及服务接口
+type IMentionService interface {
+	// GetMyMentions 获取当前用户被提及的列表
+	GetMyMentions(ctx context.Context, userID int, req schema.MentionListRequest) (*schema.MentionListResponse, error)
+	// MarkMentionsRead 标记提及为已读，未指定ID时标记全部
+	MarkMentionsRead(ctx context.Context, userID int, req schema.MentionReadRequest) error
+	// UpdateMentionSettings 更新提及提醒设置
+	UpdateMentionSettings(ctx context.Context, userID int, req schema.MentionSettingsRequest) error
+}
+
+// MentionService 提及服务实现
+type MentionService struct {
+	db       *ent.Client
+	cache    cache.ICacheService
+	logger   *zap.Logger
+	settings ISettingsService
+}
+
+// NewMentionService 创建提及服务实例
+func NewMentionService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IMentionService {
+	return &MentionService{
+		db:       db,
+		cache:    cacheService,
+		logger:   logger,
+		settings: NewSettingsService(db, cacheService, logger),
+	}
+}
+
+// GetMyMentions 获取当前用户被提及的列表
+func (s *MentionService) GetMyMentions(ctx context.Context, userID int, req schema.MentionListRequest) (*schema.MentionListResponse, error) {
+	s.logger.Info("获取提及列表", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+
+	query := s.db.Mention.Query().Where(mention.UserIDEQ(userID))
+	if req.UnreadOnly {
+		query = query.Where(mention.IsReadEQ(false))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取提及总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取提及总数失败: %w", err)
+	}
+
+	unreadCount, err := s.db.Mention.Query().
+		Where(mention.UserIDEQ(userID), mention.IsReadEQ(false)).
+		Count(ctx)
+	if err != nil {
+		s.logger.Error("获取未读提及数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取未读提及数失败: %w", err)
+	}
+
+	mentions, err := query.
+		Order(ent.Desc(mention.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取提及列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取提及列表失败: %w", err)
+	}
+
+	userData, err := s.db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldMentionEmailNotify).
+		Only(ctx)
+	if err != nil {
+		s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+
+	list, err := buildMentionItems(ctx, s.db, s.cache, loadLevelTable(ctx, s.settings, s.logger), userID, mentions)
+	if err != nil {
+		s.logger.Error("构建提及列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	return &schema.MentionListResponse{
+		List:        list,
+		Total:       total,
+		UnreadCount: unreadCount,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		EmailNotify: userData.MentionEmailNotify,
+	}, nil
+}
+
+// MarkMentionsRead 标记提及为已读，未指定ID时标记全部
+func (s *MentionService) MarkMentionsRead(ctx context.Context, userID int, req schema.MentionReadRequest) error {
+	s.logger.Info("标记提及已读", zap.Int("user_id", userID), zap.Ints("ids", req.IDs), tracing.WithTraceIDField(ctx))
+
+	update := s.db.Mention.Update().
+		Where(mention.UserIDEQ(userID), mention.IsReadEQ(false))
+	if len(req.IDs) > 0 {
+		update = update.Where(mention.IDIn(req.IDs...))
+	}
+
+	if _, err := update.SetIsRead(true).Save(ctx); err != nil {
+		s.logger.Error("标记提及已读失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("标记提及已读失败: %w", err)
+	}
+	return nil
+}
+
+// UpdateMentionSettings 更新提及提醒设置
+func (s *MentionService) UpdateMentionSettings(ctx context.Context, userID int, req schema.MentionSettingsRequest) error {
+	s.logger.Info("更新提及提醒设置", zap.Int("user_id", userID), zap.Bool("email_notify", req.EmailNotify), tracing.WithTraceIDField(ctx))
+
+	if err := s.db.User.UpdateOneID(userID).
+		SetMentionEmailNotify(req.EmailNotify).
+		Exec(ctx); err != nil {
+		s.logger.Error("更新提及提醒设置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("更新提及提醒设置失败: %w", err)
+	}
+	return nil
+}
+
+// parseMentions 解析正文中的 @用户名，结果去重并保持出现顺序
+func parseMentions(content string) []string {
+	content = mentionCodePattern.ReplaceAllString(content, " ")
+
+	seen := make(map[string]bool)
+	var usernames []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		name := strings.TrimRight(match[1], "-_")
+		if len([]rune(name)) < 3 || seen[name] {
+			continue
+		}
+		seen[name] = true
+		usernames = append(usernames, name)
+		if len(usernames) >= maxMentionsPerContent {
+			break
+		}
+	}
+	return usernames
+}
+
+// syncMentions 根据正文同步提及记录，返回新增的提及记录ID
+// 已存在的提及保持不变，正文中移除的提及一并删除；拉黑了发起者的用户不会被记录
+func syncMentions(ctx context.Context, tx *ent.Tx, fromUserID, postID, commentID int, content string) ([]int, error) {
+	usernames := parseMentions(content)
+
+	var targetIDs []int
+	if len(usernames) > 0 {
+		users, err := tx.User.Query().
+			Where(user.UsernameIn(usernames...), user.IDNEQ(fromUserID)).
+			Select(user.FieldID).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询被提及用户失败: %w", err)
+		}
+		for _, u := range users {
+			targetIDs = append(targetIDs, u.ID)
+		}
+	}
+
+	if len(targetIDs) > 0 {
+		// 排除拉黑了发起者的用户
+		blockers, err := tx.Blacklist.Query().
+			Where(blacklist.UserIDIn(targetIDs...), blacklist.BlockedUserIDEQ(fromUserID)).
+			Select(blacklist.FieldUserID).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询黑名单失败: %w", err)
+		}
+		blocked := make(map[int]bool, len(blockers))
+		for _, b := range blockers {
+			blocked[b.UserID] = true
+		}
+		filtered := targetIDs[:0]
+		for _, id := range targetIDs {
+			if !blocked[id] {
+				filtered = append(filtered, id)
+			}
+		}
+		targetIDs = filtered
+	}
+
+	existing, err := tx.Mention.Query().
+		Where(mention.PostIDEQ(postID), mention.CommentIDEQ(commentID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询提及记录失败: %w", err)
+	}
+
+	keep := make(map[int]bool, len(targetIDs))
+	for _, id := range targetIDs {
+		keep[id] = true
+	}
+	existed := make(map[int]bool, len(existing))
+	var removed []int
+	for _, m := range existing {
+		existed[m.UserID] = true
+		if !keep[m.UserID] {
+			removed = append(removed, m.ID)
+		}
+	}
+	if len(removed) > 0 {
+		if _, err = tx.Mention.Delete().Where(mention.IDIn(removed...)).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("删除提及记录失败: %w", err)
+		}
+	}
+
+	var builders []*ent.MentionCreate
+	for _, id := range targetIDs {
+		if existed[id] {
+			continue
+		}
+		builders = append(builders, tx.Mention.Create().
+			SetUserID(id).
+			SetFromUserID(fromUserID).
+			SetPostID(postID).
+			SetCommentID(commentID))
+	}
+	if len(builders) == 0 {
+		return nil, nil
+	}
+
+	created, err := tx.Mention.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("保存提及记录失败: %w", err)
+	}
+	ids := make([]int, len(created))
+	for i, m := range created {
+		ids[i] = m.ID
+	}
+	return ids, nil
+}
+
+// buildMentionItems 批量加载提及关联的用户、帖子与评论并构建列表项
+// 被提及用户无权阅读帖子正文时，帖子中的提及只返回预览内容
+func buildMentionItems(ctx context.Context, db *ent.Client, cacheService cache.ICacheService, levelTable []schema.LevelItem, viewerID int, mentions []*ent.Mention) ([]schema.MentionItem, error) {
+	list := make([]schema.MentionItem, 0, len(mentions))
+	if len(mentions) == 0 {
+		return list, nil
+	}
+
+	var userIDs, postIDs, commentIDs []int
+	for _, m := range mentions {
+		userIDs = append(userIDs, m.FromUserID)
+		postIDs = append(postIDs, m.PostID)
+		if m.CommentID > 0 {
+			commentIDs = append(commentIDs, m.CommentID)
+		}
+	}
+
+	users, err := db.User.Query().
+		Where(user.IDIn(uniqueInts(userIDs)...)).
+		Select(user.FieldID, user.FieldUsername, user.FieldAvatar).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	userMap := make(map[int]*ent.User, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u
+	}
+
+	posts, err := db.Post.Query().
+		Where(post.IDIn(uniqueInts(postIDs)...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取帖子信息失败: %w", err)
+	}
+	postMap := make(map[int]*ent.Post, len(posts))
+	for _, p := range posts {
+		postMap[p.ID] = p
+	}
+
+	commentMap := make(map[int]*ent.Comment)
+	if len(commentIDs) > 0 {
+		comments, err := db.Comment.Query().
+			Where(comment.IDIn(uniqueInts(commentIDs)...)).
+			Select(comment.FieldID, comment.FieldContent).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("获取评论信息失败: %w", err)
+		}
+		for _, c := range comments {
+			commentMap[c.ID] = c
+		}
+	}
+
+	viewer, err := loadPostReadViewer(ctx, db, cacheService, levelTable, viewerID, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range mentions {
+		item := schema.MentionItem{
+			ID:         m.ID,
+			FromUserID: m.FromUserID,
+			PostID:     m.PostID,
+			CommentID:  m.CommentID,
+			IsRead:     m.IsRead,
+			CreatedAt:  m.CreatedAt.Format(time_tools.DateTimeFormat),
+		}
+		if u, ok := userMap[m.FromUserID]; ok {
+			item.FromUsername = u.Username
+			item.FromAvatar = u.Avatar
+		}
+		if p, ok := postMap[m.PostID]; ok {
+			item.PostTitle = p.Title
+			if m.CommentID == 0 {
+				content := p.Content
+				if !viewer.canRead(p) {
+					content = postContentTeaser(p.Content)
+				}
+				item.Excerpt = contentExcerpt(content)
+			}
+		}
+		if c, ok := commentMap[m.CommentID]; ok {
+			item.Excerpt = contentExcerpt(c.Content)
+		}
+		list = append(list, item)
+	}
+	return list, nil
+}
+
+// mentionExcerpt 截取正文摘要
+func contentExcerpt(content string) string {
+	runes := []rune(strings.TrimSpace(content))
+	if len(runes) <= contentExcerptLength {
+		return string(runes)
+	}
+	return string(runes[:contentExcerptLength]) + "..."
+}
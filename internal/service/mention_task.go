@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/mention"
+	"github.com/PokeForum/PokeForum/ent/notification"
+	"github.com/PokeForum/PokeForum/ent/user"
+	pkgasynq "github.com/PokeForum/PokeForum/internal/pkg/asynq"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	smtp "github.com/PokeForum/PokeForum/internal/pkg/email"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// MentionTask 提及提醒异步任务
+// 发帖与评论只负责记录提及，站内通知与邮件提醒在任务中发送，避免SMTP耗时影响接口响应
+type MentionTask struct {
+	db               *ent.Client
+	cache            cache.ICacheService
+	logger           *zap.Logger
+	taskManager      *pkgasynq.TaskManager
+	notificationTask *NotificationTask
+}
+
+// MentionEmailTaskPayload 提及邮件提醒任务载荷
+type MentionEmailTaskPayload struct {
+	MentionIDs []int  `json:"mention_ids"`
+	TraceID    string `json:"trace_id"` // 用于链路追踪
+}
+
+// NewMentionTask 创建提及提醒异步任务
+func NewMentionTask(db *ent.Client, cacheService cache.ICacheService, taskManager *pkgasynq.TaskManager, notificationTask *NotificationTask, logger *zap.Logger) *MentionTask {
+	return &MentionTask{
+		db:               db,
+		cache:            cacheService,
+		logger:           logger,
+		taskManager:      taskManager,
+		notificationTask: notificationTask,
+	}
+}
+
+// RegisterHandler 注册任务处理器到TaskManager
+func (t *MentionTask) RegisterHandler() {
+	t.taskManager.RegisterHandlerFunc(pkgasynq.TypeMentionEmail, t.HandleEmailTask)
+	t.logger.Info("提及提醒任务处理器已注册")
+}
+
+// SubmitEmailTask 提交提及邮件提醒任务
+func (t *MentionTask) SubmitEmailTask(ctx context.Context, mentionIDs []int) error {
+	if len(mentionIDs) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(&MentionEmailTaskPayload{
+		MentionIDs: mentionIDs,
+		TraceID:    tracing.GetTraceID(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("序列化提及提醒任务失败: %w", err)
+	}
+
+	task := asynq.NewTask(pkgasynq.TypeMentionEmail, data, asynq.MaxRetry(3), asynq.Queue(pkgasynq.QueueLow))
+	info, err := t.taskManager.EnqueueContext(ctx, task)
+	if err != nil {
+		t.logger.Error("提交提及提醒任务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交任务失败: %w", err)
+	}
+
+	t.logger.Debug("提交提及提醒任务成功", zap.String("task_id", info.ID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// HandleEmailTask 为被提及用户创建站内通知，并向开启了提及邮件提醒且邮箱已验证的用户发送提醒
+func (t *MentionTask) HandleEmailTask(ctx context.Context, task *asynq.Task) error {
+	var payload MentionEmailTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		t.logger.Error("反序列化提及提醒任务失败", zap.Error(err))
+		return fmt.Errorf("反序列化失败: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.TraceID != "" {
+		ctx = tracing.WithTraceID(ctx, payload.TraceID)
+	}
+
+	t.logger.Info("开始发送提及提醒", zap.Ints("mention_ids", payload.MentionIDs), tracing.WithTraceIDField(ctx))
+
+	mentions, err := t.db.Mention.Query().
+		Where(mention.IDIn(payload.MentionIDs...)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("查询提及记录失败: %w", err)
+	}
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	// 摘要按被提及用户的阅读权限生成
+	settings := NewSettingsService(t.db, t.cache, t.logger)
+	levelTable := loadLevelTable(ctx, settings, t.logger)
+	items := make(map[int]schema.MentionItem, len(mentions))
+	for _, m := range mentions {
+		built, err := buildMentionItems(ctx, t.db, t.cache, levelTable, m.UserID, []*ent.Mention{m})
+		if err != nil {
+			t.logger.Warn("构建提及信息失败", zap.Int("mention_id", m.ID), zap.Error(err), tracing.WithTraceIDField(ctx))
+			continue
+		}
+		items[m.ID] = built[0]
+
+		// 站内通知已在任务中，直接写入
+		if err = t.notificationTask.Create(ctx, NotificationTaskPayload{
+			Type:      notification.TypeMention,
+			UserIDs:   []int{m.UserID},
+			ActorID:   m.FromUserID,
+			PostID:    m.PostID,
+			CommentID: m.CommentID,
+			Content:   built[0].Excerpt,
+		}); err != nil {
+			// 不重试整个任务，避免重复通知其他用户
+			t.logger.Warn("创建提及通知失败", zap.Int("mention_id", m.ID), zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+	}
+
+	smtpConfig, err := settings.GetSMTPConfig(ctx)
+	if err != nil {
+		t.logger.Warn("获取SMTP配置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil
+	}
+	if !smtpConfig.IsEnable {
+		return nil
+	}
+
+	var targetIDs []int
+	for _, m := range mentions {
+		targetIDs = append(targetIDs, m.UserID)
+	}
+	targets, err := t.db.User.Query().
+		Where(
+			user.IDIn(uniqueInts(targetIDs)...),
+			user.MentionEmailNotifyEQ(true),
+			user.EmailVerifiedEQ(true),
+		).
+		Select(user.FieldID, user.FieldEmail).
+		All(ctx)
+	if err != nil {
+		t.logger.Warn("查询被提及用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil
+	}
+	emailMap := make(map[int]string, len(targets))
+	for _, u := range targets {
+		emailMap[u.ID] = u.Email
+	}
+	if len(emailMap) == 0 {
+		return nil
+	}
+
+	siteConfig, err := settings.GetSeoSettings(ctx)
+	if err != nil {
+		t.logger.Warn("获取网站配置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil
+	}
+
+	emailTemplate := smtp.NewEmailTemplate(settings, t.logger)
+	sp := smtp.NewSMTPPool(smtp.SMTPConfig{
+		Name:       siteConfig.WebSiteName,
+		Address:    smtpConfig.Address,
+		Host:       smtpConfig.Host,
+		Port:       smtpConfig.Port,
+		User:       smtpConfig.Username,
+		Password:   smtpConfig.Password,
+		Encryption: smtpConfig.ForcedSSL,
+		Keepalive:  smtpConfig.ConnectionValidity,
+	}, t.logger)
+	defer sp.Close()
+
+	for _, m := range mentions {
+		to, ok := emailMap[m.UserID]
+		if !ok {
+			continue
+		}
+		item, ok := items[m.ID]
+		if !ok {
+			continue
+		}
+
+		htmlBody, err := emailTemplate.RenderMentionTemplate(ctx, smtp.MentionContext{
+			FromUsername: item.FromUsername,
+			PostTitle:    item.PostTitle,
+			Excerpt:      item.Excerpt,
+		}, siteConfig.WebSiteName)
+		if err != nil {
+			t.logger.Warn("渲染提及提醒邮件失败", zap.Int("mention_id", m.ID), zap.Error(err), tracing.WithTraceIDField(ctx))
+			continue
+		}
+
+		title := fmt.Sprintf("【%s】%s 提到了你", siteConfig.WebSiteName, item.FromUsername)
+		if err = sp.Send(ctx, to, title, htmlBody); err != nil {
+			// 单封邮件发送失败不重试整个任务，避免重复提醒其他用户
+			t.logger.Warn("发送提及提醒邮件失败", zap.Int("mention_id", m.ID), zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+	}
+
+	t.logger.Info("提及提醒发送完成", zap.Ints("mention_ids", payload.MentionIDs), tracing.WithTraceIDField(ctx))
+	return nil
+}
@@ -0,0 +1,554 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/conversation"
+	"github.com/PokeForum/PokeForum/ent/privatemessage"
+	"github.com/PokeForum/PokeForum/ent/user"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// IMessageService 私信服务接口
+type IMessageService interface {
+	// SendMessage 发送私信
+	SendMessage(ctx context.Context, userID int, req schema.MessageSendRequest) (*schema.MessageItem, error)
+	// GetConversations 获取私信会话列表
+	GetConversations(ctx context.Context, userID int, req schema.MessageConversationListRequest) (*schema.MessageConversationListResponse, error)
+	// GetMessages 获取与指定用户的消息记录
+	GetMessages(ctx context.Context, userID int, req schema.MessageHistoryRequest) (*schema.MessageHistoryResponse, error)
+	// MarkRead 将与指定用户的会话标记为已读
+	MarkRead(ctx context.Context, userID int, req schema.MessageReadRequest) error
+	// GetUnreadCount 获取私信未读总数
+	GetUnreadCount(ctx context.Context, userID int) (*schema.MessageUnreadResponse, error)
+}
+
+// MessageService 私信服务实现
+type MessageService struct {
+	db       *ent.Client
+	cache    cache.ICacheService
+	logger   *zap.Logger
+	settings ISettingsService
+}
+
+// NewMessageService 创建私信服务实例
+func NewMessageService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IMessageService {
+	return &MessageService{
+		db:       db,
+		cache:    cacheService,
+		logger:   logger,
+		settings: NewSettingsService(db, cacheService, logger),
+	}
+}
+
+// conversationPair 返回会话双方按ID排序后的用户ID
+func conversationPair(userID, peerID int) (int, int) {
+	if userID < peerID {
+		return userID, peerID
+	}
+	return peerID, userID
+}
+
+// conversationPeer 返回会话中对方的用户ID与当前用户的未读数
+func conversationPeer(conv *ent.Conversation, userID int) (int, int) {
+	if conv.UserAID == userID {
+		return conv.UserBID, conv.UserAUnread
+	}
+	return conv.UserAID, conv.UserBUnread
+}
+
+// SendMessage 发送私信
+func (s *MessageService) SendMessage(ctx context.Context, userID int, req schema.MessageSendRequest) (*schema.MessageItem, error) {
+	s.logger.Info("发送私信", zap.Int("user_id", userID), zap.Int("to_user_id", req.UserID), tracing.WithTraceIDField(ctx))
+
+	if req.UserID == userID {
+		return nil, errors.New("不能给自己发送私信")
+	}
+
+	sender, err := s.db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldStatus, user.FieldRole, user.FieldExperience).
+		Only(ctx)
+	if err != nil {
+		s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	switch sender.Status {
+	case user.StatusMute:
+		return nil, errors.New("您已被禁言，无法进行此操作")
+	case user.StatusBlocked:
+		return nil, errors.New("您的账号已被封禁，无法进行此操作")
+	}
+
+	exists, err := s.db.User.Query().
+		Where(user.IDEQ(req.UserID), user.StatusNEQ(user.StatusBlocked)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("获取接收者信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取接收者信息失败: %w", err)
+	}
+	if !exists {
+		return nil, errors.New("用户不存在")
+	}
+
+	// 双方任意一方拉黑对方都不能发送私信
+	blacklistService := NewBlacklistService(s.db, s.logger)
+	blocked, err := blacklistService.IsUserBlocked(ctx, req.UserID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("检查拉黑状态失败: %w", err)
+	}
+	if blocked {
+		return nil, errors.New("对方已将您拉黑，无法发送私信")
+	}
+	blocked, err = blacklistService.IsUserBlocked(ctx, userID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("检查拉黑状态失败: %w", err)
+	}
+	if blocked {
+		return nil, errors.New("您已将对方拉黑，请先移出黑名单")
+	}
+
+	if err = s.checkExperience(ctx, sender, req.UserID); err != nil {
+		return nil, err
+	}
+
+	conv, err := s.getOrCreateConversation(ctx, userID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	message, err := tx.PrivateMessage.Create().
+		SetConversationID(conv.ID).
+		SetSenderID(userID).
+		SetReceiverID(req.UserID).
+		SetContent(req.Content).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("创建私信失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建私信失败: %w", err)
+	}
+
+	update := tx.Conversation.UpdateOneID(conv.ID).
+		SetLastMessageID(message.ID).
+		SetLastMessageAt(message.CreatedAt)
+	if conv.UserAID == req.UserID {
+		update = update.AddUserAUnread(1)
+	} else {
+		update = update.AddUserBUnread(1)
+	}
+	if err = update.Exec(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("更新会话失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("更新会话失败: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	item := toMessageItem(message)
+	// 推送给接收者，同时同步到发送者的其他在线设备
+	publishRealtimeEvent(ctx, s.cache, s.logger, realtimeUserChannel(req.UserID), RealtimeEventMessage, item)
+	publishRealtimeEvent(ctx, s.cache, s.logger, realtimeUserChannel(userID), RealtimeEventMessage, item)
+
+	s.logger.Info("发送私信成功", zap.Int("message_id", message.ID), tracing.WithTraceIDField(ctx))
+	return &item, nil
+}
+
+// checkExperience 检查发起私信的经验值限制
+// 版主及以上身份不受限制，对方已经发来过私信时允许回复
+func (s *MessageService) checkExperience(ctx context.Context, sender *ent.User, peerID int) error {
+	if sender.Role != user.RoleUser {
+		return nil
+	}
+
+	minExperience, err := getIntSetting(ctx, s.settings, s.logger, _const.SafeMessageMinExperience)
+	if err != nil {
+		return err
+	}
+	if minExperience == 0 || sender.Experience >= minExperience {
+		return nil
+	}
+
+	replied, err := s.db.PrivateMessage.Query().
+		Where(privatemessage.SenderIDEQ(peerID), privatemessage.ReceiverIDEQ(sender.ID)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("查询私信记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("查询私信记录失败: %w", err)
+	}
+	if replied {
+		return nil
+	}
+	return fmt.Errorf("经验值达到%d后才能发起私信", minExperience)
+}
+
+// getOrCreateConversation 获取两个用户之间的会话，不存在时创建
+func (s *MessageService) getOrCreateConversation(ctx context.Context, userID, peerID int) (*ent.Conversation, error) {
+	userAID, userBID := conversationPair(userID, peerID)
+
+	conv, err := s.db.Conversation.Query().
+		Where(conversation.UserAIDEQ(userAID), conversation.UserBIDEQ(userBID)).
+		Only(ctx)
+	if err == nil {
+		return conv, nil
+	}
+	if !ent.IsNotFound(err) {
+		s.logger.Error("获取会话失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取会话失败: %w", err)
+	}
+
+	conv, err = s.db.Conversation.Create().
+		SetUserAID(userAID).
+		SetUserBID(userBID).
+		Save(ctx)
+	if err == nil {
+		return conv, nil
+	}
+	if !ent.IsConstraintError(err) {
+		s.logger.Error("创建会话失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建会话失败: %w", err)
+	}
+
+	// 并发创建时唯一索引冲突，读取已创建的会话
+	conv, err = s.db.Conversation.Query().
+		Where(conversation.UserAIDEQ(userAID), conversation.UserBIDEQ(userBID)).
+		Only(ctx)
+	if err != nil {
+		s.logger.Error("获取会话失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取会话失败: %w", err)
+	}
+	return conv, nil
+}
+
+// GetConversations 获取私信会话列表
+func (s *MessageService) GetConversations(ctx context.Context, userID int, req schema.MessageConversationListRequest) (*schema.MessageConversationListResponse, error) {
+	s.logger.Info("获取私信会话列表", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+
+	query := s.db.Conversation.Query().
+		Where(
+			conversation.Or(conversation.UserAIDEQ(userID), conversation.UserBIDEQ(userID)),
+			conversation.LastMessageIDGT(0),
+		)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取会话总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取会话总数失败: %w", err)
+	}
+
+	conversations, err := query.
+		Order(ent.Desc(conversation.FieldLastMessageAt), ent.Desc(conversation.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取会话列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取会话列表失败: %w", err)
+	}
+
+	var peerIDs, messageIDs []int
+	for _, conv := range conversations {
+		peerID, _ := conversationPeer(conv, userID)
+		peerIDs = append(peerIDs, peerID)
+		messageIDs = append(messageIDs, conv.LastMessageID)
+	}
+
+	peerMap := make(map[int]*ent.User)
+	if len(peerIDs) > 0 {
+		peers, err := s.db.User.Query().
+			Where(user.IDIn(peerIDs...)).
+			Select(user.FieldID, user.FieldUsername, user.FieldAvatar).
+			All(ctx)
+		if err != nil {
+			s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("获取用户信息失败: %w", err)
+		}
+		for _, u := range peers {
+			peerMap[u.ID] = u
+		}
+	}
+
+	messageMap := make(map[int]string)
+	if len(messageIDs) > 0 {
+		messages, err := s.db.PrivateMessage.Query().
+			Where(privatemessage.IDIn(messageIDs...)).
+			Select(privatemessage.FieldID, privatemessage.FieldContent).
+			All(ctx)
+		if err != nil {
+			s.logger.Error("获取最后一条消息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("获取最后一条消息失败: %w", err)
+		}
+		for _, m := range messages {
+			messageMap[m.ID] = contentExcerpt(m.Content)
+		}
+	}
+
+	list := make([]schema.MessageConversationItem, 0, len(conversations))
+	for _, conv := range conversations {
+		peerID, unread := conversationPeer(conv, userID)
+		item := schema.MessageConversationItem{
+			ID:          conv.ID,
+			UserID:      peerID,
+			LastMessage: messageMap[conv.LastMessageID],
+			UnreadCount: unread,
+		}
+		if conv.LastMessageAt != nil {
+			item.LastMessageAt = conv.LastMessageAt.Format(time_tools.DateTimeFormat)
+		}
+		if peer, ok := peerMap[peerID]; ok {
+			item.Username = peer.Username
+			item.Avatar = peer.Avatar
+		}
+		list = append(list, item)
+	}
+
+	unread, err := s.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.MessageConversationListResponse{
+		List:        list,
+		Total:       total,
+		UnreadCount: unread.Total,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	}, nil
+}
+
+// GetMessages 获取与指定用户的消息记录，按消息ID游标分页
+func (s *MessageService) GetMessages(ctx context.Context, userID int, req schema.MessageHistoryRequest) (*schema.MessageHistoryResponse, error) {
+	peerID := req.UserID
+	s.logger.Info("获取私信消息记录", zap.Int("user_id", userID), zap.Int("peer_id", peerID), zap.Int("before_id", req.BeforeID), tracing.WithTraceIDField(ctx))
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+
+	peer, err := s.db.User.Query().
+		Where(user.IDEQ(peerID)).
+		Select(user.FieldID, user.FieldUsername, user.FieldAvatar).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("用户不存在")
+		}
+		s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+
+	result := &schema.MessageHistoryResponse{
+		UserID:   peer.ID,
+		Username: peer.Username,
+		Avatar:   peer.Avatar,
+		List:     []schema.MessageItem{},
+	}
+
+	userAID, userBID := conversationPair(userID, peerID)
+	conv, err := s.db.Conversation.Query().
+		Where(conversation.UserAIDEQ(userAID), conversation.UserBIDEQ(userBID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return result, nil
+		}
+		s.logger.Error("获取会话失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取会话失败: %w", err)
+	}
+
+	query := s.db.PrivateMessage.Query().
+		Where(privatemessage.ConversationIDEQ(conv.ID))
+	if req.BeforeID > 0 {
+		query = query.Where(privatemessage.IDLT(req.BeforeID))
+	}
+
+	// 多查询一条用于判断是否还有更早的消息
+	messages, err := query.
+		Order(ent.Desc(privatemessage.FieldID)).
+		Limit(req.Limit + 1).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取私信消息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取私信消息失败: %w", err)
+	}
+	if len(messages) > req.Limit {
+		result.HasMore = true
+		messages = messages[:req.Limit]
+	}
+
+	for _, m := range messages {
+		result.List = append(result.List, toMessageItem(m))
+	}
+	return result, nil
+}
+
+// MarkRead 将与指定用户的会话标记为已读，并向对方发送已读回执
+func (s *MessageService) MarkRead(ctx context.Context, userID int, req schema.MessageReadRequest) error {
+	s.logger.Info("标记私信已读", zap.Int("user_id", userID), zap.Int("peer_id", req.UserID), tracing.WithTraceIDField(ctx))
+
+	userAID, userBID := conversationPair(userID, req.UserID)
+	conv, err := s.db.Conversation.Query().
+		Where(conversation.UserAIDEQ(userAID), conversation.UserBIDEQ(userBID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		s.logger.Error("获取会话失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("获取会话失败: %w", err)
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	lastUnread, err := tx.PrivateMessage.Query().
+		Where(
+			privatemessage.ConversationIDEQ(conv.ID),
+			privatemessage.ReceiverIDEQ(userID),
+			privatemessage.ReadAtIsNil(),
+		).
+		Order(ent.Desc(privatemessage.FieldID)).
+		First(ctx)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		s.logger.Error("查询未读私信失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("查询未读私信失败: %w", err)
+	}
+
+	readAt := time.Now()
+	marked, err := tx.PrivateMessage.Update().
+		Where(
+			privatemessage.ConversationIDEQ(conv.ID),
+			privatemessage.ReceiverIDEQ(userID),
+			privatemessage.ReadAtIsNil(),
+			privatemessage.IDLTE(lastUnread.ID),
+		).
+		SetReadAt(readAt).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("标记私信已读失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("标记私信已读失败: %w", err)
+	}
+
+	// 只扣减本次标记的条数，标记期间新收到的私信仍计入未读数
+	// 未读数与实际不一致而小于标记条数时直接归零
+	update := tx.Conversation.Update().Where(conversation.IDEQ(conv.ID))
+	if conv.UserAID == userID {
+		update = update.SetUserAUnread(0).Where(conversation.UserAUnreadLTE(marked))
+	} else {
+		update = update.SetUserBUnread(0).Where(conversation.UserBUnreadLTE(marked))
+	}
+	reset, err := update.Save(ctx)
+	if err == nil && reset == 0 {
+		decrement := tx.Conversation.UpdateOneID(conv.ID)
+		if conv.UserAID == userID {
+			decrement = decrement.AddUserAUnread(-marked)
+		} else {
+			decrement = decrement.AddUserBUnread(-marked)
+		}
+		err = decrement.Exec(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("更新未读数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("更新未读数失败: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	receipt := schema.MessageReadReceipt{
+		ConversationID: conv.ID,
+		ReaderID:       userID,
+		LastReadID:     lastUnread.ID,
+		ReadAt:         readAt.Format(time_tools.DateTimeFormat),
+	}
+	publishRealtimeEvent(ctx, s.cache, s.logger, realtimeUserChannel(req.UserID), RealtimeEventMessageRead, receipt)
+	publishRealtimeEvent(ctx, s.cache, s.logger, realtimeUserChannel(userID), RealtimeEventMessageRead, receipt)
+	return nil
+}
+
+// GetUnreadCount 获取私信未读总数
+func (s *MessageService) GetUnreadCount(ctx context.Context, userID int) (*schema.MessageUnreadResponse, error) {
+	conversations, err := s.db.Conversation.Query().
+		Where(conversation.Or(
+			conversation.And(conversation.UserAIDEQ(userID), conversation.UserAUnreadGT(0)),
+			conversation.And(conversation.UserBIDEQ(userID), conversation.UserBUnreadGT(0)),
+		)).
+		Select(conversation.FieldUserAID, conversation.FieldUserBID, conversation.FieldUserAUnread, conversation.FieldUserBUnread).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("统计未读私信失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("统计未读私信失败: %w", err)
+	}
+
+	total := 0
+	for _, conv := range conversations {
+		_, unread := conversationPeer(conv, userID)
+		total += unread
+	}
+	return &schema.MessageUnreadResponse{Total: total}, nil
+}
+
+// toMessageItem 转换私信消息
+func toMessageItem(m *ent.PrivateMessage) schema.MessageItem {
+	item := schema.MessageItem{
+		ID:         m.ID,
+		SenderID:   m.SenderID,
+		ReceiverID: m.ReceiverID,
+		Content:    m.Content,
+		IsRead:     m.ReadAt != nil,
+		CreatedAt:  m.CreatedAt.Format(time_tools.DateTimeFormat),
+	}
+	if m.ReadAt != nil {
+		item.ReadAt = m.ReadAt.Format(time_tools.DateTimeFormat)
+	}
+	return item
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent/conversation"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+func TestMessageMarkReadUnreadCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		sent       int
+		adjust     int // 已读前对接收方未读数的调整，模拟标记期间新到达的私信或计数偏差
+		wantUnread int
+	}{
+		{name: "全部标记已读", sent: 3, wantUnread: 0},
+		{name: "保留标记期间新到达的未读数", sent: 3, adjust: 1, wantUnread: 1},
+		{name: "未读数偏小时归零", sent: 3, adjust: -2, wantUnread: 0},
+		{name: "没有未读私信", sent: 0, wantUnread: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			svc := NewMessageService(db, newTestCache(t), zap.NewNop())
+			sender := newTestUser(t, db, "sender", "sender@example.com")
+			receiver := newTestUser(t, db, "receiver", "receiver@example.com")
+
+			for i := 0; i < tt.sent; i++ {
+				if _, err := svc.SendMessage(t.Context(), sender.ID, schema.MessageSendRequest{UserID: receiver.ID, Content: "你好"}); err != nil {
+					t.Fatalf("发送私信失败: %v", err)
+				}
+			}
+			if tt.adjust != 0 {
+				conv := db.Conversation.Query().OnlyX(t.Context())
+				update := db.Conversation.UpdateOne(conv)
+				if conv.UserAID == receiver.ID {
+					update = update.AddUserAUnread(tt.adjust)
+				} else {
+					update = update.AddUserBUnread(tt.adjust)
+				}
+				update.ExecX(t.Context())
+			}
+
+			if err := svc.MarkRead(t.Context(), receiver.ID, schema.MessageReadRequest{UserID: sender.ID}); err != nil {
+				t.Fatalf("标记已读失败: %v", err)
+			}
+
+			unread, err := svc.GetUnreadCount(t.Context(), receiver.ID)
+			if err != nil {
+				t.Fatalf("获取未读数失败: %v", err)
+			}
+			if unread.Total != tt.wantUnread {
+				t.Fatalf("未读数应为 %d，实际 %d", tt.wantUnread, unread.Total)
+			}
+			if tt.sent > 0 && db.Conversation.Query().Where(conversation.Or(conversation.UserAUnreadLT(0), conversation.UserBUnreadLT(0))).ExistX(t.Context()) {
+				t.Fatal("未读数不应为负数")
+			}
+		})
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/badge"
 	"github.com/PokeForum/PokeForum/ent/category"
-	"github.com/PokeForum/PokeForum/ent/categorymoderator"
+	"github.com/PokeForum/PokeForum/ent/notification"
 	"github.com/PokeForum/PokeForum/ent/post"
 	"github.com/PokeForum/PokeForum/ent/user"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
@@ -28,6 +30,8 @@ type IModeratorService interface {
 	EditPost(ctx context.Context, userID int, req schema.PostEditRequest) (*schema.ModeratorPostResponse, error)
 	// MovePost 移动帖子（仅限有权限的版块）
 	MovePost(ctx context.Context, userID int, req schema.PostMoveRequest) error
+	// RetagPost 修改帖子标签
+	RetagPost(ctx context.Context, userID int, req schema.PostRetagRequest) ([]string, error)
 	// SetPostEssence 设置帖子精华
 	SetPostEssence(ctx context.Context, userID int, req schema.PostEssenceRequest) error
 	// LockPost 锁定帖子
@@ -44,17 +48,21 @@ type IModeratorService interface {
 
 // ModeratorService 版主服务实现
 type ModeratorService struct {
-	db     *ent.Client
-	cache  cache.ICacheService
-	logger *zap.Logger
+	db               *ent.Client
+	cache            cache.ICacheService
+	logger           *zap.Logger
+	notificationTask *NotificationTask
+	badgeTask        *BadgeTask
 }
 
 // NewModeratorService 创建版主服务实例
-func NewModeratorService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IModeratorService {
+func NewModeratorService(db *ent.Client, cacheService cache.ICacheService, notificationTask *NotificationTask, badgeTask *BadgeTask, logger *zap.Logger) IModeratorService {
 	return &ModeratorService{
-		db:     db,
-		cache:  cacheService,
-		logger: logger,
+		db:               db,
+		cache:            cacheService,
+		logger:           logger,
+		notificationTask: notificationTask,
+		badgeTask:        badgeTask,
 	}
 }
 
@@ -62,24 +70,23 @@ func NewModeratorService(db *ent.Client, cacheService cache.ICacheService, logge
 func (s *ModeratorService) GetModeratorCategories(ctx context.Context, userID int) (*schema.ModeratorCategoriesResponse, error) {
 	s.logger.Info("获取版主管理的版块列表", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
 
-	// 通过中间表查询版主管理的版块
-	moderatorRecords, err := s.db.CategoryModerator.Query().
-		Where(categorymoderator.UserIDEQ(userID)).
-		All(ctx)
+	// 通过中间表查询版主管理的版块，父版块的版主同时管理全部子孙版块
+	moderated, err := moderatedCategorySet(ctx, s.db, s.cache, userID)
 	if err != nil {
 		s.logger.Error("查询版主关联记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
-		return nil, fmt.Errorf("查询版主关联记录失败: %w", err)
+		return nil, err
 	}
 
 	// 收集版块ID
-	categoryIDs := make([]int, len(moderatorRecords))
-	for i, record := range moderatorRecords {
-		categoryIDs[i] = record.CategoryID
+	categoryIDs := make([]int, 0, len(moderated))
+	for id := range moderated {
+		categoryIDs = append(categoryIDs, id)
 	}
 
 	// 批量查询版块信息
 	categories, err := s.db.Category.Query().
 		Where(category.IDIn(categoryIDs...)).
+		Order(ent.Asc(category.FieldWeight), ent.Desc(category.FieldCreatedAt)).
 		All(ctx)
 	if err != nil {
 		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
@@ -148,6 +155,7 @@ func (s *ModeratorService) BanPost(ctx context.Context, userID int, req schema.P
 		return fmt.Errorf("封禁帖子失败: %w", err)
 	}
 
+	s.notifyPostAuthor(ctx, userID, postData, withReason("已被封禁", req.Reason))
 	s.logger.Info("帖子封禁成功", zap.Int("post_id", req.ID), tracing.WithTraceIDField(ctx))
 	return nil
 }
@@ -177,16 +185,42 @@ func (s *ModeratorService) EditPost(ctx context.Context, userID int, req schema.
 		return nil, errors.New("您没有该版块的管理权限")
 	}
 
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
 	// 更新帖子
-	updatedPost, err := s.db.Post.UpdateOneID(req.ID).
+	updatedPost, err := tx.Post.UpdateOneID(req.ID).
 		SetTitle(req.Title).
 		SetContent(req.Content).
+		SetLastEditedAt(time.Now()).
 		Save(ctx)
 	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
 		s.logger.Error("更新帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 		return nil, fmt.Errorf("更新帖子失败: %w", err)
 	}
 
+	// 记录修订历史
+	if _, err = recordPostRevision(ctx, tx, postData, userID, req.Title, req.Content, req.Reason, 0); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("记录修订历史失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
 	// 获取评论数（简化处理）
 	commentCount := 0
 
@@ -227,6 +261,7 @@ func (s *ModeratorService) EditPost(ctx context.Context, userID int, req schema.
 		UpdatedAt:    updatedPost.UpdatedAt.Format(time_tools.DateTimeFormat),
 	}
 
+	s.notifyPostAuthor(ctx, userID, postData, withReason("已被版主编辑", req.Reason))
 	s.logger.Info("帖子编辑成功", zap.Int("post_id", req.ID), tracing.WithTraceIDField(ctx))
 	return result, nil
 }
@@ -274,10 +309,65 @@ func (s *ModeratorService) MovePost(ctx context.Context, userID int, req schema.
 		return fmt.Errorf("移动帖子失败: %w", err)
 	}
 
+	s.notifyPostAuthor(ctx, userID, postData, withReason("已被移动到其他版块", req.Reason))
 	s.logger.Info("帖子移动成功", zap.Int("post_id", req.ID), tracing.WithTraceIDField(ctx))
 	return nil
 }
 
+// RetagPost 修改帖子标签
+func (s *ModeratorService) RetagPost(ctx context.Context, userID int, req schema.PostRetagRequest) ([]string, error) {
+	s.logger.Info("修改帖子标签", zap.Int("post_id", req.ID), zap.Strings("tags", req.Tags), zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	// 检查帖子是否存在
+	postData, err := s.db.Post.Query().
+		Where(post.IDEQ(req.ID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("帖子不存在")
+		}
+		s.logger.Error("获取帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取帖子失败: %w", err)
+	}
+
+	// 检查版主是否有该版块的管理权限
+	hasPermission, err := s.checkModeratorPermission(ctx, userID, postData.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission {
+		return nil, errors.New("您没有该版块的管理权限")
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	tags, err := setPostTags(ctx, tx, req.ID, req.Tags)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Warn("修改帖子标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	s.notifyPostAuthor(ctx, userID, postData, "标签已被版主修改")
+	s.logger.Info("帖子标签修改成功", zap.Int("post_id", req.ID), tracing.WithTraceIDField(ctx))
+	return tags, nil
+}
+
 // SetPostEssence 设置帖子精华
 func (s *ModeratorService) SetPostEssence(ctx context.Context, userID int, req schema.PostEssenceRequest) error {
 	s.logger.Info("设置帖子精华", zap.Int("post_id", req.ID), zap.Bool("is_essence", req.IsEssence), zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
@@ -312,6 +402,13 @@ func (s *ModeratorService) SetPostEssence(ctx context.Context, userID int, req s
 		return fmt.Errorf("设置帖子精华失败: %w", err)
 	}
 
+	if req.IsEssence {
+		s.notifyPostAuthor(ctx, userID, postData, "已被设为精华")
+		// 评估作者的精华徽章
+		s.badgeTask.Submit(ctx, BadgeTaskPayload{UserID: postData.UserID, Criteria: badge.CriteriaEssenceCount})
+	} else {
+		s.notifyPostAuthor(ctx, userID, postData, "已被取消精华")
+	}
 	s.logger.Info("帖子精华设置成功", zap.Int("post_id", req.ID), tracing.WithTraceIDField(ctx))
 	return nil
 }
@@ -355,6 +452,11 @@ func (s *ModeratorService) LockPost(ctx context.Context, userID int, req schema.
 		return fmt.Errorf("锁定帖子失败: %w", err)
 	}
 
+	if req.IsLock {
+		s.notifyPostAuthor(ctx, userID, postData, "已被锁定")
+	} else {
+		s.notifyPostAuthor(ctx, userID, postData, "已被解除锁定")
+	}
 	s.logger.Info("帖子锁定设置成功", zap.Int("post_id", req.ID), tracing.WithTraceIDField(ctx))
 	return nil
 }
@@ -393,6 +495,11 @@ func (s *ModeratorService) PinPost(ctx context.Context, userID int, req schema.P
 		return fmt.Errorf("置顶帖子失败: %w", err)
 	}
 
+	if req.IsPin {
+		s.notifyPostAuthor(ctx, userID, postData, "已被置顶")
+	} else {
+		s.notifyPostAuthor(ctx, userID, postData, "已被取消置顶")
+	}
 	s.logger.Info("帖子置顶设置成功", zap.Int("post_id", req.ID), tracing.WithTraceIDField(ctx))
 	return nil
 }
@@ -421,6 +528,7 @@ func (s *ModeratorService) EditCategory(ctx context.Context, userID int, req sch
 		return fmt.Errorf("编辑版块失败: %w", err)
 	}
 
+	clearCategoryTreeCache(ctx, s.cache, s.logger)
 	s.logger.Info("版块编辑成功", zap.Int("category_id", req.ID), tracing.WithTraceIDField(ctx))
 	return nil
 }
@@ -456,6 +564,8 @@ func (s *ModeratorService) CreateCategoryAnnouncement(ctx context.Context, userI
 		return nil, fmt.Errorf("创建版块公告失败: %w", err)
 	}
 
+	clearCategoryTreeCache(ctx, s.cache, s.logger)
+
 	// 构建响应数据
 	result := &schema.CategoryAnnouncementResponse{
 		ID:         updatedCategory.ID,
@@ -529,15 +639,29 @@ func (s *ModeratorService) GetCategoryAnnouncements(ctx context.Context, userID
 	return result, nil
 }
 
+// notifyPostAuthor 通知帖子作者其帖子被版主处理，失败不影响管理操作
+func (s *ModeratorService) notifyPostAuthor(ctx context.Context, operatorID int, postData *ent.Post, action string) {
+	if err := s.notificationTask.Submit(ctx, NotificationTaskPayload{
+		Type:    notification.TypeModeration,
+		UserIDs: []int{postData.UserID},
+		ActorID: operatorID,
+		PostID:  postData.ID,
+		Content: fmt.Sprintf("你的帖子「%s」%s", postData.Title, action),
+	}); err != nil {
+		s.logger.Warn("提交管理通知失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+}
+
+// withReason 在操作描述后附加原因
+func withReason(action, reason string) string {
+	if reason == "" {
+		return action
+	}
+	return fmt.Sprintf("%s，原因：%s", action, reason)
+}
+
 // checkModeratorPermission 检查版主是否有指定版块的管理权限（辅助函数）
+// 父版块的版主同时拥有子孙版块的管理权限
 func (s *ModeratorService) checkModeratorPermission(ctx context.Context, userID, categoryID int) (bool, error) {
-	// 通过中间表查询版主权限
-	return s.db.CategoryModerator.Query().
-		Where(
-			categorymoderator.And(
-				categorymoderator.CategoryIDEQ(categoryID),
-				categorymoderator.UserIDEQ(userID),
-			),
-		).
-		Exist(ctx)
+	return isCategoryModerator(ctx, s.db, s.cache, userID, categoryID)
 }
@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/notification"
+	"github.com/PokeForum/PokeForum/ent/notificationpreference"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+const (
+	// notificationUnreadTotalField 未读数缓存中记录总数的字段
+	notificationUnreadTotalField = "total"
+	// notificationUnreadTTL 未读数缓存过期时间（秒）
+	notificationUnreadTTL = 86400
+)
+
+// notificationConfigurableTypes 用户可以关闭的通知类型，系统公告始终接收
+var notificationConfigurableTypes = []notification.Type{
+	notification.TypeReply,
+	notification.TypeCommentReply,
+	notification.TypeMention,
+	notification.TypeLike,
+	notification.TypeModeration,
+	notification.TypeBalance,
+}
+
+// INotificationService 站内通知服务接口
+type INotificationService interface {
+	// GetNotifications 获取通知列表
+	GetNotifications(ctx context.Context, userID int, req schema.NotificationListRequest) (*schema.NotificationListResponse, error)
+	// GetUnreadCount 获取未读通知数
+	GetUnreadCount(ctx context.Context, userID int) (*schema.NotificationUnreadResponse, error)
+	// MarkRead 标记指定通知为已读
+	MarkRead(ctx context.Context, userID int, req schema.NotificationReadRequest) error
+	// MarkAllRead 标记全部通知为已读
+	MarkAllRead(ctx context.Context, userID int, req schema.NotificationReadAllRequest) error
+	// GetPreferences 获取通知偏好
+	GetPreferences(ctx context.Context, userID int) (*schema.NotificationPreferenceResponse, error)
+	// UpdatePreferences 更新通知偏好
+	UpdatePreferences(ctx context.Context, userID int, req schema.NotificationPreferenceUpdateRequest) error
+	// SendAnnouncement 向全部用户发送系统公告
+	SendAnnouncement(ctx context.Context, operatorID int, req schema.NotificationAnnouncementRequest) error
+}
+
+// NotificationService 站内通知服务实现
+type NotificationService struct {
+	db               *ent.Client
+	cache            cache.ICacheService
+	logger           *zap.Logger
+	notificationTask *NotificationTask
+}
+
+// NewNotificationService 创建站内通知服务实例
+func NewNotificationService(db *ent.Client, cacheService cache.ICacheService, notificationTask *NotificationTask, logger *zap.Logger) INotificationService {
+	return &NotificationService{
+		db:               db,
+		cache:            cacheService,
+		logger:           logger,
+		notificationTask: notificationTask,
+	}
+}
+
+// notificationUnreadKey 用户未读通知数缓存键
+func notificationUnreadKey(userID int) string {
+	return fmt.Sprintf("notification:unread:%d", userID)
+}
+
+// GetNotifications 获取通知列表
+func (s *NotificationService) GetNotifications(ctx context.Context, userID int, req schema.NotificationListRequest) (*schema.NotificationListResponse, error) {
+	s.logger.Info("获取通知列表", zap.Int("user_id", userID), zap.String("type", req.Type), tracing.WithTraceIDField(ctx))
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+
+	query := s.db.Notification.Query().Where(notification.UserIDEQ(userID))
+	if req.Type != "" {
+		query = query.Where(notification.TypeEQ(notification.Type(req.Type)))
+	}
+	if req.UnreadOnly {
+		query = query.Where(notification.IsReadEQ(false))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取通知总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取通知总数失败: %w", err)
+	}
+
+	notifications, err := query.
+		Order(ent.Desc(notification.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取通知列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取通知列表失败: %w", err)
+	}
+
+	list, err := buildNotificationItems(ctx, s.db, notifications)
+	if err != nil {
+		s.logger.Error("构建通知列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	unread, err := s.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.NotificationListResponse{
+		List:        list,
+		Total:       total,
+		UnreadCount: unread.Total,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	}, nil
+}
+
+// GetUnreadCount 获取未读通知数，优先读取Redis缓存
+func (s *NotificationService) GetUnreadCount(ctx context.Context, userID int) (*schema.NotificationUnreadResponse, error) {
+	key := notificationUnreadKey(userID)
+
+	cached, err := s.cache.HGetAll(ctx, key)
+	if err == nil && len(cached) > 0 {
+		result := &schema.NotificationUnreadResponse{ByType: make(map[string]int)}
+		for field, value := range cached {
+			count, _ := strconv.Atoi(value) //nolint:errcheck // 缓存值由本服务写入，解析失败按0处理
+			if field == notificationUnreadTotalField {
+				result.Total = count
+				continue
+			}
+			result.ByType[field] = count
+		}
+		return result, nil
+	}
+
+	// 缓存未命中时按类型统计未读数
+	var rows []struct {
+		Type  notification.Type `json:"type"`
+		Count int               `json:"count"`
+	}
+	if err = s.db.Notification.Query().
+		Where(notification.UserIDEQ(userID), notification.IsReadEQ(false)).
+		GroupBy(notification.FieldType).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
+		s.logger.Error("统计未读通知失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("统计未读通知失败: %w", err)
+	}
+
+	result := &schema.NotificationUnreadResponse{ByType: make(map[string]int)}
+	fields := map[string]interface{}{}
+	for _, row := range rows {
+		result.ByType[string(row.Type)] = row.Count
+		result.Total += row.Count
+		fields[string(row.Type)] = row.Count
+	}
+	fields[notificationUnreadTotalField] = result.Total
+
+	if err = s.cache.HMSet(ctx, key, fields); err != nil {
+		s.logger.Warn("缓存未读通知数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	} else {
+		_, _ = s.cache.Expire(ctx, key, notificationUnreadTTL) //nolint:errcheck // 过期时间设置失败不影响主流程
+	}
+
+	return result, nil
+}
+
+// MarkRead 标记指定通知为已读
+func (s *NotificationService) MarkRead(ctx context.Context, userID int, req schema.NotificationReadRequest) error {
+	s.logger.Info("标记通知已读", zap.Int("user_id", userID), zap.Ints("ids", req.IDs), tracing.WithTraceIDField(ctx))
+
+	if _, err := s.db.Notification.Update().
+		Where(notification.UserIDEQ(userID), notification.IDIn(req.IDs...), notification.IsReadEQ(false)).
+		SetIsRead(true).
+		Save(ctx); err != nil {
+		s.logger.Error("标记通知已读失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("标记通知已读失败: %w", err)
+	}
+
+	s.clearUnreadCache(ctx, userID)
+	return nil
+}
+
+// MarkAllRead 标记全部通知为已读
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID int, req schema.NotificationReadAllRequest) error {
+	s.logger.Info("标记全部通知已读", zap.Int("user_id", userID), zap.String("type", req.Type), tracing.WithTraceIDField(ctx))
+
+	update := s.db.Notification.Update().
+		Where(notification.UserIDEQ(userID), notification.IsReadEQ(false))
+	if req.Type != "" {
+		update = update.Where(notification.TypeEQ(notification.Type(req.Type)))
+	}
+
+	if _, err := update.SetIsRead(true).Save(ctx); err != nil {
+		s.logger.Error("标记全部通知已读失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("标记全部通知已读失败: %w", err)
+	}
+
+	s.clearUnreadCache(ctx, userID)
+	return nil
+}
+
+// GetPreferences 获取通知偏好，未设置的类型默认接收
+func (s *NotificationService) GetPreferences(ctx context.Context, userID int) (*schema.NotificationPreferenceResponse, error) {
+	s.logger.Info("获取通知偏好", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	prefs, err := s.db.NotificationPreference.Query().
+		Where(notificationpreference.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取通知偏好失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取通知偏好失败: %w", err)
+	}
+
+	enabled := make(map[string]bool, len(prefs))
+	for _, p := range prefs {
+		enabled[string(p.Type)] = p.Enabled
+	}
+
+	list := make([]schema.NotificationPreferenceItem, 0, len(notificationConfigurableTypes))
+	for _, t := range notificationConfigurableTypes {
+		value, ok := enabled[string(t)]
+		list = append(list, schema.NotificationPreferenceItem{
+			Type:    string(t),
+			Enabled: !ok || value,
+		})
+	}
+
+	return &schema.NotificationPreferenceResponse{List: list}, nil
+}
+
+// UpdatePreferences 更新通知偏好
+func (s *NotificationService) UpdatePreferences(ctx context.Context, userID int, req schema.NotificationPreferenceUpdateRequest) error {
+	s.logger.Info("更新通知偏好", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	for _, p := range req.Preferences {
+		prefType := notificationpreference.Type(p.Type)
+		affected, err := tx.NotificationPreference.Update().
+			Where(notificationpreference.UserIDEQ(userID), notificationpreference.TypeEQ(prefType)).
+			SetEnabled(p.Enabled).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Error("更新通知偏好失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("更新通知偏好失败: %w", err)
+		}
+		if affected > 0 {
+			continue
+		}
+		if _, err = tx.NotificationPreference.Create().
+			SetUserID(userID).
+			SetType(prefType).
+			SetEnabled(p.Enabled).
+			Save(ctx); err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Error("创建通知偏好失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("创建通知偏好失败: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
+
+// SendAnnouncement 向全部用户发送系统公告
+func (s *NotificationService) SendAnnouncement(ctx context.Context, operatorID int, req schema.NotificationAnnouncementRequest) error {
+	s.logger.Info("发送系统公告", zap.Int("operator_id", operatorID), tracing.WithTraceIDField(ctx))
+
+	return s.notificationTask.Submit(ctx, NotificationTaskPayload{
+		Type:      notification.TypeSystem,
+		Broadcast: true,
+		Content:   req.Content,
+	})
+}
+
+// clearUnreadCache 清除未读数缓存，下次读取时重新统计
+func (s *NotificationService) clearUnreadCache(ctx context.Context, userID int) {
+	if _, err := s.cache.Del(ctx, notificationUnreadKey(userID)); err != nil {
+		s.logger.Warn("清除未读通知缓存失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+}
+
+// buildNotificationItems 批量加载通知关联的用户与帖子并构建列表项
+func buildNotificationItems(ctx context.Context, db *ent.Client, notifications []*ent.Notification) ([]schema.NotificationItem, error) {
+	list := make([]schema.NotificationItem, 0, len(notifications))
+	if len(notifications) == 0 {
+		return list, nil
+	}
+
+	var actorIDs, postIDs []int
+	for _, n := range notifications {
+		if n.ActorID > 0 {
+			actorIDs = append(actorIDs, n.ActorID)
+		}
+		if n.PostID > 0 {
+			postIDs = append(postIDs, n.PostID)
+		}
+	}
+
+	actorMap := make(map[int]*ent.User)
+	if len(actorIDs) > 0 {
+		actors, err := db.User.Query().
+			Where(user.IDIn(uniqueInts(actorIDs)...)).
+			Select(user.FieldID, user.FieldUsername, user.FieldAvatar).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("获取用户信息失败: %w", err)
+		}
+		for _, u := range actors {
+			actorMap[u.ID] = u
+		}
+	}
+
+	titleMap := make(map[int]string)
+	if len(postIDs) > 0 {
+		posts, err := db.Post.Query().
+			Where(post.IDIn(uniqueInts(postIDs)...)).
+			Select(post.FieldID, post.FieldTitle).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("获取帖子信息失败: %w", err)
+		}
+		for _, p := range posts {
+			titleMap[p.ID] = p.Title
+		}
+	}
+
+	for _, n := range notifications {
+		item := schema.NotificationItem{
+			ID:        n.ID,
+			Type:      string(n.Type),
+			ActorID:   n.ActorID,
+			PostID:    n.PostID,
+			PostTitle: titleMap[n.PostID],
+			CommentID: n.CommentID,
+			Content:   n.Content,
+			IsRead:    n.IsRead,
+			CreatedAt: n.CreatedAt.Format(time_tools.DateTimeFormat),
+		}
+		if actor, ok := actorMap[n.ActorID]; ok {
+			item.ActorUsername = actor.Username
+			item.ActorAvatar = actor.Avatar
+		}
+		list = append(list, item)
+	}
+	return list, nil
+}
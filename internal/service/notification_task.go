@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/blacklist"
+	"github.com/PokeForum/PokeForum/ent/notification"
+	"github.com/PokeForum/PokeForum/ent/notificationpreference"
+	"github.com/PokeForum/PokeForum/ent/user"
+	pkgasynq "github.com/PokeForum/PokeForum/internal/pkg/asynq"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+)
+
+// notificationBroadcastBatchSize 系统公告分批写入的用户数量
+const notificationBroadcastBatchSize = 500
+
+// NotificationTask 站内通知异步任务
+// 各业务接口只提交任务，接收者过滤与通知写入在任务中完成，避免拖慢请求
+type NotificationTask struct {
+	db          *ent.Client
+	cache       cache.ICacheService
+	logger      *zap.Logger
+	taskManager *pkgasynq.TaskManager
+}
+
+// NotificationTaskPayload 站内通知任务载荷
+type NotificationTaskPayload struct {
+	Type      notification.Type `json:"type"`
+	UserIDs   []int             `json:"user_ids"`
+	Broadcast bool              `json:"broadcast"` // 发送给全部未封禁用户，仅用于系统公告
+	ActorID   int               `json:"actor_id"`  // 触发通知的用户ID，0表示系统
+	PostID    int               `json:"post_id"`
+	CommentID int               `json:"comment_id"`
+	Content   string            `json:"content"`
+	TraceID   string            `json:"trace_id"` // 用于链路追踪
+}
+
+// NewNotificationTask 创建站内通知异步任务
+func NewNotificationTask(db *ent.Client, cacheService cache.ICacheService, taskManager *pkgasynq.TaskManager, logger *zap.Logger) *NotificationTask {
+	return &NotificationTask{
+		db:          db,
+		cache:       cacheService,
+		logger:      logger,
+		taskManager: taskManager,
+	}
+}
+
+// RegisterHandler 注册任务处理器到TaskManager
+func (t *NotificationTask) RegisterHandler() {
+	t.taskManager.RegisterHandlerFunc(pkgasynq.TypeNotificationCreate, t.HandleTask)
+	t.logger.Info("站内通知任务处理器已注册")
+}
+
+// Submit 提交站内通知任务
+// 通知属于附属功能，调用方记录失败日志即可，不应影响主流程
+func (t *NotificationTask) Submit(ctx context.Context, payload NotificationTaskPayload) error {
+	if len(payload.UserIDs) == 0 && !payload.Broadcast {
+		return nil
+	}
+	payload.TraceID = tracing.GetTraceID(ctx)
+	// 系统公告保留全文，其余通知只保存摘要
+	if payload.Type != notification.TypeSystem {
+		payload.Content = contentExcerpt(payload.Content)
+	}
+
+	data, err := json.Marshal(&payload)
+	if err != nil {
+		return fmt.Errorf("序列化站内通知任务失败: %w", err)
+	}
+
+	task := asynq.NewTask(pkgasynq.TypeNotificationCreate, data, asynq.MaxRetry(3), asynq.Queue(pkgasynq.QueueDefault))
+	info, err := t.taskManager.EnqueueContext(ctx, task)
+	if err != nil {
+		t.logger.Error("提交站内通知任务失败", zap.String("type", string(payload.Type)), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交任务失败: %w", err)
+	}
+
+	t.logger.Debug("提交站内通知任务成功", zap.String("type", string(payload.Type)), zap.String("task_id", info.ID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// HandleTask 处理站内通知任务
+func (t *NotificationTask) HandleTask(ctx context.Context, task *asynq.Task) error {
+	var payload NotificationTaskPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		t.logger.Error("反序列化站内通知任务失败", zap.Error(err))
+		return fmt.Errorf("反序列化失败: %v: %w", err, asynq.SkipRetry)
+	}
+	if payload.TraceID != "" {
+		ctx = tracing.WithTraceID(ctx, payload.TraceID)
+	}
+
+	return t.Create(ctx, payload)
+}
+
+// Create 按接收者偏好与黑名单过滤后写入通知，并清除接收者的未读数缓存
+func (t *NotificationTask) Create(ctx context.Context, payload NotificationTaskPayload) error {
+	t.logger.Info("创建站内通知", zap.String("type", string(payload.Type)), zap.Int("actor_id", payload.ActorID), zap.Bool("broadcast", payload.Broadcast), tracing.WithTraceIDField(ctx))
+
+	if !payload.Broadcast {
+		return t.createBatch(ctx, payload, uniqueInts(payload.UserIDs))
+	}
+
+	// 按ID分批遍历全部未封禁用户
+	lastID := 0
+	for {
+		users, err := t.db.User.Query().
+			Where(user.IDGT(lastID), user.StatusNEQ(user.StatusBlocked)).
+			Order(ent.Asc(user.FieldID)).
+			Limit(notificationBroadcastBatchSize).
+			Select(user.FieldID).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("查询用户失败: %w", err)
+		}
+		if len(users) == 0 {
+			return nil
+		}
+
+		userIDs := make([]int, len(users))
+		for i, u := range users {
+			userIDs[i] = u.ID
+		}
+		if err = t.createBatch(ctx, payload, userIDs); err != nil {
+			return err
+		}
+		lastID = userIDs[len(userIDs)-1]
+	}
+}
+
+// createBatch 写入一批接收者的通知
+func (t *NotificationTask) createBatch(ctx context.Context, payload NotificationTaskPayload, userIDs []int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	skip := make(map[int]bool)
+	// 不通知操作者本人
+	if payload.ActorID > 0 {
+		skip[payload.ActorID] = true
+	}
+
+	// 系统公告不受接收偏好限制
+	if payload.Type != notification.TypeSystem {
+		disabled, err := t.db.NotificationPreference.Query().
+			Where(
+				notificationpreference.UserIDIn(userIDs...),
+				notificationpreference.TypeEQ(notificationpreference.Type(payload.Type)),
+				notificationpreference.EnabledEQ(false),
+			).
+			Select(notificationpreference.FieldUserID).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("查询通知偏好失败: %w", err)
+		}
+		for _, p := range disabled {
+			skip[p.UserID] = true
+		}
+	}
+
+	if payload.ActorID > 0 {
+		// 拉黑了操作者的用户不接收其触发的通知
+		blockers, err := t.db.Blacklist.Query().
+			Where(blacklist.UserIDIn(userIDs...), blacklist.BlockedUserIDEQ(payload.ActorID)).
+			Select(blacklist.FieldUserID).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("查询黑名单失败: %w", err)
+		}
+		for _, b := range blockers {
+			skip[b.UserID] = true
+		}
+	}
+
+	if payload.Type == notification.TypeLike {
+		// 取消后再次点赞不重复通知
+		existing, err := t.db.Notification.Query().
+			Where(
+				notification.UserIDIn(userIDs...),
+				notification.ActorIDEQ(payload.ActorID),
+				notification.TypeEQ(notification.TypeLike),
+				notification.PostIDEQ(payload.PostID),
+				notification.CommentIDEQ(payload.CommentID),
+			).
+			Select(notification.FieldUserID).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("查询已有通知失败: %w", err)
+		}
+		for _, n := range existing {
+			skip[n.UserID] = true
+		}
+	}
+
+	var builders []*ent.NotificationCreate
+	var recipients []string
+	for _, id := range userIDs {
+		if skip[id] {
+			continue
+		}
+		builders = append(builders, t.db.Notification.Create().
+			SetUserID(id).
+			SetActorID(payload.ActorID).
+			SetType(payload.Type).
+			SetPostID(payload.PostID).
+			SetCommentID(payload.CommentID).
+			SetContent(payload.Content))
+		recipients = append(recipients, notificationUnreadKey(id))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+
+	created, err := t.db.Notification.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("创建站内通知失败: %w", err)
+	}
+
+	if _, err = t.cache.Del(ctx, recipients...); err != nil {
+		t.logger.Warn("清除未读通知缓存失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
+	// 推送给在线的接收者
+	items, err := buildNotificationItems(ctx, t.db, created)
+	if err != nil {
+		t.logger.Warn("构建实时通知失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil
+	}
+	for i, item := range items {
+		publishRealtimeEvent(ctx, t.cache, t.logger, realtimeUserChannel(created[i].UserID), RealtimeEventNotification, item)
+	}
+	return nil
+}
@@ -0,0 +1,602 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/oauthprovider"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/useroauth"
+	"github.com/PokeForum/PokeForum/ent/webauthncredential"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/oauth"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/utils"
+)
+
+const (
+	// oauthStateKeyFormat OAuth state缓存键，值为 oauthState 的JSON
+	oauthStateKeyFormat = "oauth:state:%s"
+	// oauthStateExpire OAuth state有效期（秒）
+	oauthStateExpire = 600
+	// oauthPlaceholderEmailDomain 第三方未提供邮箱时使用的占位邮箱域名
+	oauthPlaceholderEmailDomain = "oauth.invalid"
+)
+
+// oauthState 授权请求上下文
+type oauthState struct {
+	Provider string `json:"provider"` // 发起授权的提供商
+	UserID   int    `json:"user_id"`  // 绑定操作的用户ID，登录时为0
+}
+
+// IOAuthLoginService 第三方登录服务接口
+type IOAuthLoginService interface {
+	// GetAuthorizeURL 生成第三方授权地址
+	GetAuthorizeURL(ctx context.Context, req schema.OAuthAuthorizeRequest) (*schema.OAuthAuthorizeResponse, error)
+	// HandleCallback 处理第三方授权回调，返回登录用户
+	HandleCallback(ctx context.Context, req schema.OAuthCallbackRequest) (*ent.User, error)
+	// GetBindings 获取用户的第三方账号绑定列表
+	GetBindings(ctx context.Context, userID int) (*schema.UserOAuthBindingListResponse, error)
+	// GetBindURL 生成绑定第三方账号的授权地址
+	GetBindURL(ctx context.Context, userID int, req schema.OAuthAuthorizeRequest) (*schema.OAuthAuthorizeResponse, error)
+	// BindAccount 使用授权码绑定第三方账号
+	BindAccount(ctx context.Context, userID int, req schema.UserOAuthBindRequest) (*schema.UserOAuthBindingItem, error)
+	// UnbindAccount 解绑第三方账号
+	UnbindAccount(ctx context.Context, userID int, req schema.UserOAuthUnbindRequest) error
+}
+
+// OAuthLoginService 第三方登录服务实现
+type OAuthLoginService struct {
+	db       *ent.Client
+	cache    cache.ICacheService
+	logger   *zap.Logger
+	settings ISettingsService
+}
+
+// NewOAuthLoginService 创建第三方登录服务实例
+func NewOAuthLoginService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger, settings ISettingsService) IOAuthLoginService {
+	return &OAuthLoginService{
+		db:       db,
+		cache:    cacheService,
+		logger:   logger,
+		settings: settings,
+	}
+}
+
+// GetAuthorizeURL 生成第三方授权地址
+func (s *OAuthLoginService) GetAuthorizeURL(ctx context.Context, req schema.OAuthAuthorizeRequest) (*schema.OAuthAuthorizeResponse, error) {
+	s.logger.Info("生成第三方授权地址", zap.String("provider", req.Provider), tracing.WithTraceIDField(ctx))
+
+	return s.buildAuthorizeURL(ctx, oauthState{Provider: req.Provider})
+}
+
+// HandleCallback 处理第三方授权回调，返回登录用户
+func (s *OAuthLoginService) HandleCallback(ctx context.Context, req schema.OAuthCallbackRequest) (*ent.User, error) {
+	s.logger.Info("处理第三方授权回调", zap.String("provider", req.Provider), tracing.WithTraceIDField(ctx))
+
+	// 校验并消费state，state只能使用一次
+	if err := s.consumeState(ctx, req.State, oauthState{Provider: req.Provider}); err != nil {
+		return nil, err
+	}
+
+	info, err := s.fetchUserInfo(ctx, req.Provider, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询是否已存在绑定关系
+	binding, err := s.db.UserOAuth.Query().
+		Where(
+			useroauth.ProviderEQ(useroauth.Provider(req.Provider)),
+			useroauth.ProviderUserIDEQ(info.ProviderUserID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		s.logger.Error("查询第三方绑定失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询第三方绑定失败: %w", err)
+	}
+
+	// 已绑定：刷新第三方资料后登录对应用户
+	if binding != nil {
+		u, err := s.db.User.Get(ctx, binding.UserID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errors.New("用户不存在")
+			}
+			s.logger.Error("查询用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("查询用户失败: %w", err)
+		}
+		if err = checkUserLoginStatus(u); err != nil {
+			return nil, err
+		}
+
+		if _, err = s.db.UserOAuth.UpdateOne(binding).
+			SetProviderUsername(info.Username).
+			SetProviderEmail(info.Email).
+			SetProviderAvatar(info.Avatar).
+			SetExtraData(info.ExtraData).
+			Save(ctx); err != nil {
+			s.logger.Warn("更新第三方绑定信息失败", zap.Int("binding_id", binding.ID), zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+		return u, nil
+	}
+
+	// 未绑定：自动注册新用户并建立绑定关系
+	return s.registerByOAuth(ctx, req.Provider, info)
+}
+
+// GetBindings 获取用户的第三方账号绑定列表
+func (s *OAuthLoginService) GetBindings(ctx context.Context, userID int) (*schema.UserOAuthBindingListResponse, error) {
+	s.logger.Info("获取第三方账号绑定列表", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	bindings, err := s.db.UserOAuth.Query().
+		Where(useroauth.UserIDEQ(userID)).
+		Order(ent.Asc(useroauth.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("查询第三方绑定失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询第三方绑定失败: %w", err)
+	}
+
+	passwordLogin, err := hasPasswordLogin(ctx, s.db, userID)
+	if err != nil {
+		s.logger.Error("查询用户登录方式失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	list := make([]schema.UserOAuthBindingItem, len(bindings))
+	for i, binding := range bindings {
+		list[i] = schema.UserOAuthBindingItem{
+			ID:               binding.ID,
+			Provider:         binding.Provider.String(),
+			ProviderUsername: binding.ProviderUsername,
+			ProviderAvatar:   binding.ProviderAvatar,
+			CreatedAt:        binding.CreatedAt.Format(time_tools.DateTimeFormat),
+		}
+	}
+
+	return &schema.UserOAuthBindingListResponse{
+		List:          list,
+		PasswordLogin: passwordLogin,
+	}, nil
+}
+
+// GetBindURL 生成绑定第三方账号的授权地址
+func (s *OAuthLoginService) GetBindURL(ctx context.Context, userID int, req schema.OAuthAuthorizeRequest) (*schema.OAuthAuthorizeResponse, error) {
+	s.logger.Info("生成第三方绑定授权地址", zap.Int("user_id", userID), zap.String("provider", req.Provider), tracing.WithTraceIDField(ctx))
+
+	// 每个提供商只允许绑定一个账号
+	exists, err := s.db.UserOAuth.Query().
+		Where(
+			useroauth.UserIDEQ(userID),
+			useroauth.ProviderEQ(useroauth.Provider(req.Provider)),
+		).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("查询第三方绑定失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询第三方绑定失败: %w", err)
+	}
+	if exists {
+		return nil, errors.New("您已绑定该平台账号，请先解绑")
+	}
+
+	return s.buildAuthorizeURL(ctx, oauthState{Provider: req.Provider, UserID: userID})
+}
+
+// BindAccount 使用授权码绑定第三方账号
+func (s *OAuthLoginService) BindAccount(ctx context.Context, userID int, req schema.UserOAuthBindRequest) (*schema.UserOAuthBindingItem, error) {
+	s.logger.Info("绑定第三方账号", zap.Int("user_id", userID), zap.String("provider", req.Provider), tracing.WithTraceIDField(ctx))
+
+	// state必须由当前用户发起，防止将他人的第三方账号绑定到自己名下
+	if err := s.consumeState(ctx, req.State, oauthState{Provider: req.Provider, UserID: userID}); err != nil {
+		return nil, err
+	}
+
+	info, err := s.fetchUserInfo(ctx, req.Provider, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	// 检查第三方账号是否已被绑定
+	existing, err := s.db.UserOAuth.Query().
+		Where(
+			useroauth.ProviderEQ(useroauth.Provider(req.Provider)),
+			useroauth.ProviderUserIDEQ(info.ProviderUserID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		s.logger.Error("查询第三方绑定失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询第三方绑定失败: %w", err)
+	}
+	if existing != nil {
+		if existing.UserID == userID {
+			return nil, errors.New("您已绑定该第三方账号")
+		}
+		return nil, errors.New("该第三方账号已被其他用户绑定")
+	}
+
+	// 每个提供商只允许绑定一个账号
+	exists, err := s.db.UserOAuth.Query().
+		Where(
+			useroauth.UserIDEQ(userID),
+			useroauth.ProviderEQ(useroauth.Provider(req.Provider)),
+		).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("查询第三方绑定失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询第三方绑定失败: %w", err)
+	}
+	if exists {
+		return nil, errors.New("您已绑定该平台账号，请先解绑")
+	}
+
+	binding, err := s.db.UserOAuth.Create().
+		SetUserID(userID).
+		SetProvider(useroauth.Provider(req.Provider)).
+		SetProviderUserID(info.ProviderUserID).
+		SetProviderUsername(info.Username).
+		SetProviderEmail(info.Email).
+		SetProviderAvatar(info.Avatar).
+		SetExtraData(info.ExtraData).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("该第三方账号已被其他用户绑定")
+		}
+		s.logger.Error("创建第三方绑定失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建第三方绑定失败: %w", err)
+	}
+
+	s.logger.Info("绑定第三方账号成功", zap.Int("user_id", userID), zap.Int("binding_id", binding.ID), tracing.WithTraceIDField(ctx))
+	return &schema.UserOAuthBindingItem{
+		ID:               binding.ID,
+		Provider:         binding.Provider.String(),
+		ProviderUsername: binding.ProviderUsername,
+		ProviderAvatar:   binding.ProviderAvatar,
+		CreatedAt:        binding.CreatedAt.Format(time_tools.DateTimeFormat),
+	}, nil
+}
+
+// UnbindAccount 解绑第三方账号
+func (s *OAuthLoginService) UnbindAccount(ctx context.Context, userID int, req schema.UserOAuthUnbindRequest) error {
+	s.logger.Info("解绑第三方账号", zap.Int("user_id", userID), zap.String("provider", req.Provider), tracing.WithTraceIDField(ctx))
+
+	binding, err := s.db.UserOAuth.Query().
+		Where(
+			useroauth.UserIDEQ(userID),
+			useroauth.ProviderEQ(useroauth.Provider(req.Provider)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("未绑定该平台账号")
+		}
+		s.logger.Error("查询第三方绑定失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("查询第三方绑定失败: %w", err)
+	}
+
+	// 至少保留一种登录方式
+	methods, err := countLoginMethods(ctx, s.db, userID)
+	if err != nil {
+		s.logger.Error("统计用户登录方式失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return err
+	}
+	if methods <= 1 {
+		return errors.New("这是您唯一的登录方式，请先绑定其他账号后再解绑")
+	}
+
+	if err = s.db.UserOAuth.DeleteOne(binding).Exec(ctx); err != nil {
+		s.logger.Error("解绑第三方账号失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("解绑第三方账号失败: %w", err)
+	}
+
+	s.logger.Info("解绑第三方账号成功", zap.Int("user_id", userID), zap.String("provider", req.Provider), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// registerByOAuth 使用第三方用户信息注册新用户
+func (s *OAuthLoginService) registerByOAuth(ctx context.Context, provider string, info *oauth.UserInfo) (*ent.User, error) {
+	// 检查系统是否允许注册
+	isCloseRegister, err := s.settings.GetSettingByKey(ctx, _const.SafeIsCloseRegister, _const.SettingBoolTrue.String())
+	if err != nil {
+		s.logger.Error("查询注册设置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询注册设置失败: %w", err)
+	}
+	if isCloseRegister == _const.SettingBoolTrue.String() {
+		return nil, errors.New("系统已关闭注册功能")
+	}
+
+	// 第三方登录无法提交邀请码，开启邀请码注册时不允许自动注册
+	inviteRequired, err := isInviteCodeRequired(ctx, s.settings, s.logger)
+	if err != nil {
+		return nil, err
+	}
+	if inviteRequired {
+		return nil, errors.New("当前仅允许使用邀请码注册，请先注册账号后在个人中心绑定第三方账号")
+	}
+
+	// 邮箱已被注册时不自动关联，避免通过第三方账号接管已有账户
+	email := strings.TrimSpace(info.Email)
+	emailVerified := email != ""
+	if email != "" {
+		exists, err := s.db.User.Query().Where(user.EmailEQ(email)).Exist(ctx)
+		if err != nil {
+			s.logger.Error("查询邮箱失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("查询邮箱失败: %w", err)
+		}
+		if exists {
+			return nil, errors.New("该邮箱已注册，请登录后在个人中心绑定第三方账号")
+		}
+	} else {
+		// 第三方未提供邮箱时使用占位邮箱，用户可在个人中心修改
+		email = strings.ToLower(fmt.Sprintf("%s_%s@%s", provider, info.ProviderUserID, oauthPlaceholderEmailDomain))
+	}
+
+	// 检查邮箱白名单，占位邮箱在开启白名单时会被拒绝
+	if err = checkEmailWhitelist(ctx, s.settings, s.logger, email); err != nil {
+		return nil, err
+	}
+
+	username, err := s.generateUsername(ctx, provider, info.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	// 第三方注册的用户使用随机密码，可通过找回密码重新设置
+	pwdSalt := utils.GeneratePasswordSalt()
+	hashedPassword, err := utils.HashPassword(utils.CombinePasswordWithSalt(utils.RandomString(32), pwdSalt))
+	if err != nil {
+		s.logger.Error("密码加密失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("密码加密失败: %w", err)
+	}
+
+	// 开启数据库事务，保证用户与绑定关系同时创建
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	newUser, err := tx.User.Create().
+		SetUsername(username).
+		SetEmail(email).
+		SetEmailVerified(emailVerified).
+		SetPassword(hashedPassword).
+		SetPasswordSalt(pwdSalt).
+		SetAvatar(info.Avatar).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("创建用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建用户失败: %w", err)
+	}
+
+	_, err = tx.UserOAuth.Create().
+		SetUserID(newUser.ID).
+		SetProvider(useroauth.Provider(provider)).
+		SetProviderUserID(info.ProviderUserID).
+		SetProviderUsername(info.Username).
+		SetProviderEmail(info.Email).
+		SetProviderAvatar(info.Avatar).
+		SetExtraData(info.ExtraData).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("该第三方账号已被绑定")
+		}
+		s.logger.Error("创建第三方绑定失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建第三方绑定失败: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	s.logger.Info("第三方账号注册成功", zap.Int("user_id", newUser.ID), zap.String("provider", provider), tracing.WithTraceIDField(ctx))
+	return newUser, nil
+}
+
+// generateUsername 根据第三方用户名生成不重复的站内用户名
+func (s *OAuthLoginService) generateUsername(ctx context.Context, provider, preferred string) (string, error) {
+	base := strings.TrimSpace(preferred)
+	// 用户名长度按字符计算，与注册参数校验口径一致，按字节截断会切坏多字节字符
+	runes := []rune(base)
+	if len(runes) < 3 {
+		base = strings.ToLower(provider) + "_" + utils.RandomString(6)
+	} else if len(runes) > 90 {
+		base = string(runes[:90])
+	}
+
+	candidate := base
+	for i := 0; i < 5; i++ {
+		exists, err := s.db.User.Query().Where(user.UsernameEQ(candidate)).Exist(ctx)
+		if err != nil {
+			s.logger.Error("查询用户名失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return "", fmt.Errorf("查询用户名失败: %w", err)
+		}
+		if !exists {
+			return candidate, nil
+		}
+		candidate = base + "_" + utils.RandomString(4)
+	}
+
+	return "", errors.New("生成用户名失败，请重试")
+}
+
+// getProvider 根据数据库配置构建已启用的OAuth提供商
+func (s *OAuthLoginService) getProvider(ctx context.Context, name string) (oauth.IProvider, error) {
+	if !oauth.IsProviderSupported(oauth.Provider(name)) || oauth.Provider(name) == oauth.ProviderFIDO2 {
+		return nil, errors.New("不支持的第三方登录方式")
+	}
+
+	config, err := s.db.OAuthProvider.Query().
+		Where(
+			oauthprovider.ProviderEQ(oauthprovider.Provider(name)),
+			oauthprovider.EnabledEQ(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("该第三方登录方式未启用")
+		}
+		s.logger.Error("获取OAuth提供商配置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取OAuth提供商配置失败: %w", err)
+	}
+
+	client := oauth.NewClient()
+	if err = client.RegisterProvider(oauth.Provider(name), &oauth.Config{
+		Provider:     oauth.Provider(name),
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		AuthURL:      config.AuthURL,
+		TokenURL:     config.TokenURL,
+		UserInfoURL:  config.UserInfoURL,
+		RedirectURL:  config.RedirectURL,
+		Scopes:       config.Scopes,
+		ExtraConfig:  config.ExtraConfig,
+	}); err != nil {
+		s.logger.Error("注册OAuth提供商失败", zap.String("provider", name), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, errors.New("第三方登录配置不完整")
+	}
+
+	return client.GetProvider(oauth.Provider(name))
+}
+
+// buildAuthorizeURL 生成state并返回授权地址
+func (s *OAuthLoginService) buildAuthorizeURL(ctx context.Context, data oauthState) (*schema.OAuthAuthorizeResponse, error) {
+	provider, err := s.getProvider(ctx, data.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// 生成state并写入缓存，用于回调时防止CSRF
+	state, err := oauth.GenerateState()
+	if err != nil {
+		s.logger.Error("生成state失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("生成state失败: %w", err)
+	}
+	value, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("序列化state失败: %w", err)
+	}
+	if err = s.cache.SetEx(ctx, fmt.Sprintf(oauthStateKeyFormat, state), string(value), oauthStateExpire); err != nil {
+		s.logger.Error("存储state失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("存储state失败: %w", err)
+	}
+
+	return &schema.OAuthAuthorizeResponse{
+		AuthURL: provider.GetAuthURL(state),
+		State:   state,
+	}, nil
+}
+
+// fetchUserInfo 使用授权码换取访问令牌并获取第三方用户信息
+func (s *OAuthLoginService) fetchUserInfo(ctx context.Context, name, code string) (*oauth.UserInfo, error) {
+	provider, err := s.getProvider(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用授权码换取访问令牌
+	token, err := provider.ExchangeToken(ctx, code)
+	if err != nil {
+		s.logger.Warn("换取访问令牌失败", zap.String("provider", name), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, errors.New("第三方授权失败，请重试")
+	}
+
+	// 获取第三方用户信息
+	info, err := provider.GetUserInfo(ctx, token.AccessToken)
+	if err != nil {
+		s.logger.Warn("获取第三方用户信息失败", zap.String("provider", name), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, errors.New("获取第三方用户信息失败，请重试")
+	}
+
+	return info, nil
+}
+
+// consumeState 校验并删除state，防止重放
+// expected 为本次回调期望的授权上下文，提供商与用户必须与发起时一致
+func (s *OAuthLoginService) consumeState(ctx context.Context, state string, expected oauthState) error {
+	key := fmt.Sprintf(oauthStateKeyFormat, state)
+	value, err := s.cache.Get(ctx, key)
+	if err != nil || value == "" {
+		return errors.New("授权请求已过期，请重新发起")
+	}
+
+	// 删除成功才视为消费成功，避免并发重复使用同一个state
+	deleted, err := s.cache.Del(ctx, key)
+	if err != nil || deleted == 0 {
+		return errors.New("授权请求已过期，请重新发起")
+	}
+
+	var stored oauthState
+	if err = json.Unmarshal([]byte(value), &stored); err != nil {
+		return errors.New("授权请求校验失败")
+	}
+	if stored != expected {
+		return errors.New("授权请求校验失败")
+	}
+	return nil
+}
+
+// countLoginMethods 统计用户可用的登录方式数量（邮箱密码、第三方账号、通行密钥）
+func countLoginMethods(ctx context.Context, db *ent.Client, userID int) (int, error) {
+	bindingCount, err := db.UserOAuth.Query().
+		Where(useroauth.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("统计第三方绑定失败: %w", err)
+	}
+
+	passkeyCount, err := db.WebAuthnCredential.Query().
+		Where(webauthncredential.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("统计通行密钥失败: %w", err)
+	}
+
+	passwordLogin, err := hasPasswordLogin(ctx, db, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	methods := bindingCount + passkeyCount
+	if passwordLogin {
+		methods++
+	}
+	return methods, nil
+}
+
+// hasPasswordLogin 判断用户是否可以使用邮箱密码登录
+// 第三方注册且未设置真实邮箱的用户无法通过找回密码设置密码，不计为可用登录方式
+func hasPasswordLogin(ctx context.Context, db *ent.Client, userID int) (bool, error) {
+	u, err := db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldEmail).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, errors.New("用户不存在")
+		}
+		return false, fmt.Errorf("查询用户失败: %w", err)
+	}
+
+	return !strings.HasSuffix(u.Email, "@"+oauthPlaceholderEmailDomain), nil
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/oauthprovider"
+	"github.com/PokeForum/PokeForum/ent/settings"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/useroauth"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// fakeOAuthServer 模拟第三方OAuth服务，授权码对应预设的用户资料
+type fakeOAuthServer struct {
+	*httptest.Server
+	profiles map[string]map[string]interface{}
+}
+
+func newFakeOAuthServer(t *testing.T) *fakeOAuthServer {
+	t.Helper()
+
+	f := &fakeOAuthServer{profiles: make(map[string]map[string]interface{})}
+	mux := http.NewServeMux()
+	// 授权码换取访问令牌，令牌即为授权码
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		code := r.PostForm.Get("code")
+		if _, ok := f.profiles[code]; !ok {
+			http.Error(w, "invalid code", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{ //nolint:errcheck // 测试服务写入失败无需处理
+			"access_token": code,
+			"token_type":   "bearer",
+		})
+	})
+	// 根据访问令牌返回用户资料
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		profile, ok := f.profiles[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+		if !ok {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(profile) //nolint:errcheck // 测试服务写入失败无需处理
+	})
+
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+// newTestOAuthLoginService 创建指向模拟服务的GitHub登录配置，并开放注册
+func newTestOAuthLoginService(t *testing.T, server *fakeOAuthServer) (*ent.Client, IOAuthLoginService) {
+	t.Helper()
+
+	db := newTestDB(t)
+	cacheService := newTestCache(t)
+	newTestSaToken(t)
+
+	_, err := db.OAuthProvider.Create().
+		SetProvider(oauthprovider.ProviderGitHub).
+		SetClientID("client").
+		SetClientSecret("secret").
+		SetAuthURL(server.URL + "/authorize").
+		SetTokenURL(server.URL + "/token").
+		SetUserInfoURL(server.URL + "/user").
+		SetRedirectURL("https://forum.example.com/oauth/GitHub/callback").
+		SetEnabled(true).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("创建GitHub配置失败: %v", err)
+	}
+	setTestSetting(t, db, _const.SafeIsCloseRegister, _const.SettingBoolFalse.String())
+
+	settingsService := NewSettingsService(db, cacheService, zap.NewNop())
+	return db, NewOAuthLoginService(db, cacheService, zap.NewNop(), settingsService)
+}
+
+// setTestSetting 写入安全设置
+func setTestSetting(t *testing.T, db *ent.Client, key string, value string) {
+	t.Helper()
+
+	if _, err := db.Settings.Create().
+		SetModule(settings.ModuleSecurity).
+		SetKey(key).
+		SetValue(value).
+		Save(t.Context()); err != nil {
+		t.Fatalf("写入设置失败: %v", err)
+	}
+}
+
+// authorize 发起GitHub授权并返回state
+func authorize(t *testing.T, svc IOAuthLoginService) string {
+	t.Helper()
+
+	result, err := svc.GetAuthorizeURL(t.Context(), schema.OAuthAuthorizeRequest{Provider: "GitHub"})
+	if err != nil {
+		t.Fatalf("生成授权地址失败: %v", err)
+	}
+	authURL, err := url.Parse(result.AuthURL)
+	if err != nil || authURL.Query().Get("state") != result.State {
+		t.Fatalf("授权地址未携带state: %s", result.AuthURL)
+	}
+	return result.State
+}
+
+func TestOAuthCallbackRegistersNewUser(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	server.profiles["code-new"] = map[string]interface{}{"id": 1001, "login": "octocat", "email": "octo@example.com"}
+	db, svc := newTestOAuthLoginService(t, server)
+
+	u, err := svc.HandleCallback(t.Context(), schema.OAuthCallbackRequest{
+		Provider: "GitHub",
+		Code:     "code-new",
+		State:    authorize(t, svc),
+	})
+	if err != nil {
+		t.Fatalf("第三方注册失败: %v", err)
+	}
+	if u.Username != "octocat" || u.Email != "octo@example.com" || !u.EmailVerified {
+		t.Fatalf("注册用户信息不符合预期: %+v", u)
+	}
+
+	binding, err := db.UserOAuth.Query().
+		Where(useroauth.ProviderUserIDEQ("1001")).
+		Only(t.Context())
+	if err != nil {
+		t.Fatalf("查询绑定关系失败: %v", err)
+	}
+	if binding.UserID != u.ID || binding.Provider != useroauth.ProviderGitHub {
+		t.Fatalf("绑定关系不符合预期: %+v", binding)
+	}
+}
+
+func TestOAuthCallbackTruncatesUsernameByRune(t *testing.T) {
+	tests := []struct {
+		name  string
+		login string
+		want  string
+	}{
+		{name: "多字节用户名", login: strings.Repeat("宝", 100), want: strings.Repeat("宝", 90)},
+		{name: "混合字符用户名", login: "a" + strings.Repeat("可梦", 60), want: "a" + strings.Repeat("可梦", 44) + "可"},
+		{name: "未超长用户名", login: "皮卡丘", want: "皮卡丘"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeOAuthServer(t)
+			server.profiles["code-long"] = map[string]interface{}{"id": 3000 + i, "login": tt.login}
+			_, svc := newTestOAuthLoginService(t, server)
+
+			u, err := svc.HandleCallback(t.Context(), schema.OAuthCallbackRequest{
+				Provider: "GitHub",
+				Code:     "code-long",
+				State:    authorize(t, svc),
+			})
+			if err != nil {
+				t.Fatalf("第三方注册失败: %v", err)
+			}
+			if u.Username != tt.want {
+				t.Fatalf("用户名应为 %q，实际为 %q", tt.want, u.Username)
+			}
+		})
+	}
+}
+
+func TestOAuthCallbackRejectsStateReuse(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	server.profiles["code-new"] = map[string]interface{}{"id": 1001, "login": "octocat", "email": "octo@example.com"}
+	_, svc := newTestOAuthLoginService(t, server)
+
+	req := schema.OAuthCallbackRequest{
+		Provider: "GitHub",
+		Code:     "code-new",
+		State:    authorize(t, svc),
+	}
+	if _, err := svc.HandleCallback(t.Context(), req); err != nil {
+		t.Fatalf("首次回调失败: %v", err)
+	}
+	if _, err := svc.HandleCallback(t.Context(), req); err == nil {
+		t.Fatal("重复使用state应被拒绝")
+	}
+
+	// 未经发起的state同样无效
+	req.State = "unknown"
+	if _, err := svc.HandleCallback(t.Context(), req); err == nil {
+		t.Fatal("未知state应被拒绝")
+	}
+}
+
+func TestOAuthCallbackRejectsProviderMismatch(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	server.profiles["code-new"] = map[string]interface{}{"id": 1001, "login": "octocat", "email": "octo@example.com"}
+	db, svc := newTestOAuthLoginService(t, server)
+
+	// GitHub发起的state不能用于其他提供商的回调
+	state := authorize(t, svc)
+	if _, err := svc.HandleCallback(t.Context(), schema.OAuthCallbackRequest{
+		Provider: "Google",
+		Code:     "code-new",
+		State:    state,
+	}); err == nil {
+		t.Fatal("提供商不一致时应拒绝回调")
+	}
+
+	// state校验失败后即被消费，不能再用于原提供商
+	if _, err := svc.HandleCallback(t.Context(), schema.OAuthCallbackRequest{
+		Provider: "GitHub",
+		Code:     "code-new",
+		State:    state,
+	}); err == nil {
+		t.Fatal("校验失败的state不应再次可用")
+	}
+
+	// 绑定流程发起的state不能用于登录回调
+	owner := newTestUser(t, db, "owner", "owner@example.com")
+	bind, err := svc.GetBindURL(t.Context(), owner.ID, schema.OAuthAuthorizeRequest{Provider: "GitHub"})
+	if err != nil {
+		t.Fatalf("生成绑定授权地址失败: %v", err)
+	}
+	if _, err = svc.HandleCallback(t.Context(), schema.OAuthCallbackRequest{
+		Provider: "GitHub",
+		Code:     "code-new",
+		State:    bind.State,
+	}); err == nil {
+		t.Fatal("绑定流程的state不应用于登录")
+	}
+
+	if count := db.User.Query().CountX(t.Context()); count != 1 {
+		t.Fatalf("校验失败时不应创建用户，当前用户数 %d", count)
+	}
+}
+
+func TestOAuthCallbackLogsInExistingBinding(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	server.profiles["code-bound"] = map[string]interface{}{"id": 2002, "login": "renamed", "email": "other@example.com"}
+	db, svc := newTestOAuthLoginService(t, server)
+
+	owner := newTestUser(t, db, "owner", "owner@example.com")
+	if _, err := db.UserOAuth.Create().
+		SetUserID(owner.ID).
+		SetProvider(useroauth.ProviderGitHub).
+		SetProviderUserID("2002").
+		SetProviderUsername("original").
+		Save(t.Context()); err != nil {
+		t.Fatalf("创建绑定关系失败: %v", err)
+	}
+
+	u, err := svc.HandleCallback(t.Context(), schema.OAuthCallbackRequest{
+		Provider: "GitHub",
+		Code:     "code-bound",
+		State:    authorize(t, svc),
+	})
+	if err != nil {
+		t.Fatalf("已绑定账号登录失败: %v", err)
+	}
+	if u.ID != owner.ID {
+		t.Fatalf("应登录绑定的用户 %d，实际为 %d", owner.ID, u.ID)
+	}
+	if count := db.User.Query().CountX(t.Context()); count != 1 {
+		t.Fatalf("已绑定账号登录不应创建用户，当前用户数 %d", count)
+	}
+
+	binding := db.UserOAuth.Query().Where(useroauth.ProviderUserIDEQ("2002")).OnlyX(t.Context())
+	if binding.ProviderUsername != "renamed" {
+		t.Fatalf("登录后应刷新第三方资料，实际用户名 %q", binding.ProviderUsername)
+	}
+}
+
+func TestOAuthCallbackRefusesExistingEmail(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	server.profiles["code-taken"] = map[string]interface{}{"id": 3003, "login": "intruder", "email": "victim@example.com"}
+	db, svc := newTestOAuthLoginService(t, server)
+
+	victim := newTestUser(t, db, "victim", "victim@example.com")
+
+	_, err := svc.HandleCallback(t.Context(), schema.OAuthCallbackRequest{
+		Provider: "GitHub",
+		Code:     "code-taken",
+		State:    authorize(t, svc),
+	})
+	if err == nil {
+		t.Fatal("邮箱已注册时不应自动关联第三方账号")
+	}
+
+	if exists := db.UserOAuth.Query().Where(useroauth.UserIDEQ(victim.ID)).ExistX(t.Context()); exists {
+		t.Fatal("不应为已有邮箱的用户创建绑定关系")
+	}
+	if count := db.User.Query().CountX(t.Context()); count != 1 {
+		t.Fatalf("不应创建新用户，当前用户数 %d", count)
+	}
+}
+
+func TestOAuthCallbackEmailWhitelist(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	server.profiles["code-allowed"] = map[string]interface{}{"id": 4001, "login": "allowed", "email": "allowed@example.com"}
+	server.profiles["code-denied"] = map[string]interface{}{"id": 4002, "login": "denied", "email": "denied@other.com"}
+	server.profiles["code-noemail"] = map[string]interface{}{"id": 4003, "login": "noemail"}
+	db, svc := newTestOAuthLoginService(t, server)
+
+	setTestSetting(t, db, _const.SafeIsEnableEmailWhitelist, _const.SettingBoolTrue.String())
+	setTestSetting(t, db, _const.SafeEmailWhitelist, "example.com,"+oauthPlaceholderEmailDomain)
+
+	callback := func(code string) error {
+		_, err := svc.HandleCallback(t.Context(), schema.OAuthCallbackRequest{
+			Provider: "GitHub",
+			Code:     code,
+			State:    authorize(t, svc),
+		})
+		return err
+	}
+
+	if err := callback("code-allowed"); err != nil {
+		t.Fatalf("白名单邮箱应允许注册: %v", err)
+	}
+	if err := callback("code-denied"); err == nil {
+		t.Fatal("白名单外的邮箱应拒绝注册")
+	}
+	// 占位邮箱无法证明归属，即使域名被加入白名单也拒绝
+	if err := callback("code-noemail"); err == nil {
+		t.Fatal("未提供邮箱的第三方账号在开启白名单时应拒绝注册")
+	}
+
+	if count := db.User.Query().Where(user.UsernameNEQ("allowed")).CountX(t.Context()); count != 0 {
+		t.Fatalf("被拒绝的注册不应创建用户，当前多余用户数 %d", count)
+	}
+}
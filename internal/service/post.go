@@ -9,18 +9,27 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/attachment"
+	"github.com/PokeForum/PokeForum/ent/badge"
 	"github.com/PokeForum/PokeForum/ent/category"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
+	"github.com/PokeForum/PokeForum/ent/notification"
 	"github.com/PokeForum/PokeForum/ent/post"
 	"github.com/PokeForum/PokeForum/ent/postaction"
 	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/userbalancelog"
 	_const "github.com/PokeForum/PokeForum/internal/consts"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
 	"github.com/PokeForum/PokeForum/internal/pkg/stats"
+	"github.com/PokeForum/PokeForum/internal/pkg/storage"
 	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
 	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
 	"github.com/PokeForum/PokeForum/internal/schema"
 )
 
+// postPurchaseRelatedType 付费阅读余额变动关联的业务类型
+const postPurchaseRelatedType = "post_purchase"
+
 // IPostService 帖子服务接口
 type IPostService interface {
 	// CreatePost 创建帖子
@@ -37,8 +46,14 @@ type IPostService interface {
 	DislikePost(ctx context.Context, userID int, req schema.UserPostActionRequest) (*schema.UserPostActionResponse, error)
 	// FavoritePost 收藏帖子
 	FavoritePost(ctx context.Context, userID int, req schema.UserPostActionRequest) (*schema.UserPostActionResponse, error)
+	// PurchasePost 购买付费阅读帖子
+	PurchasePost(ctx context.Context, userID int, req schema.UserPostActionRequest) (*schema.UserPostPurchaseResponse, error)
 	// GetPostList 获取帖子列表
 	GetPostList(ctx context.Context, req schema.UserPostListRequest) (*schema.UserPostListResponse, error)
+	// GetHotPosts 获取全站或版块的热门帖子
+	GetHotPosts(ctx context.Context, req schema.UserPostHotListRequest) (*schema.UserPostListResponse, error)
+	// GetFeed 获取关注的用户与版块的最新帖子
+	GetFeed(ctx context.Context, userID int, req schema.FeedRequest) (*schema.FeedResponse, error)
 	// GetPostDetail 获取帖子详情
 	GetPostDetail(ctx context.Context, req schema.UserPostDetailRequest) (*schema.UserPostDetailResponse, error)
 	// CheckEditPermission 检查编辑权限（每三分钟可操作一次）
@@ -51,19 +66,29 @@ type IPostService interface {
 type PostService struct {
 	db               *ent.Client
 	cache            cache.ICacheService
+	storage          storage.IStorage
 	logger           *zap.Logger
 	postStatsService IPostStatsService
+	postHotService   IPostHotService
 	settingsService  ISettingsService
+	mentionTask      *MentionTask
+	notificationTask *NotificationTask
+	badgeTask        *BadgeTask
 }
 
 // NewPostService 创建帖子服务实例
-func NewPostService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IPostService {
+func NewPostService(db *ent.Client, cacheService cache.ICacheService, store storage.IStorage, mentionTask *MentionTask, notificationTask *NotificationTask, badgeTask *BadgeTask, logger *zap.Logger) IPostService {
 	return &PostService{
 		db:               db,
 		cache:            cacheService,
+		storage:          store,
 		logger:           logger,
-		postStatsService: NewPostStatsService(db, cacheService, logger),
+		postStatsService: NewPostStatsService(db, cacheService, badgeTask, logger),
+		postHotService:   NewPostHotService(db, cacheService, logger),
 		settingsService:  NewSettingsService(db, cacheService, logger),
+		mentionTask:      mentionTask,
+		notificationTask: notificationTask,
+		badgeTask:        badgeTask,
 	}
 }
 
@@ -88,6 +113,16 @@ func (s *PostService) CreatePost(ctx context.Context, userID int, req schema.Use
 		return nil, fmt.Errorf("获取版块失败: %w", err)
 	}
 
+	// 检查版块状态，隐藏与锁定状态会从父版块继承
+	if err = checkCategoryWritable(ctx, s.db, s.cache, userID, req.CategoryID, categorypermission.ActionPost); err != nil {
+		return nil, err
+	}
+
+	// 检查当前等级的每日发帖上限
+	if err = checkDailyPostLimit(ctx, s.db, s.settingsService, s.logger, userID); err != nil {
+		return nil, err
+	}
+
 	// 获取用户信息
 	userData, err := s.db.User.Query().
 		Where(user.IDEQ(userID)).
@@ -97,20 +132,15 @@ func (s *PostService) CreatePost(ctx context.Context, userID int, req schema.Use
 		return nil, fmt.Errorf("获取用户信息失败: %w", err)
 	}
 
-	// 创建帖子
-	newPost, err := s.db.Post.Create().
-		SetUserID(userID).
-		SetCategoryID(req.CategoryID).
-		SetTitle(req.Title).
-		SetContent(req.Content).
-		SetReadPermission(req.ReadPermission).
-		SetStatus(post.StatusNormal).
-		Save(ctx)
+	// 创建帖子并关联标签
+	newPost, tags, err := s.createPostWithTags(ctx, userID, req, post.StatusNormal)
 	if err != nil {
-		s.logger.Error("创建帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
-		return nil, fmt.Errorf("创建帖子失败: %w", err)
+		return nil, err
 	}
 
+	// 评估发帖徽章
+	s.badgeTask.Submit(ctx, BadgeTaskPayload{UserID: userID, Criteria: badge.CriteriaPostCount})
+
 	// 构建响应数据
 	result := &schema.UserPostCreateResponse{
 		ID:             newPost.ID,
@@ -118,8 +148,11 @@ func (s *PostService) CreatePost(ctx context.Context, userID int, req schema.Use
 		CategoryName:   categoryData.Name,
 		Title:          newPost.Title,
 		Content:        newPost.Content,
+		ContentHTML:    renderMarkdown(ctx, s.cache, s.logger, newPost.Content),
 		Username:       userData.Username,
+		AuthorLevel:    buildUserLevelBrief(loadLevelTable(ctx, s.settingsService, s.logger), userData.Experience),
 		ReadPermission: newPost.ReadPermission,
+		Tags:           tags,
 		ViewCount:      newPost.ViewCount,
 		LikeCount:      newPost.LikeCount,
 		DislikeCount:   newPost.DislikeCount,
@@ -151,6 +184,11 @@ func (s *PostService) SaveDraft(ctx context.Context, userID int, req schema.User
 		return nil, fmt.Errorf("获取版块失败: %w", err)
 	}
 
+	// 检查版块状态，隐藏与锁定状态会从父版块继承
+	if err = checkCategoryWritable(ctx, s.db, s.cache, userID, req.CategoryID, categorypermission.ActionPost); err != nil {
+		return nil, err
+	}
+
 	// 获取用户信息
 	userData, err := s.db.User.Query().
 		Where(user.IDEQ(userID)).
@@ -160,18 +198,10 @@ func (s *PostService) SaveDraft(ctx context.Context, userID int, req schema.User
 		return nil, fmt.Errorf("获取用户信息失败: %w", err)
 	}
 
-	// 创建草稿
-	newPost, err := s.db.Post.Create().
-		SetUserID(userID).
-		SetCategoryID(req.CategoryID).
-		SetTitle(req.Title).
-		SetContent(req.Content).
-		SetReadPermission(req.ReadPermission).
-		SetStatus(post.StatusDraft).
-		Save(ctx)
+	// 创建草稿并关联标签
+	newPost, tags, err := s.createPostWithTags(ctx, userID, req, post.StatusDraft)
 	if err != nil {
-		s.logger.Error("保存草稿失败", zap.Error(err), tracing.WithTraceIDField(ctx))
-		return nil, fmt.Errorf("保存草稿失败: %w", err)
+		return nil, err
 	}
 
 	// 构建响应数据
@@ -181,8 +211,11 @@ func (s *PostService) SaveDraft(ctx context.Context, userID int, req schema.User
 		CategoryName:   categoryData.Name,
 		Title:          newPost.Title,
 		Content:        newPost.Content,
+		ContentHTML:    renderMarkdown(ctx, s.cache, s.logger, newPost.Content),
 		Username:       userData.Username,
+		AuthorLevel:    buildUserLevelBrief(loadLevelTable(ctx, s.settingsService, s.logger), userData.Experience),
 		ReadPermission: newPost.ReadPermission,
+		Tags:           tags,
 		ViewCount:      newPost.ViewCount,
 		LikeCount:      newPost.LikeCount,
 		DislikeCount:   newPost.DislikeCount,
@@ -242,17 +275,77 @@ func (s *PostService) UpdatePost(ctx context.Context, userID int, req schema.Use
 		return nil, fmt.Errorf("获取用户信息失败: %w", err)
 	}
 
+	// 校验阅读权限
+	readPermission, err := normalizeReadPermission(req.ReadPermission)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
 	// 更新帖子
-	updatedPost, err := s.db.Post.UpdateOneID(req.ID).
+	updatedPost, err := tx.Post.UpdateOneID(req.ID).
 		SetTitle(req.Title).
 		SetContent(req.Content).
-		SetReadPermission(req.ReadPermission).
+		SetReadPermission(readPermission).
+		SetLastEditedAt(time.Now()).
 		Save(ctx)
 	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
 		s.logger.Error("更新帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 		return nil, fmt.Errorf("更新帖子失败: %w", err)
 	}
 
+	// 记录修订历史
+	if _, err = recordPostRevision(ctx, tx, postData, userID, req.Title, req.Content, req.Reason, 0); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("记录修订历史失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	// 未传标签时保持原标签
+	var tags []string
+	if req.Tags != nil {
+		tags, err = setPostTags(ctx, tx, updatedPost.ID, req.Tags)
+		if err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Warn("更新帖子标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, err
+		}
+	}
+
+	// 未传附件时保持原附件
+	if req.AttachmentIDs != nil {
+		if err = setPostAttachments(ctx, tx, userID, updatedPost.ID, req.AttachmentIDs); err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Warn("更新帖子附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	if req.Tags == nil {
+		tagMap, err := getPostTagNames(ctx, s.db, []int{updatedPost.ID})
+		if err != nil {
+			s.logger.Warn("查询帖子标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+		tags = tagMap[updatedPost.ID]
+	}
+
 	// 查询版块信息
 	categoryName := ""
 	categoryData, err := s.db.Category.Query().
@@ -272,6 +365,7 @@ func (s *PostService) UpdatePost(ctx context.Context, userID int, req schema.Use
 		Content:        updatedPost.Content,
 		Username:       userData.Username,
 		ReadPermission: updatedPost.ReadPermission,
+		Tags:           tags,
 		ViewCount:      updatedPost.ViewCount,
 		LikeCount:      updatedPost.LikeCount,
 		DislikeCount:   updatedPost.DislikeCount,
@@ -365,6 +459,23 @@ func (s *PostService) LikePost(ctx context.Context, userID int, req schema.UserP
 		ActionType:    "like",
 	}
 
+	// 通知帖子作者，失败不影响点赞
+	postData, err := s.db.Post.Query().
+		Where(post.IDEQ(req.ID)).
+		Select(post.FieldUserID, post.FieldTitle).
+		Only(ctx)
+	if err != nil {
+		s.logger.Warn("获取帖子作者失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	} else if err = s.notificationTask.Submit(ctx, NotificationTaskPayload{
+		Type:    notification.TypeLike,
+		UserIDs: []int{postData.UserID},
+		ActorID: userID,
+		PostID:  req.ID,
+		Content: postData.Title,
+	}); err != nil {
+		s.logger.Warn("提交点赞通知失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
 	s.logger.Info("帖子点赞成功", zap.Int("post_id", req.ID), tracing.WithTraceIDField(ctx))
 	return result, nil
 }
@@ -439,6 +550,156 @@ func (s *PostService) FavoritePost(ctx context.Context, userID int, req schema.U
 	return result, nil
 }
 
+// PurchasePost 购买付费阅读帖子
+// 扣除购买者货币并转入作者账户，双方各记录一条余额变动日志
+func (s *PostService) PurchasePost(ctx context.Context, userID int, req schema.UserPostActionRequest) (*schema.UserPostPurchaseResponse, error) {
+	s.logger.Info("购买付费帖子", zap.Int("user_id", userID), zap.Int("post_id", req.ID), tracing.WithTraceIDField(ctx))
+
+	// 检查帖子是否存在
+	postData, err := s.db.Post.Query().
+		Where(post.IDEQ(req.ID), post.StatusEQ(post.StatusNormal)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("帖子不存在")
+		}
+		s.logger.Error("获取帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取帖子失败: %w", err)
+	}
+
+	// 不可见版块中的帖子不允许购买，避免扣款后仍无法阅读
+	tree, categoryVisitor, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, userID)
+	if err != nil {
+		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	if err = tree.checkCategoryReadable(postData.CategoryID, categoryVisitor); err != nil {
+		return nil, err
+	}
+
+	price := readPermissionPrice(postData.ReadPermission)
+	if price == 0 {
+		return nil, errors.New("该帖子无需付费阅读")
+	}
+	if postData.UserID == userID {
+		return nil, errors.New("不能购买自己的帖子")
+	}
+
+	buyer, err := s.db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldUsername, user.FieldStatus).
+		Only(ctx)
+	if err != nil {
+		s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	if buyer.Status == user.StatusBlocked {
+		return nil, errors.New("您的账号已被封禁，无法进行此操作")
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	// 唯一索引保证同一用户不会重复购买
+	if err = tx.PostPurchase.Create().
+		SetPostID(postData.ID).
+		SetUserID(userID).
+		SetAuthorID(postData.UserID).
+		SetPrice(price).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("您已购买过该帖子")
+		}
+		s.logger.Error("创建购买记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建购买记录失败: %w", err)
+	}
+
+	// 条件扣款，余额不足时不会更新
+	updatedBuyer, err := tx.User.UpdateOneID(userID).
+		Where(user.CurrencyGTE(price)).
+		AddCurrency(-price).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		if ent.IsNotFound(err) {
+			return nil, errors.New("货币余额不足")
+		}
+		s.logger.Error("扣除货币失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("扣除货币失败: %w", err)
+	}
+
+	author, err := tx.User.UpdateOneID(postData.UserID).
+		AddCurrency(price).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("增加作者货币失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("增加作者货币失败: %w", err)
+	}
+
+	// 记录双方余额变动
+	if _, err = tx.UserBalanceLog.CreateBulk(
+		tx.UserBalanceLog.Create().
+			SetUserID(userID).
+			SetType(userbalancelog.TypeCurrency).
+			SetAmount(-price).
+			SetBeforeAmount(updatedBuyer.Currency+price).
+			SetAfterAmount(updatedBuyer.Currency).
+			SetReason(fmt.Sprintf("购买付费帖子#%d", postData.ID)).
+			SetOperatorID(userID).
+			SetOperatorName(buyer.Username).
+			SetRelatedID(postData.ID).
+			SetRelatedType(postPurchaseRelatedType),
+		tx.UserBalanceLog.Create().
+			SetUserID(author.ID).
+			SetType(userbalancelog.TypeCurrency).
+			SetAmount(price).
+			SetBeforeAmount(author.Currency-price).
+			SetAfterAmount(author.Currency).
+			SetReason(fmt.Sprintf("付费帖子#%d被购买", postData.ID)).
+			SetOperatorID(userID).
+			SetOperatorName(buyer.Username).
+			SetRelatedID(postData.ID).
+			SetRelatedType(postPurchaseRelatedType),
+	).Save(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("创建余额变动记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建余额变动记录失败: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	if err = s.notificationTask.Submit(ctx, NotificationTaskPayload{
+		Type:    notification.TypeBalance,
+		UserIDs: []int{author.ID},
+		ActorID: userID,
+		PostID:  postData.ID,
+		Content: fmt.Sprintf("付费帖子被购买，货币 +%d", price),
+	}); err != nil {
+		s.logger.Warn("提交余额变动通知失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
+	s.logger.Info("购买付费帖子成功", zap.Int("post_id", postData.ID), zap.Int("price", price), tracing.WithTraceIDField(ctx))
+	return &schema.UserPostPurchaseResponse{
+		PostID:   postData.ID,
+		Price:    price,
+		Currency: updatedBuyer.Currency,
+	}, nil
+}
+
 // GetPostList 获取帖子列表
 func (s *PostService) GetPostList(ctx context.Context, req schema.UserPostListRequest) (*schema.UserPostListResponse, error) {
 	s.logger.Info("获取帖子列表", zap.Int("category_id", req.CategoryID), zap.Int("page", req.Page), zap.Int("page_size", req.PageSize), tracing.WithTraceIDField(ctx))
@@ -454,12 +715,57 @@ func (s *PostService) GetPostList(ctx context.Context, req schema.UserPostListRe
 		req.Sort = "latest"
 	}
 
+	// 版块状态与访问控制规则沿版块树继承，隐藏版块与无权查看的版块不可见
+	tree, viewer, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, tracing.GetUserID(ctx))
+	if err != nil {
+		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	if req.CategoryID > 0 {
+		if err = tree.checkCategoryReadable(req.CategoryID, viewer); err != nil {
+			return nil, err
+		}
+	}
+
+	// 热门排序使用热度榜，按标签筛选或包含子版块时热度榜无法覆盖，仍按浏览数与点赞数排序
+	if req.Sort == "hot" && req.Tag == "" && !req.IncludeChildren {
+		return s.GetHotPosts(ctx, schema.UserPostHotListRequest{
+			CategoryID: req.CategoryID,
+			Page:       req.Page,
+			PageSize:   req.PageSize,
+		})
+	}
+
 	// 构建查询条件
 	query := s.db.Post.Query()
 
-	// 如果指定了版块ID
-	if req.CategoryID > 0 {
+	// 如果指定了版块ID，按需包含可见的子孙版块
+	switch {
+	case req.CategoryID > 0 && req.IncludeChildren:
+		query = query.Where(post.CategoryIDIn(tree.visibleDescendants(req.CategoryID, viewer)...))
+	case req.CategoryID > 0:
 		query = query.Where(post.CategoryID(req.CategoryID))
+	default:
+		if invisible := tree.invisibleIDs(viewer); len(invisible) > 0 {
+			query = query.Where(post.CategoryIDNotIn(invisible...))
+		}
+	}
+
+	// 如果指定了标签，同义词解析为规范标签
+	if req.Tag != "" {
+		tagData, err := findCanonicalTag(ctx, s.db, req.Tag)
+		if err != nil {
+			s.logger.Error("查询标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, err
+		}
+		if tagData == nil {
+			return &schema.UserPostListResponse{
+				Posts:    []schema.UserPostCreateResponse{},
+				Page:     req.Page,
+				PageSize: req.PageSize,
+			}, nil
+		}
+		query = query.Where(postHasTag(tagData.ID))
 	}
 
 	// 只显示正常状态的帖子
@@ -493,6 +799,152 @@ func (s *PostService) GetPostList(ctx context.Context, req schema.UserPostListRe
 		return nil, fmt.Errorf("获取帖子列表失败: %w", err)
 	}
 
+	result, err := s.buildPostListItems(ctx, tracing.GetUserID(ctx), posts)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := (total + req.PageSize - 1) / req.PageSize
+
+	return &schema.UserPostListResponse{
+		Posts:      result,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// GetHotPosts 获取全站或版块的热门帖子
+// 帖子顺序来自Redis热度榜，帖子内容与可见性以数据库为准
+func (s *PostService) GetHotPosts(ctx context.Context, req schema.UserPostHotListRequest) (*schema.UserPostListResponse, error) {
+	s.logger.Info("获取热门帖子", zap.Int("category_id", req.CategoryID), zap.Int("page", req.Page), zap.Int("page_size", req.PageSize), tracing.WithTraceIDField(ctx))
+
+	// 设置默认值
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+
+	currentUserID := tracing.GetUserID(ctx)
+	tree, viewer, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, currentUserID)
+	if err != nil {
+		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	if req.CategoryID > 0 {
+		if err = tree.checkCategoryReadable(req.CategoryID, viewer); err != nil {
+			return nil, err
+		}
+	}
+
+	postIDs, total, err := s.postHotService.GetHotPostIDs(ctx, req.CategoryID, (req.Page-1)*req.PageSize, req.PageSize)
+	if err != nil {
+		s.logger.Error("获取热度榜失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取热门帖子失败: %w", err)
+	}
+
+	query := s.db.Post.Query().
+		Where(
+			post.IDIn(postIDs...),
+			post.StatusEQ(post.StatusNormal),
+		)
+	if invisible := tree.invisibleIDs(viewer); len(invisible) > 0 {
+		query = query.Where(post.CategoryIDNotIn(invisible...))
+	}
+	// 登录用户不展示黑名单用户的帖子
+	if currentUserID != 0 {
+		query = query.Where(postAuthorNotBlocked(currentUserID))
+	}
+	found, err := query.All(ctx)
+	if err != nil {
+		s.logger.Error("获取热门帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取热门帖子失败: %w", err)
+	}
+
+	// 按热度榜顺序排列，热度榜刷新前已失效的帖子直接跳过
+	postMap := make(map[int]*ent.Post, len(found))
+	for _, p := range found {
+		postMap[p.ID] = p
+	}
+	posts := make([]*ent.Post, 0, len(found))
+	for _, id := range postIDs {
+		if p, ok := postMap[id]; ok {
+			posts = append(posts, p)
+		}
+	}
+
+	result, err := s.buildPostListItems(ctx, currentUserID, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.UserPostListResponse{
+		Posts:      result,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: (total + req.PageSize - 1) / req.PageSize,
+	}, nil
+}
+
+// GetFeed 获取关注的用户与版块的最新帖子
+// 按帖子ID倒序游标分页，读取时通过子查询合并关注关系，并排除黑名单用户与隐藏版块
+func (s *PostService) GetFeed(ctx context.Context, userID int, req schema.FeedRequest) (*schema.FeedResponse, error) {
+	s.logger.Info("获取关注动态", zap.Int("user_id", userID), zap.Int("cursor", req.Cursor), tracing.WithTraceIDField(ctx))
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+
+	tree, viewer, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, userID)
+	if err != nil {
+		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	query := s.db.Post.Query().
+		Where(
+			post.StatusEQ(post.StatusNormal),
+			postInFollowFeed(userID),
+			postAuthorNotBlocked(userID),
+		)
+	if invisible := tree.invisibleIDs(viewer); len(invisible) > 0 {
+		query = query.Where(post.CategoryIDNotIn(invisible...))
+	}
+	if req.Cursor > 0 {
+		query = query.Where(post.IDLT(req.Cursor))
+	}
+
+	// 多查询一条用于判断是否还有更多
+	posts, err := query.
+		Order(ent.Desc(post.FieldID)).
+		Limit(req.Limit + 1).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取关注动态失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取关注动态失败: %w", err)
+	}
+
+	result := &schema.FeedResponse{}
+	if len(posts) > req.Limit {
+		posts = posts[:req.Limit]
+		result.HasMore = true
+		result.NextCursor = posts[len(posts)-1].ID
+	}
+
+	result.Posts, err = s.buildPostListItems(ctx, userID, posts)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// buildPostListItems 批量组装帖子列表项，currentUserID为0表示未登录
+// 用户名、版块名、标签、点赞状态与实时统计均批量查询
+func (s *PostService) buildPostListItems(ctx context.Context, currentUserID int, posts []*ent.Post) ([]schema.UserPostCreateResponse, error) {
 	// 收集用户ID和版块ID
 	userIDs := make(map[int]bool)
 	categoryIDs := make(map[int]bool)
@@ -508,14 +960,21 @@ func (s *PostService) GetPostList(ctx context.Context, req schema.UserPostListRe
 	}
 	users, err := s.db.User.Query().
 		Where(user.IDIn(userIDList...)).
-		Select(user.FieldID, user.FieldUsername).
+		Select(user.FieldID, user.FieldUsername, user.FieldExperience).
 		All(ctx)
 	if err != nil {
 		s.logger.Warn("批量查询用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 	}
+	levelTable := loadLevelTable(ctx, s.settingsService, s.logger)
 	userMap := make(map[int]string)
+	userLevelMap := make(map[int]*schema.UserLevelBrief)
 	for _, u := range users {
 		userMap[u.ID] = u.Username
+		userLevelMap[u.ID] = buildUserLevelBrief(levelTable, u.Experience)
+	}
+	userBadgeMap, err := loadDisplayBadges(ctx, s.db, userIDList)
+	if err != nil {
+		s.logger.Warn("批量查询展示徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 	}
 
 	// 批量查询版块信息
@@ -535,9 +994,6 @@ func (s *PostService) GetPostList(ctx context.Context, req schema.UserPostListRe
 		categoryMap[c.ID] = c.Name
 	}
 
-	// 获取当前用户ID，如果未登录则为0
-	currentUserID := tracing.GetUserID(ctx)
-
 	// 获取帖子ID列表
 	postIDs := make([]int, len(posts))
 	for i, p := range posts {
@@ -579,6 +1035,19 @@ func (s *PostService) GetPostList(ctx context.Context, req schema.UserPostListRe
 		userLikeStatus = make(map[int]map[string]bool)
 	}
 
+	// 批量查询帖子标签
+	tagMap, err := getPostTagNames(ctx, s.db, postIDs)
+	if err != nil {
+		s.logger.Warn("批量查询帖子标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
+	// 批量加载阅读权限数据
+	viewer, err := loadPostReadViewer(ctx, s.db, s.cache, levelTable, currentUserID, posts)
+	if err != nil {
+		s.logger.Error("加载阅读权限失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
 	// 批量获取实时统计数据
 	statsMap, err := s.postStatsService.GetStatsMap(ctx, postIDs)
 	if err != nil {
@@ -613,14 +1082,25 @@ func (s *PostService) GetPostList(ctx context.Context, req schema.UserPostListRe
 			userDisliked = status["dislike"]
 		}
 
+		// 无阅读权限时仅展示预览
+		content := p.Content
+		contentHidden := !viewer.canRead(p)
+		if contentHidden {
+			content = postContentTeaser(p.Content)
+		}
+
 		result[i] = schema.UserPostCreateResponse{
 			ID:             p.ID,
 			CategoryID:     p.CategoryID,
 			CategoryName:   categoryName,
 			Title:          p.Title,
-			Content:        p.Content,
+			Content:        content,
+			ContentHidden:  contentHidden,
 			Username:       username,
+			AuthorLevel:    userLevelMap[p.UserID],
+			AuthorBadges:   userBadgeMap[p.UserID],
 			ReadPermission: p.ReadPermission,
+			Tags:           tagMap[p.ID],
 			ViewCount:      viewCount,
 			LikeCount:      likeCount,
 			DislikeCount:   dislikeCount,
@@ -635,15 +1115,7 @@ func (s *PostService) GetPostList(ctx context.Context, req schema.UserPostListRe
 		}
 	}
 
-	totalPages := (total + req.PageSize - 1) / req.PageSize
-
-	return &schema.UserPostListResponse{
-		Posts:      result,
-		Total:      total,
-		Page:       req.Page,
-		PageSize:   req.PageSize,
-		TotalPages: totalPages,
-	}, nil
+	return result, nil
 }
 
 // GetPostDetail 获取帖子详情
@@ -662,6 +1134,16 @@ func (s *PostService) GetPostDetail(ctx context.Context, req schema.UserPostDeta
 		return nil, fmt.Errorf("获取帖子详情失败: %w", err)
 	}
 
+	// 检查所在版块是否可见，版块状态与访问控制规则沿版块树继承
+	tree, categoryVisitor, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, tracing.GetUserID(ctx))
+	if err != nil {
+		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	if err = tree.checkCategoryReadable(postData.CategoryID, categoryVisitor); err != nil {
+		return nil, err
+	}
+
 	// 更新浏览数(使用统计服务,减少数据库压力)
 	if err = s.postStatsService.IncrViewCount(ctx, req.ID); err != nil {
 		s.logger.Warn("增加帖子浏览数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
@@ -685,12 +1167,19 @@ func (s *PostService) GetPostDetail(ctx context.Context, req schema.UserPostDeta
 
 	// 查询作者信息
 	username := ""
+	var authorLevel *schema.UserLevelBrief
+	levelTable := loadLevelTable(ctx, s.settingsService, s.logger)
 	author, err := s.db.User.Query().
 		Where(user.IDEQ(postData.UserID)).
-		Select(user.FieldUsername).
+		Select(user.FieldUsername, user.FieldExperience).
 		Only(ctx)
 	if err == nil {
 		username = author.Username
+		authorLevel = buildUserLevelBrief(levelTable, author.Experience)
+	}
+	authorBadges, err := loadDisplayBadges(ctx, s.db, []int{postData.UserID})
+	if err != nil {
+		s.logger.Warn("查询作者展示徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 	}
 
 	// 查询版块信息
@@ -703,6 +1192,12 @@ func (s *PostService) GetPostDetail(ctx context.Context, req schema.UserPostDeta
 		categoryName = categoryData.Name
 	}
 
+	// 查询帖子标签
+	tagMap, err := getPostTagNames(ctx, s.db, []int{postData.ID})
+	if err != nil {
+		s.logger.Warn("查询帖子标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
 	// 获取当前用户ID，如果未登录则为0
 	currentUserID := tracing.GetUserID(ctx)
 
@@ -730,15 +1225,44 @@ func (s *PostService) GetPostDetail(ctx context.Context, req schema.UserPostDeta
 		}
 	}
 
+	// 校验阅读权限，无权限时仅展示预览
+	viewer, err := loadPostReadViewer(ctx, s.db, s.cache, levelTable, currentUserID, []*ent.Post{postData})
+	if err != nil {
+		s.logger.Error("加载阅读权限失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	content := postData.Content
+	contentHidden := !viewer.canRead(postData)
+	if contentHidden {
+		content = postContentTeaser(postData.Content)
+	}
+
+	// 查询正文附件，无阅读权限时不返回
+	var attachments []schema.AttachmentItem
+	if !contentHidden {
+		attachmentMap, err := getAttachmentItems(ctx, s.db, s.storage, func(a *ent.Attachment) int { return a.PostID },
+			attachment.PostIDEQ(postData.ID), attachment.CommentIDEQ(0))
+		if err != nil {
+			s.logger.Warn("查询帖子附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+		attachments = attachmentMap[postData.ID]
+	}
+
 	result := &schema.UserPostDetailResponse{
 		ID:             postData.ID,
 		CategoryID:     postData.CategoryID,
 		CategoryName:   categoryName,
 		Title:          postData.Title,
-		Content:        postData.Content,
+		Content:        content,
+		ContentHTML:    renderMarkdown(ctx, s.cache, s.logger, content),
+		ContentHidden:  contentHidden,
 		UserID:         postData.UserID,
 		Username:       username,
+		AuthorLevel:    authorLevel,
+		AuthorBadges:   authorBadges[postData.UserID],
 		ReadPermission: postData.ReadPermission,
+		Tags:           tagMap[postData.ID],
+		Attachments:    attachments,
 		ViewCount:      viewCount,
 		LikeCount:      likeCount,
 		DislikeCount:   dislikeCount,
@@ -836,6 +1360,77 @@ func (s *PostService) CheckPrivatePermission(ctx context.Context, userID, postID
 	return true, nil
 }
 
+// createPostWithTags 在事务中创建帖子并关联标签，返回帖子与规范标签名称列表
+func (s *PostService) createPostWithTags(ctx context.Context, userID int, req schema.UserPostCreateRequest, status post.Status) (*ent.Post, []string, error) {
+	// 校验阅读权限
+	readPermission, err := normalizeReadPermission(req.ReadPermission)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	newPost, err := tx.Post.Create().
+		SetUserID(userID).
+		SetCategoryID(req.CategoryID).
+		SetTitle(req.Title).
+		SetContent(req.Content).
+		SetReadPermission(readPermission).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("创建帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, nil, fmt.Errorf("创建帖子失败: %w", err)
+	}
+
+	tags, err := setPostTags(ctx, tx, newPost.ID, req.Tags)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Warn("设置帖子标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, nil, err
+	}
+
+	if err = bindAttachments(ctx, tx, userID, req.AttachmentIDs, newPost.ID, 0); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Warn("关联帖子附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, nil, err
+	}
+
+	// 草稿不记录提及，避免未发布内容提醒他人
+	var mentionIDs []int
+	if status == post.StatusNormal {
+		mentionIDs, err = syncMentions(ctx, tx, userID, newPost.ID, 0, req.Content)
+		if err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Error("记录提及失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	// 提醒发送失败不影响发帖
+	if err = s.mentionTask.SubmitEmailTask(ctx, mentionIDs); err != nil {
+		s.logger.Warn("提交提及提醒任务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
+	return newPost, tags, nil
+}
+
 // checkUserStatus 检查用户状态是否允许操作
 func (s *PostService) checkUserStatus(ctx context.Context, userID int) error {
 	userData, err := s.db.User.Query().
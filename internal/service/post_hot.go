@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/comment"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/stats"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+)
+
+// 热度分计算参数
+// 采用Reddit式算法：热度分 = sign(互动分) * log10(max(|互动分|, 1)) + 发布时间 / 衰减周期
+// 发布时间越晚基础分越高，较早的帖子需要数量级更多的互动才能保持排名，
+// 且分数只在互动变化时需要重新计算，适合在Redis中增量维护
+const (
+	// hotScoreEpoch 发布时间的计算起点(2024-01-01 00:00:00 UTC)
+	hotScoreEpoch = 1704067200
+	// hotScoreDecaySeconds 衰减周期，每晚发布该时长相当于互动分增加10倍
+	hotScoreDecaySeconds = 45000.0
+
+	hotWeightLike     = 1.0
+	hotWeightDislike  = 1.0
+	hotWeightFavorite = 2.0
+	hotWeightComment  = 1.5
+	hotWeightView     = 0.05
+
+	// hotRankWindow 参与热度排行的帖子发布时间范围
+	hotRankWindow = 7 * 24 * time.Hour
+	// hotRankMaxSize 每个热度榜保留的帖子数量
+	hotRankMaxSize = 500
+)
+
+// IPostHotService 帖子热度服务接口
+type IPostHotService interface {
+	// UpdateScore 重新计算单个帖子的热度分并写入热度榜
+	// 帖子不可见或超出排行时间范围时从热度榜移除
+	UpdateScore(ctx context.Context, postID int) error
+	// Refresh 重新计算排行时间范围内全部帖子的热度分并重建热度榜
+	// 返回: 进入全站热度榜的帖子数量和错误
+	Refresh(ctx context.Context) (int, error)
+	// GetHotPostIDs 按热度从高到低获取帖子ID
+	// categoryID为0时读取全站热度榜，版块热度榜包含子孙版块的帖子
+	// 返回: 帖子ID列表、热度榜帖子总数和错误
+	GetHotPostIDs(ctx context.Context, categoryID, offset, limit int) ([]int, int, error)
+}
+
+// PostHotService 帖子热度服务实现
+type PostHotService struct {
+	db          *ent.Client
+	cache       cache.ICacheService
+	statsHelper *stats.Helper
+	logger      *zap.Logger
+}
+
+// NewPostHotService 创建帖子热度服务实例
+func NewPostHotService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IPostHotService {
+	return &PostHotService{
+		db:          db,
+		cache:       cacheService,
+		statsHelper: stats.NewStatsHelper(cacheService, logger),
+		logger:      logger,
+	}
+}
+
+// calcHotScore 计算帖子热度分
+func calcHotScore(likes, dislikes, favorites, comments, views int, createdAt time.Time) float64 {
+	points := float64(likes)*hotWeightLike -
+		float64(dislikes)*hotWeightDislike +
+		float64(favorites)*hotWeightFavorite +
+		float64(comments)*hotWeightComment +
+		float64(views)*hotWeightView
+
+	order := math.Log10(math.Max(math.Abs(points), 1))
+	sign := 0.0
+	switch {
+	case points > 0:
+		sign = 1
+	case points < 0:
+		sign = -1
+	}
+
+	return sign*order + float64(createdAt.Unix()-hotScoreEpoch)/hotScoreDecaySeconds
+}
+
+// hotRankKeys 帖子所在的热度榜键
+// 帖子同时计入所在版块及其全部祖先版块的热度榜，父版块的热度榜包含子孙版块的帖子
+func hotRankKeys(tree *categoryTree, categoryID int) []string {
+	keys := []string{stats.PostHotGlobalKey}
+	for _, id := range tree.ancestors(categoryID) {
+		keys = append(keys, stats.GetPostHotCategoryKey(id))
+	}
+	return keys
+}
+
+// overlayStats 使用Redis中的实时统计覆盖数据库中的统计字段
+// 数据库中的统计由定时任务同步，存在延迟
+func (s *PostHotService) overlayStats(ctx context.Context, p *ent.Post) {
+	statData, err := s.statsHelper.GetStats(ctx, stats.GetPostStatsKey(p.ID), []string{"like_count", "dislike_count", "favorite_count", "view_count"})
+	if err != nil {
+		return
+	}
+	for _, v := range statData {
+		if v > 0 {
+			p.LikeCount = statData["like_count"]
+			p.DislikeCount = statData["dislike_count"]
+			p.FavoriteCount = statData["favorite_count"]
+			p.ViewCount = statData["view_count"]
+			return
+		}
+	}
+}
+
+// UpdateScore 重新计算单个帖子的热度分并写入热度榜
+func (s *PostHotService) UpdateScore(ctx context.Context, postID int) error {
+	p, err := s.db.Post.Query().
+		Where(post.IDEQ(postID)).
+		Select(post.FieldID, post.FieldCategoryID, post.FieldStatus, post.FieldCreatedAt,
+			post.FieldLikeCount, post.FieldDislikeCount, post.FieldFavoriteCount, post.FieldViewCount).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("获取帖子失败: %w", err)
+	}
+
+	tree, err := loadCategoryTree(ctx, s.db, s.cache)
+	if err != nil {
+		return err
+	}
+	keys := hotRankKeys(tree, p.CategoryID)
+
+	member := strconv.Itoa(p.ID)
+	if p.Status != post.StatusNormal || time.Since(p.CreatedAt) > hotRankWindow {
+		for _, key := range keys {
+			if err = s.cache.ZRem(ctx, key, member); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	commentCount, err := s.db.Comment.Query().
+		Where(comment.PostIDEQ(p.ID)).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("统计评论数失败: %w", err)
+	}
+
+	s.overlayStats(ctx, p)
+	score := calcHotScore(p.LikeCount, p.DislikeCount, p.FavoriteCount, commentCount, p.ViewCount, p.CreatedAt)
+	for _, key := range keys {
+		if err = s.cache.ZAdd(ctx, key, member, score); err != nil {
+			return err
+		}
+		// 只保留热度最高的帖子，避免热度榜在两次全量刷新之间无限增长
+		if err = s.cache.ZRemRangeByRank(ctx, key, 0, -hotRankMaxSize-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Refresh 重新计算排行时间范围内全部帖子的热度分并重建热度榜
+func (s *PostHotService) Refresh(ctx context.Context) (int, error) {
+	s.logger.Debug("开始刷新帖子热度榜", tracing.WithTraceIDField(ctx))
+
+	posts, err := s.db.Post.Query().
+		Where(
+			post.StatusEQ(post.StatusNormal),
+			post.CreatedAtGTE(time.Now().Add(-hotRankWindow)),
+		).
+		Select(post.FieldID, post.FieldCategoryID, post.FieldCreatedAt,
+			post.FieldLikeCount, post.FieldDislikeCount, post.FieldFavoriteCount, post.FieldViewCount).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("获取帖子失败: %w", err)
+	}
+
+	postIDs := make([]int, len(posts))
+	for i, p := range posts {
+		postIDs[i] = p.ID
+	}
+
+	// 批量统计评论数
+	var rows []struct {
+		PostID int `json:"post_id"`
+		Count  int `json:"count"`
+	}
+	if len(postIDs) > 0 {
+		if err = s.db.Comment.Query().
+			Where(comment.PostIDIn(postIDs...)).
+			GroupBy(comment.FieldPostID).
+			Aggregate(ent.Count()).
+			Scan(ctx, &rows); err != nil {
+			return 0, fmt.Errorf("统计评论数失败: %w", err)
+		}
+	}
+	commentCounts := make(map[int]int, len(rows))
+	for _, row := range rows {
+		commentCounts[row.PostID] = row.Count
+	}
+
+	tree, err := loadCategoryTree(ctx, s.db, s.cache)
+	if err != nil {
+		return 0, err
+	}
+
+	// 按热度榜分组
+	members := make(map[string][]cache.ZMember)
+	for _, p := range posts {
+		s.overlayStats(ctx, p)
+		member := cache.ZMember{
+			Member: strconv.Itoa(p.ID),
+			Score:  calcHotScore(p.LikeCount, p.DislikeCount, p.FavoriteCount, commentCounts[p.ID], p.ViewCount, p.CreatedAt),
+		}
+		for _, key := range hotRankKeys(tree, p.CategoryID) {
+			members[key] = append(members[key], member)
+		}
+	}
+
+	// 没有热门帖子的版块需要清空旧的热度榜
+	keys := []string{stats.PostHotGlobalKey}
+	for id := range tree.nodes {
+		keys = append(keys, stats.GetPostHotCategoryKey(id))
+	}
+
+	for _, key := range keys {
+		if err = s.rebuild(ctx, key, members[key]); err != nil {
+			return 0, err
+		}
+	}
+
+	count := len(members[stats.PostHotGlobalKey])
+	if count > hotRankMaxSize {
+		count = hotRankMaxSize
+	}
+	s.logger.Debug("刷新帖子热度榜完成", zap.Int("count", count), tracing.WithTraceIDField(ctx))
+	return count, nil
+}
+
+// rebuild 写入临时键后整体替换热度榜，避免重建过程中读到不完整的数据
+func (s *PostHotService) rebuild(ctx context.Context, key string, members []cache.ZMember) error {
+	if len(members) == 0 {
+		_, err := s.cache.Del(ctx, key)
+		return err
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Score > members[j].Score
+	})
+	if len(members) > hotRankMaxSize {
+		members = members[:hotRankMaxSize]
+	}
+
+	tmpKey := key + ":rebuild"
+	if _, err := s.cache.Del(ctx, tmpKey); err != nil {
+		return err
+	}
+	for _, m := range members {
+		if err := s.cache.ZAdd(ctx, tmpKey, m.Member, m.Score); err != nil {
+			return err
+		}
+	}
+	return s.cache.Rename(ctx, tmpKey, key)
+}
+
+// GetHotPostIDs 按热度从高到低获取帖子ID
+func (s *PostHotService) GetHotPostIDs(ctx context.Context, categoryID, offset, limit int) ([]int, int, error) {
+	key := stats.PostHotGlobalKey
+	if categoryID > 0 {
+		key = stats.GetPostHotCategoryKey(categoryID)
+	}
+
+	total, err := s.cache.ZCard(ctx, key)
+	if err != nil {
+		return nil, 0, err
+	}
+	members, err := s.cache.ZRevRangeWithScores(ctx, key, int64(offset), int64(offset+limit-1))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.Atoi(m.Member)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, int(total), nil
+}
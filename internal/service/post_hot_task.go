@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	pkgasynq "github.com/PokeForum/PokeForum/internal/pkg/asynq"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+)
+
+// PostHotTask 帖子热度榜刷新任务
+// 互动发生时只增量更新对应帖子的热度分，浏览数变化与排行时间范围的滑动由定时任务统一刷新
+type PostHotTask struct {
+	db             *ent.Client
+	cache          cache.ICacheService
+	logger         *zap.Logger
+	postHotService IPostHotService
+	taskManager    *pkgasynq.TaskManager
+}
+
+// PostHotRefreshPayload 热度榜刷新任务载荷
+type PostHotRefreshPayload struct {
+	TriggerTime int64 `json:"trigger_time"`
+}
+
+// NewPostHotTask 创建帖子热度榜刷新任务实例
+func NewPostHotTask(db *ent.Client, cacheService cache.ICacheService, taskManager *pkgasynq.TaskManager, logger *zap.Logger) *PostHotTask {
+	return &PostHotTask{
+		db:             db,
+		cache:          cacheService,
+		logger:         logger,
+		postHotService: NewPostHotService(db, cacheService, logger),
+		taskManager:    taskManager,
+	}
+}
+
+// RegisterHandler 注册任务处理器
+func (t *PostHotTask) RegisterHandler() {
+	t.taskManager.RegisterHandlerFunc(pkgasynq.TypePostHotRefresh, t.HandleRefreshTask)
+	t.logger.Info("帖子热度榜刷新任务处理器已注册")
+}
+
+// RegisterSchedule 注册定时任务
+// interval: 刷新间隔时间
+func (t *PostHotTask) RegisterSchedule(interval time.Duration) error {
+	payload := &PostHotRefreshPayload{TriggerTime: time.Now().Unix()}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("序列化任务载荷失败: %w", err)
+	}
+
+	task := asynq.NewTask(pkgasynq.TypePostHotRefresh, data)
+
+	// 转换为cron表达式，如 @every 10m
+	cronSpec := fmt.Sprintf("@every %s", interval.String())
+
+	entryID, err := t.taskManager.RegisterSchedule(cronSpec, task, asynq.Queue(pkgasynq.QueueLow))
+	if err != nil {
+		return fmt.Errorf("注册定时任务失败: %w", err)
+	}
+
+	t.logger.Info("帖子热度榜刷新定时任务已注册",
+		zap.String("entry_id", entryID),
+		zap.Duration("interval", interval))
+
+	return nil
+}
+
+// HandleRefreshTask 处理热度榜刷新任务
+func (t *PostHotTask) HandleRefreshTask(ctx context.Context, task *asynq.Task) error {
+	var payload PostHotRefreshPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		t.logger.Error("反序列化热度榜刷新任务失败", zap.Error(err))
+		return fmt.Errorf("反序列化失败: %v: %w", err, asynq.SkipRetry)
+	}
+
+	startTime := time.Now()
+	count, err := t.postHotService.Refresh(ctx)
+	if err != nil {
+		t.logger.Error("刷新帖子热度榜失败", zap.Error(err))
+		return err
+	}
+
+	t.logger.Debug("帖子热度榜刷新完成",
+		zap.Int("count", count),
+		zap.Duration("duration", time.Since(startTime)))
+	return nil
+}
+
+// RefreshNow 立即执行一次刷新（用于启动时）
+func (t *PostHotTask) RefreshNow(ctx context.Context) {
+	t.logger.Debug("立即执行帖子热度榜刷新")
+
+	payload := &PostHotRefreshPayload{TriggerTime: time.Now().Unix()}
+	data, _ := json.Marshal(payload) //nolint:errcheck // 序列化简单结构不会失败
+	task := asynq.NewTask(pkgasynq.TypePostHotRefresh, data)
+
+	_, err := t.taskManager.EnqueueContext(ctx, task, asynq.Queue(pkgasynq.QueueLow))
+	if err != nil {
+		t.logger.Error("提交立即刷新任务失败", zap.Error(err))
+	}
+}
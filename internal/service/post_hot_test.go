@@ -0,0 +1,141 @@
+package service
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/internal/pkg/stats"
+)
+
+func TestCalcHotScore(t *testing.T) {
+	base := time.Unix(hotScoreEpoch, 0)
+
+	tests := []struct {
+		name      string
+		likes     int
+		dislikes  int
+		favorites int
+		comments  int
+		views     int
+		createdAt time.Time
+		want      float64
+	}{
+		{name: "没有互动", createdAt: base, want: 0},
+		{name: "一次点赞不加分", likes: 1, createdAt: base, want: 0},
+		{name: "十倍互动加一分", likes: 10, createdAt: base, want: 1},
+		{name: "收藏与评论按权重计分", favorites: 25, comments: 100, views: 1000, createdAt: base, want: math.Log10(250)},
+		{name: "点踩为负分", dislikes: 100, createdAt: base, want: -2},
+		{name: "点赞与点踩相抵", likes: 50, dislikes: 50, createdAt: base, want: 0},
+		{name: "晚发布一个衰减周期加一分", createdAt: base.Add(hotScoreDecaySeconds * time.Second), want: 1},
+		{name: "时间与互动叠加", likes: 100, createdAt: base.Add(2 * hotScoreDecaySeconds * time.Second), want: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := calcHotScore(tt.likes, tt.dislikes, tt.favorites, tt.comments, tt.views, tt.createdAt)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Fatalf("热度分应为 %v，实际 %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPostHotUpdateScore(t *testing.T) {
+	db := newTestDB(t)
+	cacheService := newTestCache(t)
+	svc := NewPostHotService(db, cacheService, zap.NewNop())
+	u := newTestUser(t, db, "author", "author@example.com")
+
+	parent := db.Category.Create().SetName("父版块").SetSlug("parent").SaveX(t.Context())
+	child := db.Category.Create().SetName("子版块").SetSlug("child").SetParentID(parent.ID).SaveX(t.Context())
+	sibling := db.Category.Create().SetName("兄弟版块").SetSlug("sibling").SetParentID(parent.ID).SaveX(t.Context())
+
+	p := db.Post.Create().
+		SetUserID(u.ID).
+		SetCategoryID(child.ID).
+		SetTitle("热门帖子").
+		SetContent("内容").
+		SetLikeCount(10).
+		SaveX(t.Context())
+	if err := svc.UpdateScore(t.Context(), p.ID); err != nil {
+		t.Fatalf("更新热度分失败: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		categoryID int
+		want       bool
+	}{
+		{name: "全站热度榜", categoryID: 0, want: true},
+		{name: "所在版块热度榜", categoryID: child.ID, want: true},
+		{name: "父版块热度榜包含子版块帖子", categoryID: parent.ID, want: true},
+		{name: "兄弟版块热度榜不包含", categoryID: sibling.ID, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, total, err := svc.GetHotPostIDs(t.Context(), tt.categoryID, 0, 10)
+			if err != nil {
+				t.Fatalf("获取热度榜失败: %v", err)
+			}
+			got := total == 1 && len(ids) == 1 && ids[0] == p.ID
+			if got != tt.want {
+				t.Fatalf("热度榜包含帖子应为 %v，实际 ids=%v total=%d", tt.want, ids, total)
+			}
+		})
+	}
+
+	// 帖子不可见后从全部热度榜移除
+	db.Post.UpdateOne(p).SetStatus(post.StatusPrivate).ExecX(t.Context())
+	if err := svc.UpdateScore(t.Context(), p.ID); err != nil {
+		t.Fatalf("更新热度分失败: %v", err)
+	}
+	for _, categoryID := range []int{0, child.ID, parent.ID} {
+		_, total, err := svc.GetHotPostIDs(t.Context(), categoryID, 0, 10)
+		if err != nil || total != 0 {
+			t.Fatalf("版块 %d 的热度榜应已移除帖子，实际剩余 %d 个: %v", categoryID, total, err)
+		}
+	}
+}
+
+func TestPostHotUpdateScoreTrimsRank(t *testing.T) {
+	db := newTestDB(t)
+	cacheService := newTestCache(t)
+	svc := NewPostHotService(db, cacheService, zap.NewNop())
+	u := newTestUser(t, db, "author", "author@example.com")
+	c := db.Category.Create().SetName("版块").SetSlug("board").SaveX(t.Context())
+
+	// 热度榜已满时写入新的高分帖子，最低分的帖子应被移除
+	for i := 1; i <= hotRankMaxSize; i++ {
+		if err := cacheService.ZAdd(t.Context(), stats.PostHotGlobalKey, strconv.Itoa(100000+i), float64(-i)); err != nil {
+			t.Fatalf("写入热度榜失败: %v", err)
+		}
+	}
+	p := db.Post.Create().
+		SetUserID(u.ID).
+		SetCategoryID(c.ID).
+		SetTitle("新帖子").
+		SetContent("内容").
+		SaveX(t.Context())
+	if err := svc.UpdateScore(t.Context(), p.ID); err != nil {
+		t.Fatalf("更新热度分失败: %v", err)
+	}
+
+	ids, total, err := svc.GetHotPostIDs(t.Context(), 0, 0, 1)
+	if err != nil {
+		t.Fatalf("获取热度榜失败: %v", err)
+	}
+	if total != hotRankMaxSize {
+		t.Fatalf("热度榜应保留 %d 个帖子，实际 %d 个", hotRankMaxSize, total)
+	}
+	if len(ids) != 1 || ids[0] != p.ID {
+		t.Fatalf("新帖子应排在首位，实际 %v", ids)
+	}
+	if rank, err := cacheService.ZRevRank(t.Context(), stats.PostHotGlobalKey, strconv.Itoa(100000+hotRankMaxSize)); err != nil || rank != -1 {
+		t.Fatalf("最低分的帖子应被移除，实际排名 %d: %v", rank, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -24,7 +25,7 @@ type IPostManageService interface {
 	// CreatePost 创建帖子
 	CreatePost(ctx context.Context, req schema.PostCreateRequest) (*ent.Post, error)
 	// UpdatePost 更新帖子信息
-	UpdatePost(ctx context.Context, req schema.PostUpdateRequest) (*ent.Post, error)
+	UpdatePost(ctx context.Context, operatorID int, req schema.PostUpdateRequest) (*ent.Post, error)
 	// UpdatePostStatus 更新帖子状态
 	UpdatePostStatus(ctx context.Context, req schema.PostStatusUpdateRequest) error
 	// GetPostDetail 获取帖子详情
@@ -203,6 +204,12 @@ func (s *PostManageService) GetPostList(ctx context.Context, req schema.PostList
 func (s *PostManageService) CreatePost(ctx context.Context, req schema.PostCreateRequest) (*ent.Post, error) {
 	s.logger.Info("创建帖子", zap.String("title", req.Title), zap.Int("user_id", req.UserID), tracing.WithTraceIDField(ctx))
 
+	// 校验阅读权限
+	readPermission, err := normalizeReadPermission(req.ReadPermission)
+	if err != nil {
+		return nil, err
+	}
+
 	// 检查用户是否存在
 	userExists, err := s.db.User.Query().
 		Where(user.IDEQ(req.UserID)).
@@ -233,7 +240,7 @@ func (s *PostManageService) CreatePost(ctx context.Context, req schema.PostCreat
 		SetCategoryID(req.CategoryID).
 		SetTitle(req.Title).
 		SetContent(req.Content).
-		SetReadPermission(req.ReadPermission).
+		SetReadPermission(readPermission).
 		SetPublishIP(req.PublishIP).
 		SetStatus(post.Status(req.Status)).
 		Save(ctx)
@@ -247,11 +254,11 @@ func (s *PostManageService) CreatePost(ctx context.Context, req schema.PostCreat
 }
 
 // UpdatePost 更新帖子信息
-func (s *PostManageService) UpdatePost(ctx context.Context, req schema.PostUpdateRequest) (*ent.Post, error) {
-	s.logger.Info("更新帖子信息", zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
+func (s *PostManageService) UpdatePost(ctx context.Context, operatorID int, req schema.PostUpdateRequest) (*ent.Post, error) {
+	s.logger.Info("更新帖子信息", zap.Int("id", req.ID), zap.Int("operator_id", operatorID), tracing.WithTraceIDField(ctx))
 
 	// 检查帖子是否存在
-	_, err := s.db.Post.Get(ctx, req.ID)
+	postData, err := s.db.Post.Get(ctx, req.ID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, errors.New("帖子不存在")
@@ -260,17 +267,40 @@ func (s *PostManageService) UpdatePost(ctx context.Context, req schema.PostUpdat
 		return nil, fmt.Errorf("获取帖子失败: %w", err)
 	}
 
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
 	// 构建更新操作
-	update := s.db.Post.UpdateOneID(req.ID)
+	update := tx.Post.UpdateOneID(req.ID)
 
+	title, content := postData.Title, postData.Content
 	if req.Title != "" {
+		title = req.Title
 		update = update.SetTitle(req.Title)
 	}
 	if req.Content != "" {
+		content = req.Content
 		update = update.SetContent(req.Content)
 	}
+	if title != postData.Title || content != postData.Content {
+		update = update.SetLastEditedAt(time.Now())
+	}
 	if req.ReadPermission != "" {
-		update = update.SetReadPermission(req.ReadPermission)
+		readPermission, err := normalizeReadPermission(req.ReadPermission)
+		if err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			return nil, err
+		}
+		update = update.SetReadPermission(readPermission)
 	}
 	if req.Status != "" {
 		update = update.SetStatus(post.Status(req.Status))
@@ -279,10 +309,23 @@ func (s *PostManageService) UpdatePost(ctx context.Context, req schema.PostUpdat
 	// 执行更新
 	updatedPost, err := update.Save(ctx)
 	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
 		s.logger.Error("更新帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 		return nil, fmt.Errorf("更新帖子失败: %w", err)
 	}
 
+	// 记录修订历史
+	if _, err = recordPostRevision(ctx, tx, postData, operatorID, title, content, req.Reason, 0); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("记录修订历史失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
 	s.logger.Info("帖子更新成功", zap.Int("id", updatedPost.ID), tracing.WithTraceIDField(ctx))
 	return updatedPost, nil
 }
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/category"
+	"github.com/PokeForum/PokeForum/ent/categorypermission"
+	"github.com/PokeForum/PokeForum/ent/userbalancelog"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+func TestPostPurchase(t *testing.T) {
+	tests := []struct {
+		name           string
+		readPermission string
+		buyerCurrency  int
+		categoryStatus category.Status
+		viewMinRole    categorypermission.MinRole // 版块查看规则的最低身份，为空表示不设规则
+		selfPurchase   bool
+		purchaseTwice  bool
+		wantErr        bool
+	}{
+		{name: "购买成功", readPermission: "pay_to_view:10", buyerCurrency: 15},
+		{name: "余额刚好足够", readPermission: "pay_to_view:10", buyerCurrency: 10},
+		{name: "余额不足", readPermission: "pay_to_view:10", buyerCurrency: 9, wantErr: true},
+		{name: "无需付费的帖子", readPermission: "login", buyerCurrency: 15, wantErr: true},
+		{name: "不能购买自己的帖子", readPermission: "pay_to_view:10", buyerCurrency: 15, selfPurchase: true, wantErr: true},
+		{name: "不能重复购买", readPermission: "pay_to_view:10", buyerCurrency: 30, purchaseTwice: true, wantErr: true},
+		{name: "隐藏版块中的帖子", readPermission: "pay_to_view:10", buyerCurrency: 15, categoryStatus: category.StatusHidden, wantErr: true},
+		{name: "无权查看版块", readPermission: "pay_to_view:10", buyerCurrency: 15, viewMinRole: categorypermission.MinRoleModerator, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			cacheService := newTestCache(t)
+			svc := NewPostService(db, cacheService, nil, nil, newTestNotificationTask(t, db, cacheService), nil, zap.NewNop())
+
+			author := newTestUser(t, db, "author", "author@example.com")
+			buyer := newTestUser(t, db, "buyer", "buyer@example.com")
+			buyer = db.User.UpdateOne(buyer).SetCurrency(tt.buyerCurrency).SaveX(t.Context())
+			if tt.selfPurchase {
+				buyer = db.User.UpdateOne(author).SetCurrency(tt.buyerCurrency).SaveX(t.Context())
+			}
+
+			status := tt.categoryStatus
+			if status == "" {
+				status = category.StatusNormal
+			}
+			c := db.Category.Create().SetName("付费区").SetSlug("paid").SetStatus(status).SaveX(t.Context())
+			if tt.viewMinRole != "" {
+				db.CategoryPermission.Create().
+					SetCategoryID(c.ID).
+					SetAction(categorypermission.ActionView).
+					SetMinRole(tt.viewMinRole).
+					SaveX(t.Context())
+			}
+			p := db.Post.Create().
+				SetUserID(author.ID).
+				SetCategoryID(c.ID).
+				SetTitle("付费帖子").
+				SetContent("付费内容").
+				SetReadPermission(tt.readPermission).
+				SaveX(t.Context())
+			authorCurrency := db.User.GetX(t.Context(), author.ID).Currency
+
+			req := schema.UserPostActionRequest{ID: p.ID}
+			purchases := 0
+			if tt.purchaseTwice {
+				if _, err := svc.PurchasePost(t.Context(), buyer.ID, req); err != nil {
+					t.Fatalf("首次购买失败: %v", err)
+				}
+				purchases++
+			}
+
+			result, err := svc.PurchasePost(t.Context(), buyer.ID, req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+			if err == nil {
+				purchases++
+				if result.Price != 10 || result.Currency != tt.buyerCurrency-10 {
+					t.Fatalf("购买结果不符合预期: %+v", result)
+				}
+			}
+
+			// 失败的购买不应产生任何扣款与记录
+			if tt.selfPurchase {
+				return
+			}
+			paid := purchases * 10
+			assertCurrency(t, db, buyer.ID, tt.buyerCurrency-paid)
+			assertCurrency(t, db, author.ID, authorCurrency+paid)
+			if n := db.PostPurchase.Query().CountX(t.Context()); n != purchases {
+				t.Fatalf("购买记录应为 %d 条，实际 %d 条", purchases, n)
+			}
+			logs := db.UserBalanceLog.Query().
+				Where(userbalancelog.RelatedTypeEQ(postPurchaseRelatedType)).
+				AllX(t.Context())
+			if len(logs) != purchases*2 {
+				t.Fatalf("余额变动记录应为 %d 条，实际 %d 条", purchases*2, len(logs))
+			}
+			for _, l := range logs {
+				if l.AfterAmount-l.BeforeAmount != l.Amount {
+					t.Fatalf("余额变动记录前后金额不一致: %+v", l)
+				}
+			}
+		})
+	}
+}
+
+// assertCurrency 校验用户货币余额
+func assertCurrency(t *testing.T, db *ent.Client, userID int, want int) {
+	t.Helper()
+
+	if got := db.User.GetX(t.Context(), userID).Currency; got != want {
+		t.Fatalf("用户 %d 的货币余额应为 %d，实际 %d", userID, want, got)
+	}
+}
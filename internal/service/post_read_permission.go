@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/comment"
+	"github.com/PokeForum/PokeForum/ent/postpurchase"
+	"github.com/PokeForum/PokeForum/ent/user"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// readPermissionRoleRank 身份等级，数值越大权限越高
+var readPermissionRoleRank = map[user.Role]int{
+	user.RoleUser:       1,
+	user.RoleModerator:  2,
+	user.RoleAdmin:      3,
+	user.RoleSuperAdmin: 4,
+}
+
+// parseReadPermission 解析阅读权限为模式与参数
+// 空值与无法识别的历史数据均按公开处理
+func parseReadPermission(raw string) (mode, param string) {
+	mode, param, _ = strings.Cut(strings.TrimSpace(raw), ":")
+	switch mode {
+	case _const.ReadPermissionLogin, _const.ReadPermissionMinExperience, _const.ReadPermissionMinLevel, _const.ReadPermissionMinRole,
+		_const.ReadPermissionReplyToView, _const.ReadPermissionPayToView:
+		return mode, param
+	default:
+		return _const.ReadPermissionPublic, ""
+	}
+}
+
+// normalizeReadPermission 校验并规范化用户提交的阅读权限，空值视为公开
+func normalizeReadPermission(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return _const.ReadPermissionPublic, nil
+	}
+
+	mode, param, hasParam := strings.Cut(raw, ":")
+	switch mode {
+	case _const.ReadPermissionPublic, _const.ReadPermissionLogin, _const.ReadPermissionReplyToView:
+		if hasParam {
+			return "", errors.New("该阅读权限不支持参数")
+		}
+		return mode, nil
+	case _const.ReadPermissionMinExperience:
+		exp, err := strconv.Atoi(param)
+		if err != nil || exp < 1 {
+			return "", errors.New("最低经验值必须为正整数")
+		}
+		return fmt.Sprintf("%s:%d", mode, exp), nil
+	case _const.ReadPermissionMinLevel:
+		level, err := strconv.Atoi(param)
+		if err != nil || level < 1 {
+			return "", errors.New("最低等级必须为正整数")
+		}
+		return fmt.Sprintf("%s:%d", mode, level), nil
+	case _const.ReadPermissionMinRole:
+		if _, ok := readPermissionRoleRank[user.Role(param)]; !ok {
+			return "", errors.New("最低身份无效，可选值：User、Moderator、Admin、SuperAdmin")
+		}
+		return mode + ":" + param, nil
+	case _const.ReadPermissionPayToView:
+		price, err := strconv.Atoi(param)
+		if err != nil || price < 1 || price > _const.ReadPermissionMaxPrice {
+			return "", fmt.Errorf("付费阅读价格必须在1到%d之间", _const.ReadPermissionMaxPrice)
+		}
+		return fmt.Sprintf("%s:%d", mode, price), nil
+	default:
+		return "", errors.New("阅读权限无效")
+	}
+}
+
+// readPermissionPrice 获取付费阅读价格，非付费阅读返回0
+func readPermissionPrice(raw string) int {
+	mode, param := parseReadPermission(raw)
+	if mode != _const.ReadPermissionPayToView {
+		return 0
+	}
+	price, err := strconv.Atoi(param)
+	if err != nil || price < 1 {
+		return 0
+	}
+	return price
+}
+
+// postContentTeaser 生成无阅读权限时的正文预览，最多展示正文的三分之一
+func postContentTeaser(content string) string {
+	runes := []rune(content)
+	n := min(_const.ReadPermissionTeaserLength, len(runes)/3)
+	if n >= len(runes) {
+		return content
+	}
+	return string(runes[:n]) + "..."
+}
+
+// postReadViewer 帖子访问者信息，批量预加载后用于判断帖子阅读权限
+type postReadViewer struct {
+	userID     int
+	role       user.Role
+	experience int
+	// 访问者按等级表换算的等级
+	level int
+	// 访问者担任版主的版块
+	moderated map[int]bool
+	// 访问者已回复的帖子
+	replied map[int]bool
+	// 访问者已购买的帖子
+	purchased map[int]bool
+}
+
+// loadPostReadViewer 预加载访问者对一批帖子的阅读权限相关数据，userID为0表示未登录
+// levelTable 用于将访问者经验值换算为等级，判断 min_level 阅读权限
+func loadPostReadViewer(ctx context.Context, db *ent.Client, cacheService cache.ICacheService, levelTable []schema.LevelItem, userID int, posts []*ent.Post) (*postReadViewer, error) {
+	viewer := &postReadViewer{
+		userID:    userID,
+		moderated: make(map[int]bool),
+		replied:   make(map[int]bool),
+		purchased: make(map[int]bool),
+	}
+	if userID == 0 || len(posts) == 0 {
+		return viewer, nil
+	}
+
+	userData, err := db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldRole, user.FieldExperience).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			viewer.userID = 0
+			return viewer, nil
+		}
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	viewer.role = userData.Role
+	viewer.experience = userData.Experience
+	viewer.level = levelOf(levelTable, userData.Experience)
+	if viewer.isAdmin() {
+		return viewer, nil
+	}
+
+	// 仅对受限帖子查询版主、回复与购买记录
+	var categoryIDs, replyPostIDs, payPostIDs []int
+	for _, p := range posts {
+		mode, _ := parseReadPermission(p.ReadPermission)
+		if mode == _const.ReadPermissionPublic || p.UserID == userID {
+			continue
+		}
+		categoryIDs = append(categoryIDs, p.CategoryID)
+		switch mode {
+		case _const.ReadPermissionReplyToView:
+			replyPostIDs = append(replyPostIDs, p.ID)
+		case _const.ReadPermissionPayToView:
+			payPostIDs = append(payPostIDs, p.ID)
+		}
+	}
+
+	if len(categoryIDs) > 0 {
+		// 父版块的版主同时拥有子孙版块的管理权限
+		moderated, err := moderatedCategorySet(ctx, db, cacheService, userID)
+		if err != nil {
+			return nil, fmt.Errorf("查询版主权限失败: %w", err)
+		}
+		for _, id := range categoryIDs {
+			if moderated[id] {
+				viewer.moderated[id] = true
+			}
+		}
+	}
+
+	if len(replyPostIDs) > 0 {
+		comments, err := db.Comment.Query().
+			Where(
+				comment.UserIDEQ(userID),
+				comment.PostIDIn(replyPostIDs...),
+			).
+			Select(comment.FieldPostID).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询回复记录失败: %w", err)
+		}
+		for _, c := range comments {
+			viewer.replied[c.PostID] = true
+		}
+	}
+
+	if len(payPostIDs) > 0 {
+		purchases, err := db.PostPurchase.Query().
+			Where(
+				postpurchase.UserIDEQ(userID),
+				postpurchase.PostIDIn(payPostIDs...),
+			).
+			Select(postpurchase.FieldPostID).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询购买记录失败: %w", err)
+		}
+		for _, p := range purchases {
+			viewer.purchased[p.PostID] = true
+		}
+	}
+
+	return viewer, nil
+}
+
+// isAdmin 访问者是否为管理员
+func (v *postReadViewer) isAdmin() bool {
+	return v.role == user.RoleAdmin || v.role == user.RoleSuperAdmin
+}
+
+// canRead 判断访问者是否可查看帖子正文
+// 帖子作者、所在版块版主与管理员不受阅读权限限制
+func (v *postReadViewer) canRead(p *ent.Post) bool {
+	mode, param := parseReadPermission(p.ReadPermission)
+	if mode == _const.ReadPermissionPublic {
+		return true
+	}
+	if v.userID == 0 {
+		return false
+	}
+	if p.UserID == v.userID || v.isAdmin() || v.moderated[p.CategoryID] {
+		return true
+	}
+
+	switch mode {
+	case _const.ReadPermissionLogin:
+		return true
+	case _const.ReadPermissionMinExperience:
+		exp, err := strconv.Atoi(param)
+		return err == nil && v.experience >= exp
+	case _const.ReadPermissionMinLevel:
+		level, err := strconv.Atoi(param)
+		return err == nil && v.level >= level
+	case _const.ReadPermissionMinRole:
+		required, ok := readPermissionRoleRank[user.Role(param)]
+		return ok && readPermissionRoleRank[v.role] >= required
+	case _const.ReadPermissionReplyToView:
+		return v.replied[p.ID]
+	case _const.ReadPermissionPayToView:
+		return v.purchased[p.ID]
+	default:
+		return false
+	}
+}
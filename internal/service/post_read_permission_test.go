@@ -0,0 +1,65 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/PokeForum/PokeForum/ent"
+)
+
+func TestPostReadViewerCanReadByLevel(t *testing.T) {
+	tests := []struct {
+		name           string
+		readPermission string
+		experience     int
+		want           bool
+	}{
+		{name: "未达到最低等级", readPermission: "min_level:3", experience: 499, want: false},
+		{name: "达到最低等级", readPermission: "min_level:3", experience: 500, want: true},
+		{name: "超过最低等级", readPermission: "min_level:3", experience: 2000, want: true},
+		{name: "最低经验值按原始经验值比较", readPermission: "min_experience:3", experience: 3, want: true},
+		{name: "未达到最低经验值", readPermission: "min_experience:500", experience: 499, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			author := newTestUser(t, db, "author", "author@example.com")
+			reader := newTestUser(t, db, "reader", "reader@example.com")
+			db.User.UpdateOne(reader).SetExperience(tt.experience).ExecX(t.Context())
+			p := &ent.Post{ID: 1, UserID: author.ID, CategoryID: 1, ReadPermission: tt.readPermission}
+
+			viewer, err := loadPostReadViewer(t.Context(), db, newTestCache(t), defaultLevelTable, reader.ID, []*ent.Post{p})
+			if err != nil {
+				t.Fatalf("加载阅读权限失败: %v", err)
+			}
+			if got := viewer.canRead(p); got != tt.want {
+				t.Fatalf("阅读权限应为 %v，实际 %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeReadPermissionMinLevel(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{raw: "min_level:2", want: "min_level:2"},
+		{raw: "min_level:02", want: "min_level:2"},
+		{raw: "min_level:0", wantErr: true},
+		{raw: "min_level", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := normalizeReadPermission(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Fatalf("规范化结果应为 %q，实际 %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/postrevision"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/utils"
+)
+
+// IPostRevisionService 帖子修订历史服务接口
+type IPostRevisionService interface {
+	// GetRevisions 获取帖子修订历史
+	GetRevisions(ctx context.Context, userID int, req schema.PostRevisionListRequest) (*schema.PostRevisionListResponse, error)
+	// GetRevisionDiff 对比帖子的两个修订版本
+	GetRevisionDiff(ctx context.Context, userID int, req schema.PostRevisionDiffRequest) (*schema.PostRevisionDiffResponse, error)
+	// RollbackRevision 将帖子回滚到指定修订版本
+	RollbackRevision(ctx context.Context, userID int, req schema.PostRevisionRollbackRequest) (*schema.PostRevisionItem, error)
+}
+
+// PostRevisionService 帖子修订历史服务实现
+type PostRevisionService struct {
+	db     *ent.Client
+	cache  cache.ICacheService
+	logger *zap.Logger
+}
+
+// NewPostRevisionService 创建帖子修订历史服务实例
+func NewPostRevisionService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IPostRevisionService {
+	return &PostRevisionService{
+		db:     db,
+		cache:  cacheService,
+		logger: logger,
+	}
+}
+
+// GetRevisions 获取帖子修订历史
+func (s *PostRevisionService) GetRevisions(ctx context.Context, userID int, req schema.PostRevisionListRequest) (*schema.PostRevisionListResponse, error) {
+	s.logger.Info("获取帖子修订历史", zap.Int("user_id", userID), zap.Int("post_id", req.PostID), tracing.WithTraceIDField(ctx))
+
+	if _, err := s.getManageablePost(ctx, userID, req.PostID); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.db.PostRevision.Query().
+		Where(postrevision.PostIDEQ(req.PostID)).
+		Order(ent.Desc(postrevision.FieldRevision)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("查询修订历史失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询修订历史失败: %w", err)
+	}
+
+	// 批量查询编辑者用户名
+	editorIDs := make([]int, 0, len(revisions))
+	for _, r := range revisions {
+		editorIDs = append(editorIDs, r.EditorID)
+	}
+	users, err := s.db.User.Query().
+		Where(user.IDIn(editorIDs...)).
+		Select(user.FieldID, user.FieldUsername).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("查询编辑者信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询编辑者信息失败: %w", err)
+	}
+	userMap := make(map[int]string, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u.Username
+	}
+
+	list := make([]schema.PostRevisionItem, 0, len(revisions))
+	for _, r := range revisions {
+		item := toPostRevisionItem(r)
+		item.EditorUsername = userMap[r.EditorID]
+		list = append(list, item)
+	}
+
+	return &schema.PostRevisionListResponse{
+		PostID: req.PostID,
+		List:   list,
+	}, nil
+}
+
+// GetRevisionDiff 对比帖子的两个修订版本
+func (s *PostRevisionService) GetRevisionDiff(ctx context.Context, userID int, req schema.PostRevisionDiffRequest) (*schema.PostRevisionDiffResponse, error) {
+	s.logger.Info("对比帖子修订版本", zap.Int("user_id", userID), zap.Int("post_id", req.PostID), zap.Int("from", req.From), zap.Int("to", req.To), tracing.WithTraceIDField(ctx))
+
+	if _, err := s.getManageablePost(ctx, userID, req.PostID); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.db.PostRevision.Query().
+		Where(
+			postrevision.PostIDEQ(req.PostID),
+			postrevision.RevisionIn(req.From, req.To),
+		).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("查询修订版本失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询修订版本失败: %w", err)
+	}
+
+	var from, to *ent.PostRevision
+	for _, r := range revisions {
+		if r.Revision == req.From {
+			from = r
+		}
+		if r.Revision == req.To {
+			to = r
+		}
+	}
+	if from == nil || to == nil {
+		return nil, errors.New("修订版本不存在")
+	}
+
+	return &schema.PostRevisionDiffResponse{
+		PostID:    req.PostID,
+		From:      from.Revision,
+		To:        to.Revision,
+		FromTitle: from.Title,
+		ToTitle:   to.Title,
+		ContentDiff: utils.UnifiedDiff(
+			fmt.Sprintf("版本 %d", from.Revision),
+			fmt.Sprintf("版本 %d", to.Revision),
+			from.Content,
+			to.Content,
+		),
+	}, nil
+}
+
+// RollbackRevision 将帖子回滚到指定修订版本
+func (s *PostRevisionService) RollbackRevision(ctx context.Context, userID int, req schema.PostRevisionRollbackRequest) (*schema.PostRevisionItem, error) {
+	s.logger.Info("回滚帖子", zap.Int("user_id", userID), zap.Int("post_id", req.PostID), zap.Int("revision", req.Revision), tracing.WithTraceIDField(ctx))
+
+	postData, err := s.getManageablePost(ctx, userID, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.db.PostRevision.Query().
+		Where(
+			postrevision.PostIDEQ(req.PostID),
+			postrevision.RevisionEQ(req.Revision),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("修订版本不存在")
+		}
+		s.logger.Error("查询修订版本失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询修订版本失败: %w", err)
+	}
+	if target.Title == postData.Title && target.Content == postData.Content {
+		return nil, errors.New("帖子当前内容与该版本一致，无需回滚")
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	if err = tx.Post.UpdateOneID(postData.ID).
+		SetTitle(target.Title).
+		SetContent(target.Content).
+		SetLastEditedAt(time.Now()).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("回滚帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("回滚帖子失败: %w", err)
+	}
+
+	revision, err := recordPostRevision(ctx, tx, postData, userID, target.Title, target.Content, req.Reason, target.Revision)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("记录修订历史失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	item := toPostRevisionItem(revision)
+	s.logger.Info("帖子回滚成功", zap.Int("post_id", req.PostID), zap.Int("revision", item.Revision), tracing.WithTraceIDField(ctx))
+	return &item, nil
+}
+
+// getManageablePost 查询帖子并校验用户是否可管理其修订历史
+// 帖子作者、所在版块版主、管理员可查看与回滚
+func (s *PostRevisionService) getManageablePost(ctx context.Context, userID, postID int) (*ent.Post, error) {
+	postData, err := s.db.Post.Get(ctx, postID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("帖子不存在")
+		}
+		s.logger.Error("获取帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取帖子失败: %w", err)
+	}
+
+	operator, err := s.db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldRole, user.FieldStatus).
+		Only(ctx)
+	if err != nil {
+		s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	if operator.Status == user.StatusMute || operator.Status == user.StatusBlocked {
+		return nil, errors.New("您的账号状态异常，无法进行此操作")
+	}
+
+	if postData.UserID == userID || operator.Role == user.RoleAdmin || operator.Role == user.RoleSuperAdmin {
+		return postData, nil
+	}
+
+	isModerator, err := isCategoryModerator(ctx, s.db, s.cache, userID, postData.CategoryID)
+	if err != nil {
+		s.logger.Error("查询版主权限失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询版主权限失败: %w", err)
+	}
+	if !isModerator {
+		return nil, errors.New("您没有该帖子修订历史的管理权限")
+	}
+
+	return postData, nil
+}
+
+// recordPostRevision 在事务中记录帖子修订版本，before为修改前的帖子数据
+// 帖子首次被修改时先将修改前的内容保存为版本1；标题与正文均未变化时不产生修订，返回nil
+func recordPostRevision(ctx context.Context, tx *ent.Tx, before *ent.Post, editorID int, title, content, reason string, rollbackFrom int) (*ent.PostRevision, error) {
+	if before.Title == title && before.Content == content {
+		return nil, nil
+	}
+
+	next := 1
+	latest, err := tx.PostRevision.Query().
+		Where(postrevision.PostIDEQ(before.ID)).
+		Order(ent.Desc(postrevision.FieldRevision)).
+		First(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		// 保存原始内容，时间取上次编辑时间或发帖时间
+		originalAt := before.CreatedAt
+		if !before.LastEditedAt.IsZero() {
+			originalAt = before.LastEditedAt
+		}
+		if err = tx.PostRevision.Create().
+			SetPostID(before.ID).
+			SetRevision(next).
+			SetEditorID(before.UserID).
+			SetTitle(before.Title).
+			SetContent(before.Content).
+			SetCreatedAt(originalAt).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("保存原始版本失败: %w", err)
+		}
+		next++
+	case err != nil:
+		return nil, fmt.Errorf("查询修订历史失败: %w", err)
+	default:
+		next = latest.Revision + 1
+	}
+
+	revision, err := tx.PostRevision.Create().
+		SetPostID(before.ID).
+		SetRevision(next).
+		SetEditorID(editorID).
+		SetTitle(title).
+		SetContent(content).
+		SetReason(reason).
+		SetRollbackFrom(rollbackFrom).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("帖子正在被其他人编辑，请刷新后重试")
+		}
+		return nil, fmt.Errorf("保存修订版本失败: %w", err)
+	}
+
+	return revision, nil
+}
+
+// toPostRevisionItem 转换修订记录为响应项
+func toPostRevisionItem(r *ent.PostRevision) schema.PostRevisionItem {
+	return schema.PostRevisionItem{
+		ID:           r.ID,
+		PostID:       r.PostID,
+		Revision:     r.Revision,
+		EditorID:     r.EditorID,
+		Title:        r.Title,
+		Content:      r.Content,
+		Reason:       r.Reason,
+		RollbackFrom: r.RollbackFrom,
+		CreatedAt:    r.CreatedAt.Format(time_tools.DateTimeFormat),
+	}
+}
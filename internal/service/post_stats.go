@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/badge"
 	"github.com/PokeForum/PokeForum/ent/post"
 	"github.com/PokeForum/PokeForum/ent/postaction"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
@@ -60,19 +61,24 @@ type IPostStatsService interface {
 
 // PostStatsService 帖子统计服务实现
 type PostStatsService struct {
-	db          *ent.Client
-	cache       cache.ICacheService
-	statsHelper *stats.Helper
-	logger      *zap.Logger
+	db             *ent.Client
+	cache          cache.ICacheService
+	statsHelper    *stats.Helper
+	postHotService IPostHotService
+	badgeTask      *BadgeTask
+	logger         *zap.Logger
 }
 
 // NewPostStatsService 创建帖子统计服务实例
-func NewPostStatsService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IPostStatsService {
+// badgeTask 用于在帖子获赞时评估作者的徽章，可为nil
+func NewPostStatsService(db *ent.Client, cacheService cache.ICacheService, badgeTask *BadgeTask, logger *zap.Logger) IPostStatsService {
 	return &PostStatsService{
-		db:          db,
-		cache:       cacheService,
-		statsHelper: stats.NewStatsHelper(cacheService, logger),
-		logger:      logger,
+		db:             db,
+		cache:          cacheService,
+		statsHelper:    stats.NewStatsHelper(cacheService, logger),
+		postHotService: NewPostHotService(db, cacheService, logger),
+		badgeTask:      badgeTask,
+		logger:         logger,
 	}
 }
 
@@ -190,8 +196,13 @@ func (s *PostStatsService) PerformAction(ctx context.Context, userID, postID int
 	// 标记帖子为脏数据
 	_ = s.statsHelper.MarkDirty(ctx, stats.PostDirtySetKey, postID) //nolint:errcheck // Redis操作失败不影响主流程
 
+	// 帖子获赞时评估作者的获赞徽章
+	if actionType == stats.ActionTypeLike {
+		s.badgeTask.Submit(ctx, BadgeTaskPayload{PostID: postID, Criteria: badge.CriteriaLikesReceived})
+	}
+
 	s.logger.Info("执行帖子操作成功", zap.Int("post_id", postID), tracing.WithTraceIDField(ctx))
-	return s.GetStats(ctx, postID)
+	return s.getStatsAndPublish(ctx, postID)
 }
 
 // CancelAction 取消帖子操作
@@ -233,7 +244,7 @@ func (s *PostStatsService) CancelAction(ctx context.Context, userID, postID int,
 	_ = s.statsHelper.MarkDirty(ctx, stats.PostDirtySetKey, postID) //nolint:errcheck // Redis操作失败不影响主流程
 
 	s.logger.Info("取消帖子操作成功", zap.Int("post_id", postID), tracing.WithTraceIDField(ctx))
-	return s.GetStats(ctx, postID)
+	return s.getStatsAndPublish(ctx, postID)
 }
 
 // GetStats 获取帖子统计数据
@@ -508,3 +519,16 @@ func (s *PostStatsService) getStatsField(actionType stats.ActionType) string {
 		return ""
 	}
 }
+
+// getStatsAndPublish 获取最新统计数据并推送给正在浏览该帖子的用户，同时更新帖子热度分
+func (s *PostStatsService) getStatsAndPublish(ctx context.Context, postID int) (*stats.Stats, error) {
+	postStats, err := s.GetStats(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.postHotService.UpdateScore(ctx, postID); err != nil {
+		s.logger.Warn("更新帖子热度分失败", zap.Int("post_id", postID), zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+	publishRealtimeEvent(ctx, s.cache, s.logger, realtimePostChannel(postID), RealtimeEventPostStats, postStats)
+	return postStats, nil
+}
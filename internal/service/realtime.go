@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// 实时推送事件类型
+const (
+	// RealtimeEventNotification 新的站内通知
+	RealtimeEventNotification = "notification"
+	// RealtimeEventComment 正在浏览的帖子有新评论
+	RealtimeEventComment = "comment"
+	// RealtimeEventPostStats 帖子点赞、点踩、收藏数变化
+	RealtimeEventPostStats = "post_stats"
+	// RealtimeEventCommentStats 评论点赞、点踩数变化
+	RealtimeEventCommentStats = "comment_stats"
+	// RealtimeEventMessage 收到新的私信
+	RealtimeEventMessage = "message"
+	// RealtimeEventMessageRead 私信已被对方阅读
+	RealtimeEventMessageRead = "message_read"
+)
+
+const (
+	// realtimeStreamMaxLen 每个频道保留的历史事件数量，用于断线重连补发
+	realtimeStreamMaxLen = 200
+	// realtimeStreamTTL 频道历史事件的过期时间（秒）
+	realtimeStreamTTL = 86400
+	// realtimeBufferSize 单个连接的待发送事件缓冲数量
+	realtimeBufferSize = 64
+)
+
+// realtimeEventIDRegexp Redis Stream消息ID格式
+var realtimeEventIDRegexp = regexp.MustCompile(`^\d+-\d+$`)
+
+// IRealtimeService 实时推送服务接口
+type IRealtimeService interface {
+	// Subscribe 订阅当前用户的通知以及正在浏览的帖子动态
+	// lastEventID 不为空时先补发该事件之后的历史事件，返回的通道在ctx结束后关闭
+	Subscribe(ctx context.Context, userID int, req schema.RealtimeStreamRequest, lastEventID string) (<-chan schema.RealtimeEvent, error)
+}
+
+// RealtimeService 实时推送服务实现
+// 事件先写入频道对应的Redis Stream用于补发，再通过Redis发布订阅分发到所有实例
+type RealtimeService struct {
+	db       *ent.Client
+	cache    cache.ICacheService
+	redis    *redis.Client
+	logger   *zap.Logger
+	settings ISettingsService
+}
+
+// NewRealtimeService 创建实时推送服务实例
+func NewRealtimeService(db *ent.Client, cacheService cache.ICacheService, redisClient *redis.Client, logger *zap.Logger) IRealtimeService {
+	return &RealtimeService{
+		db:       db,
+		cache:    cacheService,
+		redis:    redisClient,
+		logger:   logger,
+		settings: NewSettingsService(db, cacheService, logger),
+	}
+}
+
+// realtimeUserChannel 用户私有频道
+func realtimeUserChannel(userID int) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// realtimePostChannel 帖子频道
+func realtimePostChannel(postID int) string {
+	return fmt.Sprintf("post:%d", postID)
+}
+
+// realtimeStreamKey 频道历史事件的Stream键
+func realtimeStreamKey(channel string) string {
+	return "realtime:stream:" + channel
+}
+
+// realtimePubSubKey 频道的发布订阅键
+func realtimePubSubKey(channel string) string {
+	return "realtime:pubsub:" + channel
+}
+
+// publishRealtimeEvent 向频道推送事件，推送失败只记录日志不影响业务
+func publishRealtimeEvent(ctx context.Context, cacheService cache.ICacheService, logger *zap.Logger, channel, event string, data interface{}) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		logger.Warn("序列化实时事件失败", zap.String("channel", channel), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return
+	}
+
+	streamKey := realtimeStreamKey(channel)
+	id, err := cacheService.XAddWithMaxLen(ctx, streamKey, realtimeStreamMaxLen, map[string]interface{}{
+		"event": event,
+		"data":  string(payload),
+	})
+	if err != nil {
+		logger.Warn("记录实时事件失败", zap.String("channel", channel), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return
+	}
+	_, _ = cacheService.Expire(ctx, streamKey, realtimeStreamTTL) //nolint:errcheck // 过期时间设置失败不影响推送
+
+	message, err := json.Marshal(schema.RealtimeEvent{
+		ID:      id,
+		Channel: channel,
+		Event:   event,
+		Data:    payload,
+	})
+	if err != nil {
+		logger.Warn("序列化实时事件失败", zap.String("channel", channel), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return
+	}
+	if _, err = cacheService.Publish(ctx, realtimePubSubKey(channel), message); err != nil {
+		logger.Warn("发布实时事件失败", zap.String("channel", channel), zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+}
+
+// Subscribe 订阅当前用户的通知以及正在浏览的帖子动态
+func (s *RealtimeService) Subscribe(ctx context.Context, userID int, req schema.RealtimeStreamRequest, lastEventID string) (<-chan schema.RealtimeEvent, error) {
+	s.logger.Info("订阅实时推送", zap.Int("user_id", userID), zap.Int("post_id", req.PostID), zap.String("last_event_id", lastEventID), tracing.WithTraceIDField(ctx))
+
+	channels := []string{realtimeUserChannel(userID)}
+	if req.PostID > 0 {
+		postData, err := s.db.Post.Query().
+			Where(post.IDEQ(req.PostID)).
+			Select(post.FieldUserID, post.FieldCategoryID, post.FieldStatus, post.FieldReadPermission).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errors.New("帖子不存在")
+			}
+			s.logger.Error("获取帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("获取帖子失败: %w", err)
+		}
+		// 草稿、私有与封禁的帖子仅作者可订阅
+		if postData.Status != post.StatusNormal && postData.Status != post.StatusLocked && postData.UserID != userID {
+			return nil, errors.New("帖子不存在")
+		}
+		if err = s.checkPostReadable(ctx, userID, postData); err != nil {
+			return nil, err
+		}
+		channels = append(channels, realtimePostChannel(req.PostID))
+	}
+
+	keys := make([]string, len(channels))
+	for i, channel := range channels {
+		keys[i] = realtimePubSubKey(channel)
+	}
+
+	// 先订阅再补发历史事件，避免两者之间产生的事件丢失
+	ps := s.redis.Subscribe(ctx, keys...)
+	if _, err := ps.Receive(ctx); err != nil {
+		_ = ps.Close() //nolint:errcheck // 订阅失败时关闭失败无需处理
+		s.logger.Error("订阅实时频道失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("订阅实时频道失败: %w", err)
+	}
+
+	if !realtimeEventIDRegexp.MatchString(lastEventID) {
+		lastEventID = ""
+	}
+
+	out := make(chan schema.RealtimeEvent, realtimeBufferSize)
+	go func() {
+		defer close(out)
+		defer func() {
+			_ = ps.Close() //nolint:errcheck // 连接结束时关闭失败无需处理
+		}()
+
+		// 每个频道已发送的最后事件ID，用于过滤补发与实时推送之间的重复事件
+		sent := make(map[string]string, len(channels))
+		if lastEventID != "" {
+			for _, event := range s.replay(ctx, channels, lastEventID) {
+				select {
+				case out <- event:
+					sent[event.Channel] = event.ID
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+
+		live := ps.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-live:
+				if !ok {
+					return
+				}
+				var event schema.RealtimeEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+					s.logger.Warn("解析实时事件失败", zap.Error(err))
+					continue
+				}
+				if last, ok := sent[event.Channel]; ok && compareRealtimeEventID(event.ID, last) <= 0 {
+					continue
+				}
+				if lastEventID != "" && compareRealtimeEventID(event.ID, lastEventID) <= 0 {
+					continue
+				}
+				select {
+				case out <- event:
+					sent[event.Channel] = event.ID
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// checkPostReadable 检查用户能否查看帖子动态
+// 帖子频道会推送新评论等内容，需同时满足版块访问控制与帖子阅读权限
+func (s *RealtimeService) checkPostReadable(ctx context.Context, userID int, postData *ent.Post) error {
+	tree, categoryVisitor, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, userID)
+	if err != nil {
+		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return err
+	}
+	if err = tree.checkCategoryReadable(postData.CategoryID, categoryVisitor); err != nil {
+		return err
+	}
+
+	viewer, err := loadPostReadViewer(ctx, s.db, s.cache, loadLevelTable(ctx, s.settings, s.logger), userID, []*ent.Post{postData})
+	if err != nil {
+		s.logger.Error("加载阅读权限失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return err
+	}
+	if !viewer.canRead(postData) {
+		return errors.New("您没有查看该帖子的权限")
+	}
+	return nil
+}
+
+// replay 读取各频道在lastEventID之后的历史事件并按ID排序
+func (s *RealtimeService) replay(ctx context.Context, channels []string, lastEventID string) []schema.RealtimeEvent {
+	var events []schema.RealtimeEvent
+	for _, channel := range channels {
+		messages, err := s.cache.XRange(ctx, realtimeStreamKey(channel), lastEventID, "+")
+		if err != nil {
+			s.logger.Warn("读取历史实时事件失败", zap.String("channel", channel), zap.Error(err), tracing.WithTraceIDField(ctx))
+			continue
+		}
+		for _, message := range messages {
+			id, _ := message["message_id"].(string) //nolint:errcheck // 类型断言失败时按空值跳过
+			if compareRealtimeEventID(id, lastEventID) <= 0 {
+				continue
+			}
+			event, _ := message["event"].(string) //nolint:errcheck // 类型断言失败时按空值处理
+			data, _ := message["data"].(string)   //nolint:errcheck // 类型断言失败时按空值处理
+			events = append(events, schema.RealtimeEvent{
+				ID:      id,
+				Channel: channel,
+				Event:   event,
+				Data:    json.RawMessage(data),
+			})
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return compareRealtimeEventID(events[i].ID, events[j].ID) < 0
+	})
+	return events
+}
+
+// compareRealtimeEventID 比较两个Stream消息ID的先后
+func compareRealtimeEventID(a, b string) int {
+	aMs, aSeq := parseRealtimeEventID(a)
+	bMs, bSeq := parseRealtimeEventID(b)
+	switch {
+	case aMs != bMs:
+		if aMs < bMs {
+			return -1
+		}
+		return 1
+	case aSeq != bSeq:
+		if aSeq < bSeq {
+			return -1
+		}
+		return 1
+	default:
+		return 0
+	}
+}
+
+// parseRealtimeEventID 解析Stream消息ID的毫秒时间戳与序号
+func parseRealtimeEventID(id string) (uint64, uint64) {
+	msPart, seqPart, _ := strings.Cut(id, "-")
+	ms, _ := strconv.ParseUint(msPart, 10, 64)   //nolint:errcheck // 非法ID按0处理
+	seq, _ := strconv.ParseUint(seqPart, 10, 64) //nolint:errcheck // 非法ID按0处理
+	return ms, seq
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+func TestCompareRealtimeEventID(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{name: "相同ID", a: "1700000000000-0", b: "1700000000000-0", want: 0},
+		{name: "时间戳较早", a: "1700000000000-5", b: "1700000000001-0", want: -1},
+		{name: "时间戳较晚", a: "1700000000001-0", b: "1700000000000-5", want: 1},
+		{name: "同一毫秒按序号比较", a: "1700000000000-2", b: "1700000000000-10", want: -1},
+		{name: "按数值而非字典序比较时间戳", a: "999-0", b: "1000-0", want: -1},
+		{name: "缺少序号视为0", a: "1700000000000", b: "1700000000000-0", want: 0},
+		{name: "非法ID视为最早", a: "invalid", b: "1-0", want: -1},
+		{name: "空ID视为最早", a: "", b: "0-1", want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareRealtimeEventID(tt.a, tt.b); got != tt.want {
+				t.Fatalf("compareRealtimeEventID(%q, %q) 应为 %d，实际 %d", tt.a, tt.b, tt.want, got)
+			}
+			if got := compareRealtimeEventID(tt.b, tt.a); got != -tt.want {
+				t.Fatalf("compareRealtimeEventID(%q, %q) 应为 %d，实际 %d", tt.b, tt.a, -tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRealtimeCheckPostReadable(t *testing.T) {
+	tests := []struct {
+		name           string
+		rule           *schema.CategoryPermissionRule
+		readPermission string
+		experience     int
+		wantErr        bool
+	}{
+		{name: "公开帖子", experience: 0},
+		{name: "无权查看版块", rule: &schema.CategoryPermissionRule{Action: "View", MinExperience: 100}, experience: 99, wantErr: true},
+		{name: "满足版块查看规则", rule: &schema.CategoryPermissionRule{Action: "View", MinExperience: 100}, experience: 100},
+		{name: "未达到帖子阅读等级", readPermission: "min_level:3", experience: 499, wantErr: true},
+		{name: "达到帖子阅读等级", readPermission: "min_level:3", experience: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			cacheService := newTestCache(t)
+			svc := &RealtimeService{db: db, cache: cacheService, logger: zap.NewNop(), settings: NewSettingsService(db, cacheService, zap.NewNop())}
+			author := newTestUser(t, db, "author", "author@example.com")
+			c := newTestCategory(t, db, "board", 0)
+			if tt.rule != nil {
+				setTestCategoryRules(t, db, cacheService, c.ID, *tt.rule)
+			}
+			readerID := newTestUserWith(t, db, user.RoleUser, tt.experience)
+			p := &ent.Post{ID: 1, UserID: author.ID, CategoryID: c.ID, ReadPermission: tt.readPermission}
+
+			if err := svc.checkPostReadable(t.Context(), readerID, p); (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+		})
+	}
+}
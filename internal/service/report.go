@@ -0,0 +1,479 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/comment"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/ent/report"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// IReportService 举报服务接口
+type IReportService interface {
+	// CreateReport 提交举报
+	CreateReport(ctx context.Context, userID int, req schema.ReportCreateRequest) (*schema.ReportItem, error)
+	// GetUserReports 获取用户提交的举报列表
+	GetUserReports(ctx context.Context, userID int, req schema.ReportListRequest) (*schema.ReportListResponse, error)
+	// GetReportQueue 获取举报处理队列，版主仅能查看所管理版块的举报
+	GetReportQueue(ctx context.Context, operatorID int, asAdmin bool, req schema.ReportListRequest) (*schema.ReportListResponse, error)
+	// ClaimReport 认领举报
+	ClaimReport(ctx context.Context, operatorID int, asAdmin bool, req schema.ReportClaimRequest) error
+	// ResolveReport 处理举报，可选择执行封禁帖子、删除评论或封禁用户
+	ResolveReport(ctx context.Context, operatorID int, asAdmin bool, req schema.ReportResolveRequest) error
+	// DismissReport 驳回举报
+	DismissReport(ctx context.Context, operatorID int, asAdmin bool, req schema.ReportDismissRequest) error
+}
+
+// ReportService 举报服务实现
+type ReportService struct {
+	db                   *ent.Client
+	cache                cache.ICacheService
+	logger               *zap.Logger
+	moderatorService     IModeratorService
+	postManageService    IPostManageService
+	commentManageService ICommentManageService
+	userManageService    IUserManageService
+}
+
+// NewReportService 创建举报服务实例
+func NewReportService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger, moderatorService IModeratorService, postManageService IPostManageService, commentManageService ICommentManageService, userManageService IUserManageService) IReportService {
+	return &ReportService{
+		db:                   db,
+		cache:                cacheService,
+		logger:               logger,
+		moderatorService:     moderatorService,
+		postManageService:    postManageService,
+		commentManageService: commentManageService,
+		userManageService:    userManageService,
+	}
+}
+
+// CreateReport 提交举报
+func (s *ReportService) CreateReport(ctx context.Context, userID int, req schema.ReportCreateRequest) (*schema.ReportItem, error) {
+	s.logger.Info("提交举报", zap.Int("user_id", userID), zap.String("target_type", req.TargetType), zap.Int("target_id", req.TargetID), tracing.WithTraceIDField(ctx))
+
+	// 解析举报目标的作者与所属版块
+	targetUserID, categoryID, err := s.resolveTarget(ctx, report.TargetType(req.TargetType), req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if targetUserID == userID {
+		return nil, errors.New("不能举报自己")
+	}
+
+	// 同一用户对同一目标只能举报一次
+	exists, err := s.db.Report.Query().
+		Where(
+			report.ReporterIDEQ(userID),
+			report.TargetTypeEQ(report.TargetType(req.TargetType)),
+			report.TargetIDEQ(req.TargetID),
+		).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("查询举报记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询举报记录失败: %w", err)
+	}
+	if exists {
+		return nil, errors.New("您已举报过该内容，请耐心等待处理")
+	}
+
+	r, err := s.db.Report.Create().
+		SetReporterID(userID).
+		SetTargetType(report.TargetType(req.TargetType)).
+		SetTargetID(req.TargetID).
+		SetTargetUserID(targetUserID).
+		SetCategoryID(categoryID).
+		SetReason(report.Reason(req.Reason)).
+		SetDescription(req.Description).
+		Save(ctx)
+	if err != nil {
+		// 并发提交时由唯一索引兜底
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("您已举报过该内容，请耐心等待处理")
+		}
+		s.logger.Error("创建举报失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建举报失败: %w", err)
+	}
+
+	item := toReportItem(r, nil)
+	return &item, nil
+}
+
+// GetUserReports 获取用户提交的举报列表
+func (s *ReportService) GetUserReports(ctx context.Context, userID int, req schema.ReportListRequest) (*schema.ReportListResponse, error) {
+	s.logger.Info("获取用户举报列表", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	query := s.db.Report.Query().Where(report.ReporterIDEQ(userID))
+	return s.listReports(ctx, query, req)
+}
+
+// GetReportQueue 获取举报处理队列
+func (s *ReportService) GetReportQueue(ctx context.Context, operatorID int, asAdmin bool, req schema.ReportListRequest) (*schema.ReportListResponse, error) {
+	s.logger.Info("获取举报处理队列", zap.Int("operator_id", operatorID), zap.Bool("as_admin", asAdmin), tracing.WithTraceIDField(ctx))
+
+	query := s.db.Report.Query()
+	if !asAdmin {
+		// 版主只能查看所管理版块的举报，用户举报不属于任何版块，仅管理员处理
+		categoryIDs, err := s.getModeratorCategoryIDs(ctx, operatorID)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(report.CategoryIDIn(categoryIDs...))
+	}
+
+	return s.listReports(ctx, query, req)
+}
+
+// ClaimReport 认领举报
+func (s *ReportService) ClaimReport(ctx context.Context, operatorID int, asAdmin bool, req schema.ReportClaimRequest) error {
+	s.logger.Info("认领举报", zap.Int("operator_id", operatorID), zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
+
+	if _, err := s.getOperableReport(ctx, operatorID, asAdmin, req.ID); err != nil {
+		return err
+	}
+
+	// 仅待处理的举报可被认领，条件更新避免重复认领
+	affected, err := s.db.Report.Update().
+		Where(
+			report.IDEQ(req.ID),
+			report.StatusEQ(report.StatusPending),
+		).
+		SetStatus(report.StatusProcessing).
+		SetHandlerID(operatorID).
+		Save(ctx)
+	if err != nil {
+		s.logger.Error("认领举报失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("认领举报失败: %w", err)
+	}
+	if affected == 0 {
+		return errors.New("举报已被认领或已处理")
+	}
+
+	return nil
+}
+
+// ResolveReport 处理举报
+func (s *ReportService) ResolveReport(ctx context.Context, operatorID int, asAdmin bool, req schema.ReportResolveRequest) error {
+	s.logger.Info("处理举报", zap.Int("operator_id", operatorID), zap.Int("id", req.ID), zap.String("action", req.Action), tracing.WithTraceIDField(ctx))
+
+	r, err := s.getOperableReport(ctx, operatorID, asAdmin, req.ID)
+	if err != nil {
+		return err
+	}
+
+	action := report.ActionNone
+	if req.Action != "" {
+		action = report.Action(req.Action)
+	}
+
+	// 执行处理动作
+	if err = s.executeAction(ctx, operatorID, asAdmin, r, action, req); err != nil {
+		return err
+	}
+
+	if err = s.closeReport(ctx, operatorID, r, report.StatusResolved, action, req.Note); err != nil {
+		return err
+	}
+
+	// 已对目标执行处理动作时，同一目标的其他举报一并结案
+	if action != report.ActionNone {
+		_, err = s.db.Report.Update().
+			Where(
+				report.TargetTypeEQ(r.TargetType),
+				report.TargetIDEQ(r.TargetID),
+				report.IDNEQ(r.ID),
+				report.StatusIn(report.StatusPending, report.StatusProcessing),
+			).
+			SetStatus(report.StatusResolved).
+			SetHandlerID(operatorID).
+			SetAction(action).
+			SetHandleNote(req.Note).
+			SetHandledAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			s.logger.Warn("关闭同一目标的其他举报失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+	}
+
+	s.logger.Info("举报处理成功", zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// DismissReport 驳回举报
+func (s *ReportService) DismissReport(ctx context.Context, operatorID int, asAdmin bool, req schema.ReportDismissRequest) error {
+	s.logger.Info("驳回举报", zap.Int("operator_id", operatorID), zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
+
+	r, err := s.getOperableReport(ctx, operatorID, asAdmin, req.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.closeReport(ctx, operatorID, r, report.StatusDismissed, report.ActionNone, req.Note)
+}
+
+// resolveTarget 校验举报目标是否存在，返回目标作者ID与所属版块ID
+func (s *ReportService) resolveTarget(ctx context.Context, targetType report.TargetType, targetID int) (int, int, error) {
+	switch targetType {
+	case report.TargetTypePost:
+		p, err := s.db.Post.Query().
+			Where(post.IDEQ(targetID)).
+			Select(post.FieldUserID, post.FieldCategoryID).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return 0, 0, errors.New("帖子不存在")
+			}
+			s.logger.Error("获取帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return 0, 0, fmt.Errorf("获取帖子失败: %w", err)
+		}
+		return p.UserID, p.CategoryID, nil
+	case report.TargetTypeComment:
+		c, err := s.db.Comment.Query().
+			Where(comment.IDEQ(targetID)).
+			Select(comment.FieldUserID, comment.FieldPostID).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return 0, 0, errors.New("评论不存在")
+			}
+			s.logger.Error("获取评论失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return 0, 0, fmt.Errorf("获取评论失败: %w", err)
+		}
+		p, err := s.db.Post.Query().
+			Where(post.IDEQ(c.PostID)).
+			Select(post.FieldCategoryID).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return 0, 0, errors.New("评论所属帖子不存在")
+			}
+			s.logger.Error("获取帖子失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return 0, 0, fmt.Errorf("获取帖子失败: %w", err)
+		}
+		return c.UserID, p.CategoryID, nil
+	case report.TargetTypeUser:
+		exists, err := s.db.User.Query().
+			Where(user.IDEQ(targetID)).
+			Exist(ctx)
+		if err != nil {
+			s.logger.Error("获取用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return 0, 0, fmt.Errorf("获取用户失败: %w", err)
+		}
+		if !exists {
+			return 0, 0, errors.New("用户不存在")
+		}
+		return targetID, 0, nil
+	default:
+		return 0, 0, errors.New("不支持的举报类型")
+	}
+}
+
+// getModeratorCategoryIDs 获取版主管理的版块ID列表，包含所管理版块的子孙版块
+func (s *ReportService) getModeratorCategoryIDs(ctx context.Context, userID int) ([]int, error) {
+	moderated, err := moderatedCategorySet(ctx, s.db, s.cache, userID)
+	if err != nil {
+		s.logger.Error("查询版主关联记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	categoryIDs := make([]int, 0, len(moderated))
+	for id := range moderated {
+		categoryIDs = append(categoryIDs, id)
+	}
+	return categoryIDs, nil
+}
+
+// getOperableReport 获取操作者有权处理的举报
+// 版主只能处理所管理版块的举报；已被他人认领的举报仅管理员可接手
+func (s *ReportService) getOperableReport(ctx context.Context, operatorID int, asAdmin bool, id int) (*ent.Report, error) {
+	r, err := s.db.Report.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("举报不存在")
+		}
+		s.logger.Error("获取举报失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取举报失败: %w", err)
+	}
+
+	if !asAdmin {
+		if r.CategoryID == 0 {
+			return nil, errors.New("您没有该举报的处理权限")
+		}
+		hasPermission, err := isCategoryModerator(ctx, s.db, s.cache, operatorID, r.CategoryID)
+		if err != nil {
+			s.logger.Error("检查版主权限失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("检查版主权限失败: %w", err)
+		}
+		if !hasPermission {
+			return nil, errors.New("您没有该举报的处理权限")
+		}
+	}
+
+	switch r.Status {
+	case report.StatusResolved, report.StatusDismissed:
+		return nil, errors.New("举报已处理")
+	case report.StatusProcessing:
+		if r.HandlerID != operatorID && !asAdmin {
+			return nil, errors.New("该举报已被其他人认领")
+		}
+	}
+
+	return r, nil
+}
+
+// executeAction 执行举报处理动作，复用已有的封禁与删除逻辑
+func (s *ReportService) executeAction(ctx context.Context, operatorID int, asAdmin bool, r *ent.Report, action report.Action, req schema.ReportResolveRequest) error {
+	switch action {
+	case report.ActionNone:
+		return nil
+	case report.ActionBanPost:
+		if r.TargetType != report.TargetTypePost {
+			return errors.New("仅帖子举报可封禁帖子")
+		}
+		if asAdmin {
+			return s.postManageService.UpdatePostStatus(ctx, schema.PostStatusUpdateRequest{
+				ID:     r.TargetID,
+				Status: post.StatusBan.String(),
+				Reason: req.Note,
+			})
+		}
+		return s.moderatorService.BanPost(ctx, operatorID, schema.PostBanRequest{
+			ID:     r.TargetID,
+			Reason: req.Note,
+		})
+	case report.ActionDeleteComment:
+		if r.TargetType != report.TargetTypeComment {
+			return errors.New("仅评论举报可删除评论")
+		}
+		return s.commentManageService.DeleteComment(ctx, r.TargetID)
+	case report.ActionBanUser:
+		if !asAdmin {
+			return errors.New("封禁用户需由管理员处理")
+		}
+		return s.userManageService.BanUser(ctx, schema.UserBanRequest{
+			ID:         r.TargetUserID,
+			Duration:   req.BanDuration,
+			Reason:     req.Note,
+			OperatorID: operatorID,
+		})
+	default:
+		return errors.New("不支持的处理动作")
+	}
+}
+
+// closeReport 结案，条件更新避免并发重复处理
+func (s *ReportService) closeReport(ctx context.Context, operatorID int, r *ent.Report, status report.Status, action report.Action, note string) error {
+	affected, err := s.db.Report.Update().
+		Where(
+			report.IDEQ(r.ID),
+			report.StatusEQ(r.Status),
+		).
+		SetStatus(status).
+		SetHandlerID(operatorID).
+		SetAction(action).
+		SetHandleNote(note).
+		SetHandledAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		s.logger.Error("更新举报状态失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("更新举报状态失败: %w", err)
+	}
+	if affected == 0 {
+		return errors.New("举报状态已变更，请刷新后重试")
+	}
+	return nil
+}
+
+// listReports 分页查询举报并补充用户名
+func (s *ReportService) listReports(ctx context.Context, query *ent.ReportQuery, req schema.ReportListRequest) (*schema.ReportListResponse, error) {
+	if req.Status != "" {
+		query = query.Where(report.StatusEQ(report.Status(req.Status)))
+	}
+	if req.TargetType != "" {
+		query = query.Where(report.TargetTypeEQ(report.TargetType(req.TargetType)))
+	}
+	if req.CategoryID > 0 {
+		query = query.Where(report.CategoryIDEQ(req.CategoryID))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		s.logger.Error("获取举报总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取举报总数失败: %w", err)
+	}
+
+	reports, err := query.
+		Order(ent.Desc(report.FieldCreatedAt)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取举报列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取举报列表失败: %w", err)
+	}
+
+	// 批量查询举报人与被举报人用户名
+	userIDs := make([]int, 0, len(reports)*2)
+	for _, r := range reports {
+		userIDs = append(userIDs, r.ReporterID, r.TargetUserID)
+	}
+	users, err := s.db.User.Query().
+		Where(user.IDIn(userIDs...)).
+		Select(user.FieldID, user.FieldUsername).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("查询举报相关用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询举报相关用户失败: %w", err)
+	}
+	userMap := make(map[int]string, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u.Username
+	}
+
+	list := make([]schema.ReportItem, 0, len(reports))
+	for _, r := range reports {
+		list = append(list, toReportItem(r, userMap))
+	}
+
+	return &schema.ReportListResponse{
+		List:     list,
+		Total:    int64(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// toReportItem 转换举报记录为响应项
+func toReportItem(r *ent.Report, userMap map[int]string) schema.ReportItem {
+	item := schema.ReportItem{
+		ID:               r.ID,
+		ReporterID:       r.ReporterID,
+		ReporterUsername: userMap[r.ReporterID],
+		TargetType:       r.TargetType.String(),
+		TargetID:         r.TargetID,
+		TargetUserID:     r.TargetUserID,
+		TargetUsername:   userMap[r.TargetUserID],
+		CategoryID:       r.CategoryID,
+		Reason:           r.Reason.String(),
+		Description:      r.Description,
+		Status:           r.Status.String(),
+		HandlerID:        r.HandlerID,
+		Action:           r.Action.String(),
+		HandleNote:       r.HandleNote,
+		CreatedAt:        r.CreatedAt.Format(time_tools.DateTimeFormat),
+	}
+	if !r.HandledAt.IsZero() {
+		item.HandledAt = r.HandledAt.Format(time_tools.DateTimeFormat)
+	}
+	return item
+}
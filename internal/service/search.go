@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/category"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/ent/user"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+const (
+	// searchTypePost 搜索主题帖
+	searchTypePost = "post"
+	// searchTypeComment 搜索评论
+	searchTypeComment = "comment"
+
+	// searchHighlightStart 高亮起始标记，转义后替换为<mark>，避免用户内容中的HTML被渲染
+	searchHighlightStart = "[[hl]]"
+	// searchHighlightStop 高亮结束标记
+	searchHighlightStop = "[[/hl]]"
+	// searchTitleHeadlineOptions 标题高亮选项，保留完整标题
+	searchTitleHeadlineOptions = `StartSel="[[hl]]", StopSel="[[/hl]]", HighlightAll=true`
+	// searchSnippetHeadlineOptions 内容摘要高亮选项
+	searchSnippetHeadlineOptions = `StartSel="[[hl]]", StopSel="[[/hl]]", MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=" ... "`
+)
+
+// ISearchService 全文搜索服务接口
+type ISearchService interface {
+	// Search 搜索主题帖或评论，按相关度排序并返回高亮摘要
+	Search(ctx context.Context, req schema.SearchRequest) (*schema.SearchResponse, error)
+}
+
+// SearchService 全文搜索服务实现
+// 基于PostgreSQL tsvector生成列与GIN索引，列与索引由 initializer.AutoMigrate 维护
+type SearchService struct {
+	pgDB     *sql.DB
+	db       *ent.Client
+	cache    cache.ICacheService
+	logger   *zap.Logger
+	settings ISettingsService
+}
+
+// NewSearchService 创建全文搜索服务实例
+func NewSearchService(pgDB *sql.DB, db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) ISearchService {
+	return &SearchService{
+		pgDB:     pgDB,
+		db:       db,
+		cache:    cacheService,
+		logger:   logger,
+		settings: NewSettingsService(db, cacheService, logger),
+	}
+}
+
+// searchQuery 搜索SQL构建器，按顺序收集参数
+type searchQuery struct {
+	conditions []string
+	args       []interface{}
+}
+
+// arg 添加参数并返回占位符
+func (q *searchQuery) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// where 添加查询条件
+func (q *searchQuery) where(format string, args ...interface{}) {
+	placeholders := make([]interface{}, len(args))
+	for i, v := range args {
+		placeholders[i] = q.arg(v)
+	}
+	q.conditions = append(q.conditions, fmt.Sprintf(format, placeholders...))
+}
+
+// Search 搜索主题帖或评论
+func (s *SearchService) Search(ctx context.Context, req schema.SearchRequest) (*schema.SearchResponse, error) {
+	s.logger.Info("全文搜索", zap.String("keyword", req.Keyword), zap.String("type", req.Type), tracing.WithTraceIDField(ctx))
+
+	// 设置默认值
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.Type == "" {
+		req.Type = searchTypePost
+	}
+
+	// 目标表：评论结果同样受所属帖子与版块的可见性约束
+	alias := "p"
+	from := `FROM posts p
+		JOIN categories c ON c.id = p.category_id
+		CROSS JOIN websearch_to_tsquery($1::regconfig, $2) AS q(query)`
+	if req.Type == searchTypeComment {
+		alias = "cm"
+		from = `FROM comments cm
+		JOIN posts p ON p.id = cm.post_id
+		JOIN categories c ON c.id = p.category_id
+		CROSS JOIN websearch_to_tsquery($1::regconfig, $2) AS q(query)`
+	}
+
+	q := &searchQuery{}
+	q.arg(_const.SearchTextConfig)
+	q.arg(req.Keyword)
+	q.where(alias + ".search_vector @@ q.query")
+
+	// 与帖子列表一致：仅正常状态的帖子，私有、草稿、封禁帖子不可搜索
+	q.where("p.status = %s", post.StatusNormal.String())
+
+	// 隐藏版块与无权查看的版块不可见，版块状态与访问控制规则沿版块树继承
+	tree, categoryVisitor, err := loadCategoryTreeForViewer(ctx, s.db, s.cache, tracing.GetUserID(ctx))
+	if err != nil {
+		s.logger.Error("获取版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	if invisible := tree.invisibleIDs(categoryVisitor); len(invisible) > 0 {
+		placeholders := make([]string, len(invisible))
+		for i, id := range invisible {
+			placeholders[i] = q.arg(id)
+		}
+		q.conditions = append(q.conditions, fmt.Sprintf("p.category_id NOT IN (%s)", strings.Join(placeholders, ", ")))
+	}
+
+	// 筛选条件
+	if req.CategoryID > 0 {
+		q.where("p.category_id = %s", req.CategoryID)
+	}
+	if req.AuthorID > 0 {
+		q.where(alias+".user_id = %s", req.AuthorID)
+	}
+	if req.StartDate != "" {
+		startDate, err := time.ParseInLocation(time.DateOnly, req.StartDate, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("开始日期格式错误: %w", err)
+		}
+		q.where(alias+".created_at >= %s", startDate)
+	}
+	if req.EndDate != "" {
+		endDate, err := time.ParseInLocation(time.DateOnly, req.EndDate, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("结束日期格式错误: %w", err)
+		}
+		// 结束日期包含当天
+		q.where(alias+".created_at < %s", endDate.AddDate(0, 0, 1))
+	}
+
+	where := "WHERE " + strings.Join(q.conditions, " AND ")
+
+	// 获取总数
+	var total int
+	if err := s.pgDB.QueryRowContext(ctx, "SELECT COUNT(*) "+from+" "+where, q.args...).Scan(&total); err != nil {
+		s.logger.Error("获取搜索结果总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取搜索结果总数失败: %w", err)
+	}
+
+	resp := &schema.SearchResponse{
+		List:     []schema.SearchResultItem{},
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	if total == 0 {
+		return resp, nil
+	}
+
+	// 分页查询，按相关度降序、发布时间降序排列
+	var selectSQL string
+	if req.Type == searchTypeComment {
+		selectSQL = fmt.Sprintf(`SELECT cm.id, p.id, p.title, cm.user_id, p.category_id, cm.created_at,
+			ts_headline($1::regconfig, cm.content, q.query, '%s'),
+			ts_rank(cm.search_vector, q.query) AS rank, p.read_permission, ''`, searchSnippetHeadlineOptions)
+	} else {
+		selectSQL = fmt.Sprintf(`SELECT 0, p.id, ts_headline($1::regconfig, p.title, q.query, '%s'), p.user_id, p.category_id, p.created_at,
+			ts_headline($1::regconfig, p.content, q.query, '%s'),
+			ts_rank(p.search_vector, q.query) AS rank, p.read_permission, left(p.content, %d)`,
+			searchTitleHeadlineOptions, searchSnippetHeadlineOptions, _const.ReadPermissionTeaserLength*3)
+	}
+	query := fmt.Sprintf("%s %s %s ORDER BY rank DESC, %s.created_at DESC LIMIT %s OFFSET %s",
+		selectSQL, from, where, alias, q.arg(req.PageSize), q.arg((req.Page-1)*req.PageSize))
+
+	rows, err := s.pgDB.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		s.logger.Error("搜索失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("搜索失败: %w", err)
+	}
+	defer func() {
+		_ = rows.Close() //nolint:errcheck // 读取完成后关闭失败无需处理
+	}()
+
+	userIDs := make([]int, 0, req.PageSize)
+	categoryIDs := make([]int, 0, req.PageSize)
+	// 帖子结果的阅读权限数据，评论不受帖子阅读权限限制
+	posts := make([]*ent.Post, 0, req.PageSize)
+	teasers := make([]string, 0, req.PageSize)
+	for rows.Next() {
+		var (
+			item           schema.SearchResultItem
+			createdAt      time.Time
+			readPermission string
+			teaser         string
+		)
+		if err = rows.Scan(&item.CommentID, &item.PostID, &item.Title, &item.AuthorID, &item.CategoryID, &createdAt, &item.Snippet, &item.Rank, &readPermission, &teaser); err != nil {
+			s.logger.Error("读取搜索结果失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("读取搜索结果失败: %w", err)
+		}
+		item.Type = req.Type
+		item.Title = highlightToHTML(item.Title)
+		item.Snippet = highlightToHTML(item.Snippet)
+		item.CreatedAt = createdAt.Format(time_tools.DateTimeFormat)
+		resp.List = append(resp.List, item)
+		userIDs = append(userIDs, item.AuthorID)
+		categoryIDs = append(categoryIDs, item.CategoryID)
+		if req.Type == searchTypePost {
+			posts = append(posts, &ent.Post{
+				ID:             item.PostID,
+				UserID:         item.AuthorID,
+				CategoryID:     item.CategoryID,
+				ReadPermission: readPermission,
+			})
+			teasers = append(teasers, teaser)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		s.logger.Error("读取搜索结果失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("读取搜索结果失败: %w", err)
+	}
+
+	// 无阅读权限的帖子以正文预览替代高亮摘要，避免泄露受限内容
+	viewer, err := loadPostReadViewer(ctx, s.db, s.cache, loadLevelTable(ctx, s.settings, s.logger), tracing.GetUserID(ctx), posts)
+	if err != nil {
+		s.logger.Error("加载阅读权限失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	for i, p := range posts {
+		if !viewer.canRead(p) {
+			resp.List[i].Snippet = html.EscapeString(postContentTeaser(teasers[i]))
+			resp.List[i].ContentHidden = true
+		}
+	}
+
+	// 批量查询作者与版块名称
+	users, err := s.db.User.Query().
+		Where(user.IDIn(userIDs...)).
+		Select(user.FieldID, user.FieldUsername).
+		All(ctx)
+	if err != nil {
+		s.logger.Warn("批量查询用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+	userMap := make(map[int]string, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u.Username
+	}
+
+	categories, err := s.db.Category.Query().
+		Where(category.IDIn(categoryIDs...)).
+		Select(category.FieldID, category.FieldName).
+		All(ctx)
+	if err != nil {
+		s.logger.Warn("批量查询版块信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+	categoryMap := make(map[int]string, len(categories))
+	for _, c := range categories {
+		categoryMap[c.ID] = c.Name
+	}
+
+	for i := range resp.List {
+		resp.List[i].AuthorUsername = userMap[resp.List[i].AuthorID]
+		resp.List[i].CategoryName = categoryMap[resp.List[i].CategoryID]
+	}
+
+	return resp, nil
+}
+
+// highlightToHTML 转义搜索结果中的HTML，并将高亮标记替换为<mark>标签
+func highlightToHTML(text string) string {
+	text = html.EscapeString(text)
+	text = strings.ReplaceAll(text, searchHighlightStart, "<mark>")
+	return strings.ReplaceAll(text, searchHighlightStop, "</mark>")
+}
@@ -48,6 +48,14 @@ type ISettingsService interface {
 	GetSigninSettings(ctx context.Context) (*schema.SigninSettingsResponse, error)
 	UpdateSigninSettings(ctx context.Context, req schema.SigninSettingsRequest) error
 
+	// GetUploadSettings 上传设置
+	GetUploadSettings(ctx context.Context) (*schema.UploadSettingsResponse, error)
+	UpdateUploadSettings(ctx context.Context, req schema.UploadSettingsRequest) error
+
+	// GetLevelSettings 等级设置
+	GetLevelSettings(ctx context.Context) (*schema.LevelSettingsResponse, error)
+	UpdateLevelSettings(ctx context.Context, req schema.LevelSettingsRequest) error
+
 	// GetSMTPConfig 邮箱设置
 	GetSMTPConfig(ctx context.Context) (*schema.EmailSMTPConfigResponse, error)
 	UpdateSMTPConfig(ctx context.Context, req schema.EmailSMTPConfigRequest) error
@@ -328,6 +336,11 @@ func (s *SettingsService) GetSafeSettings(ctx context.Context) (*schema.SafeSett
 		IsEnableEmailWhitelist: configMap[_const.SafeIsEnableEmailWhitelist] == _const.SettingBoolTrue.String(),
 		EmailWhitelist:         configMap[_const.SafeEmailWhitelist],
 		VerifyEmail:            configMap[_const.SafeVerifyEmail] == _const.SettingBoolTrue.String(),
+		ForceTwoFactor:         configMap[_const.SafeForceTwoFactor] == _const.SettingBoolTrue.String(),
+		IsEnableInviteCode:     configMap[_const.SafeIsEnableInviteCode] == _const.SettingBoolTrue.String(),
+		InviteCodeUserLimit:    s.parseIntWithDefault(configMap[_const.SafeInviteCodeUserLimit], 0),
+		InviteCodeMaxUses:      s.parseIntWithDefault(configMap[_const.SafeInviteCodeMaxUses], 0),
+		MessageMinExperience:   s.parseIntWithDefault(configMap[_const.SafeMessageMinExperience], 0),
 	}
 
 	return resp, nil
@@ -340,11 +353,75 @@ func (s *SettingsService) UpdateSafeSettings(ctx context.Context, req schema.Saf
 		_const.SafeIsEnableEmailWhitelist: strconv.FormatBool(req.IsEnableEmailWhitelist),
 		_const.SafeEmailWhitelist:         req.EmailWhitelist,
 		_const.SafeVerifyEmail:            strconv.FormatBool(req.VerifyEmail),
+		_const.SafeForceTwoFactor:         strconv.FormatBool(req.ForceTwoFactor),
+		_const.SafeIsEnableInviteCode:     strconv.FormatBool(req.IsEnableInviteCode),
+		_const.SafeInviteCodeUserLimit:    strconv.Itoa(req.InviteCodeUserLimit),
+		_const.SafeInviteCodeMaxUses:      strconv.Itoa(req.InviteCodeMaxUses),
+		_const.SafeMessageMinExperience:   strconv.Itoa(req.MessageMinExperience),
 	}
 
 	return s.batchUpsertSettings(ctx, settings.ModuleSecurity, configItems)
 }
 
+// GetUploadSettings 获取上传设置
+func (s *SettingsService) GetUploadSettings(ctx context.Context) (*schema.UploadSettingsResponse, error) {
+	configMap, err := s.getSettingsByModule(ctx, settings.ModuleUpload)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedMimeTypes := configMap[_const.UploadAllowedMimeTypes]
+	if allowedMimeTypes == "" {
+		allowedMimeTypes = _const.UploadDefaultAllowedMimeTypes
+	}
+
+	resp := &schema.UploadSettingsResponse{
+		MaxFileSize:      s.parseIntWithDefault(configMap[_const.UploadMaxFileSize], _const.UploadDefaultMaxFileSize),
+		AllowedMimeTypes: allowedMimeTypes,
+		UserQuota:        s.parseIntWithDefault(configMap[_const.UploadUserQuota], _const.UploadDefaultUserQuota),
+	}
+
+	return resp, nil
+}
+
+// UpdateUploadSettings 更新上传设置
+func (s *SettingsService) UpdateUploadSettings(ctx context.Context, req schema.UploadSettingsRequest) error {
+	configItems := map[string]string{
+		_const.UploadMaxFileSize:      strconv.Itoa(req.MaxFileSize),
+		_const.UploadAllowedMimeTypes: req.AllowedMimeTypes,
+		_const.UploadUserQuota:        strconv.Itoa(req.UserQuota),
+	}
+
+	return s.batchUpsertSettings(ctx, settings.ModuleUpload, configItems)
+}
+
+// GetLevelSettings 获取等级设置
+// 等级表读取频繁，通过GetSettingByKey走Redis缓存
+func (s *SettingsService) GetLevelSettings(ctx context.Context) (*schema.LevelSettingsResponse, error) {
+	value, err := s.GetSettingByKey(ctx, _const.LevelTable, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.LevelSettingsResponse{
+		Levels: parseLevelTable(value),
+	}, nil
+}
+
+// UpdateLevelSettings 更新等级设置
+func (s *SettingsService) UpdateLevelSettings(ctx context.Context, req schema.LevelSettingsRequest) error {
+	if err := validateLevelTable(req.Levels); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(req.Levels)
+	if err != nil {
+		return fmt.Errorf("序列化等级表失败: %w", err)
+	}
+
+	return s.upsertSetting(ctx, settings.ModuleLevel, _const.LevelTable, string(data), settings.ValueTypeJSON)
+}
+
 // GetSMTPConfig 获取SMTP配置
 // 从数据库查询邮箱服务的SMTP配置信息，返回完整的配置对象
 func (s *SettingsService) GetSMTPConfig(ctx context.Context) (*schema.EmailSMTPConfigResponse, error) {
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/badge"
 	"github.com/PokeForum/PokeForum/ent/user"
 	"github.com/PokeForum/PokeForum/ent/userbalancelog"
 	"github.com/PokeForum/PokeForum/ent/usersigninstatus"
@@ -29,6 +30,7 @@ type SigninService struct {
 	logger          *zap.Logger
 	settingsService ISettingsService
 	asyncTask       *SigninAsyncTask
+	badgeTask       *BadgeTask
 }
 
 // ISigninService 签到服务接口
@@ -51,6 +53,7 @@ func NewSigninService(
 	logger *zap.Logger,
 	settingsService ISettingsService,
 	asyncTask *SigninAsyncTask,
+	badgeTask *BadgeTask,
 ) ISigninService {
 	return &SigninService{
 		db:              db,
@@ -59,6 +62,7 @@ func NewSigninService(
 		logger:          logger,
 		settingsService: settingsService,
 		asyncTask:       asyncTask,
+		badgeTask:       badgeTask,
 	}
 }
 
@@ -230,6 +234,9 @@ func (s *SigninService) Signin(ctx context.Context, userID int64) (*schema.Signi
 		return nil, errors.New("操作失败")
 	}
 
+	// 评估连续签到徽章，签到状态异步落库，由任务载荷携带连续签到天数
+	s.badgeTask.Submit(ctx, BadgeTaskPayload{UserID: int(userID), Criteria: badge.CriteriaSigninStreak, Value: continuousDays})
+
 	// 构建返回结果
 	result := &schema.SigninResult{
 		IsSuccess:        true,
@@ -573,8 +580,7 @@ func (s *SigninService) updateUserBalance(ctx context.Context, userID int64, poi
 	// 实际项目中应该通过UserService来处理
 
 	// 更新用户积分
-	_, err := s.db.User.Update().
-		Where(user.ID(int(userID))).
+	updated, err := s.db.User.UpdateOneID(int(userID)).
 		AddPoints(points).
 		AddExperience(experience).
 		Save(ctx)
@@ -583,6 +589,22 @@ func (s *SigninService) updateUserBalance(ctx context.Context, userID int64, poi
 		return err
 	}
 
+	// 检测等级提升并记录
+	levelTable := loadLevelTable(ctx, s.settingsService, s.logger)
+	leveledUp, err := recordLevelUp(ctx, s.db, levelTable, updated.ID, updated.Experience-experience, updated.Experience, "signin")
+	if err != nil {
+		s.logger.Error("记录等级变动失败",
+			zap.Int64("user_id", userID),
+			zap.Error(err),
+			tracing.WithTraceIDField(ctx))
+		// 等级记录失败不影响主流程
+	} else if leveledUp {
+		s.logger.Info("用户等级提升",
+			zap.Int64("user_id", userID),
+			zap.Int("level", levelOf(levelTable, updated.Experience)),
+			tracing.WithTraceIDField(ctx))
+	}
+
 	// 记录积分变动日志
 	err = s.createBalanceLog(ctx, userID, "points", points, "签到奖励")
 	if err != nil {
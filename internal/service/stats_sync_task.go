@@ -35,7 +35,7 @@ func NewStatsSyncTask(db *ent.Client, cacheService cache.ICacheService, taskMana
 		db:                  db,
 		cache:               cacheService,
 		logger:              logger,
-		postStatsService:    NewPostStatsService(db, cacheService, logger),
+		postStatsService:    NewPostStatsService(db, cacheService, nil, logger),
 		commentStatsService: NewCommentStatsService(db, cacheService, logger),
 		taskManager:         taskManager,
 	}
@@ -0,0 +1,671 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/ent/posttag"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/tag"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+const (
+	// maxPostTags 单个帖子最多关联的标签数
+	maxPostTags = 5
+	// maxTagNameLength 标签名称最大字符数
+	maxTagNameLength = 32
+	// defaultPopularTagLimit 热门标签默认返回数量
+	defaultPopularTagLimit = 20
+)
+
+// ITagService 标签服务接口
+type ITagService interface {
+	// GetPopularTags 获取热门标签
+	GetPopularTags(ctx context.Context, req schema.TagPopularRequest) ([]schema.TagItem, error)
+	// GetTagDetail 获取标签详情，同义词解析为规范标签
+	GetTagDetail(ctx context.Context, req schema.TagDetailRequest) (*schema.TagItem, error)
+	// GetTagList 获取标签管理列表
+	GetTagList(ctx context.Context, req schema.TagListRequest) (*schema.TagListResponse, error)
+	// RenameTag 重命名标签
+	RenameTag(ctx context.Context, req schema.TagRenameRequest) error
+	// MergeTags 合并标签，被合并的标签成为目标标签的同义词
+	MergeTags(ctx context.Context, req schema.TagMergeRequest) error
+	// CreateTagSynonym 为规范标签添加同义词
+	CreateTagSynonym(ctx context.Context, req schema.TagSynonymCreateRequest) (*schema.TagItem, error)
+	// DeleteTagSynonym 删除同义词
+	DeleteTagSynonym(ctx context.Context, req schema.TagSynonymDeleteRequest) error
+}
+
+// TagService 标签服务实现
+type TagService struct {
+	db     *ent.Client
+	logger *zap.Logger
+}
+
+// NewTagService 创建标签服务实例
+func NewTagService(db *ent.Client, logger *zap.Logger) ITagService {
+	return &TagService{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetPopularTags 获取热门标签
+func (s *TagService) GetPopularTags(ctx context.Context, req schema.TagPopularRequest) ([]schema.TagItem, error) {
+	s.logger.Info("获取热门标签", zap.Int("limit", req.Limit), tracing.WithTraceIDField(ctx))
+
+	if req.Limit <= 0 {
+		req.Limit = defaultPopularTagLimit
+	}
+
+	tags, err := s.db.Tag.Query().
+		Where(
+			tag.CanonicalIDEQ(0),
+			tag.PostCountGT(0),
+		).
+		Order(ent.Desc(tag.FieldPostCount), ent.Asc(tag.FieldID)).
+		Limit(req.Limit).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("查询热门标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询热门标签失败: %w", err)
+	}
+
+	list := make([]schema.TagItem, 0, len(tags))
+	for _, t := range tags {
+		list = append(list, toTagItem(t))
+	}
+	return list, nil
+}
+
+// GetTagDetail 获取标签详情
+func (s *TagService) GetTagDetail(ctx context.Context, req schema.TagDetailRequest) (*schema.TagItem, error) {
+	s.logger.Info("获取标签详情", zap.String("name", req.Name), tracing.WithTraceIDField(ctx))
+
+	tagData, err := findCanonicalTag(ctx, s.db, req.Name)
+	if err != nil {
+		s.logger.Error("查询标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	if tagData == nil {
+		return nil, errors.New("标签不存在")
+	}
+
+	synonyms, err := s.db.Tag.Query().
+		Where(tag.CanonicalIDEQ(tagData.ID)).
+		Order(ent.Asc(tag.FieldName)).
+		Select(tag.FieldName).
+		Strings(ctx)
+	if err != nil {
+		s.logger.Error("查询同义词失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询同义词失败: %w", err)
+	}
+
+	item := toTagItem(tagData)
+	item.Synonyms = synonyms
+	return &item, nil
+}
+
+// GetTagList 获取标签管理列表
+func (s *TagService) GetTagList(ctx context.Context, req schema.TagListRequest) (*schema.TagListResponse, error) {
+	s.logger.Info("获取标签管理列表", zap.Int("page", req.Page), zap.Int("page_size", req.PageSize), zap.String("keyword", req.Keyword), tracing.WithTraceIDField(ctx))
+
+	query := s.db.Tag.Query()
+	if req.Synonym {
+		query = query.Where(tag.CanonicalIDGT(0))
+	} else {
+		query = query.Where(tag.CanonicalIDEQ(0))
+	}
+	if keyword := normalizeTagName(req.Keyword); keyword != "" {
+		query = query.Where(tag.NameContains(keyword))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取标签总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取标签总数失败: %w", err)
+	}
+
+	tags, err := query.
+		Order(ent.Desc(tag.FieldPostCount), ent.Asc(tag.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取标签列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取标签列表失败: %w", err)
+	}
+
+	// 同义词查询规范标签名称，规范标签查询同义词列表
+	tagIDs := make([]int, 0, len(tags))
+	canonicalIDs := make([]int, 0, len(tags))
+	for _, t := range tags {
+		tagIDs = append(tagIDs, t.ID)
+		if t.CanonicalID > 0 {
+			canonicalIDs = append(canonicalIDs, t.CanonicalID)
+		}
+	}
+
+	nameMap := make(map[int]string)
+	synonymMap := make(map[int][]string)
+	if req.Synonym {
+		canonicals, err := s.db.Tag.Query().
+			Where(tag.IDIn(canonicalIDs...)).
+			Select(tag.FieldID, tag.FieldName).
+			All(ctx)
+		if err != nil {
+			s.logger.Warn("批量查询规范标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+		for _, t := range canonicals {
+			nameMap[t.ID] = t.Name
+		}
+	} else {
+		synonyms, err := s.db.Tag.Query().
+			Where(tag.CanonicalIDIn(tagIDs...)).
+			Order(ent.Asc(tag.FieldName)).
+			Select(tag.FieldCanonicalID, tag.FieldName).
+			All(ctx)
+		if err != nil {
+			s.logger.Warn("批量查询同义词失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		}
+		for _, t := range synonyms {
+			synonymMap[t.CanonicalID] = append(synonymMap[t.CanonicalID], t.Name)
+		}
+	}
+
+	list := make([]schema.TagItem, 0, len(tags))
+	for _, t := range tags {
+		item := toTagItem(t)
+		item.CanonicalName = nameMap[t.CanonicalID]
+		item.Synonyms = synonymMap[t.ID]
+		list = append(list, item)
+	}
+
+	return &schema.TagListResponse{
+		List:     list,
+		Total:    int64(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// RenameTag 重命名标签
+func (s *TagService) RenameTag(ctx context.Context, req schema.TagRenameRequest) error {
+	s.logger.Info("重命名标签", zap.Int("id", req.ID), zap.String("name", req.Name), tracing.WithTraceIDField(ctx))
+
+	name, err := validateTagName(req.Name)
+	if err != nil {
+		return err
+	}
+
+	tagData, err := s.db.Tag.Get(ctx, req.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("标签不存在")
+		}
+		s.logger.Error("查询标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("查询标签失败: %w", err)
+	}
+	if tagData.Name == name {
+		return nil
+	}
+
+	exists, err := s.db.Tag.Query().
+		Where(tag.NameEQ(name)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("查询标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("查询标签失败: %w", err)
+	}
+	if exists {
+		return errors.New("标签名称已存在，如需合并请使用合并功能")
+	}
+
+	err = s.db.Tag.UpdateOneID(req.ID).
+		SetName(name).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return errors.New("标签名称已存在，如需合并请使用合并功能")
+		}
+		s.logger.Error("重命名标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("重命名标签失败: %w", err)
+	}
+
+	return nil
+}
+
+// MergeTags 合并标签
+func (s *TagService) MergeTags(ctx context.Context, req schema.TagMergeRequest) error {
+	s.logger.Info("合并标签", zap.Int("source_id", req.SourceID), zap.Int("target_id", req.TargetID), tracing.WithTraceIDField(ctx))
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	if err = mergeTags(ctx, tx, req.SourceID, req.TargetID); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Warn("合并标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTagSynonym 为规范标签添加同义词
+func (s *TagService) CreateTagSynonym(ctx context.Context, req schema.TagSynonymCreateRequest) (*schema.TagItem, error) {
+	s.logger.Info("添加标签同义词", zap.Int("tag_id", req.TagID), zap.String("name", req.Name), tracing.WithTraceIDField(ctx))
+
+	name, err := validateTagName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical, err := s.db.Tag.Get(ctx, req.TagID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("标签不存在")
+		}
+		s.logger.Error("查询标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询标签失败: %w", err)
+	}
+	if canonical.CanonicalID > 0 {
+		return nil, errors.New("不能为同义词添加同义词，请选择规范标签")
+	}
+
+	existing, err := s.db.Tag.Query().
+		Where(tag.NameEQ(name)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		s.logger.Error("查询标签失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询标签失败: %w", err)
+	}
+	if existing != nil {
+		if existing.CanonicalID == canonical.ID {
+			return nil, errors.New("同义词已存在")
+		}
+		return nil, errors.New("标签名称已存在，如需合并请使用合并功能")
+	}
+
+	synonym, err := s.db.Tag.Create().
+		SetName(name).
+		SetCanonicalID(canonical.ID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("标签名称已存在，如需合并请使用合并功能")
+		}
+		s.logger.Error("添加同义词失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("添加同义词失败: %w", err)
+	}
+
+	item := toTagItem(synonym)
+	item.CanonicalName = canonical.Name
+	return &item, nil
+}
+
+// DeleteTagSynonym 删除同义词
+func (s *TagService) DeleteTagSynonym(ctx context.Context, req schema.TagSynonymDeleteRequest) error {
+	s.logger.Info("删除标签同义词", zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
+
+	affected, err := s.db.Tag.Delete().
+		Where(
+			tag.IDEQ(req.ID),
+			tag.CanonicalIDGT(0),
+		).
+		Exec(ctx)
+	if err != nil {
+		s.logger.Error("删除同义词失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("删除同义词失败: %w", err)
+	}
+	if affected == 0 {
+		return errors.New("同义词不存在")
+	}
+
+	return nil
+}
+
+// mergeTags 在事务中将源标签合并到目标标签
+// 源标签的帖子关联迁移到目标标签，源标签及其同义词均指向目标标签
+func mergeTags(ctx context.Context, tx *ent.Tx, sourceID, targetID int) error {
+	source, err := tx.Tag.Get(ctx, sourceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("被合并的标签不存在")
+		}
+		return fmt.Errorf("查询标签失败: %w", err)
+	}
+	if source.CanonicalID > 0 {
+		return errors.New("被合并的标签已是同义词")
+	}
+
+	target, err := tx.Tag.Get(ctx, targetID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("目标标签不存在")
+		}
+		return fmt.Errorf("查询标签失败: %w", err)
+	}
+	// 目标为同义词时合并到其规范标签
+	if target.CanonicalID > 0 {
+		targetID = target.CanonicalID
+	}
+	if sourceID == targetID {
+		return errors.New("不能将标签合并到自身")
+	}
+
+	// 已同时关联两个标签的帖子直接删除源标签关联，其余迁移到目标标签
+	taggedPostIDs, err := tx.PostTag.Query().
+		Where(posttag.TagIDEQ(targetID)).
+		Select(posttag.FieldPostID).
+		Ints(ctx)
+	if err != nil {
+		return fmt.Errorf("查询标签关联失败: %w", err)
+	}
+	if len(taggedPostIDs) > 0 {
+		if _, err = tx.PostTag.Delete().
+			Where(
+				posttag.TagIDEQ(sourceID),
+				posttag.PostIDIn(taggedPostIDs...),
+			).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("删除重复标签关联失败: %w", err)
+		}
+	}
+	if err = tx.PostTag.Update().
+		Where(posttag.TagIDEQ(sourceID)).
+		SetTagID(targetID).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("迁移标签关联失败: %w", err)
+	}
+
+	postCount, err := tx.PostTag.Query().
+		Where(posttag.TagIDEQ(targetID)).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("统计标签帖子数失败: %w", err)
+	}
+	if err = tx.Tag.UpdateOneID(targetID).
+		SetPostCount(postCount).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("更新标签帖子数失败: %w", err)
+	}
+
+	// 源标签及其同义词改为指向目标标签
+	if err = tx.Tag.Update().
+		Where(tag.CanonicalIDEQ(sourceID)).
+		SetCanonicalID(targetID).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("迁移同义词失败: %w", err)
+	}
+	if err = tx.Tag.UpdateOneID(sourceID).
+		SetCanonicalID(targetID).
+		SetPostCount(0).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("更新被合并标签失败: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeTagName 归一化标签名称：去除首尾空白与#前缀，合并连续空白并转为小写
+func normalizeTagName(name string) string {
+	name = strings.TrimLeft(strings.TrimSpace(name), "#")
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// validateTagName 归一化并校验标签名称
+func validateTagName(name string) (string, error) {
+	name = normalizeTagName(name)
+	if name == "" {
+		return "", errors.New("标签名称不能为空")
+	}
+	if utf8.RuneCountInString(name) > maxTagNameLength {
+		return "", fmt.Errorf("标签名称不能超过%d个字符", maxTagNameLength)
+	}
+	return name, nil
+}
+
+// findCanonicalTag 按名称查询标签并解析为规范标签，不存在时返回nil
+func findCanonicalTag(ctx context.Context, db *ent.Client, name string) (*ent.Tag, error) {
+	name = normalizeTagName(name)
+	if name == "" {
+		return nil, nil
+	}
+
+	tagData, err := db.Tag.Query().
+		Where(tag.NameEQ(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询标签失败: %w", err)
+	}
+	if tagData.CanonicalID == 0 {
+		return tagData, nil
+	}
+
+	tagData, err = db.Tag.Get(ctx, tagData.CanonicalID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询规范标签失败: %w", err)
+	}
+	return tagData, nil
+}
+
+// resolveTags 在事务中将标签名称解析为规范标签，不存在的标签自动创建
+// 同义词解析为规范标签，解析后重复的标签只保留一个，返回顺序与输入一致
+func resolveTags(ctx context.Context, tx *ent.Tx, names []string) ([]*ent.Tag, error) {
+	tags := make([]*ent.Tag, 0, len(names))
+	seen := make(map[int]bool, len(names))
+	for _, raw := range names {
+		name, err := validateTagName(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		tagData, err := tx.Tag.Query().
+			Where(tag.NameEQ(name)).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			tagData, err = tx.Tag.Create().
+				SetName(name).
+				Save(ctx)
+			if err != nil {
+				if ent.IsConstraintError(err) {
+					return nil, errors.New("标签正在被创建，请稍后重试")
+				}
+				return nil, fmt.Errorf("创建标签失败: %w", err)
+			}
+		case err != nil:
+			return nil, fmt.Errorf("查询标签失败: %w", err)
+		case tagData.CanonicalID > 0:
+			tagData, err = tx.Tag.Get(ctx, tagData.CanonicalID)
+			if err != nil {
+				return nil, fmt.Errorf("查询规范标签失败: %w", err)
+			}
+		}
+
+		if seen[tagData.ID] {
+			continue
+		}
+		seen[tagData.ID] = true
+		tags = append(tags, tagData)
+	}
+
+	if len(tags) > maxPostTags {
+		return nil, fmt.Errorf("每个帖子最多%d个标签", maxPostTags)
+	}
+	return tags, nil
+}
+
+// setPostTags 在事务中替换帖子的标签并维护标签帖子数，返回规范标签名称列表
+func setPostTags(ctx context.Context, tx *ent.Tx, postID int, names []string) ([]string, error) {
+	tags, err := resolveTags(ctx, tx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	existingIDs, err := tx.PostTag.Query().
+		Where(posttag.PostIDEQ(postID)).
+		Select(posttag.FieldTagID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询帖子标签失败: %w", err)
+	}
+	existing := make(map[int]bool, len(existingIDs))
+	for _, id := range existingIDs {
+		existing[id] = true
+	}
+
+	result := make([]string, 0, len(tags))
+	wanted := make(map[int]bool, len(tags))
+	added := make([]int, 0, len(tags))
+	for _, t := range tags {
+		result = append(result, t.Name)
+		wanted[t.ID] = true
+		if !existing[t.ID] {
+			added = append(added, t.ID)
+		}
+	}
+	removed := make([]int, 0, len(existingIDs))
+	for _, id := range existingIDs {
+		if !wanted[id] {
+			removed = append(removed, id)
+		}
+	}
+
+	if len(removed) > 0 {
+		if _, err = tx.PostTag.Delete().
+			Where(
+				posttag.PostIDEQ(postID),
+				posttag.TagIDIn(removed...),
+			).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("删除帖子标签失败: %w", err)
+		}
+		if err = tx.Tag.Update().
+			Where(
+				tag.IDIn(removed...),
+				tag.PostCountGT(0),
+			).
+			AddPostCount(-1).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("更新标签帖子数失败: %w", err)
+		}
+	}
+
+	if len(added) > 0 {
+		builders := make([]*ent.PostTagCreate, 0, len(added))
+		for _, id := range added {
+			builders = append(builders, tx.PostTag.Create().
+				SetPostID(postID).
+				SetTagID(id))
+		}
+		if err = tx.PostTag.CreateBulk(builders...).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("添加帖子标签失败: %w", err)
+		}
+		if err = tx.Tag.Update().
+			Where(tag.IDIn(added...)).
+			AddPostCount(1).
+			Exec(ctx); err != nil {
+			return nil, fmt.Errorf("更新标签帖子数失败: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// getPostTagNames 批量查询帖子的标签名称，返回帖子ID到标签名称列表的映射
+func getPostTagNames(ctx context.Context, db *ent.Client, postIDs []int) (map[int][]string, error) {
+	result := make(map[int][]string, len(postIDs))
+	if len(postIDs) == 0 {
+		return result, nil
+	}
+
+	relations, err := db.PostTag.Query().
+		Where(posttag.PostIDIn(postIDs...)).
+		Order(ent.Asc(posttag.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询帖子标签失败: %w", err)
+	}
+	if len(relations) == 0 {
+		return result, nil
+	}
+
+	tagIDs := make([]int, 0, len(relations))
+	for _, r := range relations {
+		tagIDs = append(tagIDs, r.TagID)
+	}
+	tags, err := db.Tag.Query().
+		Where(tag.IDIn(tagIDs...)).
+		Select(tag.FieldID, tag.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询标签失败: %w", err)
+	}
+	nameMap := make(map[int]string, len(tags))
+	for _, t := range tags {
+		nameMap[t.ID] = t.Name
+	}
+
+	for _, r := range relations {
+		if name, ok := nameMap[r.TagID]; ok {
+			result[r.PostID] = append(result[r.PostID], name)
+		}
+	}
+	return result, nil
+}
+
+// postHasTag 帖子关联了指定标签
+func postHasTag(tagID int) predicate.Post {
+	return func(s *sql.Selector) {
+		t := sql.Table(posttag.Table)
+		s.Where(sql.In(
+			s.C(post.FieldID),
+			sql.Select(t.C(posttag.FieldPostID)).
+				From(t).
+				Where(sql.EQ(t.C(posttag.FieldTagID), tagID)),
+		))
+	}
+}
+
+// toTagItem 转换标签记录为响应项
+func toTagItem(t *ent.Tag) schema.TagItem {
+	return schema.TagItem{
+		ID:          t.ID,
+		Name:        t.Name,
+		PostCount:   t.PostCount,
+		CanonicalID: t.CanonicalID,
+		CreatedAt:   t.CreatedAt.Format(time_tools.DateTimeFormat),
+	}
+}
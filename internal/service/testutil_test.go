@@ -0,0 +1,89 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/enttest"
+	"github.com/PokeForum/PokeForum/internal/configs"
+	pkgasynq "github.com/PokeForum/PokeForum/internal/pkg/asynq"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
+)
+
+// newTestDB 创建基于内存SQLite的数据库客户端，测试结束后自动关闭
+func newTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", strings.ReplaceAll(t.Name(), "/", "_"))
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() {
+		_ = client.Close() //nolint:errcheck // 测试结束时关闭失败无需处理
+	})
+	return client
+}
+
+// newTestCache 创建基于miniredis的缓存服务，测试结束后自动关闭
+func newTestCache(t *testing.T) cache.ICacheService {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close() //nolint:errcheck // 测试结束时关闭失败无需处理
+	})
+	return cache.NewRedisCacheService(client, zap.NewNop())
+}
+
+// newTestSaToken 使用生产配置在miniredis上初始化sa-token管理器
+// 登录、封禁状态检查等依赖stputil全局管理器，未初始化时会直接panic
+func newTestSaToken(t *testing.T) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	previous := configs.Cache
+	configs.Cache = client
+	t.Cleanup(func() {
+		configs.Cache = previous
+		_ = client.Close() //nolint:errcheck // 测试结束时关闭失败无需处理
+	})
+
+	saGin.SetManager(satoken.NewSaToken())
+}
+
+// newTestNotificationTask 创建投递到miniredis的站内通知任务，任务只入队不执行
+func newTestNotificationTask(t *testing.T, db *ent.Client, cacheService cache.ICacheService) *NotificationTask {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close() //nolint:errcheck // 测试结束时关闭失败无需处理
+	})
+	return NewNotificationTask(db, cacheService, pkgasynq.NewTaskManagerFromRedis(client, 1, zap.NewNop()), zap.NewNop())
+}
+
+// newTestUser 创建测试用户
+func newTestUser(t *testing.T, db *ent.Client, username string, email string) *ent.User {
+	t.Helper()
+
+	u, err := db.User.Create().
+		SetUsername(username).
+		SetEmail(email).
+		SetPassword("password").
+		SetPasswordSalt("salt").
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("创建测试用户失败: %v", err)
+	}
+	return u
+}
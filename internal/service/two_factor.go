@@ -0,0 +1,596 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/usertwofactor"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/utils"
+)
+
+const (
+	// twoFactorSetupKeyFormat 待确认的TOTP密钥缓存键，按用户ID区分
+	twoFactorSetupKeyFormat = "2fa:setup:%d"
+	// twoFactorSetupExpire 待确认密钥有效期（秒）
+	twoFactorSetupExpire = 600
+	// twoFactorPendingKeyFormat 待两步验证的登录凭证缓存键
+	twoFactorPendingKeyFormat = "2fa:pending:%s"
+	// twoFactorPendingAttemptsKeyFormat 待验证登录凭证的失败次数缓存键
+	twoFactorPendingAttemptsKeyFormat = "2fa:pending:attempts:%s"
+	// twoFactorPendingExpire 待验证登录凭证有效期（秒）
+	twoFactorPendingExpire = 300
+	// twoFactorMaxAttempts 单个待验证登录凭证允许的最大失败次数
+	twoFactorMaxAttempts = 5
+	// twoFactorRecoveryCodeCount 恢复码数量
+	twoFactorRecoveryCodeCount = 10
+	// twoFactorPeriod TOTP时间步长（秒）
+	twoFactorPeriod = 30
+	// twoFactorSkew 允许前后偏移的时间步数量
+	twoFactorSkew = 1
+)
+
+// ITwoFactorService 两步验证服务接口
+type ITwoFactorService interface {
+	// GetStatus 获取用户两步验证状态
+	GetStatus(ctx context.Context, userID int) (*schema.TwoFactorStatusResponse, error)
+	// Setup 生成TOTP密钥与绑定地址
+	Setup(ctx context.Context, userID int) (*schema.TwoFactorSetupResponse, error)
+	// Enable 校验验证码并启用两步验证，返回恢复码
+	Enable(ctx context.Context, userID int, req schema.TwoFactorEnableRequest) (*schema.TwoFactorRecoveryCodesResponse, error)
+	// Disable 校验验证码并关闭两步验证
+	Disable(ctx context.Context, userID int, req schema.TwoFactorCodeRequest) error
+	// RegenerateRecoveryCodes 校验验证码并重新生成恢复码
+	RegenerateRecoveryCodes(ctx context.Context, userID int, req schema.TwoFactorCodeRequest) (*schema.TwoFactorRecoveryCodesResponse, error)
+	// BeginLogin 密码校验通过后判断是否需要两步验证，需要时返回待验证登录信息，否则返回nil
+	BeginLogin(ctx context.Context, u *ent.User) (*schema.TwoFactorPendingLogin, error)
+	// SetupPendingLogin 被强制启用两步验证的用户在登录流程中获取绑定信息
+	SetupPendingLogin(ctx context.Context, req schema.TwoFactorPendingSetupRequest) (*schema.TwoFactorSetupResponse, error)
+	// FinishLogin 校验两步验证码，返回登录用户；登录流程中完成绑定时同时返回恢复码
+	FinishLogin(ctx context.Context, req schema.TwoFactorLoginRequest) (*ent.User, []string, error)
+}
+
+// TwoFactorService 两步验证服务实现
+type TwoFactorService struct {
+	db       *ent.Client
+	cache    cache.ICacheService
+	logger   *zap.Logger
+	settings ISettingsService
+}
+
+// NewTwoFactorService 创建两步验证服务实例
+func NewTwoFactorService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger, settings ISettingsService) ITwoFactorService {
+	return &TwoFactorService{
+		db:       db,
+		cache:    cacheService,
+		logger:   logger,
+		settings: settings,
+	}
+}
+
+// twoFactorPending 待两步验证的登录信息，存储于缓存
+type twoFactorPending struct {
+	UserID        int  `json:"user_id"`
+	SetupRequired bool `json:"setup_required"`
+}
+
+// GetStatus 获取用户两步验证状态
+func (s *TwoFactorService) GetStatus(ctx context.Context, userID int) (*schema.TwoFactorStatusResponse, error) {
+	s.logger.Info("获取两步验证状态", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	u, err := s.db.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("用户不存在")
+		}
+		s.logger.Error("查询用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+
+	required, err := s.isForced(ctx, u.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &schema.TwoFactorStatusResponse{
+		Required: required,
+	}
+
+	tf, err := s.getEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf != nil {
+		resp.Enabled = true
+		resp.RecoveryCodesRemaining = len(tf.RecoveryCodes)
+		resp.EnabledAt = tf.EnabledAt.Format(time_tools.DateTimeFormat)
+	}
+
+	return resp, nil
+}
+
+// Setup 生成TOTP密钥与绑定地址
+func (s *TwoFactorService) Setup(ctx context.Context, userID int) (*schema.TwoFactorSetupResponse, error) {
+	s.logger.Info("生成两步验证密钥", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	tf, err := s.getEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf != nil {
+		return nil, errors.New("已启用两步验证")
+	}
+
+	u, err := s.db.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("用户不存在")
+		}
+		s.logger.Error("查询用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+
+	return s.generateSetup(ctx, u)
+}
+
+// Enable 校验验证码并启用两步验证，返回恢复码
+func (s *TwoFactorService) Enable(ctx context.Context, userID int, req schema.TwoFactorEnableRequest) (*schema.TwoFactorRecoveryCodesResponse, error) {
+	s.logger.Info("启用两步验证", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	tf, err := s.getEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf != nil {
+		return nil, errors.New("已启用两步验证")
+	}
+
+	codes, err := s.confirmSetup(ctx, userID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schema.TwoFactorRecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// Disable 校验验证码并关闭两步验证
+func (s *TwoFactorService) Disable(ctx context.Context, userID int, req schema.TwoFactorCodeRequest) error {
+	s.logger.Info("关闭两步验证", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	u, err := s.db.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("用户不存在")
+		}
+		s.logger.Error("查询用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("查询用户失败: %w", err)
+	}
+
+	// 被强制启用的身份不允许关闭
+	forced, err := s.isForced(ctx, u.Role)
+	if err != nil {
+		return err
+	}
+	if forced {
+		return errors.New("当前身份要求启用两步验证，无法关闭")
+	}
+
+	tf, err := s.getEnabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if tf == nil {
+		return errors.New("未启用两步验证")
+	}
+
+	if err = s.verifyCode(ctx, tf, req.Code); err != nil {
+		return err
+	}
+
+	if err = s.db.UserTwoFactor.DeleteOneID(tf.ID).Exec(ctx); err != nil {
+		s.logger.Error("删除两步验证配置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("关闭两步验证失败: %w", err)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodes 校验验证码并重新生成恢复码
+func (s *TwoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID int, req schema.TwoFactorCodeRequest) (*schema.TwoFactorRecoveryCodesResponse, error) {
+	s.logger.Info("重新生成恢复码", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	tf, err := s.getEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil {
+		return nil, errors.New("未启用两步验证")
+	}
+
+	if err = s.verifyCode(ctx, tf, req.Code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes := generateRecoveryCodes()
+	if _, err = s.db.UserTwoFactor.UpdateOneID(tf.ID).
+		SetRecoveryCodes(hashes).
+		Save(ctx); err != nil {
+		s.logger.Error("更新恢复码失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("更新恢复码失败: %w", err)
+	}
+
+	return &schema.TwoFactorRecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// BeginLogin 密码校验通过后判断是否需要两步验证
+func (s *TwoFactorService) BeginLogin(ctx context.Context, u *ent.User) (*schema.TwoFactorPendingLogin, error) {
+	s.logger.Info("检查登录两步验证", zap.Int("user_id", u.ID), tracing.WithTraceIDField(ctx))
+
+	tf, err := s.getEnabled(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := twoFactorPending{UserID: u.ID}
+	if tf == nil {
+		forced, err := s.isForced(ctx, u.Role)
+		if err != nil {
+			return nil, err
+		}
+		// 未启用且未被强制，直接登录
+		if !forced {
+			return nil, nil
+		}
+		pending.SetupRequired = true
+	}
+
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return nil, fmt.Errorf("序列化登录信息失败: %w", err)
+	}
+
+	token := utils.RandomString(32)
+	if err = s.cache.SetEx(ctx, fmt.Sprintf(twoFactorPendingKeyFormat, token), string(data), twoFactorPendingExpire); err != nil {
+		s.logger.Error("保存待验证登录信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("保存待验证登录信息失败: %w", err)
+	}
+
+	return &schema.TwoFactorPendingLogin{
+		PendingToken:  token,
+		SetupRequired: pending.SetupRequired,
+	}, nil
+}
+
+// SetupPendingLogin 被强制启用两步验证的用户在登录流程中获取绑定信息
+func (s *TwoFactorService) SetupPendingLogin(ctx context.Context, req schema.TwoFactorPendingSetupRequest) (*schema.TwoFactorSetupResponse, error) {
+	s.logger.Info("登录流程中生成两步验证密钥", tracing.WithTraceIDField(ctx))
+
+	pending, err := s.getPending(ctx, req.PendingToken)
+	if err != nil {
+		return nil, err
+	}
+	if !pending.SetupRequired {
+		return nil, errors.New("已启用两步验证")
+	}
+
+	u, err := s.db.User.Get(ctx, pending.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("用户不存在")
+		}
+		s.logger.Error("查询用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+
+	return s.generateSetup(ctx, u)
+}
+
+// FinishLogin 校验两步验证码，返回登录用户
+func (s *TwoFactorService) FinishLogin(ctx context.Context, req schema.TwoFactorLoginRequest) (*ent.User, []string, error) {
+	s.logger.Info("两步验证登录", tracing.WithTraceIDField(ctx))
+
+	pending, err := s.getPending(ctx, req.PendingToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 限制单个凭证的失败次数，超过后作废
+	attemptsKey := fmt.Sprintf(twoFactorPendingAttemptsKeyFormat, req.PendingToken)
+	attempts, err := s.cache.Incr(ctx, attemptsKey)
+	if err != nil {
+		s.logger.Error("记录验证次数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, nil, fmt.Errorf("记录验证次数失败: %w", err)
+	}
+	if attempts == 1 {
+		_, _ = s.cache.Expire(ctx, attemptsKey, twoFactorPendingExpire) //nolint:errcheck // 过期时间设置失败不影响主流程
+	}
+	if attempts > twoFactorMaxAttempts {
+		_, _ = s.cache.Del(ctx, fmt.Sprintf(twoFactorPendingKeyFormat, req.PendingToken), attemptsKey) //nolint:errcheck // 凭证清理失败不影响主流程
+		return nil, nil, errors.New("验证失败次数过多，请重新登录")
+	}
+
+	var recoveryCodes []string
+	if pending.SetupRequired {
+		recoveryCodes, err = s.confirmSetup(ctx, pending.UserID, req.Code)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		tf, err := s.getEnabled(ctx, pending.UserID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if tf == nil {
+			return nil, nil, errors.New("未启用两步验证，请重新登录")
+		}
+		if err = s.verifyCode(ctx, tf, req.Code); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// 校验通过，凭证仅可使用一次
+	_, _ = s.cache.Del(ctx, fmt.Sprintf(twoFactorPendingKeyFormat, req.PendingToken), attemptsKey) //nolint:errcheck // 凭证清理失败不影响主流程
+
+	// 重新检查用户状态，防止在等待验证期间被封禁
+	u, err := s.db.User.Get(ctx, pending.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, errors.New("用户不存在")
+		}
+		s.logger.Error("查询用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if err = checkUserLoginStatus(u); err != nil {
+		return nil, nil, err
+	}
+
+	return u, recoveryCodes, nil
+}
+
+// isForced 判断当前身份是否被强制要求启用两步验证
+func (s *TwoFactorService) isForced(ctx context.Context, role user.Role) (bool, error) {
+	if role == user.RoleUser {
+		return false, nil
+	}
+
+	forceTwoFactor, err := s.settings.GetSettingByKey(ctx, _const.SafeForceTwoFactor, _const.SettingBoolFalse.String())
+	if err != nil {
+		s.logger.Error("查询两步验证设置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return false, fmt.Errorf("查询两步验证设置失败: %w", err)
+	}
+
+	return forceTwoFactor == _const.SettingBoolTrue.String(), nil
+}
+
+// getEnabled 获取用户已启用的两步验证配置，未启用时返回nil
+func (s *TwoFactorService) getEnabled(ctx context.Context, userID int) (*ent.UserTwoFactor, error) {
+	tf, err := s.db.UserTwoFactor.Query().
+		Where(
+			usertwofactor.UserIDEQ(userID),
+			usertwofactor.EnabledEQ(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		s.logger.Error("查询两步验证配置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询两步验证配置失败: %w", err)
+	}
+	return tf, nil
+}
+
+// getPending 读取待验证登录信息
+func (s *TwoFactorService) getPending(ctx context.Context, token string) (*twoFactorPending, error) {
+	data, err := s.cache.Get(ctx, fmt.Sprintf(twoFactorPendingKeyFormat, token))
+	if err != nil {
+		s.logger.Error("读取待验证登录信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("读取待验证登录信息失败: %w", err)
+	}
+	if data == "" {
+		return nil, errors.New("登录凭证已过期，请重新登录")
+	}
+
+	var pending twoFactorPending
+	if err = json.Unmarshal([]byte(data), &pending); err != nil {
+		return nil, fmt.Errorf("解析待验证登录信息失败: %w", err)
+	}
+	return &pending, nil
+}
+
+// generateSetup 生成TOTP密钥并缓存，等待用户确认
+func (s *TwoFactorService) generateSetup(ctx context.Context, u *ent.User) (*schema.TwoFactorSetupResponse, error) {
+	issuer, err := s.settings.GetSettingByKey(ctx, _const.SeoWebSiteName, "PokeForum")
+	if err != nil || issuer == "" {
+		issuer = "PokeForum"
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      issuer,
+		AccountName: u.Email,
+		Period:      twoFactorPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		s.logger.Error("生成TOTP密钥失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("生成TOTP密钥失败: %w", err)
+	}
+
+	if err = s.cache.SetEx(ctx, fmt.Sprintf(twoFactorSetupKeyFormat, u.ID), key.Secret(), twoFactorSetupExpire); err != nil {
+		s.logger.Error("保存TOTP密钥失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("保存TOTP密钥失败: %w", err)
+	}
+
+	return &schema.TwoFactorSetupResponse{
+		Secret:          key.Secret(),
+		ProvisioningURI: key.URL(),
+		ExpiresIn:       twoFactorSetupExpire,
+	}, nil
+}
+
+// confirmSetup 使用待确认密钥校验验证码，通过后持久化配置并返回恢复码
+func (s *TwoFactorService) confirmSetup(ctx context.Context, userID int, code string) ([]string, error) {
+	setupKey := fmt.Sprintf(twoFactorSetupKeyFormat, userID)
+	secret, err := s.cache.Get(ctx, setupKey)
+	if err != nil {
+		s.logger.Error("读取TOTP密钥失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("读取TOTP密钥失败: %w", err)
+	}
+	if secret == "" {
+		return nil, errors.New("绑定信息已过期，请重新获取")
+	}
+
+	step, ok := matchTOTP(secret, code, time.Now())
+	if !ok {
+		return nil, errors.New("验证码错误")
+	}
+
+	codes, hashes := generateRecoveryCodes()
+
+	// 删除残留的未启用记录后写入新配置
+	if _, err = s.db.UserTwoFactor.Delete().
+		Where(usertwofactor.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		s.logger.Error("清理两步验证配置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("启用两步验证失败: %w", err)
+	}
+	if _, err = s.db.UserTwoFactor.Create().
+		SetUserID(userID).
+		SetSecret(secret).
+		SetEnabled(true).
+		SetRecoveryCodes(hashes).
+		SetLastUsedStep(step).
+		SetEnabledAt(time.Now()).
+		Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("已启用两步验证")
+		}
+		s.logger.Error("保存两步验证配置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("启用两步验证失败: %w", err)
+	}
+
+	_, _ = s.cache.Del(ctx, setupKey) //nolint:errcheck // 缓存清理失败不影响主流程
+
+	return codes, nil
+}
+
+// verifyCode 校验动态验证码或恢复码，校验通过后记录使用状态
+func (s *TwoFactorService) verifyCode(ctx context.Context, tf *ent.UserTwoFactor, code string) error {
+	code = strings.TrimSpace(code)
+
+	// 6位数字按动态验证码处理
+	if len(code) == 6 {
+		step, ok := matchTOTP(tf.Secret, code, time.Now())
+		if !ok {
+			return errors.New("验证码错误")
+		}
+		// 仅当时间步大于上次使用的时间步时更新，防止同一验证码被重放
+		n, err := s.db.UserTwoFactor.Update().
+			Where(
+				usertwofactor.IDEQ(tf.ID),
+				usertwofactor.LastUsedStepLT(step),
+			).
+			SetLastUsedStep(step).
+			Save(ctx)
+		if err != nil {
+			s.logger.Error("更新验证码使用状态失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("更新验证码使用状态失败: %w", err)
+		}
+		if n == 0 {
+			return errors.New("验证码已使用，请等待下一个验证码")
+		}
+		return nil
+	}
+
+	// 其余按恢复码处理
+	hash := hashRecoveryCode(code)
+	remaining := make([]string, 0, len(tf.RecoveryCodes))
+	matched := false
+	for _, h := range tf.RecoveryCodes {
+		if !matched && subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+			matched = true
+			continue
+		}
+		remaining = append(remaining, h)
+	}
+	if !matched {
+		return errors.New("验证码错误")
+	}
+
+	// 以更新时间作为乐观锁，防止同一恢复码被并发使用
+	n, err := s.db.UserTwoFactor.Update().
+		Where(
+			usertwofactor.IDEQ(tf.ID),
+			usertwofactor.UpdatedAtEQ(tf.UpdatedAt),
+		).
+		SetRecoveryCodes(remaining).
+		Save(ctx)
+	if err != nil {
+		s.logger.Error("更新恢复码失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("更新恢复码失败: %w", err)
+	}
+	if n == 0 {
+		return errors.New("恢复码已使用，请重试")
+	}
+
+	s.logger.Info("使用恢复码", zap.Int("user_id", tf.UserID), zap.Int("remaining", len(remaining)), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// matchTOTP 在允许的时间偏移内匹配动态验证码，返回匹配的时间步
+func matchTOTP(secret, code string, now time.Time) (int64, bool) {
+	opts := totp.ValidateOpts{
+		Period:    twoFactorPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+	for offset := -twoFactorSkew; offset <= twoFactorSkew; offset++ {
+		t := now.Add(time.Duration(offset*twoFactorPeriod) * time.Second)
+		expected, err := totp.GenerateCodeCustom(secret, t, opts)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return t.Unix() / twoFactorPeriod, true
+		}
+	}
+	return 0, false
+}
+
+// generateRecoveryCodes 生成恢复码，返回明文与哈希
+func generateRecoveryCodes() ([]string, []string) {
+	codes := make([]string, 0, twoFactorRecoveryCodeCount)
+	hashes := make([]string, 0, twoFactorRecoveryCodeCount)
+	for i := 0; i < twoFactorRecoveryCodeCount; i++ {
+		raw := strings.ToLower(utils.RandomString(10))
+		code := raw[:5] + "-" + raw[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes
+}
+
+// hashRecoveryCode 计算恢复码哈希，忽略大小写与分隔符
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/usertwofactor"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// newTestTwoFactorService 创建两步验证服务
+func newTestTwoFactorService(t *testing.T) (*ent.Client, *TwoFactorService) {
+	t.Helper()
+
+	db := newTestDB(t)
+	cacheService := newTestCache(t)
+	newTestSaToken(t)
+
+	settingsService := NewSettingsService(db, cacheService, zap.NewNop())
+	return db, NewTwoFactorService(db, cacheService, zap.NewNop(), settingsService).(*TwoFactorService)
+}
+
+// totpCodeAt 生成指定时间的动态验证码
+func totpCodeAt(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+
+	code, err := totp.GenerateCodeCustom(secret, at, totp.ValidateOpts{
+		Period:    twoFactorPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		t.Fatalf("生成动态验证码失败: %v", err)
+	}
+	return code
+}
+
+// enableTestTwoFactor 为用户启用两步验证，返回密钥与恢复码
+func enableTestTwoFactor(t *testing.T, svc *TwoFactorService, userID int) (string, []string) {
+	t.Helper()
+
+	setup, err := svc.Setup(t.Context(), userID)
+	if err != nil {
+		t.Fatalf("生成两步验证密钥失败: %v", err)
+	}
+	result, err := svc.Enable(t.Context(), userID, schema.TwoFactorEnableRequest{Code: totpCodeAt(t, setup.Secret, time.Now())})
+	if err != nil {
+		t.Fatalf("启用两步验证失败: %v", err)
+	}
+	if len(result.RecoveryCodes) != twoFactorRecoveryCodeCount {
+		t.Fatalf("应返回 %d 个恢复码，实际 %d 个", twoFactorRecoveryCodeCount, len(result.RecoveryCodes))
+	}
+	return setup.Secret, result.RecoveryCodes
+}
+
+func TestTwoFactorVerifyCode(t *testing.T) {
+	db, svc := newTestTwoFactorService(t)
+	u := newTestUser(t, db, "alice", "alice@example.com")
+	secret, recoveryCodes := enableTestTwoFactor(t, svc, u.ID)
+
+	// 以启用时使用的时间步为基准生成验证码，避免跨越时间步边界导致结果不稳定
+	enabled := db.UserTwoFactor.Query().Where(usertwofactor.UserIDEQ(u.ID)).OnlyX(t.Context())
+	now := time.Unix(enabled.LastUsedStep*twoFactorPeriod, 0)
+
+	// 用例按顺序执行，后续用例依赖前面用例消耗的验证码与恢复码
+	tests := []struct {
+		name      string
+		code      string
+		wantErr   bool
+		remaining int
+	}{
+		{name: "启用时使用的动态验证码不能重放", code: totpCodeAt(t, secret, now), wantErr: true, remaining: 10},
+		{name: "偏移范围外的动态验证码", code: totpCodeAt(t, secret, now.Add(5*time.Minute)), wantErr: true, remaining: 10},
+		{name: "下一时间步的动态验证码", code: totpCodeAt(t, secret, now.Add(twoFactorPeriod*time.Second)), remaining: 10},
+		{name: "同一动态验证码再次使用", code: totpCodeAt(t, secret, now.Add(twoFactorPeriod*time.Second)), wantErr: true, remaining: 10},
+		{name: "恢复码", code: recoveryCodes[0], remaining: 9},
+		{name: "已使用的恢复码", code: recoveryCodes[0], wantErr: true, remaining: 9},
+		{name: "恢复码忽略大小写与分隔符", code: strings.ToUpper(strings.ReplaceAll(recoveryCodes[1], "-", "")), remaining: 8},
+		{name: "错误的恢复码", code: "zzzzz-zzzzz", wantErr: true, remaining: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tf := db.UserTwoFactor.Query().Where(usertwofactor.UserIDEQ(u.ID)).OnlyX(t.Context())
+			err := svc.verifyCode(t.Context(), tf, tt.code)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+
+			tf = db.UserTwoFactor.Query().Where(usertwofactor.UserIDEQ(u.ID)).OnlyX(t.Context())
+			if len(tf.RecoveryCodes) != tt.remaining {
+				t.Fatalf("剩余恢复码应为 %d 个，实际 %d 个", tt.remaining, len(tf.RecoveryCodes))
+			}
+		})
+	}
+}
+
+func TestTwoFactorFinishLogin(t *testing.T) {
+	tests := []struct {
+		name       string
+		wrongTries int
+		useCode    func(secret string, recoveryCodes []string) string
+		wantErr    string
+	}{
+		{
+			name: "动态验证码登录",
+			useCode: func(secret string, _ []string) string {
+				return totpCodeAt(t, secret, time.Now().Add(twoFactorPeriod*time.Second))
+			},
+		},
+		{
+			name:    "恢复码登录",
+			useCode: func(_ string, recoveryCodes []string) string { return recoveryCodes[3] },
+		},
+		{
+			name:       "失败次数未超限",
+			wrongTries: twoFactorMaxAttempts - 1,
+			useCode:    func(_ string, recoveryCodes []string) string { return recoveryCodes[0] },
+		},
+		{
+			name:       "失败次数超限后作废凭证",
+			wrongTries: twoFactorMaxAttempts,
+			useCode:    func(_ string, recoveryCodes []string) string { return recoveryCodes[0] },
+			wantErr:    "验证失败次数过多",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, svc := newTestTwoFactorService(t)
+			u := newTestUser(t, db, "bob", "bob@example.com")
+			secret, recoveryCodes := enableTestTwoFactor(t, svc, u.ID)
+
+			pending, err := svc.BeginLogin(t.Context(), u)
+			if err != nil || pending == nil || pending.SetupRequired {
+				t.Fatalf("已启用两步验证时应返回待验证凭证: %+v, %v", pending, err)
+			}
+
+			for i := 0; i < tt.wrongTries; i++ {
+				if _, _, err = svc.FinishLogin(t.Context(), schema.TwoFactorLoginRequest{
+					PendingToken: pending.PendingToken,
+					Code:         "zzzzz-zzzzz",
+				}); err == nil {
+					t.Fatal("错误验证码不应通过")
+				}
+			}
+
+			req := schema.TwoFactorLoginRequest{PendingToken: pending.PendingToken, Code: tt.useCode(secret, recoveryCodes)}
+			loggedIn, _, err := svc.FinishLogin(t.Context(), req)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("期望错误 %q，实际 %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("两步验证登录失败: %v", err)
+			}
+			if loggedIn.ID != u.ID {
+				t.Fatalf("应登录用户 %d，实际为 %d", u.ID, loggedIn.ID)
+			}
+
+			// 待验证凭证仅可使用一次
+			if _, _, err = svc.FinishLogin(t.Context(), req); err == nil {
+				t.Fatal("待验证凭证不应被重复使用")
+			}
+		})
+	}
+}
+
+func TestTwoFactorForcedSetupDuringLogin(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      user.Role
+		force     bool
+		wantLogin bool
+	}{
+		{name: "普通用户不受强制设置影响", role: user.RoleUser, force: true, wantLogin: true},
+		{name: "未开启强制设置", role: user.RoleModerator, force: false, wantLogin: true},
+		{name: "版主被强制绑定", role: user.RoleModerator, force: true},
+		{name: "管理员被强制绑定", role: user.RoleAdmin, force: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, svc := newTestTwoFactorService(t)
+			if tt.force {
+				setTestSetting(t, db, _const.SafeForceTwoFactor, _const.SettingBoolTrue.String())
+			}
+			u := newTestUser(t, db, "carol", "carol@example.com")
+			u = db.User.UpdateOne(u).SetRole(tt.role).SaveX(t.Context())
+
+			pending, err := svc.BeginLogin(t.Context(), u)
+			if err != nil {
+				t.Fatalf("检查登录两步验证失败: %v", err)
+			}
+			if tt.wantLogin {
+				if pending != nil {
+					t.Fatalf("未被强制时应直接登录，实际返回 %+v", pending)
+				}
+				return
+			}
+			if pending == nil || !pending.SetupRequired {
+				t.Fatalf("被强制时应要求先绑定，实际返回 %+v", pending)
+			}
+
+			setup, err := svc.SetupPendingLogin(t.Context(), schema.TwoFactorPendingSetupRequest{PendingToken: pending.PendingToken})
+			if err != nil {
+				t.Fatalf("登录流程中生成密钥失败: %v", err)
+			}
+			loggedIn, recoveryCodes, err := svc.FinishLogin(t.Context(), schema.TwoFactorLoginRequest{
+				PendingToken: pending.PendingToken,
+				Code:         totpCodeAt(t, setup.Secret, time.Now()),
+			})
+			if err != nil {
+				t.Fatalf("登录流程中完成绑定失败: %v", err)
+			}
+			if loggedIn.ID != u.ID || len(recoveryCodes) != twoFactorRecoveryCodeCount {
+				t.Fatalf("完成绑定后应登录并返回恢复码: user=%d codes=%d", loggedIn.ID, len(recoveryCodes))
+			}
+
+			// 被强制的身份不允许关闭
+			if err = svc.Disable(t.Context(), u.ID, schema.TwoFactorCodeRequest{Code: recoveryCodes[0]}); err == nil {
+				t.Fatal("被强制启用的身份不应能关闭两步验证")
+			}
+		})
+	}
+}
@@ -0,0 +1,670 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/predicate"
+	"github.com/PokeForum/PokeForum/ent/user"
+	"github.com/PokeForum/PokeForum/ent/usergroup"
+	"github.com/PokeForum/PokeForum/ent/usergroupmember"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// IUserGroupService 用户组服务接口
+type IUserGroupService interface {
+	// GetGroupList 获取用户组列表
+	GetGroupList(ctx context.Context, req schema.UserGroupListRequest) (*schema.UserGroupListResponse, error)
+	// CreateGroup 创建用户组
+	CreateGroup(ctx context.Context, req schema.UserGroupCreateRequest) (*schema.UserGroupItem, error)
+	// UpdateGroup 更新用户组
+	UpdateGroup(ctx context.Context, req schema.UserGroupUpdateRequest) (*schema.UserGroupItem, error)
+	// DeleteGroup 删除用户组，同时移除全部成员并从版块访问控制规则中移除该用户组
+	DeleteGroup(ctx context.Context, id int) error
+	// GetGroupMembers 获取用户组成员列表
+	GetGroupMembers(ctx context.Context, req schema.UserGroupMemberListRequest) (*schema.UserGroupMemberListResponse, error)
+	// AddGroupMember 添加用户组成员，用户已在用户组中时更新成员资格有效期
+	AddGroupMember(ctx context.Context, req schema.UserGroupMemberAddRequest) error
+	// RemoveGroupMember 移除用户组成员
+	RemoveGroupMember(ctx context.Context, req schema.UserGroupMemberRequest) error
+	// GetPermissionList 获取可分配给用户组的权限列表
+	GetPermissionList(ctx context.Context) *schema.PermissionListResponse
+	// GetUserPermissions 获取用户的最终权限，由身份默认权限与未过期用户组的权限合并而成
+	GetUserPermissions(ctx context.Context, userID int) ([]string, error)
+	// HasPermission 检查用户是否拥有任一指定权限
+	HasPermission(ctx context.Context, userID int, perms ...string) (bool, error)
+	// CleanExpiredMembers 清理过期的用户组成员并清理其权限缓存，返回清理数量
+	CleanExpiredMembers(ctx context.Context) (int, error)
+}
+
+// UserGroupService 用户组服务实现
+type UserGroupService struct {
+	db     *ent.Client
+	cache  cache.ICacheService
+	logger *zap.Logger
+}
+
+// NewUserGroupService 创建用户组服务实例
+func NewUserGroupService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IUserGroupService {
+	return &UserGroupService{
+		db:     db,
+		cache:  cacheService,
+		logger: logger,
+	}
+}
+
+// toGroupItem 转换为用户组列表项
+func (s *UserGroupService) toGroupItem(g *ent.UserGroup, memberCount int) *schema.UserGroupItem {
+	return &schema.UserGroupItem{
+		ID:          g.ID,
+		Name:        g.Name,
+		Description: g.Description,
+		Permissions: g.Permissions,
+		MemberCount: memberCount,
+		CreatedAt:   g.CreatedAt.Format(time_tools.DateTimeFormat),
+		UpdatedAt:   g.UpdatedAt.Format(time_tools.DateTimeFormat),
+	}
+}
+
+// activeGroupMember 筛选未过期的用户组成员资格
+func activeGroupMember() predicate.UserGroupMember {
+	return usergroupmember.Or(
+		usergroupmember.ExpiresAtIsNil(),
+		usergroupmember.ExpiresAtGT(time.Now()),
+	)
+}
+
+const (
+	// userPermissionCacheKey 用户最终权限缓存键，用户身份、用户组或成员资格变更时删除
+	userPermissionCacheKey = "user:permissions:%d"
+	// userPermissionCacheTTL 用户权限缓存时间，存在即将过期的成员资格时以其剩余有效期为准
+	userPermissionCacheTTL = 10 * time.Minute
+)
+
+// loadUserPermissions 计算用户的最终权限，同时返回该结果的有效时长
+// 有效时长不超过最近一个成员资格的剩余有效期，保证成员资格过期后权限立即失效
+func loadUserPermissions(ctx context.Context, db *ent.Client, userID int) ([]string, time.Duration, error) {
+	u, err := db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldID, user.FieldRole).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return []string{}, userPermissionCacheTTL, nil
+		}
+		return nil, 0, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+
+	members, err := db.UserGroupMember.Query().
+		Where(usergroupmember.UserIDEQ(userID), activeGroupMember()).
+		Select(usergroupmember.FieldGroupID, usergroupmember.FieldExpiresAt).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询用户组失败: %w", err)
+	}
+
+	validFor := userPermissionCacheTTL
+	groupIDs := make([]int, 0, len(members))
+	for _, m := range members {
+		groupIDs = append(groupIDs, m.GroupID)
+		if m.ExpiresAt != nil {
+			validFor = min(validFor, time.Until(*m.ExpiresAt))
+		}
+	}
+
+	var groupPermissions [][]string
+	if len(groupIDs) > 0 {
+		groups, err := db.UserGroup.Query().
+			Where(usergroup.IDIn(groupIDs...)).
+			Select(usergroup.FieldID, usergroup.FieldPermissions).
+			All(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("获取用户组失败: %w", err)
+		}
+		for _, g := range groups {
+			groupPermissions = append(groupPermissions, g.Permissions)
+		}
+	}
+
+	return satoken.GetUserPermission(u.Role.String(), groupPermissions...), validFor, nil
+}
+
+// getUserPermissions 获取用户的最终权限，优先读取缓存
+// 权限在每次校验时按需计算，不依赖登录时写入会话的快照
+func getUserPermissions(ctx context.Context, db *ent.Client, cacheService cache.ICacheService, userID int) ([]string, error) {
+	key := fmt.Sprintf(userPermissionCacheKey, userID)
+	var perms []string
+	if cached, err := cacheService.Get(ctx, key); err == nil && cached != "" &&
+		json.Unmarshal([]byte(cached), &perms) == nil {
+		return perms, nil
+	}
+
+	perms, validFor, err := loadUserPermissions(ctx, db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 写入缓存失败不影响本次请求
+	if data, err := json.Marshal(perms); err == nil && validFor > 0 {
+		_ = cacheService.SetExDuration(ctx, key, string(data), validFor) //nolint:errcheck // 缓存写入失败时下次请求重新计算
+	}
+	return perms, nil
+}
+
+// clearUserPermissionCache 删除用户权限缓存，用户身份、用户组或成员资格变更后调用
+func clearUserPermissionCache(ctx context.Context, cacheService cache.ICacheService, logger *zap.Logger, userIDs ...int) {
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range uniqueInts(userIDs) {
+		keys = append(keys, fmt.Sprintf(userPermissionCacheKey, userID))
+	}
+	if len(keys) == 0 {
+		return
+	}
+	if _, err := cacheService.Del(ctx, keys...); err != nil {
+		logger.Warn("清理用户权限缓存失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+}
+
+// normalizePermissions 校验并去重用户组权限标识
+func normalizePermissions(perms []string) ([]string, error) {
+	result := make([]string, 0, len(perms))
+	for _, perm := range perms {
+		if !satoken.IsValidPermission(perm) {
+			return nil, fmt.Errorf("无效的权限标识: %s", perm)
+		}
+		if !slices.Contains(result, perm) {
+			result = append(result, perm)
+		}
+	}
+	return result, nil
+}
+
+// groupMemberIDs 获取用户组全部成员的用户ID
+func (s *UserGroupService) groupMemberIDs(ctx context.Context, groupID int) ([]int, error) {
+	ids, err := s.db.UserGroupMember.Query().
+		Where(usergroupmember.GroupIDEQ(groupID)).
+		Select(usergroupmember.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取用户组成员失败: %w", err)
+	}
+	return ids, nil
+}
+
+// GetGroupList 获取用户组列表
+func (s *UserGroupService) GetGroupList(ctx context.Context, req schema.UserGroupListRequest) (*schema.UserGroupListResponse, error) {
+	s.logger.Info("获取用户组列表", zap.Int("page", req.Page), zap.Int("page_size", req.PageSize), zap.String("keyword", req.Keyword), tracing.WithTraceIDField(ctx))
+
+	query := s.db.UserGroup.Query()
+	if req.Keyword != "" {
+		query = query.Where(usergroup.NameContains(req.Keyword))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取用户组总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户组总数失败: %w", err)
+	}
+
+	groups, err := query.
+		Order(ent.Asc(usergroup.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取用户组列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户组列表失败: %w", err)
+	}
+
+	// 批量统计成员数量
+	groupIDs := make([]int, len(groups))
+	for i, g := range groups {
+		groupIDs[i] = g.ID
+	}
+	var rows []struct {
+		GroupID int `json:"group_id"`
+		Count   int `json:"count"`
+	}
+	if len(groupIDs) > 0 {
+		if err = s.db.UserGroupMember.Query().
+			Where(usergroupmember.GroupIDIn(groupIDs...)).
+			GroupBy(usergroupmember.FieldGroupID).
+			Aggregate(ent.Count()).
+			Scan(ctx, &rows); err != nil {
+			s.logger.Error("统计用户组成员失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("统计用户组成员失败: %w", err)
+		}
+	}
+	memberCounts := make(map[int]int, len(rows))
+	for _, row := range rows {
+		memberCounts[row.GroupID] = row.Count
+	}
+
+	list := make([]schema.UserGroupItem, len(groups))
+	for i, g := range groups {
+		list[i] = *s.toGroupItem(g, memberCounts[g.ID])
+	}
+
+	return &schema.UserGroupListResponse{
+		List:     list,
+		Total:    int64(total),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// CreateGroup 创建用户组
+func (s *UserGroupService) CreateGroup(ctx context.Context, req schema.UserGroupCreateRequest) (*schema.UserGroupItem, error) {
+	s.logger.Info("创建用户组", zap.String("name", req.Name), tracing.WithTraceIDField(ctx))
+
+	exists, err := s.db.UserGroup.Query().
+		Where(usergroup.NameEQ(req.Name)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查用户组名称失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("检查用户组名称失败: %w", err)
+	}
+	if exists {
+		return nil, errors.New("用户组名称已存在")
+	}
+
+	perms, err := normalizePermissions(req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := s.db.UserGroup.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetPermissions(perms).
+		Save(ctx)
+	if err != nil {
+		s.logger.Error("创建用户组失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("创建用户组失败: %w", err)
+	}
+
+	s.logger.Info("用户组创建成功", zap.Int("id", g.ID), tracing.WithTraceIDField(ctx))
+	return s.toGroupItem(g, 0), nil
+}
+
+// UpdateGroup 更新用户组
+func (s *UserGroupService) UpdateGroup(ctx context.Context, req schema.UserGroupUpdateRequest) (*schema.UserGroupItem, error) {
+	s.logger.Info("更新用户组", zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
+
+	existing, err := s.db.UserGroup.Get(ctx, req.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("用户组不存在")
+		}
+		s.logger.Error("获取用户组失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户组失败: %w", err)
+	}
+
+	// 如果要更新名称，检查是否与其他用户组冲突
+	if req.Name != "" && req.Name != existing.Name {
+		exists, err := s.db.UserGroup.Query().
+			Where(usergroup.NameEQ(req.Name), usergroup.IDNEQ(req.ID)).
+			Exist(ctx)
+		if err != nil {
+			s.logger.Error("检查用户组名称失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("检查用户组名称失败: %w", err)
+		}
+		if exists {
+			return nil, errors.New("用户组名称已存在")
+		}
+	}
+
+	update := s.db.UserGroup.UpdateOneID(req.ID)
+	if req.Name != "" {
+		update = update.SetName(req.Name)
+	}
+	if req.Description != "" {
+		update = update.SetDescription(req.Description)
+	}
+	if req.Permissions != nil {
+		perms, err := normalizePermissions(*req.Permissions)
+		if err != nil {
+			return nil, err
+		}
+		update = update.SetPermissions(perms)
+	}
+	g, err := update.Save(ctx)
+	if err != nil {
+		s.logger.Error("更新用户组失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("更新用户组失败: %w", err)
+	}
+
+	memberIDs, err := s.groupMemberIDs(ctx, g.ID)
+	if err != nil {
+		s.logger.Error("获取用户组成员失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	// 权限变更后清理全部成员的权限缓存
+	if !slices.Equal(existing.Permissions, g.Permissions) {
+		clearUserPermissionCache(ctx, s.cache, s.logger, memberIDs...)
+	}
+
+	s.logger.Info("用户组更新成功", zap.Int("id", g.ID), tracing.WithTraceIDField(ctx))
+	return s.toGroupItem(g, len(memberIDs)), nil
+}
+
+// DeleteGroup 删除用户组
+func (s *UserGroupService) DeleteGroup(ctx context.Context, id int) error {
+	s.logger.Info("删除用户组", zap.Int("id", id), tracing.WithTraceIDField(ctx))
+
+	exists, err := s.db.UserGroup.Query().
+		Where(usergroup.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查用户组失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("检查用户组失败: %w", err)
+	}
+	if !exists {
+		return errors.New("用户组不存在")
+	}
+
+	memberIDs, err := s.groupMemberIDs(ctx, id)
+	if err != nil {
+		s.logger.Error("获取用户组成员失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		s.logger.Error("开启事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback() //nolint:errcheck // panic恢复时回滚失败无需处理
+			panic(v)
+		}
+	}()
+
+	if _, err = tx.UserGroupMember.Delete().
+		Where(usergroupmember.GroupIDEQ(id)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("移除用户组成员失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("移除用户组成员失败: %w", err)
+	}
+
+	// 从版块访问控制规则中移除该用户组，避免残留的用户组ID使规则无人可以满足
+	rules, err := tx.CategoryPermission.Query().All(ctx)
+	if err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("获取版块访问控制规则失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("获取版块访问控制规则失败: %w", err)
+	}
+	for _, rule := range rules {
+		if !slices.Contains(rule.AllowedGroupIds, id) {
+			continue
+		}
+		groupIDs := slices.DeleteFunc(slices.Clone(rule.AllowedGroupIds), func(gid int) bool {
+			return gid == id
+		})
+		if err = tx.CategoryPermission.UpdateOneID(rule.ID).
+			SetAllowedGroupIds(groupIDs).
+			Exec(ctx); err != nil {
+			_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+			s.logger.Error("更新版块访问控制规则失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return fmt.Errorf("更新版块访问控制规则失败: %w", err)
+		}
+	}
+
+	if err = tx.UserGroup.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback() //nolint:errcheck // 错误处理时回滚失败无需处理
+		s.logger.Error("删除用户组失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("删除用户组失败: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("提交事务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	clearUserPermissionCache(ctx, s.cache, s.logger, memberIDs...)
+	clearCategoryTreeCache(ctx, s.cache, s.logger)
+
+	s.logger.Info("用户组删除成功", zap.Int("id", id), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// GetGroupMembers 获取用户组成员列表
+func (s *UserGroupService) GetGroupMembers(ctx context.Context, req schema.UserGroupMemberListRequest) (*schema.UserGroupMemberListResponse, error) {
+	s.logger.Info("获取用户组成员列表", zap.Int("group_id", req.GroupID), tracing.WithTraceIDField(ctx))
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+
+	query := s.db.UserGroupMember.Query().
+		Where(usergroupmember.GroupIDEQ(req.GroupID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.logger.Error("获取用户组成员总数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户组成员总数失败: %w", err)
+	}
+
+	members, err := query.
+		Order(ent.Desc(usergroupmember.FieldID)).
+		Offset((req.Page - 1) * req.PageSize).
+		Limit(req.PageSize).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取用户组成员列表失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户组成员列表失败: %w", err)
+	}
+
+	// 批量查询用户信息
+	userIDs := make([]int, len(members))
+	for i, m := range members {
+		userIDs[i] = m.UserID
+	}
+	users, err := s.db.User.Query().
+		Where(user.IDIn(userIDs...)).
+		Select(user.FieldID, user.FieldUsername, user.FieldAvatar).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	userMap := make(map[int]*ent.User, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u
+	}
+
+	list := make([]schema.UserGroupMemberItem, 0, len(members))
+	for _, m := range members {
+		item := schema.UserGroupMemberItem{
+			UserID:    m.UserID,
+			CreatedAt: m.CreatedAt.Format(time_tools.DateTimeFormat),
+		}
+		if m.ExpiresAt != nil {
+			item.ExpiresAt = m.ExpiresAt.Format(time_tools.DateTimeFormat)
+		}
+		if u, ok := userMap[m.UserID]; ok {
+			item.Username = u.Username
+			item.Avatar = u.Avatar
+		}
+		list = append(list, item)
+	}
+
+	return &schema.UserGroupMemberListResponse{
+		List:     list,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// AddGroupMember 添加用户组成员
+func (s *UserGroupService) AddGroupMember(ctx context.Context, req schema.UserGroupMemberAddRequest) error {
+	s.logger.Info("添加用户组成员", zap.Int("group_id", req.GroupID), zap.Int("user_id", req.UserID), zap.Int64("duration", req.Duration), tracing.WithTraceIDField(ctx))
+
+	groupExists, err := s.db.UserGroup.Query().
+		Where(usergroup.IDEQ(req.GroupID)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查用户组失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("检查用户组失败: %w", err)
+	}
+	if !groupExists {
+		return errors.New("用户组不存在")
+	}
+
+	userExists, err := s.db.User.Query().
+		Where(user.IDEQ(req.UserID)).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("检查用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("检查用户失败: %w", err)
+	}
+	if !userExists {
+		return errors.New("用户不存在")
+	}
+
+	// 有效期为0表示永久成员
+	var expiresAt *time.Time
+	if req.Duration > 0 {
+		t := time.Now().Add(time.Duration(req.Duration) * time.Second)
+		expiresAt = &t
+	}
+
+	member, err := s.db.UserGroupMember.Query().
+		Where(
+			usergroupmember.GroupIDEQ(req.GroupID),
+			usergroupmember.UserIDEQ(req.UserID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		s.logger.Error("检查用户组成员失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("检查用户组成员失败: %w", err)
+	}
+
+	if member != nil {
+		// 已在用户组中时按本次请求重新设置有效期
+		update := s.db.UserGroupMember.UpdateOneID(member.ID)
+		if expiresAt != nil {
+			update = update.SetExpiresAt(*expiresAt)
+		} else {
+			update = update.ClearExpiresAt()
+		}
+		err = update.Exec(ctx)
+	} else {
+		err = s.db.UserGroupMember.Create().
+			SetGroupID(req.GroupID).
+			SetUserID(req.UserID).
+			SetNillableExpiresAt(expiresAt).
+			Exec(ctx)
+	}
+	if err != nil {
+		s.logger.Error("添加用户组成员失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("添加用户组成员失败: %w", err)
+	}
+	clearUserPermissionCache(ctx, s.cache, s.logger, req.UserID)
+
+	s.logger.Info("用户组成员添加成功", zap.Int("group_id", req.GroupID), zap.Int("user_id", req.UserID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// RemoveGroupMember 移除用户组成员
+func (s *UserGroupService) RemoveGroupMember(ctx context.Context, req schema.UserGroupMemberRequest) error {
+	s.logger.Info("移除用户组成员", zap.Int("group_id", req.GroupID), zap.Int("user_id", req.UserID), tracing.WithTraceIDField(ctx))
+
+	deleted, err := s.db.UserGroupMember.Delete().
+		Where(
+			usergroupmember.GroupIDEQ(req.GroupID),
+			usergroupmember.UserIDEQ(req.UserID),
+		).
+		Exec(ctx)
+	if err != nil {
+		s.logger.Error("移除用户组成员失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("移除用户组成员失败: %w", err)
+	}
+	if deleted == 0 {
+		return errors.New("该用户不在用户组中")
+	}
+	clearUserPermissionCache(ctx, s.cache, s.logger, req.UserID)
+
+	s.logger.Info("用户组成员移除成功", zap.Int("group_id", req.GroupID), zap.Int("user_id", req.UserID), tracing.WithTraceIDField(ctx))
+	return nil
+}
+
+// GetPermissionList 获取可分配给用户组的权限列表
+func (s *UserGroupService) GetPermissionList(ctx context.Context) *schema.PermissionListResponse {
+	s.logger.Info("获取可分配权限列表", tracing.WithTraceIDField(ctx))
+
+	return &schema.PermissionListResponse{
+		List: slices.Clone(satoken.Permissions),
+	}
+}
+
+// GetUserPermissions 获取用户的最终权限
+func (s *UserGroupService) GetUserPermissions(ctx context.Context, userID int) ([]string, error) {
+	s.logger.Info("获取用户权限", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	perms, err := getUserPermissions(ctx, s.db, s.cache, userID)
+	if err != nil {
+		s.logger.Error("获取用户权限失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, err
+	}
+	return perms, nil
+}
+
+// HasPermission 检查用户是否拥有任一指定权限
+// 每次请求都会调用，只在失败时记录日志
+func (s *UserGroupService) HasPermission(ctx context.Context, userID int, perms ...string) (bool, error) {
+	granted, err := getUserPermissions(ctx, s.db, s.cache, userID)
+	if err != nil {
+		s.logger.Error("获取用户权限失败", zap.Int("user_id", userID), zap.Error(err), tracing.WithTraceIDField(ctx))
+		return false, err
+	}
+	return slices.ContainsFunc(perms, func(perm string) bool {
+		return satoken.HasPermission(granted, perm)
+	}), nil
+}
+
+// CleanExpiredMembers 清理过期的用户组成员
+func (s *UserGroupService) CleanExpiredMembers(ctx context.Context) (int, error) {
+	s.logger.Info("清理过期用户组成员", tracing.WithTraceIDField(ctx))
+
+	now := time.Now()
+	userIDs, err := s.db.UserGroupMember.Query().
+		Where(usergroupmember.ExpiresAtLTE(now)).
+		Select(usergroupmember.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		s.logger.Error("查询过期用户组成员失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return 0, fmt.Errorf("查询过期用户组成员失败: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+
+	deleted, err := s.db.UserGroupMember.Delete().
+		Where(usergroupmember.ExpiresAtLTE(now)).
+		Exec(ctx)
+	if err != nil {
+		s.logger.Error("清理过期用户组成员失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return 0, fmt.Errorf("清理过期用户组成员失败: %w", err)
+	}
+	clearUserPermissionCache(ctx, s.cache, s.logger, userIDs...)
+
+	s.logger.Info("过期用户组成员清理完成", zap.Int("count", deleted), tracing.WithTraceIDField(ctx))
+	return deleted, nil
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	pkgasynq "github.com/PokeForum/PokeForum/internal/pkg/asynq"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+)
+
+// UserGroupExpireTask 用户组成员过期清理任务
+// 过期的成员资格在查询时已被忽略，权限缓存也不会超过成员资格的有效期，定时任务只负责删除过期记录
+type UserGroupExpireTask struct {
+	db               *ent.Client
+	logger           *zap.Logger
+	userGroupService IUserGroupService
+	taskManager      *pkgasynq.TaskManager
+}
+
+// UserGroupExpirePayload 用户组成员过期清理任务载荷
+type UserGroupExpirePayload struct {
+	TriggerTime int64 `json:"trigger_time"`
+}
+
+// NewUserGroupExpireTask 创建用户组成员过期清理任务实例
+func NewUserGroupExpireTask(db *ent.Client, cacheService cache.ICacheService, taskManager *pkgasynq.TaskManager, logger *zap.Logger) *UserGroupExpireTask {
+	return &UserGroupExpireTask{
+		db:               db,
+		logger:           logger,
+		userGroupService: NewUserGroupService(db, cacheService, logger),
+		taskManager:      taskManager,
+	}
+}
+
+// RegisterHandler 注册任务处理器
+func (t *UserGroupExpireTask) RegisterHandler() {
+	t.taskManager.RegisterHandlerFunc(pkgasynq.TypeUserGroupExpire, t.HandleExpireTask)
+	t.logger.Info("用户组成员过期清理任务处理器已注册")
+}
+
+// RegisterSchedule 注册定时任务
+// interval: 清理间隔时间
+func (t *UserGroupExpireTask) RegisterSchedule(interval time.Duration) error {
+	payload := &UserGroupExpirePayload{TriggerTime: time.Now().Unix()}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("序列化任务载荷失败: %w", err)
+	}
+
+	task := asynq.NewTask(pkgasynq.TypeUserGroupExpire, data)
+
+	// 转换为cron表达式，如 @every 10m
+	cronSpec := fmt.Sprintf("@every %s", interval.String())
+
+	entryID, err := t.taskManager.RegisterSchedule(cronSpec, task, asynq.Queue(pkgasynq.QueueLow))
+	if err != nil {
+		return fmt.Errorf("注册定时任务失败: %w", err)
+	}
+
+	t.logger.Info("用户组成员过期清理定时任务已注册",
+		zap.String("entry_id", entryID),
+		zap.Duration("interval", interval))
+
+	return nil
+}
+
+// HandleExpireTask 处理用户组成员过期清理任务
+func (t *UserGroupExpireTask) HandleExpireTask(ctx context.Context, task *asynq.Task) error {
+	var payload UserGroupExpirePayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		t.logger.Error("反序列化用户组成员过期清理任务失败", zap.Error(err))
+		return fmt.Errorf("反序列化失败: %v: %w", err, asynq.SkipRetry)
+	}
+
+	startTime := time.Now()
+	count, err := t.userGroupService.CleanExpiredMembers(ctx)
+	if err != nil {
+		t.logger.Error("清理过期用户组成员失败", zap.Error(err))
+		return err
+	}
+
+	t.logger.Debug("用户组成员过期清理完成",
+		zap.Int("count", count),
+		zap.Duration("duration", time.Since(startTime)))
+	return nil
+}
+
+// CleanNow 立即执行一次清理（用于启动时）
+func (t *UserGroupExpireTask) CleanNow(ctx context.Context) {
+	t.logger.Debug("立即执行用户组成员过期清理")
+
+	payload := &UserGroupExpirePayload{TriggerTime: time.Now().Unix()}
+	data, _ := json.Marshal(payload) //nolint:errcheck // 序列化简单结构不会失败
+	task := asynq.NewTask(pkgasynq.TypeUserGroupExpire, data)
+
+	_, err := t.taskManager.EnqueueContext(ctx, task, asynq.Queue(pkgasynq.QueueLow))
+	if err != nil {
+		t.logger.Error("提交立即清理任务失败", zap.Error(err))
+	}
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent/user"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+func TestUserGroupPermissionExpiry(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresIn time.Duration // 0表示永久成员，负数表示已过期
+		want      bool
+	}{
+		{name: "永久成员", want: true},
+		{name: "成员资格未过期", expiresIn: time.Hour, want: true},
+		{name: "成员资格已过期", expiresIn: -time.Minute, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			svc := NewUserGroupService(db, newTestCache(t), zap.NewNop())
+			g := db.UserGroup.Create().SetName("VIP").SetPermissions([]string{satoken.PermCommentPin}).SaveX(t.Context())
+			u := newTestUser(t, db, "member", "member@example.com")
+			create := db.UserGroupMember.Create().SetGroupID(g.ID).SetUserID(u.ID)
+			if tt.expiresIn != 0 {
+				create = create.SetExpiresAt(time.Now().Add(tt.expiresIn))
+			}
+			create.ExecX(t.Context())
+
+			got, err := svc.HasPermission(t.Context(), u.ID, satoken.PermCommentPin)
+			if err != nil {
+				t.Fatalf("检查权限失败: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("置顶评论权限应为 %v，实际 %v", tt.want, got)
+			}
+			// 身份默认权限不受用户组成员资格影响
+			if ok, err := svc.HasPermission(t.Context(), u.ID, satoken.PermPostCreate); err != nil || !ok {
+				t.Fatalf("应保留身份默认的发帖权限: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserPermissionCacheTTLBoundByMembershipExpiry(t *testing.T) {
+	db := newTestDB(t)
+	cacheService := newTestCache(t)
+	svc := NewUserGroupService(db, cacheService, zap.NewNop())
+	g := db.UserGroup.Create().SetName("VIP").SetPermissions([]string{satoken.PermCommentPin}).SaveX(t.Context())
+	u := newTestUser(t, db, "member", "member@example.com")
+	if err := svc.AddGroupMember(t.Context(), schema.UserGroupMemberAddRequest{GroupID: g.ID, UserID: u.ID, Duration: 30}); err != nil {
+		t.Fatalf("添加用户组成员失败: %v", err)
+	}
+
+	if ok, err := svc.HasPermission(t.Context(), u.ID, satoken.PermCommentPin); err != nil || !ok {
+		t.Fatalf("成员资格有效期内应拥有权限: %v", err)
+	}
+	ttl, err := cacheService.TTL(t.Context(), fmt.Sprintf(userPermissionCacheKey, u.ID))
+	if err != nil {
+		t.Fatalf("获取权限缓存有效期失败: %v", err)
+	}
+	if ttl <= 0 || ttl > 30 {
+		t.Fatalf("权限缓存有效期不应超过成员资格剩余有效期，实际 %d 秒", ttl)
+	}
+}
+
+func TestUserPermissionCacheInvalidation(t *testing.T) {
+	db := newTestDB(t)
+	svc := NewUserGroupService(db, newTestCache(t), zap.NewNop())
+	g := db.UserGroup.Create().SetName("VIP").SetPermissions([]string{satoken.PermCommentPin}).SaveX(t.Context())
+	u := newTestUser(t, db, "member", "member@example.com")
+	member := schema.UserGroupMemberRequest{GroupID: g.ID, UserID: u.ID}
+	noPermissions := []string{}
+
+	steps := []struct {
+		name  string
+		apply func() error
+		want  bool
+	}{
+		{name: "加入用户组", apply: func() error {
+			return svc.AddGroupMember(t.Context(), schema.UserGroupMemberAddRequest{GroupID: g.ID, UserID: u.ID})
+		}, want: true},
+		{name: "移除用户组权限", apply: func() error {
+			_, err := svc.UpdateGroup(t.Context(), schema.UserGroupUpdateRequest{ID: g.ID, Permissions: &noPermissions})
+			return err
+		}, want: false},
+		{name: "恢复用户组权限", apply: func() error {
+			perms := []string{satoken.PermCommentPin}
+			_, err := svc.UpdateGroup(t.Context(), schema.UserGroupUpdateRequest{ID: g.ID, Permissions: &perms})
+			return err
+		}, want: true},
+		{name: "移出用户组", apply: func() error { return svc.RemoveGroupMember(t.Context(), member) }, want: false},
+	}
+
+	// 先写入未加入用户组时的权限缓存
+	if ok, err := svc.HasPermission(t.Context(), u.ID, satoken.PermCommentPin); err != nil || ok {
+		t.Fatalf("加入用户组前不应拥有权限: %v", err)
+	}
+	for _, step := range steps {
+		if err := step.apply(); err != nil {
+			t.Fatalf("%s失败: %v", step.name, err)
+		}
+		got, err := svc.HasPermission(t.Context(), u.ID, satoken.PermCommentPin)
+		if err != nil {
+			t.Fatalf("检查权限失败: %v", err)
+		}
+		if got != step.want {
+			t.Fatalf("%s后置顶评论权限应为 %v，实际 %v", step.name, step.want, got)
+		}
+	}
+}
+
+func TestPinCommentScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    *schema.CategoryPermissionRule
+		pinner  string
+		wantErr bool
+	}{
+		{name: "楼主可以置顶", pinner: "owner"},
+		{name: "父版块的版主可以置顶", pinner: "moderator"},
+		{name: "其他用户不能置顶", pinner: "other", wantErr: true},
+		{name: "无权查看版块时楼主也不能置顶", rule: &schema.CategoryPermissionRule{Action: "View", MinRole: "Admin"}, pinner: "owner", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			cacheService := newTestCache(t)
+			svc := NewCommentService(db, cacheService, nil, nil, nil, zap.NewNop())
+			parent := newTestCategory(t, db, "parent", 0)
+			c := newTestCategory(t, db, "board", parent.ID)
+			if tt.rule != nil {
+				setTestCategoryRules(t, db, cacheService, c.ID, *tt.rule)
+			}
+			pinners := map[string]int{
+				"owner":     newTestUserWith(t, db, user.RoleUser, 0),
+				"moderator": newTestUserWith(t, db, user.RoleModerator, 0),
+				"other":     newTestUserWith(t, db, user.RoleUser, 0),
+			}
+			db.CategoryModerator.Create().SetCategoryID(parent.ID).SetUserID(pinners["moderator"]).ExecX(t.Context())
+			p := db.Post.Create().SetUserID(pinners["owner"]).SetCategoryID(c.ID).SetTitle("帖子").SetContent("内容").SaveX(t.Context())
+			cm := db.Comment.Create().SetPostID(p.ID).SetUserID(pinners["other"]).SetContent("评论").SaveX(t.Context())
+
+			err := svc.PinComment(t.Context(), pinners[tt.pinner], schema.CommentPinUpdateRequest{ID: cm.ID, IsPinned: true})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+			if got := db.Comment.GetX(t.Context(), cm.ID).IsPinned; got != !tt.wantErr {
+				t.Fatalf("评论置顶状态应为 %v，实际 %v", !tt.wantErr, got)
+			}
+		})
+	}
+}
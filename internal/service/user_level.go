@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/post"
+	"github.com/PokeForum/PokeForum/ent/user"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+// defaultLevelTable 未配置等级表时使用的默认等级表，默认不限制任何权益
+var defaultLevelTable = []schema.LevelItem{
+	{Name: "新手", Threshold: 0, SignatureLinks: true},
+	{Name: "见习", Threshold: 100, SignatureLinks: true},
+	{Name: "正式", Threshold: 500, SignatureLinks: true},
+	{Name: "资深", Threshold: 2000, SignatureLinks: true},
+	{Name: "元老", Threshold: 10000, SignatureLinks: true},
+}
+
+// parseLevelTable 解析等级表配置，配置为空或无法解析时使用默认等级表
+func parseLevelTable(value string) []schema.LevelItem {
+	if value == "" {
+		return defaultLevelTable
+	}
+	var table []schema.LevelItem
+	if err := json.Unmarshal([]byte(value), &table); err != nil || len(table) == 0 {
+		return defaultLevelTable
+	}
+	return table
+}
+
+// validateLevelTable 校验等级表，第一个等级的阈值必须为0且阈值严格递增
+func validateLevelTable(table []schema.LevelItem) error {
+	if len(table) == 0 {
+		return errors.New("等级表不能为空")
+	}
+	if table[0].Threshold != 0 {
+		return errors.New("第一个等级的经验值阈值必须为0")
+	}
+	for i := 1; i < len(table); i++ {
+		if table[i].Threshold <= table[i-1].Threshold {
+			return errors.New("等级的经验值阈值必须严格递增")
+		}
+	}
+	return nil
+}
+
+// loadLevelTable 读取等级表，读取失败时使用默认等级表
+func loadLevelTable(ctx context.Context, settings ISettingsService, logger *zap.Logger) []schema.LevelItem {
+	value, err := settings.GetSettingByKey(ctx, _const.LevelTable, "")
+	if err != nil {
+		logger.Warn("读取等级表失败，使用默认等级表", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return defaultLevelTable
+	}
+	return parseLevelTable(value)
+}
+
+// levelIndex 计算经验值对应的等级下标
+func levelIndex(table []schema.LevelItem, experience int) int {
+	idx := 0
+	for i, item := range table {
+		if experience >= item.Threshold {
+			idx = i
+		}
+	}
+	return idx
+}
+
+// levelOf 计算经验值对应的等级，等级从1开始
+func levelOf(table []schema.LevelItem, experience int) int {
+	return levelIndex(table, experience) + 1
+}
+
+// levelPerks 获取经验值对应等级的权益配置
+func levelPerks(table []schema.LevelItem, experience int) schema.LevelItem {
+	return table[levelIndex(table, experience)]
+}
+
+// buildUserLevelBrief 构建用户等级摘要
+func buildUserLevelBrief(table []schema.LevelItem, experience int) *schema.UserLevelBrief {
+	idx := levelIndex(table, experience)
+	return &schema.UserLevelBrief{
+		Level: idx + 1,
+		Name:  table[idx].Name,
+		Icon:  table[idx].Icon,
+	}
+}
+
+// buildUserLevelInfo 构建用户等级详情，包含升级进度
+func buildUserLevelInfo(table []schema.LevelItem, experience int) *schema.UserLevelInfo {
+	idx := levelIndex(table, experience)
+	info := &schema.UserLevelInfo{
+		Level:            idx + 1,
+		Name:             table[idx].Name,
+		Icon:             table[idx].Icon,
+		Experience:       experience,
+		CurrentThreshold: table[idx].Threshold,
+	}
+	if idx == len(table)-1 {
+		info.IsMaxLevel = true
+		info.Progress = 100
+		return info
+	}
+	info.NextThreshold = table[idx+1].Threshold
+	info.Progress = (experience - info.CurrentThreshold) * 100 / (info.NextThreshold - info.CurrentThreshold)
+	return info
+}
+
+// getUserLevelPerks 获取用户当前等级的权益配置
+func getUserLevelPerks(ctx context.Context, db *ent.Client, settings ISettingsService, logger *zap.Logger, userID int) (schema.LevelItem, error) {
+	u, err := db.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldID, user.FieldExperience).
+		Only(ctx)
+	if err != nil {
+		return schema.LevelItem{}, fmt.Errorf("获取用户信息失败: %w", err)
+	}
+	return levelPerks(loadLevelTable(ctx, settings, logger), u.Experience), nil
+}
+
+// checkDailyPostLimit 检查用户今日发帖数是否达到等级上限，草稿不计入
+func checkDailyPostLimit(ctx context.Context, db *ent.Client, settings ISettingsService, logger *zap.Logger, userID int) error {
+	perks, err := getUserLevelPerks(ctx, db, settings, logger, userID)
+	if err != nil {
+		return err
+	}
+	if perks.DailyPostLimit <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	count, err := db.Post.Query().
+		Where(
+			post.UserIDEQ(userID),
+			post.StatusNEQ(post.StatusDraft),
+			post.CreatedAtGTE(startOfDay),
+		).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("统计今日发帖数失败: %w", err)
+	}
+	if count >= perks.DailyPostLimit {
+		return fmt.Errorf("您当前等级每日最多发布%d篇帖子", perks.DailyPostLimit)
+	}
+	return nil
+}
+
+// recordLevelUp 经验值变动后检测等级提升并写入等级变动记录
+func recordLevelUp(ctx context.Context, db *ent.Client, table []schema.LevelItem, userID, beforeExperience, afterExperience int, relatedType string) (bool, error) {
+	fromLevel := levelOf(table, beforeExperience)
+	toLevel := levelOf(table, afterExperience)
+	if toLevel <= fromLevel {
+		return false, nil
+	}
+
+	if err := db.UserLevelLog.Create().
+		SetUserID(userID).
+		SetFromLevel(fromLevel).
+		SetToLevel(toLevel).
+		SetLevelName(table[toLevel-1].Name).
+		SetExperience(afterExperience).
+		SetRelatedType(relatedType).
+		Exec(ctx); err != nil {
+		return true, fmt.Errorf("记录等级变动失败: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/userlevellog"
+	_const "github.com/PokeForum/PokeForum/internal/consts"
+)
+
+func TestLevelOf(t *testing.T) {
+	tests := []struct {
+		experience int
+		want       int
+	}{
+		{experience: 0, want: 1},
+		{experience: 99, want: 1},
+		{experience: 100, want: 2},
+		{experience: 499, want: 2},
+		{experience: 500, want: 3},
+		{experience: 10000, want: 5},
+		{experience: 999999, want: 5},
+	}
+
+	for _, tt := range tests {
+		if got := levelOf(defaultLevelTable, tt.experience); got != tt.want {
+			t.Fatalf("经验值 %d 的等级应为 %d，实际 %d", tt.experience, tt.want, got)
+		}
+	}
+}
+
+func TestBuildUserLevelInfoProgress(t *testing.T) {
+	info := buildUserLevelInfo(defaultLevelTable, 300)
+	if info.Level != 2 || info.CurrentThreshold != 100 || info.NextThreshold != 500 || info.Progress != 50 {
+		t.Fatalf("等级详情不正确: %+v", info)
+	}
+
+	info = buildUserLevelInfo(defaultLevelTable, 20000)
+	if !info.IsMaxLevel || info.Progress != 100 || info.NextThreshold != 0 {
+		t.Fatalf("满级时应标记为最高等级: %+v", info)
+	}
+}
+
+func TestParseLevelTable(t *testing.T) {
+	if got := parseLevelTable(""); len(got) != len(defaultLevelTable) {
+		t.Fatal("未配置等级表时应使用默认等级表")
+	}
+	if got := parseLevelTable("not json"); len(got) != len(defaultLevelTable) {
+		t.Fatal("等级表无法解析时应使用默认等级表")
+	}
+	got := parseLevelTable(`[{"name":"一级","threshold":0},{"name":"二级","threshold":10,"daily_post_limit":3}]`)
+	if len(got) != 2 || got[1].Name != "二级" || got[1].DailyPostLimit != 3 {
+		t.Fatalf("等级表解析结果不正确: %+v", got)
+	}
+
+	if err := validateLevelTable(got); err != nil {
+		t.Fatalf("合法等级表校验失败: %v", err)
+	}
+	if err := validateLevelTable(parseLevelTable(`[{"name":"一级","threshold":5}]`)); err == nil {
+		t.Fatal("第一个等级阈值不为0时应校验失败")
+	}
+	if err := validateLevelTable(parseLevelTable(`[{"name":"一级","threshold":0},{"name":"二级","threshold":0}]`)); err == nil {
+		t.Fatal("阈值未严格递增时应校验失败")
+	}
+}
+
+func TestRecordLevelUp(t *testing.T) {
+	tests := []struct {
+		name      string
+		before    int
+		after     int
+		wantUp    bool
+		wantFrom  int
+		wantTo    int
+		wantLevel string
+	}{
+		{name: "未跨越等级阈值", before: 10, after: 99},
+		{name: "经验值减少", before: 600, after: 50},
+		{name: "恰好达到阈值", before: 99, after: 100, wantUp: true, wantFrom: 1, wantTo: 2, wantLevel: "见习"},
+		{name: "一次提升多个等级只记录一条", before: 50, after: 2500, wantUp: true, wantFrom: 1, wantTo: 4, wantLevel: "资深"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			u := newTestUser(t, db, "member", "member@example.com")
+
+			up, err := recordLevelUp(t.Context(), db, defaultLevelTable, u.ID, tt.before, tt.after, "signin")
+			if err != nil {
+				t.Fatalf("检测等级提升失败: %v", err)
+			}
+			if up != tt.wantUp {
+				t.Fatalf("等级提升应为 %v，实际 %v", tt.wantUp, up)
+			}
+
+			logs := db.UserLevelLog.Query().Where(userlevellog.UserIDEQ(u.ID)).AllX(t.Context())
+			if !tt.wantUp {
+				if len(logs) != 0 {
+					t.Fatalf("未提升等级时不应写入记录，实际 %d 条", len(logs))
+				}
+				return
+			}
+			if len(logs) != 1 {
+				t.Fatalf("应写入 1 条等级变动记录，实际 %d 条", len(logs))
+			}
+			assertLevelLog(t, logs[0], tt.wantFrom, tt.wantTo, tt.wantLevel, tt.after)
+		})
+	}
+}
+
+func TestSigninUpdateUserBalanceRecordsLevelUp(t *testing.T) {
+	db := newTestDB(t)
+	cacheService := newTestCache(t)
+	settings := NewSettingsService(db, cacheService, zap.NewNop())
+	setTestSetting(t, db, _const.LevelTable, `[{"name":"一级","threshold":0},{"name":"二级","threshold":10}]`)
+	svc := &SigninService{db: db, cache: cacheService, logger: zap.NewNop(), settingsService: settings}
+	u := newTestUser(t, db, "member", "member@example.com")
+
+	if err := svc.updateUserBalance(t.Context(), int64(u.ID), 1, 5); err != nil {
+		t.Fatalf("更新用户余额失败: %v", err)
+	}
+	if n := db.UserLevelLog.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("未达到阈值时不应写入等级变动记录，实际 %d 条", n)
+	}
+
+	if err := svc.updateUserBalance(t.Context(), int64(u.ID), 1, 5); err != nil {
+		t.Fatalf("更新用户余额失败: %v", err)
+	}
+	logs := db.UserLevelLog.Query().AllX(t.Context())
+	if len(logs) != 1 {
+		t.Fatalf("达到阈值时应写入 1 条等级变动记录，实际 %d 条", len(logs))
+	}
+	assertLevelLog(t, logs[0], 1, 2, "二级", 10)
+	if logs[0].RelatedType != "signin" {
+		t.Fatalf("等级变动来源应为 signin，实际 %s", logs[0].RelatedType)
+	}
+}
+
+// assertLevelLog 校验等级变动记录
+func assertLevelLog(t *testing.T, log *ent.UserLevelLog, from, to int, name string, experience int) {
+	t.Helper()
+
+	if log.FromLevel != from || log.ToLevel != to || log.LevelName != name || log.Experience != experience {
+		t.Fatalf("等级变动记录应为 %d→%d %s(%d)，实际 %d→%d %s(%d)",
+			from, to, name, experience, log.FromLevel, log.ToLevel, log.LevelName, log.Experience)
+	}
+}
@@ -14,10 +14,12 @@ import (
 	"github.com/PokeForum/PokeForum/ent/category"
 	"github.com/PokeForum/PokeForum/ent/categorymoderator"
 	"github.com/PokeForum/PokeForum/ent/comment"
+	"github.com/PokeForum/PokeForum/ent/notification"
 	"github.com/PokeForum/PokeForum/ent/post"
 	"github.com/PokeForum/PokeForum/ent/user"
 	"github.com/PokeForum/PokeForum/ent/userbalancelog"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
 	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
 	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
 	"github.com/PokeForum/PokeForum/internal/schema"
@@ -56,21 +58,25 @@ type IUserManageService interface {
 	BanUser(ctx context.Context, req schema.UserBanRequest) error
 	// UnbanUser 解封用户
 	UnbanUser(ctx context.Context, req schema.UserUnbanRequest) error
+	// CheckUserOperable 校验操作者是否有权操作指定用户
+	CheckUserOperable(ctx context.Context, operatorID int, userID int) error
 }
 
 // UserManageService 用户管理服务实现
 type UserManageService struct {
-	db     *ent.Client
-	cache  cache.ICacheService
-	logger *zap.Logger
+	db               *ent.Client
+	cache            cache.ICacheService
+	logger           *zap.Logger
+	notificationTask *NotificationTask
 }
 
 // NewUserManageService 创建用户管理服务实例
-func NewUserManageService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IUserManageService {
+func NewUserManageService(db *ent.Client, cacheService cache.ICacheService, notificationTask *NotificationTask, logger *zap.Logger) IUserManageService {
 	return &UserManageService{
-		db:     db,
-		cache:  cacheService,
-		logger: logger,
+		db:               db,
+		cache:            cacheService,
+		logger:           logger,
+		notificationTask: notificationTask,
 	}
 }
 
@@ -103,6 +109,21 @@ func (s *UserManageService) checkOperatorPermission(ctx context.Context, operato
 	return errors.New("无操作权限")
 }
 
+// CheckUserOperable 校验操作者是否有权操作指定用户
+func (s *UserManageService) CheckUserOperable(ctx context.Context, operatorID int, userID int) error {
+	// 检查用户是否存在
+	u, err := s.db.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("用户不存在")
+		}
+		s.logger.Error("获取用户信息失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("获取用户信息失败: %w", err)
+	}
+
+	return s.checkOperatorPermission(ctx, operatorID, u.Role)
+}
+
 // GetUserList 获取用户列表
 func (s *UserManageService) GetUserList(ctx context.Context, req schema.UserListRequest) (*schema.UserListResponse, error) {
 	s.logger.Info("获取用户列表", tracing.WithTraceIDField(ctx))
@@ -370,6 +391,12 @@ func (s *UserManageService) UpdateUserRole(ctx context.Context, req schema.UserR
 		}
 	}
 
+	// 同步会话中的身份并清理权限缓存，使身份变更立即生效
+	if err = stputil.SetRoles(req.ID, satoken.GetUserRole(req.Role)); err != nil {
+		s.logger.Warn("刷新用户身份失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+	clearUserPermissionCache(ctx, s.cache, s.logger, req.ID)
+
 	s.logger.Info("用户身份更新成功", zap.Int("user_id", req.ID), zap.String("reason", req.Reason), tracing.WithTraceIDField(ctx))
 	return nil
 }
@@ -676,6 +703,23 @@ func (s *UserManageService) createBalanceLog(ctx context.Context, userID int, lo
 		return fmt.Errorf("创建余额变动记录失败: %w", err)
 	}
 
+	// 通知用户余额变动，失败不影响主流程
+	balanceName := "积分"
+	if logType == userbalancelog.TypeCurrency {
+		balanceName = "货币"
+	}
+	content := fmt.Sprintf("%s %+d，当前余额 %d", balanceName, amount, afterAmount)
+	if reason != "" {
+		content = fmt.Sprintf("%s，原因：%s", content, reason)
+	}
+	if err = s.notificationTask.Submit(ctx, NotificationTaskPayload{
+		Type:    notification.TypeBalance,
+		UserIDs: []int{userID},
+		Content: content,
+	}); err != nil {
+		s.logger.Warn("提交余额变动通知失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
 	return nil
 }
 
@@ -1000,6 +1044,10 @@ func (s *UserManageService) BanUser(ctx context.Context, req schema.UserBanReque
 	if err = stputil.Kickout(req.ID); err != nil {
 		s.logger.Warn("stputil 踢出设备下线失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 	}
+	// 清理登录会话记录
+	if _, err = s.cache.Del(ctx, fmt.Sprintf(userSessionsKeyFormat, req.ID)); err != nil {
+		s.logger.Warn("清理登录会话记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
 
 	s.logger.Info("用户封禁成功", zap.Int("user_id", req.ID), zap.String("reason", req.Reason), tracing.WithTraceIDField(ctx))
 	return nil
@@ -14,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/attachment"
 	"github.com/PokeForum/PokeForum/ent/category"
 	"github.com/PokeForum/PokeForum/ent/comment"
 	"github.com/PokeForum/PokeForum/ent/post"
@@ -22,6 +23,8 @@ import (
 	_const "github.com/PokeForum/PokeForum/internal/consts"
 	"github.com/PokeForum/PokeForum/internal/pkg/cache"
 	smtp "github.com/PokeForum/PokeForum/internal/pkg/email"
+	"github.com/PokeForum/PokeForum/internal/pkg/markdown"
+	"github.com/PokeForum/PokeForum/internal/pkg/storage"
 	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
 	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
 	"github.com/PokeForum/PokeForum/internal/schema"
@@ -49,7 +52,6 @@ type IUserProfileService interface {
 	VerifyEmail(ctx context.Context, userID int, req schema.EmailVerifyRequest) (*schema.EmailVerifyResponse, error)
 	// CheckUsernameUpdatePermission 检查用户名修改权限(每七日可操作一次)
 	CheckUsernameUpdatePermission(ctx context.Context, userID int) (bool, error)
-	// TODO 粉丝列表
 }
 
 // UserProfileService 用户个人中心服务实现
@@ -59,16 +61,20 @@ type UserProfileService struct {
 	logger            *zap.Logger
 	settings          ISettingsService
 	userManageService IUserManageService
+	storage           storage.IStorage
+	imageTask         *ImageProcessTask
 }
 
 // NewUserProfileService 创建用户个人中心服务实例
-func NewUserProfileService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger, settingsService ISettingsService, userManageService IUserManageService) IUserProfileService {
+func NewUserProfileService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger, settingsService ISettingsService, userManageService IUserManageService, store storage.IStorage, imageTask *ImageProcessTask) IUserProfileService {
 	return &UserProfileService{
 		db:                db,
 		cache:             cacheService,
 		logger:            logger,
 		settings:          settingsService,
 		userManageService: userManageService,
+		storage:           store,
+		imageTask:         imageTask,
 	}
 }
 
@@ -101,18 +107,43 @@ func (s *UserProfileService) GetProfileOverview(ctx context.Context, userID int,
 		commentCount = 0
 	}
 
+	followerCount, followingCount, err := NewFollowService(s.db, s.cache, s.logger).GetFollowCounts(ctx, userData.ID)
+	if err != nil {
+		s.logger.Error("查询用户关注数失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
+	badges, err := NewBadgeService(s.db, s.logger).GetUserBadges(ctx, userData.ID)
+	if err != nil {
+		s.logger.Error("查询用户徽章失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		badges = &schema.UserBadgeListResponse{List: []schema.UserBadgeItem{}}
+	}
+
+	// 计算用户等级，当前等级不允许签名链接时移除签名中的链接
+	levelTable := loadLevelTable(ctx, s.settings, s.logger)
+	signatureHTML := renderMarkdown(ctx, s.cache, s.logger, userData.Signature)
+	if !levelPerks(levelTable, userData.Experience).SignatureLinks {
+		signatureHTML = markdown.StripLinks(signatureHTML)
+	}
+
 	// 构建响应数据
 	result := &schema.UserProfileOverviewResponse{
-		ID:           userData.ID,
-		Username:     userData.Username,
-		Avatar:       userData.Avatar,
-		Signature:    userData.Signature,
-		Readme:       userData.Readme,
-		PostCount:    postCount,
-		CommentCount: commentCount,
-		Status:       string(userData.Status),
-		Role:         string(userData.Role),
-		CreatedAt:    userData.CreatedAt.Format(time_tools.DateTimeFormat),
+		ID:             userData.ID,
+		Username:       userData.Username,
+		Avatar:         userData.Avatar,
+		Signature:      userData.Signature,
+		Readme:         userData.Readme,
+		SignatureHTML:  signatureHTML,
+		ReadmeHTML:     renderMarkdown(ctx, s.cache, s.logger, userData.Readme),
+		PostCount:      postCount,
+		CommentCount:   commentCount,
+		FollowerCount:  followerCount,
+		FollowingCount: followingCount,
+		Status:         string(userData.Status),
+		Role:           string(userData.Role),
+		Experience:     userData.Experience,
+		Level:          buildUserLevelInfo(levelTable, userData.Experience),
+		Badges:         badges.List,
+		CreatedAt:      userData.CreatedAt.Format(time_tools.DateTimeFormat),
 	}
 
 	// 只有本人才能看到敏感数据
@@ -583,20 +614,51 @@ func (s *UserProfileService) UpdatePassword(ctx context.Context, userID int, req
 
 // UpdateAvatar 修改头像
 func (s *UserProfileService) UpdateAvatar(ctx context.Context, userID int, req schema.UserUpdateAvatarRequest) (*schema.UserUpdateAvatarResponse, error) {
-	s.logger.Info("修改头像", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+	s.logger.Info("修改头像", zap.Int("user_id", userID), zap.Int("attachment_id", req.AttachmentID), tracing.WithTraceIDField(ctx))
+
+	// 使用已上传的图片附件时先展示原图，裁剪完成后替换为固定尺寸的头像
+	avatarURL := req.AvatarURL
+	var avatarAttachment *ent.Attachment
+	if req.AttachmentID != 0 {
+		var err error
+		avatarAttachment, err = s.db.Attachment.Query().
+			Where(attachment.IDEQ(req.AttachmentID), attachment.UserIDEQ(userID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errors.New("附件不存在")
+			}
+			s.logger.Error("获取附件失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+			return nil, fmt.Errorf("获取附件失败: %w", err)
+		}
+		if !isInlineImage(avatarAttachment.MimeType) {
+			return nil, errors.New("头像仅支持JPEG、PNG、GIF、WebP格式的图片")
+		}
+		avatarURL = storageURL(s.storage, avatarAttachment.StorageKey)
+	}
 
 	// 更新头像
 	_, err := s.db.User.UpdateOneID(userID).
-		SetAvatar(req.AvatarURL).
+		SetAvatar(avatarURL).
 		Save(ctx)
 	if err != nil {
 		s.logger.Error("更新头像失败", zap.Error(err), tracing.WithTraceIDField(ctx))
 		return nil, fmt.Errorf("更新头像失败: %w", err)
 	}
 
+	processing := false
+	if avatarAttachment != nil {
+		if err = s.imageTask.SubmitAvatarTask(ctx, userID, avatarAttachment.ID, avatarURL); err != nil {
+			s.logger.Warn("提交头像裁剪任务失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		} else {
+			processing = true
+		}
+	}
+
 	result := &schema.UserUpdateAvatarResponse{
-		Success:   true,
-		AvatarURL: req.AvatarURL,
+		Success:    true,
+		AvatarURL:  avatarURL,
+		Processing: processing,
 	}
 
 	s.logger.Info("头像修改成功", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
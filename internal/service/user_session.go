@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	satoken "github.com/PokeForum/PokeForum/internal/pkg/sa-token"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+const (
+	// userSessionsKeyFormat 用户登录会话哈希表缓存键，field为会话ID，value为会话信息
+	userSessionsKeyFormat = "user:sessions:%d"
+	// userSessionActiveKeyFormat 会话活跃时间刷新节流键
+	userSessionActiveKeyFormat = "user:session:active:%s"
+	// userSessionExpire 会话记录有效期（秒），与Token有效期保持一致
+	userSessionExpire = satoken.TokenTimeout
+	// userSessionTouchInterval 最后活跃时间刷新间隔（秒）
+	userSessionTouchInterval = 60
+)
+
+// IUserSessionService 登录会话服务接口
+type IUserSessionService interface {
+	// RecordSession 记录新签发的登录会话
+	RecordSession(ctx context.Context, userID int, token string, device string, ip string, country string, city string) error
+	// TouchSession 刷新会话最后活跃时间与IP，IP变化时同时更新归属地
+	TouchSession(ctx context.Context, token string, ip string, country string, city string) error
+	// RemoveSession 移除会话记录，用于用户主动退出登录
+	RemoveSession(ctx context.Context, userID int, token string) error
+	// GetSessions 获取用户的有效会话列表
+	GetSessions(ctx context.Context, userID int, currentToken string) ([]schema.UserSessionItem, error)
+	// RevokeSession 注销用户的指定会话
+	RevokeSession(ctx context.Context, userID int, sessionID string) error
+	// RevokeOtherSessions 注销除当前会话外的所有会话
+	RevokeOtherSessions(ctx context.Context, userID int, currentToken string) (*schema.UserSessionRevokeResponse, error)
+	// RevokeAllSessions 注销用户的所有会话
+	RevokeAllSessions(ctx context.Context, userID int) (*schema.UserSessionRevokeResponse, error)
+}
+
+// UserSessionService 登录会话服务实现
+type UserSessionService struct {
+	cache  cache.ICacheService
+	logger *zap.Logger
+}
+
+// NewUserSessionService 创建登录会话服务实例
+func NewUserSessionService(cacheService cache.ICacheService, logger *zap.Logger) IUserSessionService {
+	return &UserSessionService{
+		cache:  cacheService,
+		logger: logger,
+	}
+}
+
+// userSession 会话信息，存储于缓存
+type userSession struct {
+	Token      string `json:"token"`
+	Device     string `json:"device"`
+	IPAddress  string `json:"ip_address"`
+	Country    string `json:"country"`
+	City       string `json:"city"`
+	LoginAt    int64  `json:"login_at"`
+	LastSeenAt int64  `json:"last_seen_at"`
+}
+
+// RecordSession 记录新签发的登录会话
+func (s *UserSessionService) RecordSession(ctx context.Context, userID int, token string, device string, ip string, country string, city string) error {
+	s.logger.Info("记录登录会话", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	now := time.Now().Unix()
+	session := userSession{
+		Token:      token,
+		Device:     device,
+		IPAddress:  ip,
+		Country:    country,
+		City:       city,
+		LoginAt:    now,
+		LastSeenAt: now,
+	}
+
+	if err := s.saveSession(ctx, userID, &session); err != nil {
+		return err
+	}
+
+	// 会话记录随最新登录续期
+	_, _ = s.cache.Expire(ctx, fmt.Sprintf(userSessionsKeyFormat, userID), userSessionExpire) //nolint:errcheck // 续期失败不影响主流程
+
+	return nil
+}
+
+// TouchSession 刷新会话最后活跃时间与IP，IP变化时同时更新归属地
+func (s *UserSessionService) TouchSession(ctx context.Context, token string, ip string, country string, city string) error {
+	// 先校验Token，避免无效Token写入节流键
+	loginID, err := stputil.GetLoginID(token)
+	if err != nil {
+		// Token无效时无需刷新
+		return nil
+	}
+	userID, err := strconv.Atoi(loginID)
+	if err != nil {
+		return nil
+	}
+
+	sessionID := sessionIDFromToken(token)
+
+	// 节流：刷新间隔内不重复写入
+	activeKey := fmt.Sprintf(userSessionActiveKeyFormat, sessionID)
+	exists, err := s.cache.Exists(ctx, activeKey)
+	if err != nil {
+		return fmt.Errorf("检查会话活跃状态失败: %w", err)
+	}
+	if exists {
+		return nil
+	}
+	if err = s.cache.SetEx(ctx, activeKey, "1", userSessionTouchInterval); err != nil {
+		return fmt.Errorf("写入会话活跃状态失败: %w", err)
+	}
+
+	session, err := s.getSession(ctx, userID, sessionID)
+	if err != nil || session == nil {
+		return err
+	}
+
+	session.LastSeenAt = time.Now().Unix()
+	if ip != "" && ip != session.IPAddress {
+		// 旧归属地属于之前的IP，以本次请求解析的归属地为准，无法解析时置空
+		session.IPAddress = ip
+		session.Country = country
+		session.City = city
+	}
+
+	return s.saveSession(ctx, userID, session)
+}
+
+// RemoveSession 移除会话记录
+func (s *UserSessionService) RemoveSession(ctx context.Context, userID int, token string) error {
+	s.logger.Info("移除登录会话", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	if _, err := s.cache.HDel(ctx, fmt.Sprintf(userSessionsKeyFormat, userID), sessionIDFromToken(token)); err != nil {
+		s.logger.Error("移除会话记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("移除会话记录失败: %w", err)
+	}
+	return nil
+}
+
+// GetSessions 获取用户的有效会话列表
+func (s *UserSessionService) GetSessions(ctx context.Context, userID int, currentToken string) ([]schema.UserSessionItem, error) {
+	s.logger.Info("获取登录会话列表", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	sessions, err := s.loadValidSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentID := ""
+	if currentToken != "" {
+		currentID = sessionIDFromToken(currentToken)
+	}
+
+	list := make([]schema.UserSessionItem, 0, len(sessions))
+	for sessionID, session := range sessions {
+		list = append(list, schema.UserSessionItem{
+			SessionID:  sessionID,
+			Device:     session.Device,
+			IPAddress:  session.IPAddress,
+			Country:    session.Country,
+			City:       session.City,
+			LoginAt:    time.Unix(session.LoginAt, 0).Format(time_tools.DateTimeFormat),
+			LastSeenAt: time.Unix(session.LastSeenAt, 0).Format(time_tools.DateTimeFormat),
+			Current:    sessionID == currentID,
+		})
+	}
+
+	// 按最后活跃时间倒序
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].LastSeenAt > list[j].LastSeenAt
+	})
+
+	return list, nil
+}
+
+// RevokeSession 注销用户的指定会话
+func (s *UserSessionService) RevokeSession(ctx context.Context, userID int, sessionID string) error {
+	s.logger.Info("注销登录会话", zap.Int("user_id", userID), zap.String("session_id", sessionID), tracing.WithTraceIDField(ctx))
+
+	session, err := s.getSession(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return errors.New("会话不存在或已失效")
+	}
+
+	return s.revoke(ctx, userID, sessionID, session)
+}
+
+// RevokeOtherSessions 注销除当前会话外的所有会话
+func (s *UserSessionService) RevokeOtherSessions(ctx context.Context, userID int, currentToken string) (*schema.UserSessionRevokeResponse, error) {
+	s.logger.Info("注销其他登录会话", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	return s.revokeAll(ctx, userID, sessionIDFromToken(currentToken))
+}
+
+// RevokeAllSessions 注销用户的所有会话
+func (s *UserSessionService) RevokeAllSessions(ctx context.Context, userID int) (*schema.UserSessionRevokeResponse, error) {
+	s.logger.Info("注销全部登录会话", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	return s.revokeAll(ctx, userID, "")
+}
+
+// revokeAll 注销用户除exceptID外的所有会话
+func (s *UserSessionService) revokeAll(ctx context.Context, userID int, exceptID string) (*schema.UserSessionRevokeResponse, error) {
+	sessions, err := s.loadValidSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := 0
+	for sessionID, session := range sessions {
+		if sessionID == exceptID {
+			continue
+		}
+		if err = s.revoke(ctx, userID, sessionID, session); err != nil {
+			return nil, err
+		}
+		revoked++
+	}
+
+	return &schema.UserSessionRevokeResponse{Revoked: revoked}, nil
+}
+
+// revoke 使Token失效并删除会话记录
+func (s *UserSessionService) revoke(ctx context.Context, userID int, sessionID string, session *userSession) error {
+	if err := saGin.LogoutByToken(session.Token); err != nil {
+		s.logger.Warn("注销Token失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+	if _, err := s.cache.HDel(ctx, fmt.Sprintf(userSessionsKeyFormat, userID), sessionID); err != nil {
+		s.logger.Error("删除会话记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("删除会话记录失败: %w", err)
+	}
+	return nil
+}
+
+// loadValidSessions 读取用户会话并清理已失效的记录
+func (s *UserSessionService) loadValidSessions(ctx context.Context, userID int) (map[string]*userSession, error) {
+	key := fmt.Sprintf(userSessionsKeyFormat, userID)
+	values, err := s.cache.HGetAll(ctx, key)
+	if err != nil {
+		s.logger.Error("读取会话记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("读取会话记录失败: %w", err)
+	}
+
+	sessions := make(map[string]*userSession, len(values))
+	stale := make([]string, 0)
+	for sessionID, value := range values {
+		var session userSession
+		if err = json.Unmarshal([]byte(value), &session); err != nil {
+			stale = append(stale, sessionID)
+			continue
+		}
+		// Token已过期、已退出或被踢下线时清理记录
+		loginID, err := stputil.GetLoginID(session.Token)
+		if err != nil || loginID != strconv.Itoa(userID) {
+			stale = append(stale, sessionID)
+			continue
+		}
+		sessions[sessionID] = &session
+	}
+
+	if len(stale) > 0 {
+		_, _ = s.cache.HDel(ctx, key, stale...) //nolint:errcheck // 清理失效记录失败不影响主流程
+	}
+
+	return sessions, nil
+}
+
+// getSession 读取单个会话记录，不存在或已失效时返回nil
+func (s *UserSessionService) getSession(ctx context.Context, userID int, sessionID string) (*userSession, error) {
+	value, err := s.cache.HGet(ctx, fmt.Sprintf(userSessionsKeyFormat, userID), sessionID)
+	if err != nil {
+		s.logger.Error("读取会话记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("读取会话记录失败: %w", err)
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	var session userSession
+	if err = json.Unmarshal([]byte(value), &session); err != nil {
+		return nil, fmt.Errorf("解析会话记录失败: %w", err)
+	}
+	return &session, nil
+}
+
+// saveSession 写入会话记录
+func (s *UserSessionService) saveSession(ctx context.Context, userID int, session *userSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("序列化会话记录失败: %w", err)
+	}
+
+	if err = s.cache.HSet(ctx, fmt.Sprintf(userSessionsKeyFormat, userID), sessionIDFromToken(session.Token), string(data)); err != nil {
+		s.logger.Error("保存会话记录失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("保存会话记录失败: %w", err)
+	}
+	return nil
+}
+
+// sessionIDFromToken 由Token计算会话ID，避免在接口中暴露Token本身
+func sessionIDFromToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:8])
+}
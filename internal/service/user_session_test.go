@@ -0,0 +1,164 @@
+package service
+
+import (
+	"testing"
+
+	saGin "github.com/click33/sa-token-go/integrations/gin"
+	"github.com/click33/sa-token-go/stputil"
+	"go.uber.org/zap"
+)
+
+// loginTestSessions 为用户签发多个登录Token并记录会话
+func loginTestSessions(t *testing.T, svc IUserSessionService, userID int, count int) []string {
+	t.Helper()
+
+	tokens := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		token, err := saGin.Login(userID, "device")
+		if err != nil {
+			t.Fatalf("签发登录Token失败: %v", err)
+		}
+		if err = svc.RecordSession(t.Context(), userID, token, "device", "10.0.0.1", "CN", "Shanghai"); err != nil {
+			t.Fatalf("记录登录会话失败: %v", err)
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+func TestUserSessionRevoke(t *testing.T) {
+	tests := []struct {
+		name        string
+		revoke      func(svc IUserSessionService, tokens []string) (int, error)
+		wantErr     bool
+		wantRevoked int
+		wantAlive   []int // 注销后仍然有效的Token下标
+	}{
+		{
+			name: "注销指定会话",
+			revoke: func(svc IUserSessionService, tokens []string) (int, error) {
+				return 1, svc.RevokeSession(t.Context(), 1, sessionIDFromToken(tokens[1]))
+			},
+			wantRevoked: 1,
+			wantAlive:   []int{0, 2},
+		},
+		{
+			name: "注销不存在的会话",
+			revoke: func(svc IUserSessionService, _ []string) (int, error) {
+				return 0, svc.RevokeSession(t.Context(), 1, "unknown")
+			},
+			wantErr:   true,
+			wantAlive: []int{0, 1, 2},
+		},
+		{
+			name: "不能注销其他用户的会话",
+			revoke: func(svc IUserSessionService, tokens []string) (int, error) {
+				return 0, svc.RevokeSession(t.Context(), 2, sessionIDFromToken(tokens[0]))
+			},
+			wantErr:   true,
+			wantAlive: []int{0, 1, 2},
+		},
+		{
+			name: "注销其他会话",
+			revoke: func(svc IUserSessionService, tokens []string) (int, error) {
+				result, err := svc.RevokeOtherSessions(t.Context(), 1, tokens[2])
+				if err != nil {
+					return 0, err
+				}
+				return result.Revoked, nil
+			},
+			wantRevoked: 2,
+			wantAlive:   []int{2},
+		},
+		{
+			name: "注销全部会话",
+			revoke: func(svc IUserSessionService, _ []string) (int, error) {
+				result, err := svc.RevokeAllSessions(t.Context(), 1)
+				if err != nil {
+					return 0, err
+				}
+				return result.Revoked, nil
+			},
+			wantRevoked: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestSaToken(t)
+			svc := NewUserSessionService(newTestCache(t), zap.NewNop())
+			tokens := loginTestSessions(t, svc, 1, 3)
+
+			revoked, err := tt.revoke(svc, tokens)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("期望错误 %v，实际 %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && revoked != tt.wantRevoked {
+				t.Fatalf("应注销 %d 个会话，实际 %d 个", tt.wantRevoked, revoked)
+			}
+
+			sessions, err := svc.GetSessions(t.Context(), 1, "")
+			if err != nil {
+				t.Fatalf("获取会话列表失败: %v", err)
+			}
+			if len(sessions) != len(tt.wantAlive) {
+				t.Fatalf("应剩余 %d 个会话，实际 %d 个", len(tt.wantAlive), len(sessions))
+			}
+
+			alive := make(map[int]bool, len(tt.wantAlive))
+			for _, i := range tt.wantAlive {
+				alive[i] = true
+			}
+			for i, token := range tokens {
+				_, err := stputil.GetLoginID(token)
+				if alive[i] && err != nil {
+					t.Fatalf("第 %d 个Token不应被注销: %v", i, err)
+				}
+				if !alive[i] && err == nil {
+					t.Fatalf("第 %d 个Token应已被注销", i)
+				}
+			}
+		})
+	}
+}
+
+func TestUserSessionTouchUpdatesLocation(t *testing.T) {
+	tests := []struct {
+		name        string
+		ip          string
+		country     string
+		city        string
+		wantIP      string
+		wantCountry string
+		wantCity    string
+	}{
+		{name: "IP未变化时保留归属地", ip: "10.0.0.1", wantIP: "10.0.0.1", wantCountry: "CN", wantCity: "Shanghai"},
+		{name: "IP变化时更新归属地", ip: "10.0.0.2", country: "JP", city: "Tokyo", wantIP: "10.0.0.2", wantCountry: "JP", wantCity: "Tokyo"},
+		{name: "IP变化但无法解析归属地时置空", ip: "10.0.0.3", wantIP: "10.0.0.3"},
+		{name: "未获取到IP时保持原样", ip: "", country: "JP", city: "Tokyo", wantIP: "10.0.0.1", wantCountry: "CN", wantCity: "Shanghai"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestSaToken(t)
+			svc := NewUserSessionService(newTestCache(t), zap.NewNop())
+			tokens := loginTestSessions(t, svc, 1, 1)
+
+			if err := svc.TouchSession(t.Context(), tokens[0], tt.ip, tt.country, tt.city); err != nil {
+				t.Fatalf("刷新会话失败: %v", err)
+			}
+
+			sessions, err := svc.GetSessions(t.Context(), 1, tokens[0])
+			if err != nil || len(sessions) != 1 {
+				t.Fatalf("获取会话列表失败: %v", err)
+			}
+			got := sessions[0]
+			if !got.Current {
+				t.Fatal("应标记为当前会话")
+			}
+			if got.IPAddress != tt.wantIP || got.Country != tt.wantCountry || got.City != tt.wantCity {
+				t.Fatalf("会话位置应为 %s %s %s，实际为 %s %s %s", tt.wantIP, tt.wantCountry, tt.wantCity, got.IPAddress, got.Country, got.City)
+			}
+		})
+	}
+}
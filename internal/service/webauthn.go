@@ -0,0 +1,489 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/oauthprovider"
+	"github.com/PokeForum/PokeForum/ent/webauthncredential"
+	"github.com/PokeForum/PokeForum/internal/pkg/cache"
+	"github.com/PokeForum/PokeForum/internal/pkg/time_tools"
+	"github.com/PokeForum/PokeForum/internal/pkg/tracing"
+	"github.com/PokeForum/PokeForum/internal/schema"
+	"github.com/PokeForum/PokeForum/internal/utils"
+)
+
+const (
+	// webAuthnRegisterSessionKeyFormat 注册仪式会话缓存键，按用户ID区分
+	webAuthnRegisterSessionKeyFormat = "webauthn:session:register:%d"
+	// webAuthnLoginSessionKeyFormat 登录仪式会话缓存键，按会话ID区分
+	webAuthnLoginSessionKeyFormat = "webauthn:session:login:%s"
+	// webAuthnSessionExpire 仪式会话有效期（秒）
+	webAuthnSessionExpire = 300
+	// webAuthnMaxCredentials 每个用户最多注册的凭证数量
+	webAuthnMaxCredentials = 10
+)
+
+// IWebAuthnService WebAuthn/Passkey服务接口
+type IWebAuthnService interface {
+	// BeginRegistration 生成凭证注册选项
+	BeginRegistration(ctx context.Context, userID int) (*schema.WebAuthnRegisterOptionsResponse, error)
+	// FinishRegistration 校验注册结果并保存凭证
+	FinishRegistration(ctx context.Context, userID int, req schema.WebAuthnRegisterRequest) (*schema.WebAuthnCredentialItem, error)
+	// BeginLogin 生成无密码登录选项
+	BeginLogin(ctx context.Context) (*schema.WebAuthnLoginOptionsResponse, error)
+	// FinishLogin 校验登录断言，返回登录用户
+	FinishLogin(ctx context.Context, req schema.WebAuthnLoginRequest) (*ent.User, error)
+	// GetCredentials 获取用户的凭证列表
+	GetCredentials(ctx context.Context, userID int) ([]schema.WebAuthnCredentialItem, error)
+	// DeleteCredential 删除用户的凭证
+	DeleteCredential(ctx context.Context, userID int, req schema.WebAuthnCredentialDeleteRequest) error
+}
+
+// WebAuthnService WebAuthn/Passkey服务实现
+type WebAuthnService struct {
+	db     *ent.Client
+	cache  cache.ICacheService
+	logger *zap.Logger
+}
+
+// NewWebAuthnService 创建WebAuthn服务实例
+func NewWebAuthnService(db *ent.Client, cacheService cache.ICacheService, logger *zap.Logger) IWebAuthnService {
+	return &WebAuthnService{
+		db:     db,
+		cache:  cacheService,
+		logger: logger,
+	}
+}
+
+// webAuthnUser 适配 webauthn.User 接口
+type webAuthnUser struct {
+	user        *ent.User
+	credentials []webauthn.Credential
+}
+
+// WebAuthnID 用户句柄，使用用户ID
+func (u *webAuthnUser) WebAuthnID() []byte {
+	return []byte(strconv.Itoa(u.user.ID))
+}
+
+// WebAuthnName 用户名
+func (u *webAuthnUser) WebAuthnName() string {
+	return u.user.Username
+}
+
+// WebAuthnDisplayName 显示名称
+func (u *webAuthnUser) WebAuthnDisplayName() string {
+	return u.user.Username
+}
+
+// WebAuthnCredentials 用户已注册的凭证
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
+
+// BeginRegistration 生成凭证注册选项
+func (s *WebAuthnService) BeginRegistration(ctx context.Context, userID int) (*schema.WebAuthnRegisterOptionsResponse, error) {
+	s.logger.Info("生成凭证注册选项", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	wa, err := s.newWebAuthn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	waUser, err := s.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(waUser.credentials) >= webAuthnMaxCredentials {
+		return nil, fmt.Errorf("最多只能注册%d个通行密钥", webAuthnMaxCredentials)
+	}
+
+	// 排除已注册的凭证，要求可发现凭证以支持无用户名登录
+	creation, session, err := wa.BeginRegistration(waUser,
+		webauthn.WithExclusions(webauthn.Credentials(waUser.credentials).CredentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		s.logger.Error("生成注册选项失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("生成注册选项失败: %w", err)
+	}
+
+	if err = s.saveSession(ctx, fmt.Sprintf(webAuthnRegisterSessionKeyFormat, userID), session); err != nil {
+		return nil, err
+	}
+
+	return &schema.WebAuthnRegisterOptionsResponse{
+		Options: creation,
+	}, nil
+}
+
+// FinishRegistration 校验注册结果并保存凭证
+func (s *WebAuthnService) FinishRegistration(ctx context.Context, userID int, req schema.WebAuthnRegisterRequest) (*schema.WebAuthnCredentialItem, error) {
+	s.logger.Info("完成凭证注册", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	wa, err := s.newWebAuthn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.consumeSession(ctx, fmt.Sprintf(webAuthnRegisterSessionKeyFormat, userID))
+	if err != nil {
+		return nil, err
+	}
+
+	waUser, err := s.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(req.Credential)
+	if err != nil {
+		s.logger.Warn("解析注册结果失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, errors.New("注册数据格式错误")
+	}
+
+	credential, err := wa.CreateCredential(waUser, *session, parsed)
+	if err != nil {
+		s.logger.Warn("校验注册结果失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, errors.New("通行密钥校验失败")
+	}
+
+	transports := make([]string, len(credential.Transport))
+	for i, transport := range credential.Transport {
+		transports[i] = string(transport)
+	}
+
+	record, err := s.db.WebAuthnCredential.Create().
+		SetUserID(userID).
+		SetCredentialID(base64.RawURLEncoding.EncodeToString(credential.ID)).
+		SetPublicKey(credential.PublicKey).
+		SetAttestationType(credential.AttestationType).
+		SetAaguid(credential.Authenticator.AAGUID).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetTransports(transports).
+		SetFlags(uint8(credential.Flags.ProtocolValue())).
+		SetName(req.Name).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errors.New("该通行密钥已注册")
+		}
+		s.logger.Error("保存凭证失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("保存凭证失败: %w", err)
+	}
+
+	s.logger.Info("凭证注册成功", zap.Int("user_id", userID), zap.Int("credential_id", record.ID), tracing.WithTraceIDField(ctx))
+	item := s.toCredentialItem(record)
+	return &item, nil
+}
+
+// BeginLogin 生成无密码登录选项
+func (s *WebAuthnService) BeginLogin(ctx context.Context) (*schema.WebAuthnLoginOptionsResponse, error) {
+	s.logger.Info("生成无密码登录选项", tracing.WithTraceIDField(ctx))
+
+	wa, err := s.newWebAuthn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用可发现凭证登录，由认证器返回用户句柄
+	assertion, session, err := wa.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		s.logger.Error("生成登录选项失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("生成登录选项失败: %w", err)
+	}
+
+	sessionID := utils.RandomString(32)
+	if err = s.saveSession(ctx, fmt.Sprintf(webAuthnLoginSessionKeyFormat, sessionID), session); err != nil {
+		return nil, err
+	}
+
+	return &schema.WebAuthnLoginOptionsResponse{
+		SessionID: sessionID,
+		Options:   assertion,
+	}, nil
+}
+
+// FinishLogin 校验登录断言，返回登录用户
+func (s *WebAuthnService) FinishLogin(ctx context.Context, req schema.WebAuthnLoginRequest) (*ent.User, error) {
+	s.logger.Info("完成无密码登录", tracing.WithTraceIDField(ctx))
+
+	wa, err := s.newWebAuthn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.consumeSession(ctx, fmt.Sprintf(webAuthnLoginSessionKeyFormat, req.SessionID))
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(req.Credential)
+	if err != nil {
+		s.logger.Warn("解析登录断言失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, errors.New("登录数据格式错误")
+	}
+
+	// 根据认证器返回的用户句柄加载用户及其凭证
+	handler := func(_, userHandle []byte) (webauthn.User, error) {
+		userID, err := strconv.Atoi(string(userHandle))
+		if err != nil {
+			return nil, errors.New("无效的用户句柄")
+		}
+		return s.loadUser(ctx, userID)
+	}
+
+	waUser, credential, err := wa.ValidatePasskeyLogin(handler, *session, parsed)
+	if err != nil {
+		s.logger.Warn("校验登录断言失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, errors.New("通行密钥校验失败")
+	}
+
+	u := waUser.(*webAuthnUser).user
+	if err = checkUserLoginStatus(u); err != nil {
+		return nil, err
+	}
+
+	// 更新签名计数器与使用时间
+	credentialID := base64.RawURLEncoding.EncodeToString(credential.ID)
+	_, err = s.db.WebAuthnCredential.Update().
+		Where(webauthncredential.CredentialIDEQ(credentialID)).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetCloneWarning(credential.Authenticator.CloneWarning).
+		SetFlags(uint8(credential.Flags.ProtocolValue())).
+		SetLastUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		s.logger.Warn("更新凭证使用信息失败", zap.String("credential_id", credentialID), zap.Error(err), tracing.WithTraceIDField(ctx))
+	}
+
+	// 签名计数器回退说明认证器可能被克隆，拒绝本次登录
+	if credential.Authenticator.CloneWarning {
+		s.logger.Warn("检测到认证器克隆警告", zap.Int("user_id", u.ID), zap.String("credential_id", credentialID), tracing.WithTraceIDField(ctx))
+		return nil, errors.New("通行密钥存在安全风险，请删除后重新注册")
+	}
+
+	return u, nil
+}
+
+// GetCredentials 获取用户的凭证列表
+func (s *WebAuthnService) GetCredentials(ctx context.Context, userID int) ([]schema.WebAuthnCredentialItem, error) {
+	s.logger.Info("获取凭证列表", zap.Int("user_id", userID), tracing.WithTraceIDField(ctx))
+
+	records, err := s.db.WebAuthnCredential.Query().
+		Where(webauthncredential.UserIDEQ(userID)).
+		Order(ent.Asc(webauthncredential.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("查询凭证失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询凭证失败: %w", err)
+	}
+
+	list := make([]schema.WebAuthnCredentialItem, len(records))
+	for i, record := range records {
+		list[i] = s.toCredentialItem(record)
+	}
+	return list, nil
+}
+
+// DeleteCredential 删除用户的凭证
+func (s *WebAuthnService) DeleteCredential(ctx context.Context, userID int, req schema.WebAuthnCredentialDeleteRequest) error {
+	s.logger.Info("删除凭证", zap.Int("user_id", userID), zap.Int("id", req.ID), tracing.WithTraceIDField(ctx))
+
+	exists, err := s.db.WebAuthnCredential.Query().
+		Where(
+			webauthncredential.IDEQ(req.ID),
+			webauthncredential.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		s.logger.Error("查询凭证失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("查询凭证失败: %w", err)
+	}
+	if !exists {
+		return errors.New("通行密钥不存在")
+	}
+
+	// 至少保留一种登录方式
+	methods, err := countLoginMethods(ctx, s.db, userID)
+	if err != nil {
+		s.logger.Error("统计用户登录方式失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return err
+	}
+	if methods <= 1 {
+		return errors.New("这是您唯一的登录方式，请先绑定其他账号后再删除")
+	}
+
+	deleted, err := s.db.WebAuthnCredential.Delete().
+		Where(
+			webauthncredential.IDEQ(req.ID),
+			webauthncredential.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		s.logger.Error("删除凭证失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("删除凭证失败: %w", err)
+	}
+	if deleted == 0 {
+		return errors.New("通行密钥不存在")
+	}
+
+	return nil
+}
+
+// newWebAuthn 根据FIDO2提供商配置创建WebAuthn实例
+// redirect_url 作为默认的RP源，extra_config 可通过 rp_id、rp_display_name、rp_origins 覆盖
+func (s *WebAuthnService) newWebAuthn(ctx context.Context) (*webauthn.WebAuthn, error) {
+	config, err := s.db.OAuthProvider.Query().
+		Where(
+			oauthprovider.ProviderEQ(oauthprovider.ProviderFIDO2),
+			oauthprovider.EnabledEQ(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("通行密钥登录未启用")
+		}
+		s.logger.Error("获取FIDO2配置失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("获取FIDO2配置失败: %w", err)
+	}
+
+	waConfig := &webauthn.Config{
+		RPDisplayName: "PokeForum",
+	}
+	if u, err := url.Parse(config.RedirectURL); err == nil && u.Host != "" {
+		waConfig.RPID = u.Hostname()
+		waConfig.RPOrigins = []string{u.Scheme + "://" + u.Host}
+	}
+	if rpID, ok := config.ExtraConfig["rp_id"].(string); ok && rpID != "" {
+		waConfig.RPID = rpID
+	}
+	if name, ok := config.ExtraConfig["rp_display_name"].(string); ok && name != "" {
+		waConfig.RPDisplayName = name
+	}
+	if origins, ok := config.ExtraConfig["rp_origins"].([]interface{}); ok && len(origins) > 0 {
+		waConfig.RPOrigins = make([]string, 0, len(origins))
+		for _, origin := range origins {
+			if o, ok := origin.(string); ok && o != "" {
+				waConfig.RPOrigins = append(waConfig.RPOrigins, o)
+			}
+		}
+	}
+
+	wa, err := webauthn.New(waConfig)
+	if err != nil {
+		s.logger.Error("FIDO2配置无效", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, errors.New("通行密钥配置不完整")
+	}
+	return wa, nil
+}
+
+// loadUser 加载用户及其已注册的凭证
+func (s *WebAuthnService) loadUser(ctx context.Context, userID int) (*webAuthnUser, error) {
+	u, err := s.db.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("用户不存在")
+		}
+		s.logger.Error("查询用户失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+
+	records, err := s.db.WebAuthnCredential.Query().
+		Where(webauthncredential.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("查询凭证失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return nil, fmt.Errorf("查询凭证失败: %w", err)
+	}
+
+	credentials := make([]webauthn.Credential, 0, len(records))
+	for _, record := range records {
+		id, err := base64.RawURLEncoding.DecodeString(record.CredentialID)
+		if err != nil {
+			s.logger.Warn("凭证ID格式错误", zap.Int("id", record.ID), zap.Error(err), tracing.WithTraceIDField(ctx))
+			continue
+		}
+		transports := make([]protocol.AuthenticatorTransport, len(record.Transports))
+		for i, transport := range record.Transports {
+			transports[i] = protocol.AuthenticatorTransport(transport)
+		}
+		credentials = append(credentials, webauthn.Credential{
+			ID:              id,
+			PublicKey:       record.PublicKey,
+			AttestationType: record.AttestationType,
+			Transport:       transports,
+			Flags:           webauthn.NewCredentialFlags(protocol.AuthenticatorFlags(record.Flags)),
+			Authenticator: webauthn.Authenticator{
+				AAGUID:       record.Aaguid,
+				SignCount:    record.SignCount,
+				CloneWarning: record.CloneWarning,
+			},
+		})
+	}
+
+	return &webAuthnUser{
+		user:        u,
+		credentials: credentials,
+	}, nil
+}
+
+// saveSession 保存仪式会话数据
+func (s *WebAuthnService) saveSession(ctx context.Context, key string, session *webauthn.SessionData) error {
+	value, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("序列化会话失败: %w", err)
+	}
+	if err = s.cache.SetEx(ctx, key, string(value), webAuthnSessionExpire); err != nil {
+		s.logger.Error("存储会话失败", zap.Error(err), tracing.WithTraceIDField(ctx))
+		return fmt.Errorf("存储会话失败: %w", err)
+	}
+	return nil
+}
+
+// consumeSession 读取并删除仪式会话数据，会话只能使用一次
+func (s *WebAuthnService) consumeSession(ctx context.Context, key string) (*webauthn.SessionData, error) {
+	value, err := s.cache.Get(ctx, key)
+	if err != nil || value == "" {
+		return nil, errors.New("会话已过期，请重新操作")
+	}
+	deleted, err := s.cache.Del(ctx, key)
+	if err != nil || deleted == 0 {
+		return nil, errors.New("会话已过期，请重新操作")
+	}
+
+	var session webauthn.SessionData
+	if err = json.Unmarshal([]byte(value), &session); err != nil {
+		return nil, errors.New("会话数据无效")
+	}
+	return &session, nil
+}
+
+// toCredentialItem 转换为凭证响应项
+func (s *WebAuthnService) toCredentialItem(record *ent.WebAuthnCredential) schema.WebAuthnCredentialItem {
+	item := schema.WebAuthnCredentialItem{
+		ID:           record.ID,
+		Name:         record.Name,
+		Transports:   record.Transports,
+		CloneWarning: record.CloneWarning,
+		CreatedAt:    record.CreatedAt.Format(time_tools.DateTimeFormat),
+	}
+	if !record.LastUsedAt.IsZero() {
+		item.LastUsedAt = record.LastUsedAt.Format(time_tools.DateTimeFormat)
+	}
+	return item
+}
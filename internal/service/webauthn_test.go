@@ -0,0 +1,312 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"go.uber.org/zap"
+
+	"github.com/PokeForum/PokeForum/ent"
+	"github.com/PokeForum/PokeForum/ent/oauthprovider"
+	"github.com/PokeForum/PokeForum/ent/webauthncredential"
+	"github.com/PokeForum/PokeForum/internal/schema"
+)
+
+const (
+	testRPID     = "forum.example.com"
+	testRPOrigin = "https://forum.example.com"
+)
+
+// 认证器数据标志位
+const (
+	authFlagUserPresent  byte = 0x01
+	authFlagUserVerified byte = 0x04
+	authFlagAttestedData byte = 0x40
+)
+
+// softAuthenticator 软件实现的认证器，用于模拟浏览器完成注册与登录仪式
+type softAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	signCount    uint32
+}
+
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("生成密钥失败: %v", err)
+	}
+	credentialID := make([]byte, 16)
+	if _, err = rand.Read(credentialID); err != nil {
+		t.Fatalf("生成凭证ID失败: %v", err)
+	}
+	return &softAuthenticator{key: key, credentialID: credentialID}
+}
+
+// clientData 构造客户端数据JSON
+func (a *softAuthenticator) clientData(t *testing.T, ceremony protocol.CeremonyType, challenge protocol.URLEncodedBase64) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]interface{}{
+		"type":      ceremony,
+		"challenge": challenge,
+		"origin":    testRPOrigin,
+	})
+	if err != nil {
+		t.Fatalf("序列化客户端数据失败: %v", err)
+	}
+	return data
+}
+
+// authData 构造认证器数据，attested为true时附带凭证公钥
+func (a *softAuthenticator) authData(t *testing.T, flags byte, attested bool) []byte {
+	t.Helper()
+
+	rpIDHash := sha256.Sum256([]byte(testRPID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	if !attested {
+		return data
+	}
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		t.Fatalf("编码公钥失败: %v", err)
+	}
+
+	data = append(data, make([]byte, 16)...) // AAGUID
+	data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+	data = append(data, a.credentialID...)
+	return append(data, publicKey...)
+}
+
+// create 响应注册选项，返回navigator.credentials.create的结果
+func (a *softAuthenticator) create(t *testing.T, options *protocol.CredentialCreation) json.RawMessage {
+	t.Helper()
+
+	a.userHandle = options.Response.User.ID.(protocol.URLEncodedBase64)
+	clientDataJSON := a.clientData(t, protocol.CreateCeremony, options.Response.Challenge)
+
+	attestation, err := webauthncbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": a.authData(t, authFlagUserPresent|authFlagUserVerified|authFlagAttestedData, true),
+	})
+	if err != nil {
+		t.Fatalf("编码证明对象失败: %v", err)
+	}
+
+	return a.marshal(t, map[string]interface{}{
+		"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+		"attestationObject": base64.RawURLEncoding.EncodeToString(attestation),
+	})
+}
+
+// get 响应登录选项，返回navigator.credentials.get的结果
+func (a *softAuthenticator) get(t *testing.T, options *protocol.CredentialAssertion) json.RawMessage {
+	t.Helper()
+
+	clientDataJSON := a.clientData(t, protocol.AssertCeremony, options.Response.Challenge)
+	authData := a.authData(t, authFlagUserPresent|authFlagUserVerified, false)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatalf("签名失败: %v", err)
+	}
+
+	return a.marshal(t, map[string]interface{}{
+		"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+		"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+		"signature":         base64.RawURLEncoding.EncodeToString(signature),
+		"userHandle":        base64.RawURLEncoding.EncodeToString(a.userHandle),
+	})
+}
+
+func (a *softAuthenticator) marshal(t *testing.T, response map[string]interface{}) json.RawMessage {
+	t.Helper()
+
+	id := base64.RawURLEncoding.EncodeToString(a.credentialID)
+	data, err := json.Marshal(map[string]interface{}{
+		"id":       id,
+		"rawId":    id,
+		"type":     "public-key",
+		"response": response,
+	})
+	if err != nil {
+		t.Fatalf("序列化凭证失败: %v", err)
+	}
+	return data
+}
+
+// newTestWebAuthnService 创建启用FIDO2配置的WebAuthn服务
+func newTestWebAuthnService(t *testing.T) (*ent.Client, IWebAuthnService) {
+	t.Helper()
+
+	db := newTestDB(t)
+	newTestSaToken(t)
+
+	_, err := db.OAuthProvider.Create().
+		SetProvider(oauthprovider.ProviderFIDO2).
+		SetRedirectURL(testRPOrigin + "/login").
+		SetEnabled(true).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("创建FIDO2配置失败: %v", err)
+	}
+
+	return db, NewWebAuthnService(db, newTestCache(t), zap.NewNop())
+}
+
+// registerPasskey 使用软件认证器为用户注册通行密钥
+func registerPasskey(t *testing.T, svc IWebAuthnService, userID int, authenticator *softAuthenticator) {
+	t.Helper()
+
+	options, err := svc.BeginRegistration(t.Context(), userID)
+	if err != nil {
+		t.Fatalf("生成注册选项失败: %v", err)
+	}
+	_, err = svc.FinishRegistration(t.Context(), userID, schema.WebAuthnRegisterRequest{
+		Name:       "soft",
+		Credential: authenticator.create(t, options.Options),
+	})
+	if err != nil {
+		t.Fatalf("完成注册失败: %v", err)
+	}
+}
+
+// loginPasskey 使用软件认证器完成一次无密码登录
+func loginPasskey(t *testing.T, svc IWebAuthnService, authenticator *softAuthenticator) (*ent.User, error) {
+	t.Helper()
+
+	options, err := svc.BeginLogin(t.Context())
+	if err != nil {
+		t.Fatalf("生成登录选项失败: %v", err)
+	}
+	return svc.FinishLogin(t.Context(), schema.WebAuthnLoginRequest{
+		SessionID:  options.SessionID,
+		Credential: authenticator.get(t, options.Options),
+	})
+}
+
+func TestWebAuthnRegisterAndLogin(t *testing.T) {
+	db, svc := newTestWebAuthnService(t)
+	u := newTestUser(t, db, "alice", "alice@example.com")
+	authenticator := newSoftAuthenticator(t)
+
+	registerPasskey(t, svc, u.ID, authenticator)
+
+	if string(authenticator.userHandle) != strconv.Itoa(u.ID) {
+		t.Fatalf("用户句柄应为用户ID，实际为 %q", authenticator.userHandle)
+	}
+
+	credentials, err := svc.GetCredentials(t.Context(), u.ID)
+	if err != nil {
+		t.Fatalf("获取凭证列表失败: %v", err)
+	}
+	if len(credentials) != 1 || credentials[0].Name != "soft" {
+		t.Fatalf("凭证列表不符合预期: %+v", credentials)
+	}
+
+	authenticator.signCount = 1
+	loggedIn, err := loginPasskey(t, svc, authenticator)
+	if err != nil {
+		t.Fatalf("通行密钥登录失败: %v", err)
+	}
+	if loggedIn.ID != u.ID {
+		t.Fatalf("登录用户应为 %d，实际为 %d", u.ID, loggedIn.ID)
+	}
+
+	record, err := db.WebAuthnCredential.Query().
+		Where(webauthncredential.UserIDEQ(u.ID)).
+		Only(t.Context())
+	if err != nil {
+		t.Fatalf("查询凭证失败: %v", err)
+	}
+	if record.SignCount != 1 || record.LastUsedAt.IsZero() {
+		t.Fatalf("登录后应更新签名计数与使用时间: sign_count=%d last_used_at=%v", record.SignCount, record.LastUsedAt)
+	}
+}
+
+func TestWebAuthnLoginSessionSingleUse(t *testing.T) {
+	db, svc := newTestWebAuthnService(t)
+	u := newTestUser(t, db, "alice", "alice@example.com")
+	authenticator := newSoftAuthenticator(t)
+	registerPasskey(t, svc, u.ID, authenticator)
+
+	options, err := svc.BeginLogin(t.Context())
+	if err != nil {
+		t.Fatalf("生成登录选项失败: %v", err)
+	}
+	authenticator.signCount = 1
+	req := schema.WebAuthnLoginRequest{
+		SessionID:  options.SessionID,
+		Credential: authenticator.get(t, options.Options),
+	}
+	if _, err = svc.FinishLogin(t.Context(), req); err != nil {
+		t.Fatalf("首次登录失败: %v", err)
+	}
+	if _, err = svc.FinishLogin(t.Context(), req); err == nil {
+		t.Fatal("重放同一登录会话应被拒绝")
+	}
+}
+
+func TestWebAuthnLoginRejectsSignCountRegression(t *testing.T) {
+	db, svc := newTestWebAuthnService(t)
+	u := newTestUser(t, db, "alice", "alice@example.com")
+	authenticator := newSoftAuthenticator(t)
+	registerPasskey(t, svc, u.ID, authenticator)
+
+	authenticator.signCount = 5
+	if _, err := loginPasskey(t, svc, authenticator); err != nil {
+		t.Fatalf("首次登录失败: %v", err)
+	}
+
+	// 计数器回退，模拟被克隆的认证器
+	authenticator.signCount = 3
+	if _, err := loginPasskey(t, svc, authenticator); err == nil {
+		t.Fatal("签名计数器回退时应拒绝登录")
+	}
+
+	record, err := db.WebAuthnCredential.Query().
+		Where(webauthncredential.UserIDEQ(u.ID)).
+		Only(t.Context())
+	if err != nil {
+		t.Fatalf("查询凭证失败: %v", err)
+	}
+	if !record.CloneWarning {
+		t.Fatal("签名计数器回退后应记录克隆警告")
+	}
+	if record.SignCount != 5 {
+		t.Fatalf("签名计数器不应回退，期望 5，实际 %d", record.SignCount)
+	}
+
+	// 已标记克隆警告的凭证即使计数器恢复递增也不能继续登录
+	authenticator.signCount = 10
+	if _, err = loginPasskey(t, svc, authenticator); err == nil {
+		t.Fatal("存在克隆警告的凭证应持续被拒绝")
+	}
+}
@@ -0,0 +1,265 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// diffContextLines 统一diff每个变更块保留的上下文行数
+	diffContextLines = 3
+	// diffMaxMatrixSize 逐行比对的最大计算规模（两段差异行数之积），用于限制耗时，超出时中间差异部分按整体替换输出
+	// 比对使用Hirschberg算法，内存占用与行数成线性关系
+	diffMaxMatrixSize = 4_000_000
+)
+
+// diffLine 单行比对结果
+type diffLine struct {
+	// 操作类型：' ' 未变更、'-' 删除、'+' 新增
+	op   byte
+	text string
+	// 所在原文本行号与新文本行号（从1开始，不存在时为0）
+	oldLine int
+	newLine int
+}
+
+// UnifiedDiff 生成两段文本的逐行统一diff（unified diff）
+// fromName、toName 为diff头部显示的名称，文本相同时返回空字符串
+func UnifiedDiff(fromName, toName, from, to string) string {
+	if from == to {
+		return ""
+	}
+
+	lines := diffLines(splitLines(from), splitLines(to))
+
+	var b strings.Builder
+	b.WriteString("--- " + fromName + "\n")
+	b.WriteString("+++ " + toName + "\n")
+
+	for start := 0; start < len(lines); {
+		// 定位下一个变更行
+		for start < len(lines) && lines[start].op == ' ' {
+			start++
+		}
+		if start >= len(lines) {
+			break
+		}
+
+		// 向前保留上下文，向后合并间隔不超过两倍上下文的变更
+		hunkStart := max(start-diffContextLines, 0)
+		end := start
+		for i := start; i < len(lines); i++ {
+			if lines[i].op != ' ' {
+				end = i
+			} else if i-end > 2*diffContextLines {
+				break
+			}
+		}
+		hunkEnd := min(end+diffContextLines+1, len(lines))
+
+		writeHunk(&b, lines[hunkStart:hunkEnd])
+		start = hunkEnd
+	}
+
+	return b.String()
+}
+
+// writeHunk 输出单个变更块
+func writeHunk(b *strings.Builder, hunk []diffLine) {
+	oldStart, newStart, oldCount, newCount := 0, 0, 0, 0
+	for _, l := range hunk {
+		if l.op != '+' {
+			if oldStart == 0 {
+				oldStart = l.oldLine
+			}
+			oldCount++
+		}
+		if l.op != '-' {
+			if newStart == 0 {
+				newStart = l.newLine
+			}
+			newCount++
+		}
+	}
+	// 变更块中无对应行时，行号取前一行（与GNU diff一致）
+	if oldStart == 0 {
+		oldStart = hunkLineBefore(hunk, true)
+	}
+	if newStart == 0 {
+		newStart = hunkLineBefore(hunk, false)
+	}
+
+	fmt.Fprintf(b, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+	for _, l := range hunk {
+		b.WriteByte(l.op)
+		b.WriteString(l.text)
+		b.WriteByte('\n')
+	}
+}
+
+// hunkLineBefore 计算变更块之前的行号
+func hunkLineBefore(hunk []diffLine, old bool) int {
+	for _, l := range hunk {
+		if old && l.op == '+' {
+			return l.oldLine
+		}
+		if !old && l.op == '-' {
+			return l.newLine
+		}
+	}
+	return 0
+}
+
+// hunkRange 格式化变更块行号范围
+func hunkRange(start, count int) string {
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// splitLines 按行拆分文本，统一换行符
+func splitLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffLines 基于最长公共子序列计算逐行差异
+func diffLines(a, b []string) []diffLine {
+	// 去除公共前缀与后缀，缩小计算规模
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+
+	result := make([]diffLine, 0, len(a)+len(b))
+	oldLine, newLine := 0, 0
+	appendLine := func(op byte, text string) {
+		switch op {
+		case ' ':
+			oldLine++
+			newLine++
+			result = append(result, diffLine{op: op, text: text, oldLine: oldLine, newLine: newLine})
+		case '-':
+			oldLine++
+			result = append(result, diffLine{op: op, text: text, oldLine: oldLine, newLine: newLine})
+		case '+':
+			newLine++
+			result = append(result, diffLine{op: op, text: text, oldLine: oldLine, newLine: newLine})
+		}
+	}
+
+	for _, text := range a[:prefix] {
+		appendLine(' ', text)
+	}
+
+	if len(midA)*len(midB) > diffMaxMatrixSize {
+		// 差异过大时按整体替换输出
+		for _, text := range midA {
+			appendLine('-', text)
+		}
+		for _, text := range midB {
+			appendLine('+', text)
+		}
+	} else {
+		diffLCS(midA, midB, appendLine)
+	}
+
+	for _, text := range a[len(a)-suffix:] {
+		appendLine(' ', text)
+	}
+
+	return result
+}
+
+// diffLCS 使用Hirschberg算法按最长公共子序列输出逐行差异
+// 每层递归只保留两行长度表，避免构造 len(a)*len(b) 的完整矩阵
+func diffLCS(a, b []string, emit func(op byte, text string)) {
+	switch {
+	case len(a) == 0:
+		for _, text := range b {
+			emit('+', text)
+		}
+		return
+	case len(b) == 0:
+		for _, text := range a {
+			emit('-', text)
+		}
+		return
+	case len(a) == 1:
+		for j, text := range b {
+			if text == a[0] {
+				for _, added := range b[:j] {
+					emit('+', added)
+				}
+				emit(' ', text)
+				for _, added := range b[j+1:] {
+					emit('+', added)
+				}
+				return
+			}
+		}
+		emit('-', a[0])
+		for _, text := range b {
+			emit('+', text)
+		}
+		return
+	}
+
+	// 以a的中间行为界，寻找使前后两半公共子序列长度之和最大的b分割点
+	mid := len(a) / 2
+	forward := lcsLengths(a[:mid], b)
+	backward := lcsLengthsReverse(a[mid:], b)
+	split, best := 0, -1
+	for j := 0; j <= len(b); j++ {
+		if total := forward[j] + backward[j]; total > best {
+			split, best = j, total
+		}
+	}
+
+	diffLCS(a[:mid], b[:split], emit)
+	diffLCS(a[mid:], b[split:], emit)
+}
+
+// lcsLengths 计算a与b[:j]（j=0..len(b)）的最长公共子序列长度
+func lcsLengths(a, b []string) []int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				curr[j+1] = prev[j] + 1
+			} else {
+				curr[j+1] = max(prev[j+1], curr[j])
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev
+}
+
+// lcsLengthsReverse 计算a与b[j:]（j=0..len(b)）的最长公共子序列长度
+func lcsLengthsReverse(a, b []string) []int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				curr[j] = prev[j+1] + 1
+			} else {
+				curr[j] = max(prev[j], curr[j+1])
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev
+}
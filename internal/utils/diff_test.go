@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// numberedLines 生成指定行数的文本，每行内容为行号
+func numberedLines(n int) []string {
+	lines := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	return lines
+}
+
+// applyDiff 按diff行还原两段文本，用于校验diff的完整性
+func applyDiff(lines []diffLine) (string, string) {
+	var from, to []string
+	for _, l := range lines {
+		if l.op != '+' {
+			from = append(from, l.text)
+		}
+		if l.op != '-' {
+			to = append(to, l.text)
+		}
+	}
+	return strings.Join(from, "\n"), strings.Join(to, "\n")
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	ten := strings.Join(numberedLines(10), "\n") + "\n"
+
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want string
+	}{
+		{
+			name: "文本相同",
+			from: "a\nb\n",
+			to:   "a\nb\n",
+			want: "",
+		},
+		{
+			name: "修改中间行",
+			from: "a\nb\nc\n",
+			to:   "a\nx\nc\n",
+			want: "--- v1\n+++ v2\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			name: "末尾追加",
+			from: "a\n",
+			to:   "a\nb\n",
+			want: "--- v1\n+++ v2\n@@ -1 +1,2 @@\n a\n+b\n",
+		},
+		{
+			name: "由空文本新增",
+			from: "",
+			to:   "a\n",
+			want: "--- v1\n+++ v2\n@@ -0,0 +1 @@\n+a\n",
+		},
+		{
+			name: "统一换行符",
+			from: "a\r\nb\r\n",
+			to:   "a\nb\nc\n",
+			want: "--- v1\n+++ v2\n@@ -1,2 +1,3 @@\n a\n b\n+c\n",
+		},
+		{
+			name: "只保留三行上下文",
+			from: ten,
+			to:   strings.Replace(ten, "line 5\n", "line five\n", 1),
+			want: "--- v1\n+++ v2\n@@ -2,7 +2,7 @@\n line 2\n line 3\n line 4\n-line 5\n+line five\n line 6\n line 7\n line 8\n",
+		},
+		{
+			name: "相距较远的变更拆分为多个变更块",
+			from: ten,
+			to:   strings.Replace(strings.Replace(ten, "line 1\n", "line one\n", 1), "line 10\n", "line ten\n", 1),
+			want: "--- v1\n+++ v2\n@@ -1,4 +1,4 @@\n-line 1\n+line one\n line 2\n line 3\n line 4\n" +
+				"@@ -7,4 +7,4 @@\n line 7\n line 8\n line 9\n-line 10\n+line ten\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnifiedDiff("v1", "v2", tt.from, tt.to); got != tt.want {
+				t.Fatalf("diff不符合预期\n期望:\n%s\n实际:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        []string
+		to          []string
+		wantChanged int // 期望的删除与新增行数之和
+	}{
+		{name: "交错修改", from: []string{"a", "b", "c", "d", "e"}, to: []string{"b", "x", "d", "e", "f"}, wantChanged: 4},
+		{name: "完全不同", from: []string{"a", "b"}, to: []string{"c", "d", "e"}, wantChanged: 5},
+		{name: "重复行", from: []string{"a", "a", "b", "a"}, to: []string{"a", "b", "a", "a"}, wantChanged: 2},
+		{
+			name:        "大文本少量修改仍逐行比对",
+			from:        numberedLines(1500),
+			to:          append(append([]string{"head"}, numberedLines(1500)[1:750]...), append([]string{"middle"}, numberedLines(1500)[751:]...)...),
+			wantChanged: 4,
+		},
+		{
+			name:        "超出计算规模时整体替换",
+			from:        append(numberedLines(3000), "tail"),
+			to:          append(append([]string{"head"}, numberedLines(3000)[1:]...), "changed"),
+			wantChanged: 6002,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := diffLines(tt.from, tt.to)
+
+			changed := 0
+			for _, l := range lines {
+				if l.op != ' ' {
+					changed++
+				}
+			}
+			if changed != tt.wantChanged {
+				t.Fatalf("变更行数应为 %d，实际 %d", tt.wantChanged, changed)
+			}
+
+			from, to := applyDiff(lines)
+			if from != strings.Join(tt.from, "\n") || to != strings.Join(tt.to, "\n") {
+				t.Fatal("diff无法还原原文本与新文本")
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// IsTrustedProxy 判断直连IP是否属于受信任的代理
+// trusted 支持单个IP或CIDR网段，例如: "127.0.0.1"、"173.245.48.0/20"
+// 列表为空时不信任任何代理
+func IsTrustedProxy(remoteIP string, trusted []string) bool {
+	ip := net.ParseIP(strings.TrimSpace(remoteIP))
+	if ip == nil {
+		return false
+	}
+
+	for _, item := range trusted {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		// CIDR网段
+		if strings.Contains(item, "/") {
+			_, network, err := net.ParseCIDR(item)
+			if err == nil && network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		// 单个IP
+		if trustedIP := net.ParseIP(item); trustedIP != nil && trustedIP.Equal(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TrustedGeoHeaders 读取前置CDN注入的IP归属地请求头
+// 仅在直连地址为受信任代理时读取，防止客户端伪造；不受信任时返回空值
+func TrustedGeoHeaders(remoteIP string, trusted []string, header http.Header) (country string, city string) {
+	if !IsTrustedProxy(remoteIP, trusted) {
+		return "", ""
+	}
+	return header.Get("CF-IPCountry"), header.Get("CF-IPCity")
+}